@@ -0,0 +1,42 @@
+package atc
+
+// ActivityEventType is the kind of change an ActivityEvent describes.
+type ActivityEventType string
+
+const (
+	ActivityBuildStarted               ActivityEventType = "build_started"
+	ActivityBuildFinished              ActivityEventType = "build_finished"
+	ActivityJobChanged                 ActivityEventType = "job_changed"
+	ActivityPipelineChanged            ActivityEventType = "pipeline_changed"
+	ActivityPipelineDestroyed          ActivityEventType = "pipeline_destroyed"
+	ActivityResourceVersionsDiscovered ActivityEventType = "resource_versions_discovered"
+
+	// ActivityResync is sent when events may have been missed, e.g. when the
+	// web node lost its connection to the database. Clients should refetch
+	// whatever state they are keeping.
+	ActivityResync ActivityEventType = "resync"
+)
+
+// ActivityEvent is a change in a team's builds, jobs, pipelines or
+// resources, as sent on the activity streams. Only the fields relevant to
+// the type of event are set.
+type ActivityEvent struct {
+	Type ActivityEventType `json:"type"`
+	Time int64             `json:"time"`
+
+	TeamName             string       `json:"team_name,omitempty"`
+	PipelineID           int          `json:"pipeline_id,omitempty"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string       `json:"job_name,omitempty"`
+	ResourceName         string       `json:"resource_name,omitempty"`
+
+	BuildID     int         `json:"build_id,omitempty"`
+	BuildName   string      `json:"build_name,omitempty"`
+	BuildStatus BuildStatus `json:"build_status,omitempty"`
+
+	Paused   *bool `json:"paused,omitempty"`
+	Archived *bool `json:"archived,omitempty"`
+	Public   *bool `json:"public,omitempty"`
+	Active   *bool `json:"active,omitempty"`
+}
@@ -14,6 +14,8 @@ const (
 var DefaultRoles = map[string]string{
 	atc.SaveConfig:                     MemberRole,
 	atc.GetConfig:                      ViewerRole,
+	atc.ListConfigVersions:             ViewerRole,
+	atc.GetConfigVersion:               ViewerRole,
 	atc.GetCC:                          ViewerRole,
 	atc.GetBuild:                       ViewerRole,
 	atc.GetBuildPlan:                   ViewerRole,
@@ -23,6 +25,8 @@ var DefaultRoles = map[string]string{
 	atc.BuildResources:                 ViewerRole,
 	atc.AbortBuild:                     OperatorRole,
 	atc.GetBuildPreparation:            ViewerRole,
+	atc.GetBuildTestResults:            ViewerRole,
+	atc.GetBuildProvenance:             ViewerRole,
 	atc.GetJob:                         ViewerRole,
 	atc.CreateJobBuild:                 OperatorRole,
 	atc.RerunJobBuild:                  OperatorRole,
@@ -31,6 +35,8 @@ var DefaultRoles = map[string]string{
 	atc.ListJobs:                       ViewerRole,
 	atc.ListJobBuilds:                  ViewerRole,
 	atc.ListJobInputs:                  ViewerRole,
+	atc.ListJobTests:                   ViewerRole,
+	atc.GetJobSLO:                      ViewerRole,
 	atc.GetJobBuild:                    ViewerRole,
 	atc.PauseJob:                       OperatorRole,
 	atc.UnpauseJob:                     OperatorRole,
@@ -89,6 +95,8 @@ var DefaultRoles = map[string]string{
 	atc.ListContainers:                 ViewerRole,
 	atc.GetContainer:                   ViewerRole,
 	atc.HijackContainer:                MemberRole,
+	atc.ListInterceptSessions:          OwnerRole,
+	atc.GetInterceptRecording:          OwnerRole,
 	atc.ListDestroyingContainers:       ViewerRole,
 	atc.ReportWorkerContainers:         MemberRole,
 	atc.ListVolumes:                    ViewerRole,
@@ -100,6 +108,13 @@ var DefaultRoles = map[string]string{
 	atc.RenameTeam:                     OwnerRole,
 	atc.DestroyTeam:                    OwnerRole,
 	atc.ListTeamBuilds:                 ViewerRole,
+	atc.SearchBuildLogs:                ViewerRole,
+	atc.ListWebhooks:                   MemberRole,
+	atc.SetWebhook:                     OwnerRole,
+	atc.DestroyWebhook:                 OwnerRole,
+	atc.ListWebhookDeliveries:          MemberRole,
+	atc.TeamActivity:                   ViewerRole,
+	atc.GraphQL:                        ViewerRole,
 	atc.CreateArtifact:                 MemberRole,
 	atc.GetArtifact:                    MemberRole,
 	atc.ListBuildArtifacts:             ViewerRole,
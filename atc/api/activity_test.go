@@ -0,0 +1,240 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/tedsuo/rata"
+	"github.com/vito/go-sse/sse"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Activity API", func() {
+	var (
+		requestGenerator *rata.RequestGenerator
+
+		fakeSubscription *dbfakes.FakeActivitySubscription
+		events           chan db.ActivityEvent
+
+		requestHeader http.Header
+		response      *http.Response
+	)
+
+	BeforeEach(func() {
+		requestGenerator = rata.NewRequestGenerator(server.URL, atc.Routes)
+		requestHeader = http.Header{}
+
+		events = make(chan db.ActivityEvent, 10)
+
+		fakeSubscription = new(dbfakes.FakeActivitySubscription)
+		fakeSubscription.NextStub = func(ctx context.Context) (db.ActivityEvent, error) {
+			select {
+			case event := <-events:
+				return event, nil
+			case <-ctx.Done():
+				return db.ActivityEvent{}, ctx.Err()
+			}
+		}
+
+		dbActivityFeed.SubscribeReturns(fakeSubscription, nil)
+	})
+
+	AfterEach(func() {
+		if response != nil {
+			response.Body.Close()
+		}
+	})
+
+	buildFinished := func(teamID int, buildID int) db.ActivityEvent {
+		return db.ActivityEvent{
+			TeamID: teamID,
+			ActivityEvent: atc.ActivityEvent{
+				Type:        atc.ActivityBuildFinished,
+				Time:        100,
+				TeamName:    "some-team",
+				BuildID:     buildID,
+				BuildStatus: atc.StatusSucceeded,
+			},
+		}
+	}
+
+	nextEvent := func(reader *sse.ReadCloser) atc.ActivityEvent {
+		event, err := reader.Next()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(event.Name).To(Equal("event"))
+
+		var activity atc.ActivityEvent
+		Expect(json.Unmarshal(event.Data, &activity)).To(Succeed())
+		return activity
+	}
+
+	Describe("GET /api/v1/teams/:team_name/activity", func() {
+		JustBeforeEach(func() {
+			request, err := requestGenerator.CreateRequest(atc.TeamActivity, rata.Params{
+				"team_name": "a-team",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Header = requestHeader
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("streams the team's events", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream; charset=utf-8"))
+
+				events <- buildFinished(1, 41)
+				events <- buildFinished(734, 42)
+
+				reader := sse.NewReadCloser(response.Body)
+
+				event := nextEvent(reader)
+				Expect(event.BuildID).To(Equal(42))
+				Expect(event.BuildStatus).To(Equal(atc.StatusSucceeded))
+			})
+
+			It("streams resync events", func() {
+				events <- db.ActivityEvent{
+					ActivityEvent: atc.ActivityEvent{
+						Type: atc.ActivityResync,
+						Time: 100,
+					},
+				}
+
+				reader := sse.NewReadCloser(response.Body)
+				Expect(nextEvent(reader).Type).To(Equal(atc.ActivityResync))
+			})
+
+			Context("when reconnecting", func() {
+				BeforeEach(func() {
+					requestHeader = http.Header{"Last-Event-ID": {"5"}}
+				})
+
+				It("sends a resync event first, as events may have been missed", func() {
+					events <- buildFinished(734, 42)
+
+					reader := sse.NewReadCloser(response.Body)
+					Expect(nextEvent(reader).Type).To(Equal(atc.ActivityResync))
+					Expect(nextEvent(reader).BuildID).To(Equal(42))
+				})
+			})
+
+			It("closes the subscription when the client goes away", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				response.Body.Close()
+
+				Eventually(fakeSubscription.CloseCallCount).Should(Equal(1))
+			})
+
+			Context("when subscribing fails", func() {
+				BeforeEach(func() {
+					dbActivityFeed.SubscribeReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbActivityFeed.SubscribeCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/activity", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			request, err := requestGenerator.CreateRequest(atc.ClusterActivity, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			request.URL.RawQuery = query
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the user is an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("streams every team's events", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				events <- buildFinished(1, 41)
+				events <- buildFinished(734, 42)
+
+				reader := sse.NewReadCloser(response.Body)
+				Expect(nextEvent(reader).BuildID).To(Equal(41))
+				Expect(nextEvent(reader).BuildID).To(Equal(42))
+			})
+
+			Context("when limited to some types of events", func() {
+				BeforeEach(func() {
+					query = "type=job_changed"
+				})
+
+				It("only streams those events", func() {
+					events <- buildFinished(1, 41)
+					events <- db.ActivityEvent{
+						TeamID: 1,
+						ActivityEvent: atc.ActivityEvent{
+							Type:    atc.ActivityJobChanged,
+							JobName: "some-job",
+						},
+					}
+
+					reader := sse.NewReadCloser(response.Body)
+					Expect(nextEvent(reader).JobName).To(Equal("some-job"))
+				})
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+})
@@ -0,0 +1,21 @@
+package activityserver
+
+import (
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger       lager.Logger
+	activityFeed db.ActivityFeed
+}
+
+func NewServer(
+	logger lager.Logger,
+	activityFeed db.ActivityFeed,
+) *Server {
+	return &Server{
+		logger:       logger,
+		activityFeed: activityFeed,
+	}
+}
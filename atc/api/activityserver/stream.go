@@ -0,0 +1,166 @@
+package activityserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/buildserver"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/vito/go-sse/sse"
+)
+
+// HeartbeatInterval is how long a stream may go without events before a
+// comment is written, so that proxies don't close it for being idle.
+const HeartbeatInterval = 30 * time.Second
+
+func (s *Server) ClusterActivity(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("cluster-activity")
+
+	s.stream(logger, w, r, func(db.ActivityEvent) bool {
+		return true
+	})
+}
+
+func (s *Server) TeamActivity(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("team-activity", lager.Data{"team": team.Name()})
+
+		s.stream(logger, w, r, func(event db.ActivityEvent) bool {
+			return event.TeamID == team.ID()
+		})
+	})
+}
+
+// stream writes each event the filter accepts, and every resync event, until
+// the client goes away. Events may be limited to some types with the 'type'
+// query param.
+//
+// Events are not kept, so a client reconnecting with a Last-Event-ID is sent
+// a resync event first, as it may have missed some.
+func (s *Server) stream(logger lager.Logger, w http.ResponseWriter, r *http.Request, filter func(db.ActivityEvent) bool) {
+	types := map[atc.ActivityEventType]bool{}
+	for _, t := range r.URL.Query()["type"] {
+		types[atc.ActivityEventType(t)] = true
+	}
+
+	subscription, err := s.activityFeed.Subscribe()
+	if err != nil {
+		logger.Error("failed-to-subscribe", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	defer db.Close(subscription)
+
+	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Add("X-Accel-Buffering", "no")
+	w.Header().Add(buildserver.ProtocolVersionHeader, buildserver.CurrentProtocolVersion)
+	w.WriteHeader(http.StatusOK)
+
+	writer := eventWriter{
+		responseWriter:  w,
+		responseFlusher: w.(http.Flusher),
+	}
+
+	writer.responseFlusher.Flush()
+
+	var eventID uint
+	if r.Header.Get("Last-Event-ID") != "" {
+		err = writer.WriteEvent(eventID, atc.ActivityEvent{
+			Type: atc.ActivityResync,
+			Time: time.Now().Unix(),
+		})
+		if err != nil {
+			logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
+			return
+		}
+
+		eventID++
+	}
+
+	for {
+		ctx, cancel := context.WithTimeout(r.Context(), HeartbeatInterval)
+		event, err := subscription.Next(ctx)
+		cancel()
+
+		if err != nil {
+			if r.Context().Err() != nil {
+				return
+			}
+
+			if err == context.DeadlineExceeded {
+				err = writer.WriteHeartbeat()
+				if err != nil {
+					logger.Info("failed-to-write-heartbeat", lager.Data{"error": err.Error()})
+					return
+				}
+
+				continue
+			}
+
+			logger.Error("failed-to-get-next-activity-event", err)
+			return
+		}
+
+		if event.Type != atc.ActivityResync {
+			if !filter(event) {
+				continue
+			}
+
+			if len(types) > 0 && !types[event.Type] {
+				continue
+			}
+		}
+
+		err = writer.WriteEvent(eventID, event.ActivityEvent)
+		if err != nil {
+			logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
+			return
+		}
+
+		eventID++
+	}
+}
+
+type eventWriter struct {
+	responseWriter  io.Writer
+	responseFlusher http.Flusher
+}
+
+func (writer eventWriter) WriteEvent(id uint, event atc.ActivityEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	err = sse.Event{
+		ID:   fmt.Sprintf("%d", id),
+		Name: "event",
+		Data: payload,
+	}.Write(writer.responseWriter)
+	if err != nil {
+		return err
+	}
+
+	writer.responseFlusher.Flush()
+
+	return nil
+}
+
+func (writer eventWriter) WriteHeartbeat() error {
+	_, err := fmt.Fprint(writer.responseWriter, ": heartbeat\n\n")
+	if err != nil {
+		return err
+	}
+
+	writer.responseFlusher.Flush()
+
+	return nil
+}
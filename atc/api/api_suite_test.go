@@ -41,6 +41,7 @@ var (
 	clusterName      = "Test Cluster"
 	featureFlagsJson = ` {
 	"across_step": false,
+	"build_log_search": false,
 	"build_rerun": false,
 	"cache_streamed_volumes": false,
 	"global_resources": false,
@@ -67,6 +68,9 @@ var (
 	build                   *dbfakes.FakeBuild
 	dbBuildFactory          *dbfakes.FakeBuildFactory
 	dbUserFactory           *dbfakes.FakeUserFactory
+	dbInterceptSessions     *dbfakes.FakeInterceptSessionFactory
+	dbWebhookFactory        *dbfakes.FakeWebhookFactory
+	dbActivityFeed          *dbfakes.FakeActivityFeed
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
@@ -116,6 +120,9 @@ var _ = BeforeEach(func() {
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 	dbUserFactory = new(dbfakes.FakeUserFactory)
+	dbInterceptSessions = new(dbfakes.FakeInterceptSessionFactory)
+	dbWebhookFactory = new(dbfakes.FakeWebhookFactory)
+	dbActivityFeed = new(dbfakes.FakeActivityFeed)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
 
@@ -208,6 +215,9 @@ var _ = BeforeEach(func() {
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		dbUserFactory,
+		dbInterceptSessions,
+		dbWebhookFactory,
+		dbActivityFeed,
 
 		constructedEventHandler.Construct,
 
@@ -225,6 +235,7 @@ var _ = BeforeEach(func() {
 		credsManagers,
 		interceptTimeoutFactory,
 		time.Second,
+		true,
 		dbWall,
 		fakeClock,
 	)
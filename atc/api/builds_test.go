@@ -1120,6 +1120,170 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/:build_id/test-results", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Get(server.URL + "/api/v1/builds/42/test-results")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			dbBuildFactory.BuildForAPIReturns(build, true, nil)
+			build.TeamNameReturns("some-team")
+			build.AllAssociatedTeamNamesReturns([]string{"some-team"})
+			build.JobIDReturns(42)
+			build.JobNameReturns("job1")
+			build.PipelineIDReturns(42)
+		})
+
+		Context("when not authenticated and the pipeline is private", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				build.PipelineReturns(fakePipeline, true, nil)
+				fakePipeline.PublicReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the build has test results", func() {
+				BeforeEach(func() {
+					build.TestResultsReturns([]atc.TestResult{
+						{Step: "unit", Suite: "api", Name: "creates", Status: atc.TestPassed, Duration: 0.5},
+						{Step: "unit", Suite: "api", Name: "deletes", Status: atc.TestFailed, Message: "expected 204"},
+					}, nil)
+				})
+
+				It("returns them with a summary", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).Should(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"summary": {"total": 2, "passed": 1, "failed": 1, "errored": 0, "skipped": 0, "duration": 0.5},
+						"results": [
+							{"step": "unit", "suite": "api", "name": "creates", "status": "passed", "duration": 0.5},
+							{"step": "unit", "suite": "api", "name": "deletes", "status": "failed", "duration": 0, "message": "expected 204"}
+						]
+					}`))
+				})
+			})
+
+			Context("when the build has no test results", func() {
+				It("returns an empty list", func() {
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"summary": {"total": 0, "passed": 0, "failed": 0, "errored": 0, "skipped": 0, "duration": 0},
+						"results": []
+					}`))
+				})
+			})
+
+			Context("when looking up the test results fails", func() {
+				BeforeEach(func() {
+					build.TestResultsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/builds/:build_id/provenance", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Get(server.URL + "/api/v1/builds/42/provenance")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			dbBuildFactory.BuildForAPIReturns(build, true, nil)
+			build.TeamNameReturns("some-team")
+			build.AllAssociatedTeamNamesReturns([]string{"some-team"})
+			build.JobIDReturns(42)
+			build.JobNameReturns("job1")
+			build.PipelineIDReturns(42)
+		})
+
+		Context("when not authenticated and the pipeline is private", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				build.PipelineReturns(fakePipeline, true, nil)
+				fakePipeline.PublicReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the build has provenance", func() {
+				BeforeEach(func() {
+					build.ProvenanceReturns(atc.ProvenanceEnvelope{
+						PayloadType: atc.ProvenancePayloadType,
+						Payload:     []byte(`{"_type":"https://in-toto.io/Statement/v1"}`),
+						Signatures:  []atc.ProvenanceSignature{{KeyID: "some-key", Sig: []byte("some-sig")}},
+					}, true, nil)
+				})
+
+				It("returns the signed envelope", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).Should(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"payloadType": "application/vnd.in-toto+json",
+						"payload": "eyJfdHlwZSI6Imh0dHBzOi8vaW4tdG90by5pby9TdGF0ZW1lbnQvdjEifQ==",
+						"signatures": [{"keyid": "some-key", "sig": "c29tZS1zaWc="}]
+					}`))
+				})
+			})
+
+			Context("when the build has no provenance", func() {
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when looking up the provenance fails", func() {
+				BeforeEach(func() {
+					build.ProvenanceReturns(atc.ProvenanceEnvelope{}, false, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/preparation", func() {
 		var response *http.Response
 
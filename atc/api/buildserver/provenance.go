@@ -0,0 +1,34 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetBuildProvenance(build db.BuildForAPI) http.Handler {
+	logger := s.logger.Session("build-provenance", lager.Data{"build-id": build.ID()})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		envelope, found, err := build.Provenance()
+		if err != nil {
+			logger.Error("failed-to-get-provenance", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(envelope)
+		if err != nil {
+			logger.Error("failed-to-encode-provenance", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetBuildTestResults(build db.BuildForAPI) http.Handler {
+	logger := s.logger.Session("build-test-results", lager.Data{"build-id": build.ID()})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		results, err := build.TestResults()
+		if err != nil {
+			logger.Error("failed-to-get-test-results", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if results == nil {
+			results = []atc.TestResult{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(atc.BuildTestResults{
+			Summary: atc.SummarizeTestResults(results),
+			Results: results,
+		})
+		if err != nil {
+			logger.Error("failed-to-encode-test-results", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -513,13 +513,24 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+							ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
 							Expect(ref.Name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
 						})
 
+						Context("when the user is known", func() {
+							BeforeEach(func() {
+								fakeAccess.UserInfoReturns(atc.UserInfo{DisplayUserId: "some-user"})
+							})
+
+							It("records who saved it", func() {
+								_, _, _, _, createdBy := dbTeam.SavePipelineArgsForCall(0)
+								Expect(createdBy).To(Equal("some-user"))
+							})
+						})
+
 						Context("and saving it fails", func() {
 							BeforeEach(func() {
 								dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
@@ -611,7 +622,7 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+							ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
 							Expect(ref.Name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -658,7 +669,7 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+								ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
 								Expect(ref.Name).To(Equal("a-pipeline"))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
@@ -967,7 +978,7 @@ jobs:
 									It("passes validation and saves it un-interpolated", func() {
 										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-										ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+										ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
 										Expect(ref.Name).To(Equal("a-pipeline"))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 										Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -1117,7 +1128,7 @@ jobs:
 								It("saves an instanced pipeline", func() {
 									Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-									ref, _, _, _ := dbTeam.SavePipelineArgsForCall(0)
+									ref, _, _, _, _ := dbTeam.SavePipelineArgsForCall(0)
 									Expect(ref).To(Equal(atc.PipelineRef{
 										Name:         "a-pipeline",
 										InstanceVars: atc.InstanceVars{"branch": "feature"},
@@ -1217,7 +1228,7 @@ jobs:
 					It("saves it", func() {
 						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-						ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+						ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
 						Expect(ref.Name).To(Equal("a-pipeline"))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
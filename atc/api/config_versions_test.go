@@ -0,0 +1,198 @@
+package api_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/tedsuo/rata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config Versions API", func() {
+	var (
+		requestGenerator *rata.RequestGenerator
+
+		fakeTeam     *dbfakes.FakeTeam
+		fakePipeline *dbfakes.FakePipeline
+
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		requestGenerator = rata.NewRequestGenerator(server.URL, atc.Routes)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.NameReturns("a-team")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakeTeam.PipelineReturns(fakePipeline, true, nil)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", func() {
+		JustBeforeEach(func() {
+			request, err := requestGenerator.CreateRequest(atc.ListConfigVersions, rata.Params{
+				"team_name":     "a-team",
+				"pipeline_name": "a-pipeline",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the pipeline has history", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigHistoryReturns([]db.PipelineConfig{
+						{
+							Version:   2,
+							CreatedAt: time.Unix(200, 0),
+							BuildID:   42,
+							BuildName: "7",
+							JobName:   "reconfigure",
+						},
+						{
+							Version:   1,
+							CreatedBy: "some-user",
+							CreatedAt: time.Unix(100, 0),
+						},
+					}, nil)
+				})
+
+				It("returns the versions, newest first", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					Expect(io.ReadAll(response.Body)).To(MatchJSON(`[
+						{"version": 2, "created_at": 200, "build_id": 42, "build_name": "7", "job_name": "reconfigure"},
+						{"version": 1, "created_by": "some-user", "created_at": 100}
+					]`))
+				})
+
+				It("looks up the pipeline of the team", func() {
+					Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
+				})
+			})
+
+			Context("when the pipeline has no history", func() {
+				It("returns an empty list", func() {
+					Expect(io.ReadAll(response.Body)).To(MatchJSON(`[]`))
+				})
+			})
+
+			Context("when getting the history fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigHistoryReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the pipeline is not found", func() {
+				BeforeEach(func() {
+					fakeTeam.PipelineReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version", func() {
+		var configVersion string
+
+		BeforeEach(func() {
+			configVersion = "1"
+		})
+
+		JustBeforeEach(func() {
+			request, err := requestGenerator.CreateRequest(atc.GetConfigVersion, rata.Params{
+				"team_name":      "a-team",
+				"pipeline_name":  "a-pipeline",
+				"config_version": configVersion,
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the version is found", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigAtVersionReturns(db.PipelineConfig{
+						Version:   1,
+						CreatedBy: "some-user",
+						CreatedAt: time.Unix(100, 0),
+						Config: atc.Config{
+							Jobs: atc.JobConfigs{{Name: "some-job"}},
+						},
+					}, true, nil)
+				})
+
+				It("returns the version with its config", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakePipeline.ConfigAtVersionArgsForCall(0)).To(Equal(1))
+					Expect(io.ReadAll(response.Body)).To(MatchJSON(`{
+						"version": 1,
+						"created_by": "some-user",
+						"created_at": 100,
+						"config": {"jobs": [{"name": "some-job", "plan": null}]}
+					}`))
+				})
+			})
+
+			Context("when the version is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigAtVersionReturns(db.PipelineConfig{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the version is malformed", func() {
+				BeforeEach(func() {
+					configVersion = "latest"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeTeam.PipelineCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,127 @@
+package configserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager/v3"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/api/helpers"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) ListConfigVersions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-config-versions")
+
+	pipeline, found := s.findPipeline(logger, w, r)
+	if !found {
+		return
+	}
+
+	configs, err := pipeline.ConfigHistory()
+	if err != nil {
+		logger.Error("failed-to-get-config-history", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	versions := []atc.ConfigVersion{}
+	for _, config := range configs {
+		versions = append(versions, present.ConfigVersion(config))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(versions)
+	if err != nil {
+		logger.Error("failed-to-encode-config-versions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) GetConfigVersion(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-config-version")
+
+	version, err := strconv.Atoi(rata.Param(r, "config_version"))
+	if err != nil {
+		HandleBadRequest(w, fmt.Sprintf("config version is malformed: %s", err))
+		return
+	}
+
+	pipeline, found := s.findPipeline(logger, w, r)
+	if !found {
+		return
+	}
+
+	config, found, err := pipeline.ConfigAtVersion(version)
+	if err != nil {
+		logger.Error("failed-to-get-config-version", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Debug("config-version-not-found", lager.Data{"version": version})
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(atc.ConfigVersionResponse{
+		ConfigVersion: present.ConfigVersion(config),
+		Config:        config.Config,
+	})
+	if err != nil {
+		logger.Error("failed-to-encode-config-version", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// findPipeline looks up the pipeline of the request, responding for it if
+// it can't be found.
+func (s *Server) findPipeline(logger lager.Logger, w http.ResponseWriter, r *http.Request) (db.Pipeline, bool) {
+	teamName := rata.Param(r, "team_name")
+	pipelineName := rata.Param(r, "pipeline_name")
+	pipelineRef := atc.PipelineRef{Name: pipelineName}
+	var err error
+	pipelineRef.InstanceVars, err = atc.InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		logger.Error("malformed-instance-vars", err)
+		HandleBadRequest(w, fmt.Sprintf("instance vars are malformed: %v", err))
+		return nil, false
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		logger.Debug("team-not-found", lager.Data{"team": teamName})
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	pipeline, found, err := team.Pipeline(pipelineRef)
+	if err != nil {
+		logger.Error("failed-to-find-pipeline", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineName})
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	return pipeline, true
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -755,6 +756,97 @@ var _ = Describe("Containers API", func() {
 								Expect(fakeAccess.IsAdminCallCount()).To(Equal(0))
 							})
 
+							Context("when recording the session", func() {
+								BeforeEach(func() {
+									fakeAccess.UserInfoReturns(atc.UserInfo{DisplayUserId: "some-user"})
+									dbInterceptSessions.CreateInterceptSessionReturns(db.InterceptSession{ID: 42}, nil)
+								})
+
+								JustBeforeEach(func() {
+									err := conn.WriteJSON(atc.HijackInput{
+										Stdin: []byte("whoami\n"),
+									})
+									Expect(err).NotTo(HaveOccurred())
+
+									process := waitForHijack()
+
+									_, err = bufio.NewReader(process.Stdin()).ReadBytes('\n')
+									Expect(err).NotTo(HaveOccurred())
+
+									_, err = fmt.Fprintf(process.Stdout(), "snoopy\n")
+									Expect(err).NotTo(HaveOccurred())
+
+									var hijackOutput atc.HijackOutput
+									err = conn.ReadJSON(&hijackOutput)
+									Expect(err).NotTo(HaveOccurred())
+								})
+
+								recording := func() []string {
+									var recording []byte
+									for i := 0; i < dbInterceptSessions.AppendInterceptSessionRecordingCallCount(); i++ {
+										id, chunk := dbInterceptSessions.AppendInterceptSessionRecordingArgsForCall(i)
+										Expect(id).To(Equal(42))
+
+										recording = append(recording, chunk...)
+									}
+
+									return strings.Split(strings.TrimSpace(string(recording)), "\n")
+								}
+
+								It("creates a session for the user", func() {
+									Eventually(processExit).Should(BeSent(0))
+
+									Expect(dbInterceptSessions.CreateInterceptSessionCallCount()).To(Equal(1))
+
+									teamID, sessionHandle, _, createdBy, command := dbInterceptSessions.CreateInterceptSessionArgsForCall(0)
+									Expect(teamID).To(Equal(734))
+									Expect(sessionHandle).To(Equal(handle))
+									Expect(createdBy).To(Equal("some-user"))
+									Expect(command).To(Equal([]string{"ls"}))
+								})
+
+								It("saves an asciicast recording of the input and output as the session runs", func() {
+									Expect(dbInterceptSessions.AppendInterceptSessionRecordingCallCount()).To(BeZero())
+
+									fakeClock.Increment(5 * time.Second)
+
+									Eventually(dbInterceptSessions.AppendInterceptSessionRecordingCallCount).Should(Equal(1))
+									Expect(dbInterceptSessions.FinishInterceptSessionCallCount()).To(BeZero())
+
+									lines := recording()
+									Expect(lines).To(HaveLen(3))
+									Expect(lines[0]).To(MatchJSON(`{"version":2,"width":80,"height":24,"timestamp":123,"command":"ls"}`))
+									Expect(lines[1]).To(MatchJSON(`[0,"i","whoami\n"]`))
+									Expect(lines[2]).To(MatchJSON(`[0,"o","snoopy\n"]`))
+
+									Eventually(processExit).Should(BeSent(0))
+								})
+
+								It("saves the rest of the recording when the session finishes", func() {
+									Eventually(processExit).Should(BeSent(0))
+
+									Eventually(dbInterceptSessions.FinishInterceptSessionCallCount).Should(Equal(1))
+
+									id, _ := dbInterceptSessions.FinishInterceptSessionArgsForCall(0)
+									Expect(id).To(Equal(42))
+
+									Expect(recording()).To(HaveLen(3))
+								})
+							})
+
+							Context("when the session can't be created", func() {
+								BeforeEach(func() {
+									dbInterceptSessions.CreateInterceptSessionReturns(db.InterceptSession{}, errors.New("nope"))
+								})
+
+								It("closes the connection with an error", func() {
+									_, _, err := conn.ReadMessage()
+
+									Expect(websocket.IsCloseError(err, websocket.CloseInternalServerErr)).To(BeTrue())
+									Expect(err).To(MatchError(ContainSubstring("failed to record session")))
+								})
+							})
+
 							It("hijacks the build", func() {
 								waitForHijack()
 
@@ -771,7 +863,8 @@ var _ = Describe("Containers API", func() {
 
 							Context("when the hijack timer elapses", func() {
 								JustBeforeEach(func() {
-									fakeClock.WaitForWatcherAndIncrement(time.Second)
+									// the session recording is flushed on a ticker too
+									fakeClock.WaitForNWatchersAndIncrement(time.Second, 2)
 								})
 
 								It("updates the last hijack value again", func() {
@@ -867,6 +960,14 @@ var _ = Describe("Containers API", func() {
 									}))
 								})
 
+								It("saves the session's exit status", func() {
+									Eventually(dbInterceptSessions.FinishInterceptSessionCallCount).Should(Equal(1))
+
+									_, exitStatus := dbInterceptSessions.FinishInterceptSessionArgsForCall(0)
+									Expect(exitStatus).ToNot(BeNil())
+									Expect(*exitStatus).To(Equal(123))
+								})
+
 								It("closes the process' stdin pipe", func() {
 									process := waitForHijack()
 
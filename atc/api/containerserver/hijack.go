@@ -155,6 +155,26 @@ func (s *Server) HijackContainer(team db.Team) http.Handler {
 			Process:   processSpec,
 		}
 
+		if s.recordInterceptSessions {
+			acc := accessor.GetAccessor(r)
+
+			session, err := s.interceptSessionFactory.CreateInterceptSession(
+				team.ID(),
+				handle,
+				container.DBContainer().Metadata(),
+				acc.UserInfo().DisplayUserId,
+				append([]string{processSpec.Path}, processSpec.Args...),
+			)
+			if err != nil {
+				hLog.Error("failed-to-create-intercept-session", err)
+				closeWithErr(hLog, conn, websocket.CloseInternalServerErr, "failed to record session")
+				return
+			}
+
+			hijackRequest.SessionID = session.ID
+			hijackRequest.Recorder = newSessionRecorder(s.clock, processSpec)
+		}
+
 		s.hijack(r.Context(), hLog, conn, hijackRequest)
 	})
 }
@@ -162,6 +182,10 @@ func (s *Server) HijackContainer(team db.Team) http.Handler {
 type hijackRequest struct {
 	Container runtime.Container
 	Process   atc.HijackProcessSpec
+
+	// Recorder records the session with the ID, if sessions are recorded.
+	SessionID int
+	Recorder  *sessionRecorder
 }
 
 func closeWithErr(log lager.Logger, conn *websocket.Conn, code int, reason string) {
@@ -193,6 +217,24 @@ func (s *Server) hijack(ctx context.Context, hLog lager.Logger, conn *websocket.
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	var exitStatus *int
+	var flushRecording <-chan time.Time
+	if request.Recorder != nil {
+		flushTicker := s.clock.NewTicker(recordingFlushInterval)
+		defer flushTicker.Stop()
+
+		flushRecording = flushTicker.C()
+
+		defer func() {
+			s.saveRecording(hLog, request)
+
+			err := s.interceptSessionFactory.FinishInterceptSession(request.SessionID, exitStatus)
+			if err != nil {
+				hLog.Error("failed-to-save-intercept-session", err)
+			}
+		}()
+	}
+
 	outW := &stdoutWriter{
 		outputs: outputs,
 		done:    ctx.Done(),
@@ -304,6 +346,10 @@ func (s *Server) hijack(ctx context.Context, hLog lager.Logger, conn *websocket.
 			if input.Closed {
 				_ = stdinW.Close()
 			} else if input.TTYSpec != nil {
+				if request.Recorder != nil {
+					request.Recorder.Resize(input.TTYSpec.WindowSize.Columns, input.TTYSpec.WindowSize.Rows)
+				}
+
 				err := process.SetTTY(runtime.TTYSpec{
 					WindowSize: runtime.WindowSize{
 						Columns: input.TTYSpec.WindowSize.Columns,
@@ -316,19 +362,41 @@ func (s *Server) hijack(ctx context.Context, hLog lager.Logger, conn *websocket.
 					})
 				}
 			} else {
+				if request.Recorder != nil {
+					request.Recorder.Input(input.Stdin)
+				}
+
 				_, _ = stdinW.Write(input.Stdin)
 			}
 
+			if request.Recorder != nil && request.Recorder.Full() {
+				s.saveRecording(hLog, request)
+			}
+
 		case <-idleChan:
 			errs <- idle.Error()
 
 		case output := <-outputs:
+			if request.Recorder != nil {
+				request.Recorder.Output(output.Stdout)
+				request.Recorder.Output(output.Stderr)
+
+				if request.Recorder.Full() {
+					s.saveRecording(hLog, request)
+				}
+			}
+
 			err := conn.WriteJSON(output)
 			if err != nil {
 				return
 			}
 
+		case <-flushRecording:
+			s.saveRecording(hLog, request)
+
 		case status := <-exited:
+			exitStatus = &status
+
 			_ = conn.WriteJSON(atc.HijackOutput{
 				ExitStatus: &status,
 			})
@@ -345,6 +413,20 @@ func (s *Server) hijack(ctx context.Context, hLog lager.Logger, conn *websocket.
 	}
 }
 
+// saveRecording saves the part of the session recorded since it was last
+// saved.
+func (s *Server) saveRecording(hLog lager.Logger, request hijackRequest) {
+	chunk := request.Recorder.Flush()
+	if len(chunk) == 0 {
+		return
+	}
+
+	err := s.interceptSessionFactory.AppendInterceptSessionRecording(request.SessionID, chunk)
+	if err != nil {
+		hLog.Error("failed-to-save-intercept-session-recording", err)
+	}
+}
+
 type stdoutWriter struct {
 	outputs chan<- atc.HijackOutput
 	done    <-chan struct{}
@@ -0,0 +1,85 @@
+package containerserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/api/helpers"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListInterceptSessions(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hLog := s.logger.Session("list-intercept-sessions")
+
+		limit := 0
+		if rawLimit := r.FormValue(atc.PaginationQueryLimit); rawLimit != "" {
+			var err error
+			limit, err = strconv.Atoi(rawLimit)
+			if err != nil || limit < 0 {
+				HandleBadRequest(w, fmt.Sprintf("invalid limit: %s", rawLimit))
+				return
+			}
+		}
+
+		sessions, err := s.interceptSessionFactory.InterceptSessions(team.ID(), limit)
+		if err != nil {
+			hLog.Error("failed-to-get-intercept-sessions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := []atc.InterceptSession{}
+		for _, session := range sessions {
+			presented = append(presented, present.InterceptSession(session))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			hLog.Error("failed-to-encode-intercept-sessions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) GetInterceptRecording(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawID := r.FormValue(":session_id")
+
+		hLog := s.logger.Session("get-intercept-recording", lager.Data{
+			"session": rawID,
+		})
+
+		id, err := strconv.Atoi(rawID)
+		if err != nil {
+			HandleBadRequest(w, fmt.Sprintf("session id is malformed: %s", err))
+			return
+		}
+
+		recording, found, err := s.interceptSessionFactory.InterceptSessionRecording(team.ID(), id)
+		if err != nil {
+			hLog.Error("failed-to-get-intercept-recording", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-asciicast")
+
+		_, err = w.Write(recording)
+		if err != nil {
+			hLog.Error("failed-to-write-intercept-recording", err)
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package containerserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"github.com/concourse/concourse/atc"
+)
+
+// MaxRecordingSize is how much of an intercept session is recorded. Anything
+// past it is dropped, so that a session spewing output can't fill up the
+// database.
+const MaxRecordingSize = 16 * 1024 * 1024
+
+const (
+	defaultRecordingColumns = 80
+	defaultRecordingRows    = 24
+
+	// recordingChunkSize is how much of the recording is buffered before
+	// it's saved, and recordingFlushInterval is how often it's saved when
+	// less is buffered, so that a session is recorded as it runs.
+	recordingChunkSize     = 64 * 1024
+	recordingFlushInterval = 5 * time.Second
+)
+
+// sessionRecorder records an intercept session in the asciicast v2 format
+// (https://docs.asciinema.org/manual/asciicast/v2/): a header line followed
+// by a line for each input, output and resize event, timed from the start of
+// the session. The recording is buffered until it's flushed.
+type sessionRecorder struct {
+	clock clock.Clock
+	start time.Time
+
+	buf       bytes.Buffer
+	recorded  int
+	truncated bool
+}
+
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Command   string            `json:"command,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+func newSessionRecorder(clock clock.Clock, process atc.HijackProcessSpec) *sessionRecorder {
+	recorder := &sessionRecorder{
+		clock: clock,
+		start: clock.Now(),
+	}
+
+	header := asciicastHeader{
+		Version:   2,
+		Width:     defaultRecordingColumns,
+		Height:    defaultRecordingRows,
+		Timestamp: recorder.start.Unix(),
+		Command:   strings.Join(append([]string{process.Path}, process.Args...), " "),
+	}
+
+	if process.TTY != nil {
+		header.Width = process.TTY.WindowSize.Columns
+		header.Height = process.TTY.WindowSize.Rows
+	}
+
+	for _, env := range process.Env {
+		name, value, found := strings.Cut(env, "=")
+		if found && (name == "TERM" || name == "SHELL") {
+			if header.Env == nil {
+				header.Env = map[string]string{}
+			}
+
+			header.Env[name] = value
+		}
+	}
+
+	payload, _ := json.Marshal(header)
+	recorder.write(payload)
+
+	return recorder
+}
+
+func (recorder *sessionRecorder) Input(data []byte) {
+	if len(data) > 0 {
+		recorder.event("i", string(data))
+	}
+}
+
+func (recorder *sessionRecorder) Output(data []byte) {
+	if len(data) > 0 {
+		recorder.event("o", string(data))
+	}
+}
+
+func (recorder *sessionRecorder) Resize(columns int, rows int) {
+	recorder.event("r", fmt.Sprintf("%dx%d", columns, rows))
+}
+
+// Full is whether enough of the recording is buffered that it should be
+// flushed.
+func (recorder *sessionRecorder) Full() bool {
+	return recorder.buf.Len() >= recordingChunkSize
+}
+
+// Flush returns the recording buffered since it was last flushed.
+func (recorder *sessionRecorder) Flush() []byte {
+	chunk := bytes.Clone(recorder.buf.Bytes())
+	recorder.buf.Reset()
+	return chunk
+}
+
+func (recorder *sessionRecorder) event(code string, data string) {
+	if recorder.truncated {
+		return
+	}
+
+	elapsed := math.Round(recorder.clock.Since(recorder.start).Seconds()*1e6) / 1e6
+
+	payload, _ := json.Marshal([]interface{}{elapsed, code, data})
+	if recorder.recorded+len(payload)+1 > MaxRecordingSize {
+		recorder.truncated = true
+
+		payload, _ = json.Marshal([]interface{}{elapsed, "o", "\r\n[recording truncated]\r\n"})
+	}
+
+	recorder.write(payload)
+}
+
+func (recorder *sessionRecorder) write(line []byte) {
+	recorder.buf.Write(line)
+	recorder.buf.WriteByte('\n')
+	recorder.recorded += len(line) + 1
+}
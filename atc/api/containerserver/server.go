@@ -21,6 +21,8 @@ type Server struct {
 	workerPool              Pool
 	interceptTimeoutFactory InterceptTimeoutFactory
 	interceptUpdateInterval time.Duration
+	interceptSessionFactory db.InterceptSessionFactory
+	recordInterceptSessions bool
 	containerRepository     db.ContainerRepository
 	destroyer               gc.Destroyer
 	clock                   clock.Clock
@@ -31,6 +33,8 @@ func NewServer(
 	workerPool Pool,
 	interceptTimeoutFactory InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
+	interceptSessionFactory db.InterceptSessionFactory,
+	recordInterceptSessions bool,
 	containerRepository db.ContainerRepository,
 	destroyer gc.Destroyer,
 	clock clock.Clock,
@@ -40,6 +44,8 @@ func NewServer(
 		workerPool:              workerPool,
 		interceptTimeoutFactory: interceptTimeoutFactory,
 		interceptUpdateInterval: interceptUpdateInterval,
+		interceptSessionFactory: interceptSessionFactory,
+		recordInterceptSessions: recordInterceptSessions,
 		containerRepository:     containerRepository,
 		destroyer:               destroyer,
 		clock:                   clock,
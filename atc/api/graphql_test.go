@@ -0,0 +1,407 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GraphQL API", func() {
+	var (
+		body     string
+		response *http.Response
+	)
+
+	JustBeforeEach(func() {
+		var err error
+		response, err = client.Post(server.URL+"/api/v1/graphql", "application/json", bytes.NewBufferString(body))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		response.Body.Close()
+	})
+
+	query := func(query string) string {
+		payload, err := json.Marshal(map[string]string{"query": query})
+		Expect(err).NotTo(HaveOccurred())
+		return string(payload)
+	}
+
+	responseBody := func() string {
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+		payload, err := io.ReadAll(response.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(payload)
+	}
+
+	cursor := func(buildID string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte("build:" + buildID))
+	}
+
+	fakeBuild := func(id int, teamName string, jobID int) *dbfakes.FakeBuildForAPI {
+		build := new(dbfakes.FakeBuildForAPI)
+		build.IDReturns(id)
+		build.NameReturns("1")
+		build.TeamNameReturns(teamName)
+		build.StatusReturns(db.BuildStatusSucceeded)
+		build.JobIDReturns(jobID)
+		build.AllAssociatedTeamNamesReturns([]string{teamName})
+		return build
+	}
+
+	Context("when the request is malformed", func() {
+		BeforeEach(func() {
+			body = "{"
+		})
+
+		It("returns 400", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("pipelines", func() {
+		BeforeEach(func() {
+			body = query(`{
+				pipelines {
+					name
+					jobs { name finishedBuild { id status } }
+					resources { name type }
+				}
+			}`)
+
+			pipeline1 := new(dbfakes.FakePipeline)
+			pipeline1.IDReturns(1)
+			pipeline1.NameReturns("pipeline-1")
+			pipeline1.TeamNameReturns("some-team")
+
+			pipeline2 := new(dbfakes.FakePipeline)
+			pipeline2.IDReturns(2)
+			pipeline2.NameReturns("pipeline-2")
+			pipeline2.TeamNameReturns("other-team")
+
+			dbPipelineFactory.VisiblePipelinesReturns([]db.Pipeline{pipeline1, pipeline2}, nil)
+			dbPipelineFactory.AllPipelinesReturns([]db.Pipeline{pipeline1}, nil)
+
+			dbJobFactory.JobsForPipelinesReturns([]atc.JobSummary{
+				{
+					ID:           1,
+					Name:         "job-1",
+					PipelineID:   1,
+					PipelineName: "pipeline-1",
+					TeamName:     "some-team",
+					FinishedBuild: &atc.BuildSummary{
+						ID:     10,
+						Name:   "1",
+						Status: atc.StatusSucceeded,
+					},
+				},
+				{
+					ID:           2,
+					Name:         "job-2",
+					PipelineID:   2,
+					PipelineName: "pipeline-2",
+					TeamName:     "other-team",
+				},
+			}, nil)
+
+			resource := new(dbfakes.FakeResource)
+			resource.IDReturns(1)
+			resource.NameReturns("some-resource")
+			resource.TypeReturns("git")
+			resource.PipelineIDReturns(2)
+
+			dbResourceFactory.ResourcesForPipelinesReturns([]db.Resource{resource}, nil)
+
+			fakeAccess.TeamNamesReturns([]string{"some-team"})
+		})
+
+		It("returns the visible pipelines with their jobs and resources", func() {
+			Expect(responseBody()).To(MatchJSON(`{
+				"data": {
+					"pipelines": [
+						{
+							"name": "pipeline-1",
+							"jobs": [{"name": "job-1", "finishedBuild": {"id": 10, "status": "succeeded"}}],
+							"resources": []
+						},
+						{
+							"name": "pipeline-2",
+							"jobs": [{"name": "job-2", "finishedBuild": null}],
+							"resources": [{"name": "some-resource", "type": "git"}]
+						}
+					]
+				}
+			}`))
+
+			Expect(dbPipelineFactory.VisiblePipelinesArgsForCall(0)).To(Equal([]string{"some-team"}))
+		})
+
+		It("loads the jobs and resources of every pipeline at once", func() {
+			responseBody()
+
+			Expect(dbJobFactory.JobsForPipelinesCallCount()).To(Equal(1))
+			Expect(dbJobFactory.JobsForPipelinesArgsForCall(0)).To(Equal([]int{1, 2}))
+
+			Expect(dbResourceFactory.ResourcesForPipelinesCallCount()).To(Equal(1))
+			Expect(dbResourceFactory.ResourcesForPipelinesArgsForCall(0)).To(Equal([]int{1, 2}))
+		})
+
+		Context("when the user is an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns every pipeline", func() {
+				responseBody()
+
+				Expect(dbPipelineFactory.AllPipelinesCallCount()).To(Equal(1))
+				Expect(dbPipelineFactory.VisiblePipelinesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when loading the jobs fails", func() {
+			BeforeEach(func() {
+				dbJobFactory.JobsForPipelinesReturns(nil, errors.New("disaster"))
+			})
+
+			It("returns an error without leaking it", func() {
+				payload := responseBody()
+				Expect(payload).To(ContainSubstring("internal server error"))
+				Expect(payload).NotTo(ContainSubstring("disaster"))
+			})
+		})
+	})
+
+	Describe("builds", func() {
+		BeforeEach(func() {
+			fakeAccess.TeamNamesReturns([]string{"some-team"})
+
+			dbBuildFactory.VisibleBuildsReturns(
+				[]db.BuildForAPI{fakeBuild(5, "some-team", 0), fakeBuild(4, "some-team", 0)},
+				db.Pagination{Older: &db.Page{To: db.NewIntPtr(3), Limit: 2}},
+				nil,
+			)
+		})
+
+		Context("when paging forwards", func() {
+			BeforeEach(func() {
+				body = query(`{
+					builds(first: 2, after: "` + cursor("6") + `") {
+						edges { cursor node { id } }
+						pageInfo { hasNextPage hasPreviousPage startCursor endCursor }
+					}
+				}`)
+			})
+
+			It("returns a page of builds with cursors", func() {
+				Expect(responseBody()).To(MatchJSON(`{
+					"data": {
+						"builds": {
+							"edges": [
+								{"cursor": "` + cursor("5") + `", "node": {"id": 5}},
+								{"cursor": "` + cursor("4") + `", "node": {"id": 4}}
+							],
+							"pageInfo": {
+								"hasNextPage": true,
+								"hasPreviousPage": false,
+								"startCursor": "` + cursor("5") + `",
+								"endCursor": "` + cursor("4") + `"
+							}
+						}
+					}
+				}`))
+
+				teamNames, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+				Expect(teamNames).To(Equal([]string{"some-team"}))
+				Expect(page).To(Equal(db.Page{To: db.NewIntPtr(5), Limit: 2}))
+			})
+		})
+
+		Context("when paging backwards", func() {
+			BeforeEach(func() {
+				body = query(`{ builds(last: 2, before: "` + cursor("3") + `") { edges { node { id } } } }`)
+			})
+
+			It("asks for the builds after the cursor", func() {
+				responseBody()
+
+				_, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+				Expect(page).To(Equal(db.Page{From: db.NewIntPtr(4), Limit: 2}))
+			})
+		})
+
+		Context("when the page is too big", func() {
+			BeforeEach(func() {
+				body = query(`{ builds(first: 1000) { edges { node { id } } } }`)
+			})
+
+			It("returns an error", func() {
+				Expect(responseBody()).To(ContainSubstring("page size must be between 1 and 100"))
+				Expect(dbBuildFactory.VisibleBuildsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the cursor is invalid", func() {
+			BeforeEach(func() {
+				body = query(`{ builds(after: "bogus") { edges { node { id } } } }`)
+			})
+
+			It("returns an error", func() {
+				Expect(responseBody()).To(ContainSubstring("invalid cursor"))
+			})
+		})
+
+		Describe("inputs", func() {
+			BeforeEach(func() {
+				body = query(`{ builds { edges { node { id inputs { name version } } } } }`)
+
+				dbBuildFactory.VisibleBuildsReturns(
+					[]db.BuildForAPI{fakeBuild(5, "some-team", 0), fakeBuild(4, "other-team", 0)},
+					db.Pagination{},
+					nil,
+				)
+
+				fakeAccess.IsAuthorizedStub = func(team string) bool {
+					return team == "some-team"
+				}
+
+				dbBuildFactory.BuildsResourcesReturns(map[int][]db.BuildInput{
+					5: {{Name: "some-input", Version: atc.Version{"ref": "abc"}}},
+				}, nil, nil)
+			})
+
+			It("loads them at once for the builds of the user's teams", func() {
+				Expect(responseBody()).To(MatchJSON(`{
+					"data": {
+						"builds": {
+							"edges": [
+								{"node": {"id": 5, "inputs": [{"name": "some-input", "version": {"ref": "abc"}}]}},
+								{"node": {"id": 4, "inputs": []}}
+							]
+						}
+					}
+				}`))
+
+				Expect(dbBuildFactory.BuildsResourcesCallCount()).To(Equal(1))
+				Expect(dbBuildFactory.BuildsResourcesArgsForCall(0)).To(Equal([]int{5}))
+			})
+		})
+	})
+
+	Describe("a job's builds", func() {
+		BeforeEach(func() {
+			body = query(`{
+				pipelines {
+					jobs {
+						name
+						builds(first: 1) {
+							edges { node { id } }
+							pageInfo { hasNextPage hasPreviousPage }
+						}
+					}
+				}
+			}`)
+
+			pipeline := new(dbfakes.FakePipeline)
+			pipeline.IDReturns(1)
+			dbPipelineFactory.VisiblePipelinesReturns([]db.Pipeline{pipeline}, nil)
+
+			dbJobFactory.JobsForPipelinesReturns([]atc.JobSummary{
+				{ID: 1, Name: "job-1", PipelineID: 1},
+				{ID: 2, Name: "job-2", PipelineID: 1},
+			}, nil)
+
+			dbBuildFactory.JobsBuildsReturns(map[int][]db.BuildForAPI{
+				1: {fakeBuild(12, "some-team", 1), fakeBuild(11, "some-team", 1)},
+				2: {fakeBuild(21, "some-team", 2)},
+			}, nil)
+		})
+
+		It("loads a page of builds for every job at once", func() {
+			Expect(responseBody()).To(MatchJSON(`{
+				"data": {
+					"pipelines": [{
+						"jobs": [
+							{
+								"name": "job-1",
+								"builds": {
+									"edges": [{"node": {"id": 12}}],
+									"pageInfo": {"hasNextPage": true, "hasPreviousPage": false}
+								}
+							},
+							{
+								"name": "job-2",
+								"builds": {
+									"edges": [{"node": {"id": 21}}],
+									"pageInfo": {"hasNextPage": false, "hasPreviousPage": false}
+								}
+							}
+						]
+					}]
+				}
+			}`))
+
+			Expect(dbBuildFactory.JobsBuildsCallCount()).To(Equal(1))
+
+			jobIDs, page := dbBuildFactory.JobsBuildsArgsForCall(0)
+			Expect(jobIDs).To(Equal([]int{1, 2}))
+			Expect(page).To(Equal(db.Page{Limit: 2}))
+		})
+	})
+
+	Describe("build", func() {
+		var build *dbfakes.FakeBuildForAPI
+
+		BeforeEach(func() {
+			body = query(`{ build(id: 1) { id teamName } }`)
+
+			build = fakeBuild(1, "some-team", 1)
+			build.PipelineIDReturns(1)
+			dbBuildFactory.BuildForAPIReturns(build, true, nil)
+		})
+
+		Context("when the user is authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns the build", func() {
+				Expect(responseBody()).To(MatchJSON(`{"data": {"build": {"id": 1, "teamName": "some-team"}}}`))
+			})
+		})
+
+		Context("when the build is of a private pipeline", func() {
+			BeforeEach(func() {
+				pipeline := new(dbfakes.FakePipeline)
+				build.PipelineReturns(pipeline, true, nil)
+			})
+
+			It("returns null", func() {
+				Expect(responseBody()).To(MatchJSON(`{"data": {"build": null}}`))
+			})
+		})
+	})
+
+	Context("when given a mutation", func() {
+		BeforeEach(func() {
+			body = query(`mutation { pausePipeline(id: 1) }`)
+		})
+
+		It("rejects it", func() {
+			Expect(responseBody()).To(ContainSubstring("no mutations are offered"))
+		})
+	})
+})
@@ -0,0 +1,252 @@
+package graphqlserver
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// buildGroup is a set of builds resolved together. The inputs and outputs of
+// all of them are loaded the first time any of them are asked for.
+type buildGroup struct {
+	root   *Resolver
+	access accessor.Access
+	builds []atc.Build
+
+	resourcesOnce sync.Once
+	inputs        map[int][]db.BuildInput
+	outputs       map[int][]db.BuildOutput
+	resourcesErr  error
+}
+
+func newBuildGroup(root *Resolver, access accessor.Access, builds []atc.Build) *buildGroup {
+	return &buildGroup{
+		root:   root,
+		access: access,
+		builds: builds,
+	}
+}
+
+func (g *buildGroup) resolvers() []*buildResolver {
+	resolvers := make([]*buildResolver, len(g.builds))
+	for i, build := range g.builds {
+		resolvers[i] = &buildResolver{
+			group: g,
+			build: build,
+		}
+	}
+
+	return resolvers
+}
+
+func (g *buildGroup) loadResources() (map[int][]db.BuildInput, map[int][]db.BuildOutput, error) {
+	g.resourcesOnce.Do(func() {
+		var buildIDs []int
+		for _, build := range g.builds {
+			if g.access.IsAuthorized(build.TeamName) {
+				buildIDs = append(buildIDs, build.ID)
+			}
+		}
+
+		if len(buildIDs) == 0 {
+			return
+		}
+
+		g.inputs, g.outputs, g.resourcesErr = g.root.buildFactory.BuildsResources(buildIDs)
+		if g.resourcesErr != nil {
+			g.resourcesErr = g.root.internalError("failed-to-get-build-resources", g.resourcesErr)
+		}
+	})
+
+	return g.inputs, g.outputs, g.resourcesErr
+}
+
+type buildResolver struct {
+	group *buildGroup
+	build atc.Build
+}
+
+func (r *buildResolver) ID() int32 {
+	return int32(r.build.ID)
+}
+
+func (r *buildResolver) Name() string {
+	return r.build.Name
+}
+
+func (r *buildResolver) Status() string {
+	return string(r.build.Status)
+}
+
+func (r *buildResolver) TeamName() string {
+	return r.build.TeamName
+}
+
+func (r *buildResolver) PipelineID() *int32 {
+	return optionalInt(r.build.PipelineID)
+}
+
+func (r *buildResolver) PipelineName() *string {
+	return optionalString(r.build.PipelineName)
+}
+
+func (r *buildResolver) PipelineInstanceVars() *JSON {
+	return optionalJSON(r.build.PipelineInstanceVars, len(r.build.PipelineInstanceVars) == 0)
+}
+
+func (r *buildResolver) JobName() *string {
+	return optionalString(r.build.JobName)
+}
+
+func (r *buildResolver) ResourceName() *string {
+	return optionalString(r.build.ResourceName)
+}
+
+func (r *buildResolver) CreatedBy() *string {
+	if r.build.CreatedBy == nil {
+		return nil
+	}
+
+	return optionalString(*r.build.CreatedBy)
+}
+
+func (r *buildResolver) Comment() *string {
+	return optionalString(r.build.Comment)
+}
+
+func (r *buildResolver) StartTime() *Timestamp {
+	return optionalTimestamp(r.build.StartTime)
+}
+
+func (r *buildResolver) EndTime() *Timestamp {
+	return optionalTimestamp(r.build.EndTime)
+}
+
+func (r *buildResolver) Inputs() ([]*buildInputResolver, error) {
+	inputs, _, err := r.group.loadResources()
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := []*buildInputResolver{}
+	for _, input := range inputs[r.build.ID] {
+		resolvers = append(resolvers, &buildInputResolver{input})
+	}
+
+	return resolvers, nil
+}
+
+func (r *buildResolver) Outputs() ([]*buildOutputResolver, error) {
+	_, outputs, err := r.group.loadResources()
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := []*buildOutputResolver{}
+	for _, output := range outputs[r.build.ID] {
+		resolvers = append(resolvers, &buildOutputResolver{output})
+	}
+
+	return resolvers, nil
+}
+
+type buildInputResolver struct {
+	input db.BuildInput
+}
+
+func (r *buildInputResolver) Name() string {
+	return r.input.Name
+}
+
+func (r *buildInputResolver) ResourceID() int32 {
+	return int32(r.input.ResourceID)
+}
+
+func (r *buildInputResolver) Version() JSON {
+	return JSON{Value: r.input.Version}
+}
+
+func (r *buildInputResolver) FirstOccurrence() bool {
+	return r.input.FirstOccurrence
+}
+
+type buildOutputResolver struct {
+	output db.BuildOutput
+}
+
+func (r *buildOutputResolver) Name() string {
+	return r.output.Name
+}
+
+func (r *buildOutputResolver) Version() JSON {
+	return JSON{Value: r.output.Version}
+}
+
+type buildConnectionResolver struct {
+	builds          []*buildResolver
+	hasNextPage     bool
+	hasPreviousPage bool
+}
+
+func (r *buildConnectionResolver) Edges() []*buildEdgeResolver {
+	edges := []*buildEdgeResolver{}
+	for _, build := range r.builds {
+		edges = append(edges, &buildEdgeResolver{build})
+	}
+
+	return edges
+}
+
+func (r *buildConnectionResolver) PageInfo() *pageInfoResolver {
+	info := &pageInfoResolver{
+		hasNextPage:     r.hasNextPage,
+		hasPreviousPage: r.hasPreviousPage,
+	}
+
+	if len(r.builds) > 0 {
+		start := encodeCursor(r.builds[0].build.ID)
+		end := encodeCursor(r.builds[len(r.builds)-1].build.ID)
+
+		info.startCursor = &start
+		info.endCursor = &end
+	}
+
+	return info
+}
+
+type buildEdgeResolver struct {
+	build *buildResolver
+}
+
+func (r *buildEdgeResolver) Cursor() string {
+	return encodeCursor(r.build.build.ID)
+}
+
+func (r *buildEdgeResolver) Node() *buildResolver {
+	return r.build
+}
+
+type pageInfoResolver struct {
+	hasNextPage     bool
+	hasPreviousPage bool
+	startCursor     *string
+	endCursor       *string
+}
+
+func (r *pageInfoResolver) HasNextPage() bool {
+	return r.hasNextPage
+}
+
+func (r *pageInfoResolver) HasPreviousPage() bool {
+	return r.hasPreviousPage
+}
+
+func (r *pageInfoResolver) StartCursor() *string {
+	return r.startCursor
+}
+
+func (r *pageInfoResolver) EndCursor() *string {
+	return r.endCursor
+}
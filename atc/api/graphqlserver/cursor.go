@@ -0,0 +1,99 @@
+package graphqlserver
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+const (
+	DefaultPageSize = 25
+	MaxPageSize     = 100
+
+	cursorPrefix = "build:"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+type pageArgs struct {
+	First  *int32
+	After  *string
+	Last   *int32
+	Before *string
+}
+
+// page converts the arguments into a db.Page. The cursors are exclusive,
+// whereas the page's bounds are inclusive. Asking for the last builds without
+// a cursor pages backwards from the oldest build.
+func (args pageArgs) page() (db.Page, error) {
+	if args.First != nil && args.Last != nil {
+		return db.Page{}, errors.New("first and last cannot both be given")
+	}
+
+	page := db.Page{Limit: DefaultPageSize}
+
+	size := args.First
+	if args.Last != nil {
+		size = args.Last
+	}
+
+	if size != nil {
+		if *size < 1 || *size > MaxPageSize {
+			return db.Page{}, fmt.Errorf("page size must be between 1 and %d", MaxPageSize)
+		}
+
+		page.Limit = int(*size)
+	}
+
+	if args.After != nil {
+		id, err := decodeCursor(*args.After)
+		if err != nil {
+			return db.Page{}, err
+		}
+
+		page.To = db.NewIntPtr(id - 1)
+	}
+
+	if args.Before != nil {
+		id, err := decodeCursor(*args.Before)
+		if err != nil {
+			return db.Page{}, err
+		}
+
+		page.From = db.NewIntPtr(id + 1)
+	} else if args.Last != nil {
+		page.From = db.NewIntPtr(0)
+	}
+
+	return page, nil
+}
+
+func (args pageArgs) backwards() bool {
+	return args.Last != nil || args.Before != nil
+}
+
+func encodeCursor(buildID int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(buildID)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+
+	if !strings.HasPrefix(string(decoded), cursorPrefix) {
+		return 0, errInvalidCursor
+	}
+
+	id, err := strconv.Atoi(strings.TrimPrefix(string(decoded), cursorPrefix))
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+
+	return id, nil
+}
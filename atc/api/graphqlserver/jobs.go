@@ -0,0 +1,234 @@
+package graphqlserver
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// jobGroup is a set of jobs resolved together. Each page of builds asked for
+// is loaded for all of the jobs at once.
+type jobGroup struct {
+	root   *Resolver
+	access accessor.Access
+	jobs   []atc.JobSummary
+
+	summaryBuilds map[int]*buildResolver
+
+	buildsLock sync.Mutex
+	builds     map[string]*jobBuildsBatch
+}
+
+type jobBuildsBatch struct {
+	once   sync.Once
+	builds map[int][]*buildResolver
+	err    error
+}
+
+func newJobGroup(root *Resolver, access accessor.Access, jobs []atc.JobSummary) *jobGroup {
+	group := &jobGroup{
+		root:   root,
+		access: access,
+		jobs:   jobs,
+
+		builds: map[string]*jobBuildsBatch{},
+	}
+
+	var summaries []atc.Build
+	for _, job := range jobs {
+		for _, summary := range []*atc.BuildSummary{job.FinishedBuild, job.NextBuild} {
+			if summary != nil {
+				summaries = append(summaries, summaryBuild(summary))
+			}
+		}
+	}
+
+	group.summaryBuilds = map[int]*buildResolver{}
+	for _, build := range newBuildGroup(root, access, summaries).resolvers() {
+		group.summaryBuilds[build.build.ID] = build
+	}
+
+	return group
+}
+
+func (g *jobGroup) resolvers() []*jobResolver {
+	resolvers := make([]*jobResolver, len(g.jobs))
+	for i, job := range g.jobs {
+		resolvers[i] = &jobResolver{
+			group: g,
+			job:   job,
+		}
+	}
+
+	return resolvers
+}
+
+func (g *jobGroup) loadBuilds(page db.Page) (map[int][]*buildResolver, error) {
+	g.buildsLock.Lock()
+	key := pageKey(page)
+	batch, found := g.builds[key]
+	if !found {
+		batch = &jobBuildsBatch{}
+		g.builds[key] = batch
+	}
+	g.buildsLock.Unlock()
+
+	batch.once.Do(func() {
+		jobIDs := make([]int, len(g.jobs))
+		for i, job := range g.jobs {
+			jobIDs[i] = job.ID
+		}
+
+		jobsBuilds, err := g.root.buildFactory.JobsBuilds(jobIDs, page)
+		if err != nil {
+			batch.err = g.root.internalError("failed-to-get-job-builds", err)
+			return
+		}
+
+		var builds []db.BuildForAPI
+		for _, jobID := range jobIDs {
+			builds = append(builds, jobsBuilds[jobID]...)
+		}
+
+		resolvers := newBuildGroup(g.root, g.access, presentBuilds(builds, g.access)).resolvers()
+
+		batch.builds = map[int][]*buildResolver{}
+		for i, build := range builds {
+			batch.builds[build.JobID()] = append(batch.builds[build.JobID()], resolvers[i])
+		}
+	})
+
+	return batch.builds, batch.err
+}
+
+func pageKey(page db.Page) string {
+	key := fmt.Sprintf("limit:%d", page.Limit)
+	if page.From != nil {
+		key += fmt.Sprintf(",from:%d", *page.From)
+	}
+
+	if page.To != nil {
+		key += fmt.Sprintf(",to:%d", *page.To)
+	}
+
+	return key
+}
+
+// summaryBuild is a job's finished or next build, as far as the job summary
+// knows it.
+func summaryBuild(summary *atc.BuildSummary) atc.Build {
+	return atc.Build{
+		ID:                   summary.ID,
+		Name:                 summary.Name,
+		Status:               summary.Status,
+		TeamName:             summary.TeamName,
+		PipelineID:           summary.PipelineID,
+		PipelineName:         summary.PipelineName,
+		PipelineInstanceVars: summary.PipelineInstanceVars,
+		JobName:              summary.JobName,
+		StartTime:            summary.StartTime,
+		EndTime:              summary.EndTime,
+	}
+}
+
+type jobResolver struct {
+	group *jobGroup
+	job   atc.JobSummary
+}
+
+func (r *jobResolver) ID() int32 {
+	return int32(r.job.ID)
+}
+
+func (r *jobResolver) Name() string {
+	return r.job.Name
+}
+
+func (r *jobResolver) TeamName() string {
+	return r.job.TeamName
+}
+
+func (r *jobResolver) PipelineID() int32 {
+	return int32(r.job.PipelineID)
+}
+
+func (r *jobResolver) PipelineName() string {
+	return r.job.PipelineName
+}
+
+func (r *jobResolver) PipelineInstanceVars() *JSON {
+	return optionalJSON(r.job.PipelineInstanceVars, len(r.job.PipelineInstanceVars) == 0)
+}
+
+func (r *jobResolver) Paused() bool {
+	return r.job.Paused
+}
+
+func (r *jobResolver) HasNewInputs() bool {
+	return r.job.HasNewInputs
+}
+
+func (r *jobResolver) Groups() []string {
+	return append([]string{}, r.job.Groups...)
+}
+
+func (r *jobResolver) FinishedBuild() *buildResolver {
+	if r.job.FinishedBuild == nil {
+		return nil
+	}
+
+	return r.group.summaryBuilds[r.job.FinishedBuild.ID]
+}
+
+func (r *jobResolver) NextBuild() *buildResolver {
+	if r.job.NextBuild == nil {
+		return nil
+	}
+
+	return r.group.summaryBuilds[r.job.NextBuild.ID]
+}
+
+// Builds loads one more build than asked for, to know whether there is
+// another page in the direction being paged.
+func (r *jobResolver) Builds(args pageArgs) (*buildConnectionResolver, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := page.Limit
+	page.Limit++
+
+	jobsBuilds, err := r.group.loadBuilds(page)
+	if err != nil {
+		return nil, err
+	}
+
+	builds := jobsBuilds[r.job.ID]
+	more := len(builds) > limit
+
+	if args.backwards() {
+		if more {
+			builds = builds[1:]
+		}
+
+		return &buildConnectionResolver{
+			builds:          builds,
+			hasNextPage:     args.Before != nil,
+			hasPreviousPage: more,
+		}, nil
+	}
+
+	if more {
+		builds = builds[:limit]
+	}
+
+	return &buildConnectionResolver{
+		builds:          builds,
+		hasNextPage:     more,
+		hasPreviousPage: args.After != nil,
+	}, nil
+}
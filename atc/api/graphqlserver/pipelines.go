@@ -0,0 +1,193 @@
+package graphqlserver
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// pipelineGroup is a set of pipelines resolved together. The jobs and
+// resources of all of them are loaded the first time any of them are asked
+// for, rather than once per pipeline.
+type pipelineGroup struct {
+	root      *Resolver
+	access    accessor.Access
+	pipelines []db.Pipeline
+
+	jobsOnce sync.Once
+	jobs     map[int][]*jobResolver
+	jobsErr  error
+
+	resourcesOnce sync.Once
+	resources     map[int][]*resourceResolver
+	resourcesErr  error
+}
+
+func newPipelineGroup(root *Resolver, access accessor.Access, pipelines []db.Pipeline) *pipelineGroup {
+	return &pipelineGroup{
+		root:      root,
+		access:    access,
+		pipelines: pipelines,
+	}
+}
+
+func (g *pipelineGroup) resolvers() []*pipelineResolver {
+	resolvers := make([]*pipelineResolver, len(g.pipelines))
+	for i, pipeline := range g.pipelines {
+		resolvers[i] = &pipelineResolver{
+			group:    g,
+			pipeline: present.Pipeline(pipeline),
+		}
+	}
+
+	return resolvers
+}
+
+func (g *pipelineGroup) pipelineIDs() []int {
+	ids := make([]int, len(g.pipelines))
+	for i, pipeline := range g.pipelines {
+		ids[i] = pipeline.ID()
+	}
+
+	return ids
+}
+
+func (g *pipelineGroup) loadJobs() (map[int][]*jobResolver, error) {
+	g.jobsOnce.Do(func() {
+		jobs, err := g.root.jobFactory.JobsForPipelines(g.pipelineIDs())
+		if err != nil {
+			g.jobsErr = g.root.internalError("failed-to-get-jobs", err)
+			return
+		}
+
+		g.jobs = map[int][]*jobResolver{}
+		for _, job := range newJobGroup(g.root, g.access, jobs).resolvers() {
+			g.jobs[job.job.PipelineID] = append(g.jobs[job.job.PipelineID], job)
+		}
+	})
+
+	return g.jobs, g.jobsErr
+}
+
+func (g *pipelineGroup) loadResources() (map[int][]*resourceResolver, error) {
+	g.resourcesOnce.Do(func() {
+		resources, err := g.root.resourceFactory.ResourcesForPipelines(g.pipelineIDs())
+		if err != nil {
+			g.resourcesErr = g.root.internalError("failed-to-get-resources", err)
+			return
+		}
+
+		g.resources = map[int][]*resourceResolver{}
+		for _, resource := range resources {
+			g.resources[resource.PipelineID()] = append(g.resources[resource.PipelineID()], &resourceResolver{
+				id:       resource.ID(),
+				resource: present.Resource(resource),
+			})
+		}
+	})
+
+	return g.resources, g.resourcesErr
+}
+
+type pipelineResolver struct {
+	group    *pipelineGroup
+	pipeline atc.Pipeline
+}
+
+func (r *pipelineResolver) ID() int32 {
+	return int32(r.pipeline.ID)
+}
+
+func (r *pipelineResolver) Name() string {
+	return r.pipeline.Name
+}
+
+func (r *pipelineResolver) InstanceVars() *JSON {
+	return optionalJSON(r.pipeline.InstanceVars, len(r.pipeline.InstanceVars) == 0)
+}
+
+func (r *pipelineResolver) TeamName() string {
+	return r.pipeline.TeamName
+}
+
+func (r *pipelineResolver) Paused() bool {
+	return r.pipeline.Paused
+}
+
+func (r *pipelineResolver) Public() bool {
+	return r.pipeline.Public
+}
+
+func (r *pipelineResolver) Archived() bool {
+	return r.pipeline.Archived
+}
+
+func (r *pipelineResolver) LastUpdated() *Timestamp {
+	return optionalTimestamp(r.pipeline.LastUpdated)
+}
+
+func (r *pipelineResolver) Jobs() ([]*jobResolver, error) {
+	jobs, err := r.group.loadJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*jobResolver{}, jobs[r.pipeline.ID]...), nil
+}
+
+func (r *pipelineResolver) Resources() ([]*resourceResolver, error) {
+	resources, err := r.group.loadResources()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*resourceResolver{}, resources[r.pipeline.ID]...), nil
+}
+
+type resourceResolver struct {
+	id       int
+	resource atc.Resource
+}
+
+func (r *resourceResolver) ID() int32 {
+	return int32(r.id)
+}
+
+func (r *resourceResolver) Name() string {
+	return r.resource.Name
+}
+
+func (r *resourceResolver) Type() string {
+	return r.resource.Type
+}
+
+func (r *resourceResolver) TeamName() string {
+	return r.resource.TeamName
+}
+
+func (r *resourceResolver) PipelineID() int32 {
+	return int32(r.resource.PipelineID)
+}
+
+func (r *resourceResolver) PipelineName() string {
+	return r.resource.PipelineName
+}
+
+func (r *resourceResolver) PipelineInstanceVars() *JSON {
+	return optionalJSON(r.resource.PipelineInstanceVars, len(r.resource.PipelineInstanceVars) == 0)
+}
+
+func (r *resourceResolver) LastChecked() *Timestamp {
+	return optionalTimestamp(r.resource.LastChecked)
+}
+
+func (r *resourceResolver) PinnedVersion() *JSON {
+	return optionalJSON(r.resource.PinnedVersion, r.resource.PinnedVersion == nil)
+}
+
+func (r *resourceResolver) PinComment() *string {
+	return optionalString(r.resource.PinComment)
+}
@@ -0,0 +1,39 @@
+package graphqlserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+type queryRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func (s *Server) Query(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("graphql")
+
+	var request queryRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.Info("malformed-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx := withAccess(r.Context(), accessor.GetAccessor(r))
+
+	response := s.schema.Exec(ctx, request.Query, request.OperationName, request.Variables)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		logger.Error("failed-to-encode-response", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,217 @@
+package graphqlserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+var errInternal = errors.New("internal server error")
+
+type accessContextKey struct{}
+
+func withAccess(ctx context.Context, access accessor.Access) context.Context {
+	return context.WithValue(ctx, accessContextKey{}, access)
+}
+
+func accessFrom(ctx context.Context) accessor.Access {
+	return ctx.Value(accessContextKey{}).(accessor.Access)
+}
+
+// Resolver is the root of the schema. The schema is bound to it once, so
+// everything about the request, such as the user's access, comes in through
+// the context.
+type Resolver struct {
+	logger lager.Logger
+
+	teamFactory     db.TeamFactory
+	pipelineFactory db.PipelineFactory
+	jobFactory      db.JobFactory
+	resourceFactory db.ResourceFactory
+	buildFactory    db.BuildFactory
+}
+
+// internalError logs the error and hides it from the user, as the REST
+// handlers do with a 500.
+func (r *Resolver) internalError(action string, err error) error {
+	r.logger.Error(action, err)
+	return errInternal
+}
+
+type pipelinesArgs struct {
+	Team *string
+}
+
+func (r *Resolver) Pipelines(ctx context.Context, args pipelinesArgs) ([]*pipelineResolver, error) {
+	access := accessFrom(ctx)
+
+	var (
+		pipelines []db.Pipeline
+		err       error
+	)
+
+	if access.IsAdmin() {
+		pipelines, err = r.pipelineFactory.AllPipelines()
+	} else {
+		pipelines, err = r.pipelineFactory.VisiblePipelines(access.TeamNames())
+	}
+	if err != nil {
+		return nil, r.internalError("failed-to-get-pipelines", err)
+	}
+
+	if args.Team != nil {
+		var teamPipelines []db.Pipeline
+		for _, pipeline := range pipelines {
+			if pipeline.TeamName() == *args.Team {
+				teamPipelines = append(teamPipelines, pipeline)
+			}
+		}
+
+		pipelines = teamPipelines
+	}
+
+	return newPipelineGroup(r, access, pipelines).resolvers(), nil
+}
+
+type pipelineArgs struct {
+	Team         string
+	Name         string
+	InstanceVars *JSON
+}
+
+func (r *Resolver) Pipeline(ctx context.Context, args pipelineArgs) (*pipelineResolver, error) {
+	access := accessFrom(ctx)
+
+	ref := atc.PipelineRef{Name: args.Name}
+	if args.InstanceVars != nil {
+		err := args.InstanceVars.Decode(&ref.InstanceVars)
+		if err != nil {
+			return nil, fmt.Errorf("invalid instance vars: %w", err)
+		}
+	}
+
+	team, found, err := r.teamFactory.FindTeam(args.Team)
+	if err != nil {
+		return nil, r.internalError("failed-to-find-team", err)
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	pipeline, found, err := team.Pipeline(ref)
+	if err != nil {
+		return nil, r.internalError("failed-to-find-pipeline", err)
+	}
+
+	if !found || !(pipeline.Public() || access.IsAuthorized(team.Name())) {
+		return nil, nil
+	}
+
+	return newPipelineGroup(r, access, []db.Pipeline{pipeline}).resolvers()[0], nil
+}
+
+func (r *Resolver) Builds(ctx context.Context, args pageArgs) (*buildConnectionResolver, error) {
+	access := accessFrom(ctx)
+
+	page, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		builds     []db.BuildForAPI
+		pagination db.Pagination
+	)
+
+	if access.IsAdmin() {
+		builds, pagination, err = r.buildFactory.AllBuilds(page)
+	} else {
+		builds, pagination, err = r.buildFactory.VisibleBuilds(access.TeamNames(), page)
+	}
+	if err != nil {
+		return nil, r.internalError("failed-to-get-builds", err)
+	}
+
+	resolvers := newBuildGroup(r, access, presentBuilds(builds, access)).resolvers()
+
+	return &buildConnectionResolver{
+		builds:          resolvers,
+		hasNextPage:     pagination.Older != nil,
+		hasPreviousPage: pagination.Newer != nil,
+	}, nil
+}
+
+type buildArgs struct {
+	ID int32
+}
+
+func (r *Resolver) Build(ctx context.Context, args buildArgs) (*buildResolver, error) {
+	access := accessFrom(ctx)
+
+	build, found, err := r.buildFactory.BuildForAPI(int(args.ID))
+	if err != nil {
+		return nil, r.internalError("failed-to-get-build", err)
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	visible, err := buildVisible(build, access)
+	if err != nil {
+		return nil, r.internalError("failed-to-check-build-access", err)
+	}
+
+	if !visible {
+		return nil, nil
+	}
+
+	return newBuildGroup(r, access, presentBuilds([]db.BuildForAPI{build}, access)).resolvers()[0], nil
+}
+
+// buildVisible applies the same rule as reading a build through the REST
+// API: the user must be on one of the build's teams, or the build must be of
+// a public job in a public pipeline.
+func buildVisible(build db.BuildForAPI, access accessor.Access) (bool, error) {
+	for _, team := range build.AllAssociatedTeamNames() {
+		if access.IsAuthorized(team) {
+			return true, nil
+		}
+	}
+
+	if build.PipelineID() == 0 || build.JobID() == 0 {
+		return false, nil
+	}
+
+	pipeline, found, err := build.Pipeline()
+	if err != nil {
+		return false, err
+	}
+
+	if !found || !pipeline.Public() {
+		return false, nil
+	}
+
+	job, found, err := pipeline.Job(build.JobName())
+	if err != nil {
+		return false, err
+	}
+
+	return found && job.Public(), nil
+}
+
+func presentBuilds(builds []db.BuildForAPI, access accessor.Access) []atc.Build {
+	presented := make([]atc.Build, len(builds))
+	for i, build := range builds {
+		presented[i] = present.Build(build, nil, access)
+	}
+
+	return presented
+}
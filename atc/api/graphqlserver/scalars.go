@@ -0,0 +1,103 @@
+package graphqlserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// JSON is the JSON scalar, for values without a fixed shape.
+type JSON struct {
+	Value interface{}
+}
+
+func (JSON) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+func (j *JSON) UnmarshalGraphQL(input interface{}) error {
+	j.Value = input
+	return nil
+}
+
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(j.Value)
+}
+
+// Decode converts the value into dest, by way of JSON.
+func (j JSON) Decode(dest interface{}) error {
+	payload, err := json.Marshal(j.Value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(payload, dest)
+}
+
+// Timestamp is the Timestamp scalar: Unix seconds, as elsewhere in the API.
+type Timestamp int64
+
+func (Timestamp) ImplementsGraphQLType(name string) bool {
+	return name == "Timestamp"
+}
+
+func (t *Timestamp) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case int32:
+		*t = Timestamp(input)
+	case int64:
+		*t = Timestamp(input)
+	case float64:
+		*t = Timestamp(input)
+	default:
+		return fmt.Errorf("invalid timestamp: %v", input)
+	}
+
+	return nil
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(t))
+}
+
+func optionalJSON(value interface{}, empty bool) *JSON {
+	if empty {
+		return nil
+	}
+
+	return &JSON{Value: value}
+}
+
+func optionalTimestamp(unix int64) *Timestamp {
+	if unix == 0 {
+		return nil
+	}
+
+	timestamp := Timestamp(unix)
+	return &timestamp
+}
+
+func optionalTime(t time.Time) *Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return optionalTimestamp(t.Unix())
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func optionalInt(i int) *int32 {
+	if i == 0 {
+		return nil
+	}
+
+	i32 := int32(i)
+	return &i32
+}
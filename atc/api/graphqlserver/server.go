@@ -0,0 +1,59 @@
+package graphqlserver
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc/db"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+// MaxDepth limits how deeply queries may nest, so that one request can't
+// walk the whole database.
+const MaxDepth = 10
+
+//go:embed schema.graphql
+var schema string
+
+type Server struct {
+	logger lager.Logger
+	schema *graphql.Schema
+}
+
+func NewServer(
+	logger lager.Logger,
+	teamFactory db.TeamFactory,
+	pipelineFactory db.PipelineFactory,
+	jobFactory db.JobFactory,
+	resourceFactory db.ResourceFactory,
+	buildFactory db.BuildFactory,
+) *Server {
+	resolver := &Resolver{
+		logger:          logger,
+		teamFactory:     teamFactory,
+		pipelineFactory: pipelineFactory,
+		jobFactory:      jobFactory,
+		resourceFactory: resourceFactory,
+		buildFactory:    buildFactory,
+	}
+
+	return &Server{
+		logger: logger,
+		schema: graphql.MustParseSchema(
+			schema,
+			resolver,
+			graphql.MaxDepth(MaxDepth),
+			graphql.Logger(panicLogger{logger}),
+		),
+	}
+}
+
+type panicLogger struct {
+	logger lager.Logger
+}
+
+func (l panicLogger) LogPanic(_ context.Context, value interface{}) {
+	l.logger.Error("resolver-panicked", fmt.Errorf("%v", value))
+}
@@ -8,12 +8,14 @@ import (
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager/v3"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/activityserver"
 	"github.com/concourse/concourse/atc/api/artifactserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/cliserver"
 	"github.com/concourse/concourse/atc/api/configserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/api/graphqlserver"
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
@@ -24,6 +26,7 @@ import (
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/wallserver"
+	"github.com/concourse/concourse/atc/api/webhookserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -61,6 +64,9 @@ func NewHandler(
 	dbCheckFactory db.CheckFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbInterceptSessionFactory db.InterceptSessionFactory,
+	dbWebhookFactory db.WebhookFactory,
+	dbActivityFeed db.ActivityFeed,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 
@@ -78,6 +84,7 @@ func NewHandler(
 	credsManagers creds.Managers,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
+	recordInterceptSessions bool,
 	dbWall db.Wall,
 	clock clock.Clock,
 ) (http.Handler, error) {
@@ -102,17 +109,22 @@ func NewHandler(
 	workerServer := workerserver.NewServer(logger, workerTeamFactory, dbWorkerFactory)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
-	containerServer := containerserver.NewServer(logger, workerPool, interceptTimeoutFactory, interceptUpdateInterval, containerRepository, destroyer, clock)
+	containerServer := containerserver.NewServer(logger, workerPool, interceptTimeoutFactory, interceptUpdateInterval, dbInterceptSessionFactory, recordInterceptSessions, containerRepository, destroyer, clock)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
 	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
 	artifactServer := artifactserver.NewServer(logger, workerPool)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	webhookServer := webhookserver.NewServer(logger, dbWebhookFactory)
+	activityServer := activityserver.NewServer(logger, dbActivityFeed)
+	graphqlServer := graphqlserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, dbJobFactory, dbResourceFactory, dbBuildFactory)
 
 	handlers := map[string]http.Handler{
-		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
-		atc.SaveConfig: http.HandlerFunc(configServer.SaveConfig),
+		atc.GetConfig:          http.HandlerFunc(configServer.GetConfig),
+		atc.SaveConfig:         http.HandlerFunc(configServer.SaveConfig),
+		atc.ListConfigVersions: http.HandlerFunc(configServer.ListConfigVersions),
+		atc.GetConfigVersion:   http.HandlerFunc(configServer.GetConfigVersion),
 
 		atc.GetCC: http.HandlerFunc(ccServer.GetCC),
 
@@ -126,12 +138,16 @@ func NewHandler(
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
 		atc.SetBuildComment:     buildHandlerFactory.HandlerFor(buildServer.SetBuildComment),
+		atc.GetBuildTestResults: buildHandlerFactory.HandlerFor(buildServer.GetBuildTestResults),
+		atc.GetBuildProvenance:  buildHandlerFactory.HandlerFor(buildServer.GetBuildProvenance),
 
 		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
 		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
 		atc.GetJob:         pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
 		atc.ListJobBuilds:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
 		atc.ListJobInputs:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
+		atc.ListJobTests:   pipelineHandlerFactory.HandlerFor(jobServer.ListJobTests),
+		atc.GetJobSLO:      pipelineHandlerFactory.HandlerFor(jobServer.GetJobSLO),
 		atc.GetJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
 		atc.CreateJobBuild: pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
 		atc.RerunJobBuild:  pipelineHandlerFactory.HandlerFor(jobServer.RerunJobBuild),
@@ -210,6 +226,8 @@ func NewHandler(
 		atc.ListContainers:           teamHandlerFactory.HandlerFor(containerServer.ListContainers),
 		atc.GetContainer:             teamHandlerFactory.HandlerFor(containerServer.GetContainer),
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
+		atc.ListInterceptSessions:    teamHandlerFactory.HandlerFor(containerServer.ListInterceptSessions),
+		atc.GetInterceptRecording:    teamHandlerFactory.HandlerFor(containerServer.GetInterceptRecording),
 		atc.ListDestroyingContainers: http.HandlerFunc(containerServer.ListDestroyingContainers),
 		atc.ReportWorkerContainers:   http.HandlerFunc(containerServer.ReportWorkerContainers),
 
@@ -224,6 +242,18 @@ func NewHandler(
 		atc.DestroyTeam:    teamHandlerFactory.HandlerFor(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: teamHandlerFactory.HandlerFor(teamServer.ListTeamBuilds),
 
+		atc.SearchBuildLogs: teamHandlerFactory.HandlerFor(teamServer.SearchBuildLogs),
+
+		atc.ListWebhooks:          teamHandlerFactory.HandlerFor(webhookServer.ListWebhooks),
+		atc.SetWebhook:            teamHandlerFactory.HandlerFor(webhookServer.SetWebhook),
+		atc.DestroyWebhook:        teamHandlerFactory.HandlerFor(webhookServer.DestroyWebhook),
+		atc.ListWebhookDeliveries: teamHandlerFactory.HandlerFor(webhookServer.ListWebhookDeliveries),
+
+		atc.ClusterActivity: http.HandlerFunc(activityServer.ClusterActivity),
+		atc.TeamActivity:    teamHandlerFactory.HandlerFor(activityServer.TeamActivity),
+
+		atc.GraphQL: http.HandlerFunc(graphqlServer.Query),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
 
@@ -0,0 +1,254 @@
+package api_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Intercept Sessions API", func() {
+	var (
+		requestGenerator *rata.RequestGenerator
+
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		requestGenerator = rata.NewRequestGenerator(server.URL, atc.Routes)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/intercept-sessions", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			request, err := requestGenerator.CreateRequest(atc.ListInterceptSessions, rata.Params{
+				"team_name": "a-team",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			request.URL.RawQuery = query
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the team has sessions", func() {
+				BeforeEach(func() {
+					exitStatus := 0
+					dbInterceptSessions.InterceptSessionsReturns([]db.InterceptSession{
+						{
+							ID:              2,
+							TeamID:          734,
+							ContainerHandle: "some-handle",
+							CreatedBy:       "some-user",
+							Command:         []string{"bash"},
+							BuildID:         42,
+							BuildName:       "7",
+							PipelineName:    "some-pipeline",
+							JobName:         "deploy",
+							StepName:        "push",
+							StartedAt:       time.Unix(100, 0),
+							EndedAt:         time.Unix(160, 0),
+							ExitStatus:      &exitStatus,
+							Recorded:        true,
+						},
+						{
+							ID:              1,
+							TeamID:          734,
+							ContainerHandle: "other-handle",
+							Command:         []string{"sh", "-c", "env"},
+							StartedAt:       time.Unix(50, 0),
+						},
+					}, nil)
+				})
+
+				It("returns 200 with the sessions", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 2,
+							"container_handle": "some-handle",
+							"created_by": "some-user",
+							"command": ["bash"],
+							"build_id": 42,
+							"build_name": "7",
+							"pipeline_name": "some-pipeline",
+							"job_name": "deploy",
+							"step_name": "push",
+							"started_at": 100,
+							"ended_at": 160,
+							"exit_status": 0,
+							"recorded": true
+						},
+						{
+							"id": 1,
+							"container_handle": "other-handle",
+							"command": ["sh", "-c", "env"],
+							"started_at": 50,
+							"recorded": false
+						}
+					]`))
+				})
+
+				It("looks up the team's sessions", func() {
+					Expect(dbInterceptSessions.InterceptSessionsCallCount()).To(Equal(1))
+
+					teamID, limit := dbInterceptSessions.InterceptSessionsArgsForCall(0)
+					Expect(teamID).To(Equal(734))
+					Expect(limit).To(Equal(0))
+				})
+
+				Context("with a limit", func() {
+					BeforeEach(func() {
+						query = "limit=5"
+					})
+
+					It("passes it along", func() {
+						_, limit := dbInterceptSessions.InterceptSessionsArgsForCall(0)
+						Expect(limit).To(Equal(5))
+					})
+				})
+
+				Context("with an invalid limit", func() {
+					BeforeEach(func() {
+						query = "limit=lots"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+			})
+
+			Context("when looking up the sessions fails", func() {
+				BeforeEach(func() {
+					dbInterceptSessions.InterceptSessionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/intercept-sessions/:session_id/recording", func() {
+		var sessionID string
+
+		BeforeEach(func() {
+			sessionID = "2"
+		})
+
+		JustBeforeEach(func() {
+			request, err := requestGenerator.CreateRequest(atc.GetInterceptRecording, rata.Params{
+				"team_name":  "a-team",
+				"session_id": sessionID,
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the session was recorded", func() {
+				BeforeEach(func() {
+					dbInterceptSessions.InterceptSessionRecordingReturns([]byte(`{"version":2}`+"\n"), true, nil)
+				})
+
+				It("returns the recording", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/x-asciicast"))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal(`{"version":2}` + "\n"))
+
+					teamID, id := dbInterceptSessions.InterceptSessionRecordingArgsForCall(0)
+					Expect(teamID).To(Equal(734))
+					Expect(id).To(Equal(2))
+				})
+			})
+
+			Context("when the session has no recording", func() {
+				BeforeEach(func() {
+					dbInterceptSessions.InterceptSessionRecordingReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the session id is malformed", func() {
+				BeforeEach(func() {
+					sessionID = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+})
@@ -1665,6 +1665,250 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/test-results", func() {
+		var (
+			response *http.Response
+			query    string
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/test-results" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.TestResultsReturns(atc.JobTestResults{
+						Builds: []atc.BuildTestSummary{
+							{
+								BuildID:   2,
+								BuildName: "2",
+								Status:    atc.StatusFailed,
+								Summary:   atc.TestSummary{Total: 2, Passed: 1, Failed: 1, Duration: 1.5},
+							},
+						},
+						Flaky: []atc.FlakyTest{
+							{Suite: "api", Name: "deletes", Passed: 3, Failed: 1, LastFailedBuild: "2"},
+						},
+					}, nil)
+				})
+
+				It("returns the job's test history", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"builds": [
+							{
+								"build_id": 2,
+								"build_name": "2",
+								"status": "failed",
+								"summary": {"total": 2, "passed": 1, "failed": 1, "errored": 0, "skipped": 0, "duration": 1.5}
+							}
+						],
+						"flaky": [
+							{"suite": "api", "name": "deletes", "passed": 3, "failed": 1, "last_failed_build": "2"}
+						]
+					}`))
+				})
+
+				It("looks at the default number of builds", func() {
+					Expect(fakeJob.TestResultsCallCount()).To(Equal(1))
+					Expect(fakeJob.TestResultsArgsForCall(0)).To(Equal(0))
+				})
+
+				Context("when the number of builds is given", func() {
+					BeforeEach(func() {
+						query = "?builds=10"
+					})
+
+					It("looks at that many builds", func() {
+						Expect(fakeJob.TestResultsArgsForCall(0)).To(Equal(10))
+					})
+				})
+
+				Context("when the number of builds is invalid", func() {
+					BeforeEach(func() {
+						query = "?builds=lots"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeJob.TestResultsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when getting the test results fails", func() {
+					BeforeEach(func() {
+						fakeJob.TestResultsReturns(atc.JobTestResults{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/slo", func() {
+		var (
+			response *http.Response
+			query    string
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/slo" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.SLOReportReturns(atc.JobSLOReport{
+						SLO: &atc.JobSLO{Duration: "10m", SuccessRate: 0.9},
+						Stats: atc.JobSLOStats{
+							Builds:         4,
+							Succeeded:      3,
+							SuccessRate:    0.75,
+							MeanDuration:   540,
+							StdDevDuration: 30,
+						},
+						Breaches: []atc.JobSLOBreach{
+							{
+								Build: atc.Build{ID: 7, Name: "7", JobName: "some-job", Status: atc.StatusSucceeded},
+								Stats: atc.JobSLOStats{Builds: 3, Succeeded: 3, SuccessRate: 1, MeanDuration: 500},
+								Violations: []atc.JobSLOViolation{
+									{Kind: atc.JobSLOViolationDuration, Value: 660, Target: 600},
+								},
+							},
+						},
+					}, nil)
+				})
+
+				It("returns the job's SLO report", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"slo": {"duration": "10m", "success_rate": 0.9},
+						"stats": {"builds": 4, "succeeded": 3, "success_rate": 0.75, "mean_duration": 540, "stddev_duration": 30},
+						"breaches": [
+							{
+								"build": {"id": 7, "name": "7", "job_name": "some-job", "status": "succeeded", "team_name": "", "api_url": ""},
+								"stats": {"builds": 3, "succeeded": 3, "success_rate": 1, "mean_duration": 500, "stddev_duration": 0},
+								"violations": [{"kind": "duration", "value": 660, "target": 600}]
+							}
+						]
+					}`))
+				})
+
+				It("lists the default number of breaches", func() {
+					Expect(fakeJob.SLOReportCallCount()).To(Equal(1))
+					Expect(fakeJob.SLOReportArgsForCall(0)).To(Equal(0))
+				})
+
+				Context("when the number of breaches is given", func() {
+					BeforeEach(func() {
+						query = "?breaches=5"
+					})
+
+					It("lists that many breaches", func() {
+						Expect(fakeJob.SLOReportArgsForCall(0)).To(Equal(5))
+					})
+				})
+
+				Context("when the number of breaches is invalid", func() {
+					BeforeEach(func() {
+						query = "?breaches=-1"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeJob.SLOReportCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when getting the report fails", func() {
+					BeforeEach(func() {
+						fakeJob.SLOReportReturns(atc.JobSLOReport{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", func() {
 		var response *http.Response
 
@@ -0,0 +1,54 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	. "github.com/concourse/concourse/atc/api/helpers"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetJobSLO(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-job-slo")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		breaches := 0
+		if rawBreaches := r.FormValue("breaches"); rawBreaches != "" {
+			var err error
+			breaches, err = strconv.Atoi(rawBreaches)
+			if err != nil || breaches < 0 {
+				HandleBadRequest(w, fmt.Sprintf("invalid breaches: %s", rawBreaches))
+				return
+			}
+		}
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		report, err := job.SLOReport(breaches)
+		if err != nil {
+			logger.Error("failed-to-get-slo-report", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(report)
+		if err != nil {
+			logger.Error("failed-to-encode-slo-report", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
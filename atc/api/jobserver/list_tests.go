@@ -0,0 +1,54 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	. "github.com/concourse/concourse/atc/api/helpers"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListJobTests(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-job-tests")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		builds := 0
+		if rawBuilds := r.FormValue("builds"); rawBuilds != "" {
+			var err error
+			builds, err = strconv.Atoi(rawBuilds)
+			if err != nil || builds < 0 {
+				HandleBadRequest(w, fmt.Sprintf("invalid builds: %s", rawBuilds))
+				return
+			}
+		}
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		history, err := job.TestResults(builds)
+		if err != nil {
+			logger.Error("failed-to-get-test-results", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(history)
+		if err != nil {
+			logger.Error("failed-to-encode-test-results", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -44,6 +44,10 @@ func Build(build db.BuildForAPI, job db.Job, access accessor.Access) atc.Build {
 	if showComments {
 		comment := build.Comment()
 		atcBuild.Comment = comment
+
+		// annotations come from the build's tasks, so they are only as
+		// visible as its comment
+		atcBuild.Annotations = build.Annotations()
 	}
 
 	if build.RerunOf() != 0 {
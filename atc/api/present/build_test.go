@@ -3,6 +3,7 @@ package present_test
 import (
 	"fmt"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/present"
@@ -52,4 +53,30 @@ var _ = Describe("Build", func() {
 			})
 		}
 	})
+
+	Describe("Annotations", func() {
+		annotations := []atc.BuildAnnotations{{Step: "unit", Summary: "all good"}}
+
+		BeforeEach(func() {
+			dbBuild.AnnotationsReturns(annotations)
+		})
+
+		It("should not be set if neither job nor accessor is passed in", func() {
+			Expect(present.Build(&dbBuild, nil, nil).Annotations).To(BeEmpty())
+		})
+
+		It("should be set if job is public", func() {
+			var dbJob dbfakes.FakeJob
+			dbJob.PublicReturns(true)
+
+			Expect(present.Build(&dbBuild, &dbJob, nil).Annotations).To(Equal(annotations))
+		})
+
+		It("should be set if accessor allows it", func() {
+			var accessor accessorfakes.FakeAccess
+			accessor.IsAuthorizedReturns(true)
+
+			Expect(present.Build(&dbBuild, nil, &accessor).Annotations).To(Equal(annotations))
+		})
+	})
 })
@@ -0,0 +1,17 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func ConfigVersion(config db.PipelineConfig) atc.ConfigVersion {
+	return atc.ConfigVersion{
+		Version:   config.Version,
+		CreatedBy: config.CreatedBy,
+		CreatedAt: config.CreatedAt.Unix(),
+		BuildID:   config.BuildID,
+		BuildName: config.BuildName,
+		JobName:   config.JobName,
+	}
+}
@@ -0,0 +1,29 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func InterceptSession(session db.InterceptSession) atc.InterceptSession {
+	var endedAt int64
+	if !session.EndedAt.IsZero() {
+		endedAt = session.EndedAt.Unix()
+	}
+
+	return atc.InterceptSession{
+		ID:              session.ID,
+		ContainerHandle: session.ContainerHandle,
+		CreatedBy:       session.CreatedBy,
+		Command:         session.Command,
+		BuildID:         session.BuildID,
+		BuildName:       session.BuildName,
+		PipelineName:    session.PipelineName,
+		JobName:         session.JobName,
+		StepName:        session.StepName,
+		StartedAt:       session.StartedAt.Unix(),
+		EndedAt:         endedAt,
+		ExitStatus:      session.ExitStatus,
+		Recorded:        session.Recorded,
+	}
+}
@@ -0,0 +1,47 @@
+package present
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Webhook presents a webhook without its secret, which is write-only.
+func Webhook(webhook db.Webhook) atc.Webhook {
+	events := []atc.WebhookEvent{}
+	for _, event := range webhook.Events {
+		events = append(events, atc.WebhookEvent(event))
+	}
+
+	return atc.Webhook{
+		Name:      webhook.Name,
+		URL:       webhook.URL,
+		Events:    events,
+		CreatedAt: webhook.CreatedAt.Unix(),
+	}
+}
+
+func WebhookDelivery(delivery db.WebhookDelivery) atc.WebhookDelivery {
+	presented := atc.WebhookDelivery{
+		ID:             delivery.ID,
+		Event:          atc.WebhookEvent(delivery.Event),
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         atc.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt.Unix(),
+	}
+
+	if !delivery.LastAttemptAt.IsZero() {
+		presented.LastAttemptAt = delivery.LastAttemptAt.Unix()
+	}
+
+	// the next attempt is only meaningful while the delivery is pending
+	if delivery.Status == string(atc.WebhookDeliveryPending) && !delivery.NextAttemptAt.IsZero() {
+		presented.NextAttemptAt = delivery.NextAttemptAt.Unix()
+	}
+
+	return presented
+}
@@ -34,7 +34,10 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		ActiveTasks:      activeTasks,
 		ResourceTypes:    workerInfo.ResourceTypes(),
 		Platform:         workerInfo.Platform(),
+		Architecture:     workerInfo.Architecture(),
+		Variant:          workerInfo.Variant(),
 		Tags:             workerInfo.Tags(),
+		RuntimeClasses:   workerInfo.RuntimeClasses(),
 		Name:             workerInfo.Name(),
 		Team:             workerInfo.TeamName(),
 		State:            string(workerInfo.State()),
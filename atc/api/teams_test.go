@@ -790,4 +790,201 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/builds/search", func() {
+		var (
+			response    *http.Response
+			queryParams string
+		)
+
+		BeforeEach(func() {
+			queryParams = "?q=connection+refused"
+			dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			atc.EnableBuildLogSearch = true
+		})
+
+		AfterEach(func() {
+			atc.EnableBuildLogSearch = false
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/builds/search" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the feature is disabled", func() {
+				BeforeEach(func() {
+					atc.EnableBuildLogSearch = false
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the search succeeds", func() {
+				BeforeEach(func() {
+					fakeTeam.SearchBuildLogsReturns([]atc.BuildLogMatch{
+						{
+							BuildID:      4,
+							BuildName:    "2",
+							JobName:      "some-job",
+							PipelineID:   1,
+							PipelineName: "some-pipeline",
+							TeamName:     "some-team",
+							Origin:       "some-origin",
+							Time:         100,
+							Payload:      "error: connection refused\n",
+						},
+					}, nil)
+				})
+
+				It("searches with the default limit", func() {
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(1))
+					Expect(fakeTeam.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+						Query: "connection refused",
+					}))
+				})
+
+				It("returns the matches", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).Should(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"build_id": 4,
+							"build_name": "2",
+							"job_name": "some-job",
+							"pipeline_id": 1,
+							"pipeline_name": "some-pipeline",
+							"team_name": "some-team",
+							"origin": "some-origin",
+							"time": 100,
+							"payload": "error: connection refused\n"
+						}
+					]`))
+				})
+
+				Context("when filters are given", func() {
+					BeforeEach(func() {
+						queryParams += "&pipeline_name=some-pipeline&vars.branch=%22main%22&job_name=some-job&since=100&until=200&limit=10"
+
+						fakeJob := new(dbfakes.FakeJob)
+						fakeJob.IDReturns(42)
+
+						fakePipeline := new(dbfakes.FakePipeline)
+						fakePipeline.JobReturns(fakeJob, true, nil)
+						fakeTeam.PipelineReturns(fakePipeline, true, nil)
+					})
+
+					It("passes them through", func() {
+						Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "main"},
+						}))
+
+						Expect(fakeTeam.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+							Query: "connection refused",
+							JobID: 42,
+							Since: time.Unix(100, 0),
+							Until: time.Unix(200, 0),
+							Limit: 10,
+						}))
+					})
+				})
+			})
+
+			Context("when the query is missing", func() {
+				BeforeEach(func() {
+					queryParams = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the time filter is invalid", func() {
+				BeforeEach(func() {
+					queryParams += "&since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when a job is given without its pipeline", func() {
+				BeforeEach(func() {
+					queryParams += "&job_name=some-job"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the job does not exist", func() {
+				BeforeEach(func() {
+					queryParams += "&pipeline_name=some-pipeline&job_name=some-job"
+
+					fakePipeline := new(dbfakes.FakePipeline)
+					fakePipeline.JobReturns(nil, false, nil)
+					fakeTeam.PipelineReturns(fakePipeline, true, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the search fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SearchBuildLogsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,125 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/api/helpers"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SearchBuildLogs(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("search-build-logs")
+
+		if !atc.EnableBuildLogSearch {
+			logger.Info("build-log-search-disabled")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		search := db.BuildLogSearch{
+			Query: r.FormValue(atc.BuildLogSearchQuery),
+		}
+
+		if search.Query == "" {
+			HandleBadRequest(w, "missing search query")
+			return
+		}
+
+		var err error
+		if rawLimit := r.FormValue(atc.PaginationQueryLimit); rawLimit != "" {
+			search.Limit, err = strconv.Atoi(rawLimit)
+			if err != nil || search.Limit < 0 {
+				HandleBadRequest(w, fmt.Sprintf("invalid limit: %s", rawLimit))
+				return
+			}
+		}
+
+		search.Since, err = searchTime(r, atc.BuildLogSearchSince)
+		if err != nil {
+			HandleBadRequest(w, err.Error())
+			return
+		}
+
+		search.Until, err = searchTime(r, atc.BuildLogSearchUntil)
+		if err != nil {
+			HandleBadRequest(w, err.Error())
+			return
+		}
+
+		if jobName := r.FormValue(atc.BuildLogSearchJobName); jobName != "" {
+			pipelineRef := atc.PipelineRef{Name: r.FormValue(atc.BuildLogSearchPipelineName)}
+			if pipelineRef.Name == "" {
+				HandleBadRequest(w, "a job can only be given along with its pipeline")
+				return
+			}
+
+			pipelineRef.InstanceVars, err = atc.InstanceVarsFromQueryParams(r.URL.Query())
+			if err != nil {
+				HandleBadRequest(w, err.Error())
+				return
+			}
+
+			pipeline, found, err := team.Pipeline(pipelineRef)
+			if err != nil {
+				logger.Error("failed-to-get-pipeline", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			job, found, err := pipeline.Job(jobName)
+			if err != nil {
+				logger.Error("failed-to-get-job", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			search.JobID = job.ID()
+		}
+
+		matches, err := team.SearchBuildLogs(search)
+		if err != nil {
+			logger.Error("failed-to-search-build-logs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(matches)
+		if err != nil {
+			logger.Error("failed-to-encode-build-log-matches", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// searchTime parses a search's time filter, given in seconds since the epoch.
+func searchTime(r *http.Request, name string) (time.Time, error) {
+	raw := r.FormValue(name)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s: %s", name, raw)
+	}
+
+	return time.Unix(seconds, 0), nil
+}
@@ -0,0 +1,430 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhooks API", func() {
+	var (
+		requestGenerator *rata.RequestGenerator
+
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		requestGenerator = rata.NewRequestGenerator(server.URL, atc.Routes)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/webhooks", func() {
+		JustBeforeEach(func() {
+			request, err := requestGenerator.CreateRequest(atc.ListWebhooks, rata.Params{
+				"team_name": "a-team",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the team has webhooks", func() {
+				BeforeEach(func() {
+					dbWebhookFactory.WebhooksReturns([]db.Webhook{
+						{
+							ID:        1,
+							TeamID:    734,
+							Name:      "some-webhook",
+							URL:       "https://example.com/hook",
+							Secret:    "some-secret",
+							Events:    []string{"build_status"},
+							CreatedAt: time.Unix(100, 0),
+						},
+						{
+							ID:        2,
+							TeamID:    734,
+							Name:      "other-webhook",
+							URL:       "https://example.com/other",
+							CreatedAt: time.Unix(200, 0),
+						},
+					}, nil)
+				})
+
+				It("returns 200 with the webhooks, without their secrets", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "some-webhook",
+							"url": "https://example.com/hook",
+							"events": ["build_status"],
+							"created_at": 100
+						},
+						{
+							"name": "other-webhook",
+							"url": "https://example.com/other",
+							"created_at": 200
+						}
+					]`))
+				})
+
+				It("looks up the team's webhooks", func() {
+					Expect(dbWebhookFactory.WebhooksCallCount()).To(Equal(1))
+					Expect(dbWebhookFactory.WebhooksArgsForCall(0)).To(Equal(734))
+				})
+			})
+
+			Context("when looking up the webhooks fails", func() {
+				BeforeEach(func() {
+					dbWebhookFactory.WebhooksReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/webhooks/:webhook_name", func() {
+		var (
+			webhookName string
+			webhook     atc.Webhook
+		)
+
+		BeforeEach(func() {
+			webhookName = "some-webhook"
+			webhook = atc.Webhook{
+				URL:    "https://example.com/hook",
+				Secret: "some-secret",
+				Events: []atc.WebhookEvent{atc.WebhookEventBuildStatus, atc.WebhookEventWorkerState},
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(webhook)
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err := requestGenerator.CreateRequest(atc.SetWebhook, rata.Params{
+				"team_name":    "a-team",
+				"webhook_name": webhookName,
+			}, bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				dbWebhookFactory.SaveWebhookReturns(db.Webhook{
+					ID:        1,
+					TeamID:    734,
+					Name:      "some-webhook",
+					URL:       "https://example.com/hook",
+					Secret:    "some-secret",
+					Events:    []string{"build_status", "worker_state"},
+					CreatedAt: time.Unix(100, 0),
+				}, nil)
+			})
+
+			It("saves the webhook", func() {
+				Expect(dbWebhookFactory.SaveWebhookCallCount()).To(Equal(1))
+
+				teamID, name, url, secret, events := dbWebhookFactory.SaveWebhookArgsForCall(0)
+				Expect(teamID).To(Equal(734))
+				Expect(name).To(Equal("some-webhook"))
+				Expect(url).To(Equal("https://example.com/hook"))
+				Expect(secret).To(Equal("some-secret"))
+				Expect(events).To(Equal([]string{"build_status", "worker_state"}))
+			})
+
+			It("returns 200 with the webhook, without its secret", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := io.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{
+					"name": "some-webhook",
+					"url": "https://example.com/hook",
+					"events": ["build_status", "worker_state"],
+					"created_at": 100
+				}`))
+			})
+
+			Context("when the webhook is invalid", func() {
+				BeforeEach(func() {
+					webhook.URL = "example.com"
+					webhook.Events = []atc.WebhookEvent{"bogus"}
+				})
+
+				It("returns 400 with the errors", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("must be an absolute http or https URL"))
+					Expect(string(body)).To(ContainSubstring("unknown event 'bogus'"))
+
+					Expect(dbWebhookFactory.SaveWebhookCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the name is not a valid identifier", func() {
+				BeforeEach(func() {
+					webhookName = "Some-Webhook"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbWebhookFactory.SaveWebhookCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					dbWebhookFactory.SaveWebhookReturns(db.Webhook{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/webhooks/:webhook_name", func() {
+		JustBeforeEach(func() {
+			request, err := requestGenerator.CreateRequest(atc.DestroyWebhook, rata.Params{
+				"team_name":    "a-team",
+				"webhook_name": "some-webhook",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the webhook exists", func() {
+				BeforeEach(func() {
+					dbWebhookFactory.DestroyWebhookReturns(true, nil)
+				})
+
+				It("destroys it and returns 204", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					teamID, name := dbWebhookFactory.DestroyWebhookArgsForCall(0)
+					Expect(teamID).To(Equal(734))
+					Expect(name).To(Equal("some-webhook"))
+				})
+			})
+
+			Context("when the webhook does not exist", func() {
+				BeforeEach(func() {
+					dbWebhookFactory.DestroyWebhookReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when destroying fails", func() {
+				BeforeEach(func() {
+					dbWebhookFactory.DestroyWebhookReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/webhooks/:webhook_name/deliveries", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			request, err := requestGenerator.CreateRequest(atc.ListWebhookDeliveries, rata.Params{
+				"team_name":    "a-team",
+				"webhook_name": "some-webhook",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			request.URL.RawQuery = query
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the webhook exists", func() {
+				BeforeEach(func() {
+					dbWebhookFactory.WebhookDeliveriesReturns([]db.WebhookDelivery{
+						{
+							ID:             2,
+							WebhookID:      1,
+							Event:          "pipeline_paused",
+							Payload:        `{"event":"pipeline_paused"}`,
+							Status:         "pending",
+							Attempts:       1,
+							ResponseStatus: 502,
+							Error:          "unexpected response: 502 Bad Gateway",
+							CreatedAt:      time.Unix(100, 0),
+							LastAttemptAt:  time.Unix(110, 0),
+							NextAttemptAt:  time.Unix(140, 0),
+						},
+						{
+							ID:             1,
+							WebhookID:      1,
+							Event:          "build_status",
+							Payload:        `{"event":"build_status"}`,
+							Status:         "delivered",
+							Attempts:       1,
+							ResponseStatus: 200,
+							CreatedAt:      time.Unix(50, 0),
+							LastAttemptAt:  time.Unix(51, 0),
+							NextAttemptAt:  time.Unix(50, 0),
+						},
+					}, true, nil)
+				})
+
+				It("returns 200 with the deliveries", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := io.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 2,
+							"event": "pipeline_paused",
+							"payload": {"event":"pipeline_paused"},
+							"status": "pending",
+							"attempts": 1,
+							"response_status": 502,
+							"error": "unexpected response: 502 Bad Gateway",
+							"created_at": 100,
+							"last_attempt_at": 110,
+							"next_attempt_at": 140
+						},
+						{
+							"id": 1,
+							"event": "build_status",
+							"payload": {"event":"build_status"},
+							"status": "delivered",
+							"attempts": 1,
+							"response_status": 200,
+							"created_at": 50,
+							"last_attempt_at": 51
+						}
+					]`))
+				})
+
+				Context("with a limit", func() {
+					BeforeEach(func() {
+						query = "limit=5"
+					})
+
+					It("passes it along", func() {
+						teamID, name, limit := dbWebhookFactory.WebhookDeliveriesArgsForCall(0)
+						Expect(teamID).To(Equal(734))
+						Expect(name).To(Equal("some-webhook"))
+						Expect(limit).To(Equal(5))
+					})
+				})
+
+				Context("with an invalid limit", func() {
+					BeforeEach(func() {
+						query = "limit=-1"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+			})
+
+			Context("when the webhook does not exist", func() {
+				BeforeEach(func() {
+					dbWebhookFactory.WebhookDeliveriesReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,32 @@
+package webhookserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) DestroyWebhook(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.FormValue(":webhook_name")
+
+		hLog := s.logger.Session("destroy-webhook", lager.Data{
+			"webhook": name,
+		})
+
+		destroyed, err := s.webhookFactory.DestroyWebhook(team.ID(), name)
+		if err != nil {
+			hLog.Error("failed-to-destroy-webhook", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !destroyed {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
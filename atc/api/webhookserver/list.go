@@ -0,0 +1,36 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListWebhooks(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hLog := s.logger.Session("list-webhooks")
+
+		webhooks, err := s.webhookFactory.Webhooks(team.ID())
+		if err != nil {
+			hLog.Error("failed-to-get-webhooks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := []atc.Webhook{}
+		for _, webhook := range webhooks {
+			presented = append(presented, present.Webhook(webhook))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			hLog.Error("failed-to-encode-webhooks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/api/helpers"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListWebhookDeliveries(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.FormValue(":webhook_name")
+
+		hLog := s.logger.Session("list-webhook-deliveries", lager.Data{
+			"webhook": name,
+		})
+
+		limit := 0
+		if rawLimit := r.FormValue(atc.PaginationQueryLimit); rawLimit != "" {
+			var err error
+			limit, err = strconv.Atoi(rawLimit)
+			if err != nil || limit < 0 {
+				HandleBadRequest(w, fmt.Sprintf("invalid limit: %s", rawLimit))
+				return
+			}
+		}
+
+		deliveries, found, err := s.webhookFactory.WebhookDeliveries(team.ID(), name, limit)
+		if err != nil {
+			hLog.Error("failed-to-get-webhook-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		presented := []atc.WebhookDelivery{}
+		for _, delivery := range deliveries {
+			presented = append(presented, present.WebhookDelivery(delivery))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			hLog.Error("failed-to-encode-webhook-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package webhookserver
+
+import (
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger         lager.Logger
+	webhookFactory db.WebhookFactory
+}
+
+func NewServer(
+	logger lager.Logger,
+	webhookFactory db.WebhookFactory,
+) *Server {
+	return &Server{
+		logger:         logger,
+		webhookFactory: webhookFactory,
+	}
+}
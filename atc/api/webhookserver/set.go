@@ -0,0 +1,67 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/api/helpers"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SetWebhook(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.FormValue(":webhook_name")
+
+		hLog := s.logger.Session("set-webhook", lager.Data{
+			"webhook": name,
+		})
+
+		var webhook atc.Webhook
+		err := json.NewDecoder(r.Body).Decode(&webhook)
+		if err != nil {
+			hLog.Info("malformed-request", lager.Data{"error": err.Error()})
+			HandleBadRequest(w, "malformed request: "+err.Error())
+			return
+		}
+
+		warning, err := atc.ValidateIdentifier(name, "webhook")
+		if err != nil {
+			HandleBadRequest(w, err.Error())
+			return
+		}
+
+		if warning != nil {
+			HandleBadRequest(w, warning.Message)
+			return
+		}
+
+		err = webhook.Validate()
+		if err != nil {
+			HandleBadRequest(w, err.Error())
+			return
+		}
+
+		events := []string{}
+		for _, event := range webhook.Events {
+			events = append(events, string(event))
+		}
+
+		saved, err := s.webhookFactory.SaveWebhook(team.ID(), name, webhook.URL, webhook.Secret, events)
+		if err != nil {
+			hLog.Error("failed-to-save-webhook", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(present.Webhook(saved))
+		if err != nil {
+			hLog.Error("failed-to-encode-webhook", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
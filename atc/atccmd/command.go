@@ -41,13 +41,16 @@ import (
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/logarchive"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/pauser"
 	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/provenance"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	"github.com/concourse/concourse/atc/syslog"
 	"github.com/concourse/concourse/atc/util"
+	"github.com/concourse/concourse/atc/webhook"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
 	"github.com/concourse/concourse/skymarshal/dexserver"
@@ -140,7 +143,9 @@ type RunCommand struct {
 	DebugBindIP   flag.IP `long:"debug-bind-ip"   default:"127.0.0.1" description:"IP address on which to listen for the pprof debugger endpoints."`
 	DebugBindPort uint16  `long:"debug-bind-port" default:"8079"      description:"Port on which to listen for the pprof debugger endpoints."`
 
-	InterceptIdleTimeout time.Duration `long:"intercept-idle-timeout" default:"0m" description:"Length of time for a intercepted session to be idle before terminating."`
+	InterceptIdleTimeout      time.Duration `long:"intercept-idle-timeout" default:"0m" description:"Length of time for a intercepted session to be idle before terminating."`
+	RecordInterceptSessions   bool          `long:"record-intercept-sessions" description:"Record the input and output of intercepted sessions, so that team owners can replay them with 'fly replay'."`
+	InterceptSessionRetention time.Duration `long:"intercept-session-retention" default:"720h" description:"How long recorded intercept sessions are kept. 0 means forever."`
 
 	ComponentRunnerInterval time.Duration `long:"component-runner-interval" default:"10s" description:"Interval on which runners are kicked off for builds, locks, scans, and checks"`
 
@@ -150,6 +155,7 @@ type RunCommand struct {
 	ResourceCheckingInterval            time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceTypeCheckingInterval        time.Duration `long:"resource-type-checking-interval" default:"1m" description:"Interval on which to check for new versions of resource types."`
 	ResourceWithWebhookCheckingInterval time.Duration `long:"resource-with-webhook-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources that has webhook defined."`
+	CheckContainerPoolSize              int           `long:"check-container-pool-size" default:"0" description:"Number of warm containers to keep per worker for running resource checks in, shared by checks of resources with the same type and image, each run in a scratch directory of its own. A value of zero disables the pool."`
 	MaxChecksPerSecond                  int           `long:"max-checks-per-second" description:"Maximum number of checks that can be started per second. If not specified, this will be calculated as (# of resources)/(resource checking interval). -1 value will remove this maximum limit of checks per second."`
 	PausePipelinesAfter                 int           `long:"pause-pipelines-after" default:"0" description:"The number of days after which a pipeline will be automatically paused if none of its jobs have run in more than the given number of days. A value of zero disables this component."`
 	PipelinePauserInterval              time.Duration `long:"pipeline-pauser-interval" default:"24h" hidden:"true" description:"The frequency on which the Pipeline Pauser component will be run to check if any pipelines need to be paused."`
@@ -233,8 +239,36 @@ type RunCommand struct {
 		Transport     string        `long:"syslog-transport" description:"Transport protocol for syslog messages (Currently supporting tcp, udp & tls)."`
 		DrainInterval time.Duration `long:"syslog-drain-interval" description:"Interval over which checking is done for new build logs to send to syslog server (duration measurement units are s/m/h; eg. 30s/30m/1h)" default:"30s"`
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
+		Format        string        `long:"syslog-format" default:"text" choice:"text" choice:"rfc5424" choice:"json" description:"Format of the messages sent to the syslog server. 'text' tags each message with the build's team/pipeline/job/build/origin, 'rfc5424' describes the build and step with RFC 5424 structured data, and 'json' sends a line of JSON per event."`
+		Events        string        `long:"syslog-events" default:"all" choice:"all" choice:"logs" description:"Whether to send every build event, such as steps starting and finishing, or only the builds' output."`
+		Teams         []string      `long:"syslog-team" description:"Only send the builds of this team. Can be specified multiple times. The builds of all teams are sent if not specified."`
+
+		DestinationsConfig flag.File `long:"syslog-destinations-config" description:"Path to a YAML list of additional syslog servers to drain build logs to, each with its own address, transport, hostname, ca_certs, format, events and teams."`
 	} ` group:"Syslog Drainer Configuration"`
 
+	Webhooks struct {
+		DeliveryInterval  time.Duration            `long:"webhook-delivery-interval" default:"10s" description:"Interval on which queued webhook deliveries are sent."`
+		Timeout           time.Duration            `long:"webhook-timeout" default:"10s" description:"Timeout for each request made to a webhook."`
+		MaxAttempts       int                      `long:"webhook-max-attempts" default:"8" description:"Number of times a webhook delivery is attempted before it is marked as failed."`
+		DeliveryRetention time.Duration            `long:"webhook-delivery-retention" default:"168h" description:"How long the log of sent and failed webhook deliveries is kept. 0 means forever."`
+		AllowedNetworks   []webhook.AllowedNetwork `long:"webhook-allowed-network" value-name:"CIDR" description:"Network that webhooks may be sent to even though it is not publicly routable, which is otherwise refused. Can be specified multiple times."`
+	} `group:"Webhooks"`
+
+	BuildLogArchive struct {
+		Dir               string `long:"build-log-archive-dir" description:"Directory to archive the events of builds to before they are reaped, e.g. a mounted network volume."`
+		Compression       string `long:"build-log-archive-compression" default:"gzip" choice:"gzip" choice:"zstd" choice:"raw" description:"Compression algorithm for archived build events."`
+		S3Bucket          string `long:"build-log-archive-s3-bucket" description:"S3 bucket to archive the events of builds to before they are reaped."`
+		S3Prefix          string `long:"build-log-archive-s3-prefix" description:"Prefix of the keys that archived build events are stored under."`
+		S3Region          string `long:"build-log-archive-s3-region" description:"AWS region of the S3 bucket."`
+		S3Endpoint        string `long:"build-log-archive-s3-endpoint" description:"Endpoint of an S3-compatible object storage to use instead of AWS."`
+		S3AccessKeyID     string `long:"build-log-archive-s3-access-key-id" description:"Access key ID for the S3 bucket. Uses the default AWS credentials chain if not set."`
+		S3SecretAccessKey string `long:"build-log-archive-s3-secret-access-key" description:"Secret access key for the S3 bucket."`
+		S3SessionToken    string `long:"build-log-archive-s3-session-token" description:"Session token for the S3 bucket."`
+		S3ForcePathStyle  bool   `long:"build-log-archive-s3-force-path-style" description:"Address the bucket by path rather than by subdomain, as many S3-compatible object storages require."`
+	} `group:"Build Log Archive"`
+
+	ProvenanceSigningKey flag.File `long:"provenance-signing-key" description:"File containing a PEM-encoded RSA, ECDSA or Ed25519 private key, used to sign the SLSA provenance generated for builds that run a put. Provenance is only generated when this is set."`
+
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
@@ -254,6 +288,7 @@ type RunCommand struct {
 		EnableP2PVolumeStreaming             bool `long:"enable-p2p-volume-streaming" description:"Enable P2P volume streaming. NOTE: All workers must be on the same LAN network"`
 		EnableCacheStreamedVolumes           bool `long:"enable-cache-streamed-volumes" description:"When enabled, streamed resource volumes will be cached on the destination worker."`
 		EnableResourceCausality              bool `long:"enable-resource-causality" description:"Enable the resource causality page. Computing causality can be expensive for the database. "`
+		EnableBuildLogSearch                 bool `long:"enable-build-log-search" description:"Index build logs so that they can be searched. The index roughly doubles the space taken by build logs in the database."`
 	} `group:"Feature Flags"`
 
 	BaseResourceTypeDefaults flag.File `long:"base-resource-type-defaults" description:"Base resource type defaults"`
@@ -540,9 +575,11 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	atc.EnablePipelineInstances = cmd.FeatureFlags.EnablePipelineInstances
 	atc.EnableCacheStreamedVolumes = cmd.FeatureFlags.EnableCacheStreamedVolumes
 	atc.EnableResourceCausality = cmd.FeatureFlags.EnableResourceCausality
+	atc.EnableBuildLogSearch = cmd.FeatureFlags.EnableBuildLogSearch
 	atc.DefaultCheckInterval = cmd.ResourceCheckingInterval
 	atc.DefaultWebhookInterval = cmd.ResourceWithWebhookCheckingInterval
 	atc.DefaultResourceTypeInterval = cmd.ResourceTypeCheckingInterval
+	atc.CheckContainerPoolSize = cmd.CheckContainerPoolSize
 
 	if cmd.BaseResourceTypeDefaults.Path() != "" {
 		content, err := os.ReadFile(cmd.BaseResourceTypeDefaults.Path())
@@ -820,6 +857,9 @@ func (cmd *RunCommand) constructAPIMembers(
 	}
 
 	userFactory := db.NewUserFactory(dbConn)
+	interceptSessionFactory := db.NewInterceptSessionFactory(dbConn)
+	webhookFactory := db.NewWebhookFactory(dbConn)
+	activityFeed := db.NewActivityFeed(dbConn)
 
 	dbResourceConfigFactory := db.NewResourceConfigFactory(dbConn, lockFactory)
 
@@ -885,6 +925,9 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		userFactory,
+		interceptSessionFactory,
+		webhookFactory,
+		activityFeed,
 		pool,
 		secretManager,
 		credsManagers,
@@ -1027,9 +1070,26 @@ func (cmd *RunCommand) backendComponents(
 		return nil, fmt.Errorf("syslog Drainer is misconfigured, cannot configure a drainer without a transport")
 	}
 
-	syslogDrainConfigured := true
-	if cmd.Syslog.Address == "" {
-		syslogDrainConfigured = false
+	syslogDestinations, err := cmd.syslogDestinations()
+	if err != nil {
+		return nil, err
+	}
+
+	syslogDrainConfigured := len(syslogDestinations) > 0
+
+	var buildLogArchiver gc.BuildLogArchiver
+	buildLogArchive, err := cmd.buildLogArchive()
+	if err != nil {
+		return nil, err
+	}
+
+	if buildLogArchive != nil {
+		buildLogArchiver = buildLogArchive
+	}
+
+	provenanceGenerator, err := cmd.provenanceGenerator()
+	if err != nil {
+		return nil, err
 	}
 
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
@@ -1043,6 +1103,7 @@ func (cmd *RunCommand) backendComponents(
 	dbJobFactory := db.NewJobFactory(dbConn, lockFactory)
 	dbPipelineLifecycle := db.NewPipelineLifecycle(dbConn, lockFactory)
 	dbPipelinePauser := db.NewPipelinePauser(dbConn, lockFactory)
+	dbWebhookFactory := db.NewWebhookFactory(dbConn)
 
 	dbWorkerFactory := db.NewWorkerFactory(dbConn, workerCache)
 
@@ -1087,6 +1148,7 @@ func (cmd *RunCommand) backendComponents(
 		lockFactory,
 		rateLimiter,
 		policyChecker,
+		provenanceGenerator,
 	)
 
 	buildEventWatcher, err := db.NewBuildBeingWatchedMarker(logger, dbConn, db.DefaultBuildBeingWatchedMarkDuration, clock.NewClock())
@@ -1178,6 +1240,7 @@ func (cmd *RunCommand) backendComponents(
 					cmd.MaxDaysToRetainBuildLogs,
 				),
 				syslogDrainConfigured,
+				buildLogArchiver,
 			),
 		},
 		{
@@ -1187,6 +1250,18 @@ func (cmd *RunCommand) backendComponents(
 			},
 			Runnable: buildEventWatcher,
 		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentWebhookDispatcher,
+				Interval: cmd.Webhooks.DeliveryInterval,
+			},
+			Runnable: webhook.NewDispatcher(
+				dbWebhookFactory,
+				webhook.NewHTTPClient(cmd.Webhooks.Timeout, cmd.Webhooks.AllowedNetworks),
+				cmd.Webhooks.MaxAttempts,
+				cmd.Webhooks.DeliveryRetention,
+			),
+		},
 	}
 
 	if syslogDrainConfigured {
@@ -1196,10 +1271,7 @@ func (cmd *RunCommand) backendComponents(
 				Interval: cmd.Syslog.DrainInterval,
 			},
 			Runnable: syslog.NewDrainer(
-				cmd.Syslog.Transport,
-				cmd.Syslog.Address,
-				cmd.Syslog.Hostname,
-				cmd.Syslog.CACerts,
+				syslogDestinations,
 				dbBuildFactory,
 			),
 		})
@@ -1218,6 +1290,115 @@ func (cmd *RunCommand) compression() compression.Compression {
 	}
 }
 
+func (cmd *RunCommand) syslogDestinations() ([]syslog.Destination, error) {
+	destinations := []syslog.Destination{}
+
+	if cmd.Syslog.Address != "" {
+		destinations = append(destinations, syslog.Destination{
+			Transport: cmd.Syslog.Transport,
+			Address:   cmd.Syslog.Address,
+			Hostname:  cmd.Syslog.Hostname,
+			CACerts:   cmd.Syslog.CACerts,
+			Format:    syslog.Format(cmd.Syslog.Format),
+			Events:    cmd.Syslog.Events,
+			Teams:     cmd.Syslog.Teams,
+		})
+	}
+
+	path := cmd.Syslog.DestinationsConfig.Path()
+	if path == "" {
+		return destinations, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open syslog destinations config file (%s): %w", path, err)
+	}
+
+	var configured []syslog.Destination
+	if err = yaml.Unmarshal(content, &configured); err != nil {
+		return nil, fmt.Errorf("failed to parse syslog destinations config file (%s): %w", path, err)
+	}
+
+	for _, destination := range configured {
+		if destination.Hostname == "" {
+			destination.Hostname = cmd.Syslog.Hostname
+		}
+
+		err := destination.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("invalid syslog destination: %w", err)
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return destinations, nil
+}
+
+func (cmd *RunCommand) buildLogArchive() (*logarchive.Archive, error) {
+	var store logarchive.Store
+	switch {
+	case cmd.BuildLogArchive.Dir != "":
+		store = logarchive.NewLocalStore(cmd.BuildLogArchive.Dir)
+	case cmd.BuildLogArchive.S3Bucket != "":
+		var err error
+		store, err = logarchive.NewS3Store(logarchive.S3Config{
+			Bucket:          cmd.BuildLogArchive.S3Bucket,
+			Prefix:          cmd.BuildLogArchive.S3Prefix,
+			Region:          cmd.BuildLogArchive.S3Region,
+			Endpoint:        cmd.BuildLogArchive.S3Endpoint,
+			AccessKeyID:     cmd.BuildLogArchive.S3AccessKeyID,
+			SecretAccessKey: cmd.BuildLogArchive.S3SecretAccessKey,
+			SessionToken:    cmd.BuildLogArchive.S3SessionToken,
+			ForcePathStyle:  cmd.BuildLogArchive.S3ForcePathStyle,
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, nil
+	}
+
+	var archiveCompression compression.Compression
+	switch cmd.BuildLogArchive.Compression {
+	case "zstd":
+		archiveCompression = compression.NewZstdCompression()
+	case "raw":
+		archiveCompression = compression.NewNoCompression()
+	default:
+		archiveCompression = compression.NewGzipCompression()
+	}
+
+	return logarchive.NewArchive(store, archiveCompression), nil
+}
+
+// provenanceGenerator returns nil unless a key to sign provenance with has
+// been configured.
+func (cmd *RunCommand) provenanceGenerator() (engine.ProvenanceGenerator, error) {
+	path := cmd.ProvenanceSigningKey.Path()
+	if path == "" {
+		return nil, nil
+	}
+
+	pemBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read provenance signing key (%s): %w", path, err)
+	}
+
+	key, err := provenance.ParsePrivateKey(pemBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse provenance signing key (%s): %w", path, err)
+	}
+
+	signer, err := provenance.NewSigner(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return provenance.NewGenerator(signer, cmd.ExternalURL.String()), nil
+}
+
 func (cmd *RunCommand) streamer(cacheFactory db.ResourceCacheFactory) worker.Streamer {
 	return worker.NewStreamer(cacheFactory,
 		cmd.compression(),
@@ -1283,6 +1464,7 @@ func (cmd *RunCommand) gcComponents(
 	dbResourceConfigFactory := db.NewResourceConfigFactory(gcConn, lockFactory)
 	dbPipelineLifecycle := db.NewPipelineLifecycle(gcConn, lockFactory)
 	dbCheckLifecycle := db.NewCheckLifecycle(gcConn)
+	dbInterceptSessionFactory := db.NewInterceptSessionFactory(gcConn)
 
 	dbVolumeRepository := db.NewVolumeRepository(gcConn)
 
@@ -1309,6 +1491,7 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorPipelines:         gc.NewPipelineCollector(dbPipelineLifecycle),
 		atc.ComponentCollectorAccessTokens:      gc.NewAccessTokensCollector(dbAccessTokenLifecycle, jwt.DefaultLeeway),
 		atc.ComponentCollectorChecks:            gc.NewChecksCollector(dbCheckLifecycle),
+		atc.ComponentCollectorInterceptSessions: gc.NewInterceptSessionCollector(dbInterceptSessionFactory, cmd.InterceptSessionRetention),
 	}
 
 	var components []RunnableComponent
@@ -1648,6 +1831,13 @@ func (cmd *RunCommand) validate() error {
 		errs = multierror.Append(errs, err)
 	}
 
+	if cmd.BuildLogArchive.Dir != "" && cmd.BuildLogArchive.S3Bucket != "" {
+		errs = multierror.Append(
+			errs,
+			errors.New("cannot specify both --build-log-archive-dir and --build-log-archive-s3-bucket"),
+		)
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -1764,6 +1954,7 @@ func (cmd *RunCommand) constructEngine(
 	lockFactory lock.LockFactory,
 	rateLimiter engine.RateLimiter,
 	policyChecker policy.Checker,
+	provenanceGenerator engine.ProvenanceGenerator,
 ) engine.Engine {
 	return engine.NewEngine(
 		engine.NewStepperFactory(
@@ -1789,6 +1980,7 @@ func (cmd *RunCommand) constructEngine(
 			policyChecker,
 			workerFactory,
 			lockFactory,
+			provenanceGenerator,
 		),
 		secretManager,
 		cmd.varSourcePool,
@@ -1813,6 +2005,7 @@ func (cmd *RunCommand) constructHTTPHandler(
 	webMux := http.NewServeMux()
 	webMux.Handle("/api/v1/", csrfHandler)
 	webMux.Handle("/sky/issuer/", authHandler)
+	webMux.Handle(dexserver.DeviceCallbackPath, dexserver.NewDeviceCallbackHandler("/sky/issuer", authHandler))
 	webMux.Handle("/sky/", loginHandler)
 	webMux.Handle("/auth/", legacyHandler)
 	webMux.Handle("/login", legacyHandler)
@@ -1960,6 +2153,9 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbCheckFactory db.CheckFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbInterceptSessionFactory db.InterceptSessionFactory,
+	dbWebhookFactory db.WebhookFactory,
+	dbActivityFeed db.ActivityFeed,
 	workerPool worker.Pool,
 	secretManager creds.Secrets,
 	credsManagers creds.Managers,
@@ -1993,6 +2189,23 @@ func (cmd *RunCommand) constructAPIHandler(
 		return nil, err
 	}
 
+	eventHandlerFactory := buildserver.NewEventHandler
+
+	buildLogArchive, err := cmd.buildLogArchive()
+	if err != nil {
+		return nil, err
+	}
+
+	if buildLogArchive != nil {
+		// serve the events of reaped builds from the archive, for as long as
+		// the request for them
+		eventHandlerFactory = func(logger lager.Logger, build db.BuildForAPI) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				buildserver.NewEventHandler(logger, buildLogArchive.Build(r.Context(), build)).ServeHTTP(w, r)
+			})
+		}
+	}
+
 	apiWrapper := wrappa.MultiWrappa{
 		wrappa.NewConcurrentRequestLimitsWrappa(
 			logger,
@@ -2036,8 +2249,11 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbCheckFactory,
 		resourceConfigFactory,
 		dbUserFactory,
+		dbInterceptSessionFactory,
+		dbWebhookFactory,
+		dbActivityFeed,
 
-		buildserver.NewEventHandler,
+		eventHandlerFactory,
 
 		workerPool,
 
@@ -2053,6 +2269,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
+		cmd.RecordInterceptSessions,
 		dbWall,
 		clock.NewClock(),
 	)
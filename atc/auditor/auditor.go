@@ -66,6 +66,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildResources,
 		atc.AbortBuild,
 		atc.GetBuildPreparation,
+		atc.GetBuildTestResults,
+		atc.GetBuildProvenance,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
@@ -75,6 +77,8 @@ func (a *auditor) ValidateAction(action string) bool {
 	case atc.ListContainers,
 		atc.GetContainer,
 		atc.HijackContainer,
+		atc.ListInterceptSessions,
+		atc.GetInterceptRecording,
 		atc.ListDestroyingContainers,
 		atc.ReportWorkerContainers:
 		return a.EnableContainerAuditLog
@@ -84,6 +88,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListJobs,
 		atc.ListJobBuilds,
 		atc.ListJobInputs,
+		atc.ListJobTests,
+		atc.GetJobSLO,
 		atc.GetJobBuild,
 		atc.PauseJob,
 		atc.UnpauseJob,
@@ -133,6 +139,8 @@ func (a *auditor) ValidateAction(action string) bool {
 	case
 		atc.SaveConfig,
 		atc.GetConfig,
+		atc.ListConfigVersions,
+		atc.GetConfigVersion,
 		atc.GetCC,
 		atc.GetVersionsDB,
 		atc.ClearTaskCache,
@@ -145,14 +153,22 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetUser,
 		atc.GetWall,
 		atc.SetWall,
-		atc.ClearWall:
+		atc.ClearWall,
+		atc.ClusterActivity,
+		atc.GraphQL:
 		return a.EnableSystemAuditLog
 	case atc.ListTeams,
 		atc.SetTeam,
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
-		atc.GetTeam:
+		atc.SearchBuildLogs,
+		atc.GetTeam,
+		atc.ListWebhooks,
+		atc.SetWebhook,
+		atc.DestroyWebhook,
+		atc.ListWebhookDeliveries,
+		atc.TeamActivity:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
 		atc.LandWorker,
@@ -33,6 +33,8 @@ type Build struct {
 	RerunNumber          int           `json:"rerun_number,omitempty"`
 	RerunOf              *RerunOfBuild `json:"rerun_of,omitempty"`
 	CreatedBy            *string       `json:"created_by,omitempty"`
+
+	Annotations []BuildAnnotations `json:"annotations,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -0,0 +1,88 @@
+package atc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// MaxBuildAnnotationsSize is the largest annotations file a task may write.
+const MaxBuildAnnotationsSize = 64 * 1024
+
+// BuildAnnotations is structured metadata that a task attaches to its build
+// by writing it as JSON to the file at $BUILD_ANNOTATIONS_FILE.
+type BuildAnnotations struct {
+	// Step is the name of the task that wrote the annotations. It is set by
+	// Concourse rather than the task.
+	Step string `json:"step,omitempty"`
+
+	Metadata []MetadataField       `json:"metadata,omitempty"`
+	Links    []BuildAnnotationLink `json:"links,omitempty"`
+	Summary  string                `json:"summary,omitempty"`
+	Tests    *BuildAnnotationTests `json:"tests,omitempty"`
+}
+
+type BuildAnnotationLink struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type BuildAnnotationTests struct {
+	Passed  int `json:"passed"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped,omitempty"`
+}
+
+// ParseBuildAnnotations decodes and validates an annotations file. Unknown
+// fields are rejected so that typos don't go unnoticed.
+func ParseBuildAnnotations(payload []byte) (BuildAnnotations, error) {
+	if len(payload) > MaxBuildAnnotationsSize {
+		return BuildAnnotations{}, fmt.Errorf("annotations must be at most %d bytes", MaxBuildAnnotationsSize)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+
+	var annotations BuildAnnotations
+	err := decoder.Decode(&annotations)
+	if err != nil {
+		return BuildAnnotations{}, fmt.Errorf("malformed annotations: %w", err)
+	}
+
+	err = annotations.Validate()
+	if err != nil {
+		return BuildAnnotations{}, err
+	}
+
+	return annotations, nil
+}
+
+func (annotations BuildAnnotations) Validate() error {
+	for _, field := range annotations.Metadata {
+		if field.Name == "" {
+			return errors.New("metadata must have a name")
+		}
+	}
+
+	for _, link := range annotations.Links {
+		if link.Name == "" {
+			return errors.New("links must have a name")
+		}
+
+		// only web links, so that they are safe to render
+		u, err := url.Parse(link.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("link '%s' must be an http or https URL", link.Name)
+		}
+	}
+
+	if tests := annotations.Tests; tests != nil {
+		if tests.Passed < 0 || tests.Failed < 0 || tests.Skipped < 0 {
+			return errors.New("test counts must not be negative")
+		}
+	}
+
+	return nil
+}
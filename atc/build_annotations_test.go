@@ -0,0 +1,49 @@
+package atc_test
+
+import (
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseBuildAnnotations", func() {
+	It("parses every kind of annotation", func() {
+		annotations, err := atc.ParseBuildAnnotations([]byte(`{
+			"metadata": [{"name": "commit", "value": "abc123"}],
+			"links": [{"name": "preview", "url": "https://preview.example.com"}],
+			"summary": "**all good**",
+			"tests": {"passed": 1234, "failed": 1, "skipped": 2}
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(annotations).To(Equal(atc.BuildAnnotations{
+			Metadata: []atc.MetadataField{{Name: "commit", Value: "abc123"}},
+			Links:    []atc.BuildAnnotationLink{{Name: "preview", URL: "https://preview.example.com"}},
+			Summary:  "**all good**",
+			Tests:    &atc.BuildAnnotationTests{Passed: 1234, Failed: 1, Skipped: 2},
+		}))
+	})
+
+	DescribeTable("invalid annotations",
+		func(payload string, message string) {
+			_, err := atc.ParseBuildAnnotations([]byte(payload))
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("malformed JSON", `{`, "malformed annotations"),
+		Entry("an unknown field", `{"sumary": "typo"}`, `unknown field "sumary"`),
+		Entry("unnamed metadata", `{"metadata": [{"value": "abc"}]}`, "metadata must have a name"),
+		Entry("an unnamed link", `{"links": [{"url": "https://example.com"}]}`, "links must have a name"),
+		Entry("a non-web link", `{"links": [{"name": "x", "url": "javascript:alert(1)"}]}`, "link 'x' must be an http or https URL"),
+		Entry("a relative link", `{"links": [{"name": "x", "url": "/foo"}]}`, "link 'x' must be an http or https URL"),
+		Entry("negative test counts", `{"tests": {"passed": -1, "failed": 0}}`, "test counts must not be negative"),
+	)
+
+	It("rejects annotations that are too large", func() {
+		payload := `{"summary": "` + strings.Repeat("a", atc.MaxBuildAnnotationsSize) + `"}`
+
+		_, err := atc.ParseBuildAnnotations([]byte(payload))
+		Expect(err).To(MatchError("annotations must be at most 65536 bytes"))
+	})
+})
@@ -0,0 +1,26 @@
+package atc
+
+const (
+	BuildLogSearchQuery        = "q"
+	BuildLogSearchPipelineName = "pipeline_name"
+	BuildLogSearchJobName      = "job_name"
+	BuildLogSearchSince        = "since"
+	BuildLogSearchUntil        = "until"
+
+	BuildLogSearchDefaultLimit = 50
+	BuildLogSearchMaxLimit     = 500
+)
+
+// BuildLogMatch is a chunk of a build's log that matched a search.
+type BuildLogMatch struct {
+	BuildID              int          `json:"build_id"`
+	BuildName            string       `json:"build_name"`
+	JobName              string       `json:"job_name,omitempty"`
+	PipelineID           int          `json:"pipeline_id,omitempty"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	TeamName             string       `json:"team_name"`
+	Origin               string       `json:"origin,omitempty"`
+	Time                 int64        `json:"time"`
+	Payload              string       `json:"payload"`
+}
@@ -22,6 +22,7 @@ func (planner Planner) Create(
 	resources db.SchedulerResources,
 	resourceTypes atc.ResourceTypes,
 	prototypes atc.Prototypes,
+	egress atc.EgressRules,
 	inputs []db.BuildInput,
 	manuallyTriggered bool,
 ) (atc.Plan, error) {
@@ -31,6 +32,7 @@ func (planner Planner) Create(
 		resources:         resources,
 		resourceTypes:     resourceTypes,
 		prototypes:        prototypes,
+		egress:            egress,
 		inputs:            inputs,
 		manuallyTriggered: manuallyTriggered,
 	}
@@ -49,6 +51,7 @@ type planVisitor struct {
 	resources         db.SchedulerResources
 	resourceTypes     atc.ResourceTypes
 	prototypes        atc.Prototypes
+	egress            atc.EgressRules
 	inputs            []db.BuildInput
 	manuallyTriggered bool
 
@@ -56,10 +59,18 @@ type planVisitor struct {
 }
 
 func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
+	egress := step.Egress
+	if len(egress) == 0 && !step.Hermetic {
+		egress = visitor.egress
+	}
+
 	visitor.plan = visitor.planFactory.NewPlan(atc.TaskPlan{
 		Name:              step.Name,
 		Privileged:        step.Privileged,
 		Hermetic:          step.Hermetic,
+		RuntimeClass:      step.RuntimeClass,
+		Checkpointable:    step.Checkpointable,
+		Egress:            egress,
 		Limits:            step.Limits,
 		Config:            step.Config,
 		ConfigPath:        step.ConfigPath,
@@ -70,6 +81,7 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		OutputMapping:     step.OutputMapping,
 		ImageArtifactName: step.ImageArtifactName,
 		Timeout:           step.Timeout,
+		Reports:           step.Reports,
 
 		ResourceTypes:     visitor.resourceTypes,
 		CheckSkipInterval: visitor.manuallyTriggered,
@@ -166,6 +178,8 @@ func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
 		Inputs:   step.Inputs,
 		Timeout:  step.Timeout,
 
+		Provenance: step.Provenance,
+
 		ExposeBuildCreatedBy: resource.ExposeBuildCreatedBy,
 	})
 
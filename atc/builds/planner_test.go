@@ -32,6 +32,7 @@ type PlannerTest struct {
 	CompareIDs             bool
 	ManuallyTriggered      bool
 	OverwriteResourceTypes atc.ResourceTypes
+	PipelineEgress         atc.EgressRules
 
 	PlanJSON string
 	Err      error
@@ -1171,12 +1172,13 @@ var factoryTests = []PlannerTest{
 	{
 		Title: "put step with no_get",
 		Config: &atc.PutStep{
-			Name:     "some-name",
-			Resource: "some-resource",
-			Params:   atc.Params{"some": "params"},
-			Tags:     atc.Tags{"tag-1", "tag-2"},
-			Inputs:   &atc.InputsConfig{All: true},
-			NoGet:    true,
+			Name:       "some-name",
+			Resource:   "some-resource",
+			Params:     atc.Params{"some": "params"},
+			Tags:       atc.Tags{"tag-1", "tag-2"},
+			Inputs:     &atc.InputsConfig{All: true},
+			NoGet:      true,
+			Provenance: "some-provenance",
 		},
 		Inputs: []db.BuildInput{
 			{
@@ -1199,6 +1201,7 @@ var factoryTests = []PlannerTest{
 				"params": {"some":"params"},
 				"tags": ["tag-1", "tag-2"],
 				"inputs": "all",
+				"provenance": "some-provenance",
 				"image": {
 					"base_type": "some-base-resource-type",
 					"check_plan": {
@@ -1258,6 +1261,7 @@ var factoryTests = []PlannerTest{
 			OutputMapping:     map[string]string{"specific": "generic"},
 			ImageArtifactName: "some-image",
 			Timeout:           "1h",
+			Reports:           []string{"reports/junit.xml"},
 		},
 
 		PlanJSON: `{
@@ -1278,6 +1282,95 @@ var factoryTests = []PlannerTest{
 				"output_mapping": {"specific": "generic"},
 				"image": "some-image",
 				"timeout": "1h",
+				"reports": ["reports/junit.xml"],
+				"resource_types": [
+					{
+						"name": "some-resource-type",
+						"type": "some-base-resource-type",
+						"source": {"some": "type-source"},
+						"defaults": {"default-key":"default-value"}
+					}
+				]
+			}
+		}`,
+	},
+	{
+		Title: "task step with egress",
+
+		Config: &atc.TaskStep{
+			Name:       "some-task",
+			Egress:     atc.EgressRules{"artifacts.example.com:443"},
+			ConfigPath: "some-task-file",
+		},
+
+		PipelineEgress: atc.EgressRules{"10.0.0.0/8"},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"task": {
+				"name": "some-task",
+				"privileged": false,
+				"hermetic": false,
+				"egress": ["artifacts.example.com:443"],
+				"config_path": "some-task-file",
+				"resource_types": [
+					{
+						"name": "some-resource-type",
+						"type": "some-base-resource-type",
+						"source": {"some": "type-source"},
+						"defaults": {"default-key":"default-value"}
+					}
+				]
+			}
+		}`,
+	},
+	{
+		Title: "task step inheriting pipeline egress",
+
+		Config: &atc.TaskStep{
+			Name:       "some-task",
+			ConfigPath: "some-task-file",
+		},
+
+		PipelineEgress: atc.EgressRules{"10.0.0.0/8"},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"task": {
+				"name": "some-task",
+				"privileged": false,
+				"hermetic": false,
+				"egress": ["10.0.0.0/8"],
+				"config_path": "some-task-file",
+				"resource_types": [
+					{
+						"name": "some-resource-type",
+						"type": "some-base-resource-type",
+						"source": {"some": "type-source"},
+						"defaults": {"default-key":"default-value"}
+					}
+				]
+			}
+		}`,
+	},
+	{
+		Title: "hermetic task step ignoring pipeline egress",
+
+		Config: &atc.TaskStep{
+			Name:       "some-task",
+			Hermetic:   true,
+			ConfigPath: "some-task-file",
+		},
+
+		PipelineEgress: atc.EgressRules{"10.0.0.0/8"},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"task": {
+				"name": "some-task",
+				"privileged": false,
+				"hermetic": true,
+				"config_path": "some-task-file",
 				"resource_types": [
 					{
 						"name": "some-resource-type",
@@ -1883,7 +1976,7 @@ func (test PlannerTest) Run(s *PlannerSuite) {
 	if test.OverwriteResourceTypes != nil {
 		resourceTypes = test.OverwriteResourceTypes
 	}
-	actualPlan, actualErr := factory.Create(test.Config, resources, resourceTypes, prototypes, test.Inputs, test.ManuallyTriggered)
+	actualPlan, actualErr := factory.Create(test.Config, resources, resourceTypes, prototypes, test.PipelineEgress, test.Inputs, test.ManuallyTriggered)
 
 	if test.Err != nil {
 		s.Equal(test.Err, actualErr)
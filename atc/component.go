@@ -21,8 +21,10 @@ const (
 	ComponentCollectorVolumes           = "collector_volumes"
 	ComponentCollectorWorkers           = "collector_workers"
 	ComponentCollectorPipelines         = "collector_pipelines"
+	ComponentCollectorInterceptSessions = "collector_intercept_sessions"
 	ComponentPipelinePauser             = "pipeline_pauser"
 	ComponentBeingWatchedBuildMarker    = "being_watched_build_marker"
+	ComponentWebhookDispatcher          = "webhook_dispatcher"
 )
 
 type Component struct {
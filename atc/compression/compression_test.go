@@ -1,6 +1,9 @@
 package compression_test
 
 import (
+	"bytes"
+	"io"
+
 	"github.com/concourse/concourse/atc/compression"
 	"github.com/concourse/concourse/worker/baggageclaim"
 
@@ -13,6 +16,27 @@ var _ = Describe("Compression", func() {
 		comp compression.Compression
 	)
 
+	roundTrips := func() {
+		It("reads back what it writes", func() {
+			buf := new(bytes.Buffer)
+
+			writer, err := comp.NewWriter(buf)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = writer.Write([]byte("some-content"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(writer.Close()).To(Succeed())
+
+			reader, err := comp.NewReader(io.NopCloser(buf))
+			Expect(err).ToNot(HaveOccurred())
+
+			content, err := io.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal("some-content"))
+			Expect(reader.Close()).To(Succeed())
+		})
+	}
+
 	Describe("Gzip", func() {
 		BeforeEach(func() {
 			comp = compression.NewGzipCompression()
@@ -21,6 +45,8 @@ var _ = Describe("Compression", func() {
 		It("returns gzip", func() {
 			Expect(comp.Encoding()).To(Equal(baggageclaim.GzipEncoding))
 		})
+
+		roundTrips()
 	})
 
 	Describe("Zstd", func() {
@@ -31,5 +57,19 @@ var _ = Describe("Compression", func() {
 		It("returns zstd", func() {
 			Expect(comp.Encoding()).To(Equal(baggageclaim.ZstdEncoding))
 		})
+
+		roundTrips()
+	})
+
+	Describe("Raw", func() {
+		BeforeEach(func() {
+			comp = compression.NewNoCompression()
+		})
+
+		It("returns raw", func() {
+			Expect(comp.Encoding()).To(Equal(baggageclaim.RawEncoding))
+		})
+
+		roundTrips()
 	})
 })
@@ -16,6 +16,10 @@ func (c *noCompression) NewReader(reader io.ReadCloser) (io.ReadCloser, error) {
 	return &rawReader{reader: reader}, nil
 }
 
+func (c *noCompression) NewWriter(writer io.Writer) (io.WriteCloser, error) {
+	return &rawWriter{writer: writer}, nil
+}
+
 func (c *noCompression) Encoding() baggageclaim.Encoding {
 	return baggageclaim.RawEncoding
 }
@@ -31,3 +35,15 @@ func (zr *rawReader) Read(p []byte) (int, error) {
 func (zr *rawReader) Close() error {
 	return zr.reader.Close()
 }
+
+type rawWriter struct {
+	writer io.Writer
+}
+
+func (rw *rawWriter) Write(p []byte) (int, error) {
+	return rw.writer.Write(p)
+}
+
+func (rw *rawWriter) Close() error {
+	return nil
+}
@@ -25,6 +25,8 @@ type Config struct {
 	Prototypes    Prototypes       `json:"prototypes,omitempty"`
 	Jobs          JobConfigs       `json:"jobs,omitempty"`
 	Display       *DisplayConfig   `json:"display,omitempty"`
+	Egress        EgressRules      `json:"egress,omitempty"`
+	Lint          *LintConfig      `json:"lint,omitempty"`
 }
 
 func UnmarshalConfig(payload []byte, config interface{}) error {
@@ -37,6 +39,8 @@ func UnmarshalConfig(payload []byte, config interface{}) error {
 		Prototypes    interface{} `json:"prototypes,omitempty"`
 		Jobs          interface{} `json:"jobs,omitempty"`
 		Display       interface{} `json:"display,omitempty"`
+		Egress        interface{} `json:"egress,omitempty"`
+		Lint          interface{} `json:"lint,omitempty"`
 	}
 
 	var stripped skeletonConfig
@@ -216,6 +220,12 @@ type DisplayConfig struct {
 	BackgroundImage string `json:"background_image,omitempty"`
 }
 
+// LintConfig configures how `fly lint-pipeline` checks the pipeline.
+type LintConfig struct {
+	// Disable lists the IDs of the lint rules not to check.
+	Disable []string `json:"disable,omitempty"`
+}
+
 type CheckEvery struct {
 	Never    bool
 	Interval time.Duration
@@ -335,5 +335,27 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 		displayDiff.Render(indent)
 	}
 
+	if len(c.Egress) > 0 || len(newConfig.Egress) > 0 {
+		if practicallyDifferent(c.Egress, newConfig.Egress) {
+			diffExists = true
+			fmt.Fprintln(indent, ansi.Color("egress configuration has changed:", "yellow"))
+
+			payloadA, _ := yaml.Marshal(c.Egress)
+			payloadB, _ := yaml.Marshal(newConfig.Egress)
+			renderDiff(indent, string(payloadA), string(payloadB))
+		}
+	}
+
+	if c.Lint != nil || newConfig.Lint != nil {
+		if practicallyDifferent(c.Lint, newConfig.Lint) {
+			diffExists = true
+			fmt.Fprintln(indent, ansi.Color("lint configuration has changed:", "yellow"))
+
+			payloadA, _ := yaml.Marshal(c.Lint)
+			payloadB, _ := yaml.Marshal(newConfig.Lint)
+			renderDiff(indent, string(payloadA), string(payloadB))
+		}
+	}
+
 	return diffExists
 }
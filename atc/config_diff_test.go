@@ -216,4 +216,45 @@ var _ = Describe("Config diff", func() {
 			})
 		})
 	})
+
+	Describe("diffing egress config", func() {
+		It("does not report a diff when egress is unchanged", func() {
+			oldConfig := Config{Egress: EgressRules{"10.0.0.0/8"}}
+			newConfig := Config{Egress: EgressRules{"10.0.0.0/8"}}
+
+			Expect(oldConfig.Diff(NewBuffer(), newConfig)).To(BeFalse())
+		})
+
+		It("reports the changed rules", func() {
+			oldConfig := Config{Egress: EgressRules{"10.0.0.0/8"}}
+			newConfig := Config{Egress: EgressRules{"artifacts.example.com:443"}}
+
+			buffer := NewBuffer()
+			diff := oldConfig.Diff(buffer, newConfig)
+			Expect(diff).To(BeTrue())
+			Eventually(buffer).Should(Say("egress configuration has changed:"))
+			Eventually(buffer).Should(Say("-.*10.0.0.0/8"))
+			Eventually(buffer).Should(Say(`\+.*artifacts.example.com:443`))
+		})
+	})
+
+	Describe("diffing lint config", func() {
+		It("does not report a diff when lint config is unchanged", func() {
+			oldConfig := Config{Lint: &LintConfig{Disable: []string{"privileged-task"}}}
+			newConfig := Config{Lint: &LintConfig{Disable: []string{"privileged-task"}}}
+
+			Expect(oldConfig.Diff(NewBuffer(), newConfig)).To(BeFalse())
+		})
+
+		It("reports the changed rules", func() {
+			oldConfig := Config{}
+			newConfig := Config{Lint: &LintConfig{Disable: []string{"privileged-task"}}}
+
+			buffer := NewBuffer()
+			diff := oldConfig.Diff(buffer, newConfig)
+			Expect(diff).To(BeTrue())
+			Eventually(buffer).Should(Say("lint configuration has changed:"))
+			Eventually(buffer).Should(Say(`\+.*privileged-task`))
+		})
+	})
 })
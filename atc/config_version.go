@@ -0,0 +1,13 @@
+package atc
+
+// ConfigVersion is a config saved for a pipeline, as kept in its history.
+type ConfigVersion struct {
+	Version   int    `json:"version"`
+	CreatedBy string `json:"created_by,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+
+	// The set_pipeline step that saved the config, if any.
+	BuildID   int    `json:"build_id,omitempty"`
+	BuildName string `json:"build_name,omitempty"`
+	JobName   string `json:"job_name,omitempty"`
+}
@@ -0,0 +1,13 @@
+package configlint_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestConfiglint(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Configlint Suite")
+}
@@ -0,0 +1,147 @@
+package configlint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// credentialKey matches keys whose values are likely to be credentials.
+var credentialKey = regexp.MustCompile(`(?i)(password|passwd|secret|token|private_key|access_key|api_key|apikey|credential)`)
+
+// credentialValues match values that look like credentials, whatever their
+// key.
+var credentialValues = []*regexp.Regexp{
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+	regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`),
+	regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+	regexp.MustCompile(`\bxox[baprs]-[A-Za-z0-9-]{10,}`),
+}
+
+func checkInlineCredentials(config atc.Config) []Finding {
+	var findings []Finding
+	check := func(location string, value interface{}) {
+		findings = append(findings, credentialFindings(location, value)...)
+	}
+
+	for _, resource := range config.Resources {
+		check(resourceLocation(resource.Name)+".source", map[string]interface{}(resource.Source))
+	}
+
+	for _, resourceType := range config.ResourceTypes {
+		check("resource_types."+resourceType.Name+".source", map[string]interface{}(resourceType.Source))
+	}
+
+	for _, job := range config.Jobs {
+		location := jobLocation(job.Name)
+
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnGet: func(step *atc.GetStep) error {
+				check(fmt.Sprintf("%s.get(%s).params", location, step.Name), map[string]interface{}(step.Params))
+				return nil
+			},
+			OnPut: func(step *atc.PutStep) error {
+				check(fmt.Sprintf("%s.put(%s).params", location, step.Name), map[string]interface{}(step.Params))
+				check(fmt.Sprintf("%s.put(%s).get_params", location, step.Name), map[string]interface{}(step.GetParams))
+				return nil
+			},
+			OnTask: func(step *atc.TaskStep) error {
+				taskLocation := fmt.Sprintf("%s.task(%s)", location, step.Name)
+				check(taskLocation+".params", envMap(step.Params))
+				check(taskLocation+".vars", map[string]interface{}(step.Vars))
+				if step.Config != nil {
+					check(taskLocation+".config.params", envMap(step.Config.Params))
+				}
+				return nil
+			},
+			OnRun: func(step *atc.RunStep) error {
+				check(fmt.Sprintf("%s.run(%s).params", location, step.Message), map[string]interface{}(step.Params))
+				return nil
+			},
+			OnSetPipeline: func(step *atc.SetPipelineStep) error {
+				check(fmt.Sprintf("%s.set_pipeline(%s).vars", location, step.Name), map[string]interface{}(step.Vars))
+				return nil
+			},
+		})
+	}
+
+	return findings
+}
+
+func envMap(env atc.TaskEnv) map[string]interface{} {
+	values := map[string]interface{}{}
+	for key, value := range env {
+		values[key] = value
+	}
+
+	return values
+}
+
+// credentialFindings looks through the value for strings that look like
+// credentials. Strings that interpolate a ((var)) are assumed to be fetched
+// from a credential manager, and keys naming a file or path refer to where a
+// credential is rather than being one.
+func credentialFindings(location string, value interface{}) []Finding {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var findings []Finding
+		for _, key := range keys {
+			keyLocation := location + "." + key
+
+			if s, ok := v[key].(string); ok && isCredentialKey(key) {
+				if s != "" && !strings.Contains(s, "((") {
+					findings = append(findings, Finding{
+						Location: keyLocation,
+						Message:  fmt.Sprintf("'%s' looks like a credential; use a ((var)) instead", keyLocation),
+					})
+				}
+
+				continue
+			}
+
+			findings = append(findings, credentialFindings(keyLocation, v[key])...)
+		}
+
+		return findings
+	case []interface{}:
+		var findings []Finding
+		for i, elem := range v {
+			findings = append(findings, credentialFindings(fmt.Sprintf("%s[%d]", location, i), elem)...)
+		}
+
+		return findings
+	case string:
+		if strings.Contains(v, "((") {
+			return nil
+		}
+
+		for _, pattern := range credentialValues {
+			if pattern.MatchString(v) {
+				return []Finding{{
+					Location: location,
+					Message:  fmt.Sprintf("'%s' looks like a credential; use a ((var)) instead", location),
+				}}
+			}
+		}
+	}
+
+	return nil
+}
+
+func isCredentialKey(key string) bool {
+	lower := strings.ToLower(key)
+	if strings.HasSuffix(lower, "_file") || strings.HasSuffix(lower, "_path") {
+		return false
+	}
+
+	return credentialKey.MatchString(key)
+}
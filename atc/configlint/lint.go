@@ -0,0 +1,130 @@
+// Package configlint checks pipeline configs for likely mistakes and risky
+// practices. Unlike configvalidate, which rejects configs the ATC cannot run,
+// the configs it finds issues with are valid.
+package configlint
+
+import (
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type Level string
+
+const (
+	LevelError   Level = "error"
+	LevelWarning Level = "warning"
+	LevelNote    Level = "note"
+)
+
+// Rule is a check of a pipeline config. Its ID is stable, so that it can be
+// disabled by configs and tracked by code review tools.
+type Rule struct {
+	ID          string
+	Level       Level
+	Description string
+
+	check func(atc.Config) []Finding
+}
+
+// Finding is an issue found by a rule. Location is where in the config it
+// was found, e.g. `jobs.unit.task(test)`.
+type Finding struct {
+	RuleID   string `json:"rule_id"`
+	Level    Level  `json:"level"`
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+// Rules is the catalog of rules, in the order they are checked.
+var Rules = []Rule{
+	{
+		ID:          "unused-resource",
+		Level:       LevelWarning,
+		Description: "Resource is not used by any get or put step.",
+		check:       checkUnusedResources,
+	},
+	{
+		ID:          "untriggered-resource",
+		Level:       LevelNote,
+		Description: "Resource is fetched by jobs, but none of them are triggered by it.",
+		check:       checkUntriggeredResources,
+	},
+	{
+		ID:          "unsatisfiable-passed",
+		Level:       LevelError,
+		Description: "No version of the resource can pass through all of the jobs in a get step's passed constraint.",
+		check:       checkUnsatisfiablePassed,
+	},
+	{
+		ID:          "task-without-timeout",
+		Level:       LevelWarning,
+		Description: "Task has no timeout, so it can run forever.",
+		check:       checkTasksWithoutTimeout,
+	},
+	{
+		ID:          "privileged-task",
+		Level:       LevelWarning,
+		Description: "Task runs privileged, with full access to its worker.",
+		check:       checkPrivilegedTasks,
+	},
+	{
+		ID:          "inline-credential",
+		Level:       LevelError,
+		Description: "Value looks like a credential written into the config rather than a ((var)).",
+		check:       checkInlineCredentials,
+	},
+	{
+		ID:          "missing-on-failure",
+		Level:       LevelNote,
+		Description: "Job has no on_failure hook, so its failures go unnoticed outside of the UI.",
+		check:       checkMissingOnFailure,
+	},
+}
+
+// LookupRule finds the rule with the ID.
+func LookupRule(id string) (Rule, bool) {
+	for _, rule := range Rules {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+// Lint checks the config against each rule, other than those disabled by the
+// config's lint section or by ID. Unknown IDs are ignored. Findings are
+// ordered by rule, then by location.
+func Lint(config atc.Config, disabled ...string) []Finding {
+	skip := map[string]bool{}
+	for _, id := range disabled {
+		skip[id] = true
+	}
+
+	if config.Lint != nil {
+		for _, id := range config.Lint.Disable {
+			skip[id] = true
+		}
+	}
+
+	findings := []Finding{}
+	for _, rule := range Rules {
+		if skip[rule.ID] {
+			continue
+		}
+
+		ruleFindings := rule.check(config)
+		sort.SliceStable(ruleFindings, func(i, j int) bool {
+			return ruleFindings[i].Location < ruleFindings[j].Location
+		})
+
+		for _, finding := range ruleFindings {
+			finding.RuleID = rule.ID
+			finding.Level = rule.Level
+			findings = append(findings, finding)
+		}
+	}
+
+	return findings
+}
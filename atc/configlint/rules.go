@@ -0,0 +1,313 @@
+package configlint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+func resourceLocation(name string) string {
+	return "resources." + name
+}
+
+func jobLocation(name string) string {
+	return "jobs." + name
+}
+
+func checkUnusedResources(config atc.Config) []Finding {
+	used := map[string]bool{}
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnGet: func(step *atc.GetStep) error {
+				used[step.ResourceName()] = true
+				return nil
+			},
+			OnPut: func(step *atc.PutStep) error {
+				used[step.ResourceName()] = true
+				return nil
+			},
+		})
+	}
+
+	var findings []Finding
+	for _, resource := range config.Resources {
+		if !used[resource.Name] {
+			findings = append(findings, Finding{
+				Location: resourceLocation(resource.Name),
+				Message:  fmt.Sprintf("resource '%s' is not used by any job", resource.Name),
+			})
+		}
+	}
+
+	return findings
+}
+
+func checkUntriggeredResources(config atc.Config) []Finding {
+	fetched := map[string]bool{}
+	triggers := map[string]bool{}
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnGet: func(step *atc.GetStep) error {
+				fetched[step.ResourceName()] = true
+				if step.Trigger {
+					triggers[step.ResourceName()] = true
+				}
+				return nil
+			},
+		})
+	}
+
+	var findings []Finding
+	for _, resource := range config.Resources {
+		if fetched[resource.Name] && !triggers[resource.Name] {
+			findings = append(findings, Finding{
+				Location: resourceLocation(resource.Name),
+				Message:  fmt.Sprintf("resource '%s' does not trigger any job", resource.Name),
+			})
+		}
+	}
+
+	return findings
+}
+
+// versionSet is the versions of a resource that can reach a job: either any
+// version, or only those put by some jobs.
+type versionSet struct {
+	any  bool
+	puts map[string]bool
+}
+
+func (set versionSet) empty() bool {
+	return !set.any && len(set.puts) == 0
+}
+
+func (set versionSet) union(other versionSet) versionSet {
+	if set.any || other.any {
+		return versionSet{any: true}
+	}
+
+	puts := map[string]bool{}
+	for job := range set.puts {
+		puts[job] = true
+	}
+
+	for job := range other.puts {
+		puts[job] = true
+	}
+
+	return versionSet{puts: puts}
+}
+
+func (set versionSet) intersect(other versionSet) versionSet {
+	if set.any {
+		return other
+	}
+
+	if other.any {
+		return set
+	}
+
+	puts := map[string]bool{}
+	for job := range set.puts {
+		if other.puts[job] {
+			puts[job] = true
+		}
+	}
+
+	return versionSet{puts: puts}
+}
+
+// passedChecker works out which versions of resources can pass through each
+// job. A get with no passed constraint lets any version through, while one
+// with passed constraints only lets through the versions that could have
+// passed through all of the jobs. A put lets through the versions it
+// creates.
+type passedChecker struct {
+	jobs atc.JobConfigs
+	seen map[[2]string]versionSet
+
+	visiting map[[2]string]bool
+}
+
+func (checker *passedChecker) through(jobName string, resource string) versionSet {
+	key := [2]string{jobName, resource}
+	if set, found := checker.seen[key]; found {
+		return set
+	}
+
+	if checker.visiting[key] {
+		// passed constraints can't form cycles in a valid config, but don't
+		// report anything if they do
+		return versionSet{any: true}
+	}
+
+	checker.visiting[key] = true
+	defer delete(checker.visiting, key)
+
+	job, found := checker.jobs.Lookup(jobName)
+	if !found {
+		return versionSet{any: true}
+	}
+
+	var set versionSet
+	_ = job.StepConfig().Visit(atc.StepRecursor{
+		OnGet: func(step *atc.GetStep) error {
+			if step.ResourceName() == resource {
+				set = set.union(checker.fetchable(step))
+			}
+			return nil
+		},
+		OnPut: func(step *atc.PutStep) error {
+			if step.ResourceName() == resource {
+				set = set.union(versionSet{puts: map[string]bool{jobName: true}})
+			}
+			return nil
+		},
+	})
+
+	checker.seen[key] = set
+
+	return set
+}
+
+func (checker *passedChecker) fetchable(step *atc.GetStep) versionSet {
+	set := versionSet{any: true}
+	for _, passed := range step.Passed {
+		set = set.intersect(checker.through(passed, step.ResourceName()))
+	}
+
+	return set
+}
+
+func checkUnsatisfiablePassed(config atc.Config) []Finding {
+	checker := &passedChecker{
+		jobs:     config.Jobs,
+		seen:     map[[2]string]versionSet{},
+		visiting: map[[2]string]bool{},
+	}
+
+	var findings []Finding
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnGet: func(step *atc.GetStep) error {
+				if len(step.Passed) < 2 {
+					return nil
+				}
+
+				// a passed job that no versions pass through is either
+				// rejected by validation or reported where it fetches the
+				// resource
+				for _, passed := range step.Passed {
+					if checker.through(passed, step.ResourceName()).empty() {
+						return nil
+					}
+				}
+
+				if checker.fetchable(step).empty() {
+					findings = append(findings, Finding{
+						Location: fmt.Sprintf("%s.get(%s)", jobLocation(job.Name), step.Name),
+						Message: fmt.Sprintf(
+							"no version of resource '%s' can pass through all of '%s'",
+							step.ResourceName(),
+							strings.Join(step.Passed, "', '"),
+						),
+					})
+				}
+
+				return nil
+			},
+		})
+	}
+
+	return findings
+}
+
+func checkTasksWithoutTimeout(config atc.Config) []Finding {
+	var findings []Finding
+	for _, job := range config.Jobs {
+		walkTimeouts(job.StepConfig(), false, func(step *atc.TaskStep, timed bool) {
+			if !timed && step.Timeout == "" {
+				findings = append(findings, Finding{
+					Location: fmt.Sprintf("%s.task(%s)", jobLocation(job.Name), step.Name),
+					Message:  fmt.Sprintf("task '%s' has no timeout", step.Name),
+				})
+			}
+		})
+	}
+
+	return findings
+}
+
+// walkTimeouts calls the function with each task in the step, and whether it
+// is within a timeout.
+func walkTimeouts(step atc.StepConfig, timed bool, fn func(*atc.TaskStep, bool)) {
+	switch s := step.(type) {
+	case *atc.TaskStep:
+		fn(s, timed)
+	case *atc.TimeoutStep:
+		walkTimeouts(s.Step, true, fn)
+	case *atc.TryStep:
+		walkTimeouts(s.Step.Config, timed, fn)
+	case *atc.DoStep:
+		for _, sub := range s.Steps {
+			walkTimeouts(sub.Config, timed, fn)
+		}
+	case *atc.InParallelStep:
+		for _, sub := range s.Config.Steps {
+			walkTimeouts(sub.Config, timed, fn)
+		}
+	case *atc.AcrossStep:
+		walkTimeouts(s.Step, timed, fn)
+	case *atc.RetryStep:
+		walkTimeouts(s.Step, timed, fn)
+	case *atc.OnSuccessStep:
+		walkTimeouts(s.Step, timed, fn)
+		walkTimeouts(s.Hook.Config, timed, fn)
+	case *atc.OnFailureStep:
+		walkTimeouts(s.Step, timed, fn)
+		walkTimeouts(s.Hook.Config, timed, fn)
+	case *atc.OnAbortStep:
+		walkTimeouts(s.Step, timed, fn)
+		walkTimeouts(s.Hook.Config, timed, fn)
+	case *atc.OnErrorStep:
+		walkTimeouts(s.Step, timed, fn)
+		walkTimeouts(s.Hook.Config, timed, fn)
+	case *atc.EnsureStep:
+		walkTimeouts(s.Step, timed, fn)
+		walkTimeouts(s.Hook.Config, timed, fn)
+	}
+}
+
+func checkPrivilegedTasks(config atc.Config) []Finding {
+	var findings []Finding
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnTask: func(step *atc.TaskStep) error {
+				if step.Privileged {
+					findings = append(findings, Finding{
+						Location: fmt.Sprintf("%s.task(%s)", jobLocation(job.Name), step.Name),
+						Message:  fmt.Sprintf("task '%s' is privileged", step.Name),
+					})
+				}
+				return nil
+			},
+		})
+	}
+
+	return findings
+}
+
+func checkMissingOnFailure(config atc.Config) []Finding {
+	var findings []Finding
+	for _, job := range config.Jobs {
+		if job.OnFailure == nil {
+			findings = append(findings, Finding{
+				Location: jobLocation(job.Name),
+				Message:  fmt.Sprintf("job '%s' has no on_failure hook", job.Name),
+			})
+		}
+	}
+
+	return findings
+}
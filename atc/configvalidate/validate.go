@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configlint"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/gobwas/glob"
 )
@@ -86,6 +88,14 @@ func Validate(c atc.Config) ([]atc.ConfigWarning, []string) {
 	}
 	warnings = append(warnings, displayWarnings...)
 
+	egressWarnings, egressErr := validateEgress(c)
+	if egressErr != nil {
+		errorMessages = append(errorMessages, formatErr("egress config", egressErr))
+	}
+	warnings = append(warnings, egressWarnings...)
+
+	warnings = append(warnings, validateLint(c)...)
+
 	cycleErr := validateCycle(c)
 
 	if cycleErr != nil {
@@ -395,6 +405,10 @@ func validateJobs(c atc.Config) ([]atc.ConfigWarning, error) {
 			}
 		}
 
+		if job.SLO != nil {
+			errorMessages = append(errorMessages, validateJobSLO(identifier, *job.SLO)...)
+		}
+
 		step := job.Step()
 
 		validator := atc.NewStepValidator(c, []string{identifier, ".plan"})
@@ -409,6 +423,33 @@ func validateJobs(c atc.Config) ([]atc.ConfigWarning, error) {
 	return warnings, compositeErr(errorMessages)
 }
 
+func validateJobSLO(identifier string, slo atc.JobSLO) []string {
+	var errorMessages []string
+
+	if slo.Duration != "" {
+		duration, err := time.ParseDuration(slo.Duration)
+		if err != nil {
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has invalid slo.duration: '%s'", slo.Duration))
+		} else if duration <= 0 {
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has non-positive slo.duration: '%s'", slo.Duration))
+		}
+	}
+
+	if slo.SuccessRate < 0 || slo.SuccessRate > 1 {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has slo.success_rate: %g outside of 0 to 1", slo.SuccessRate))
+	}
+
+	if slo.Window < 0 {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has negative slo.window: %d", slo.Window))
+	}
+
+	if slo.AnomalyThreshold < 0 {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has negative slo.anomaly_threshold: %g", slo.AnomalyThreshold))
+	}
+
+	return errorMessages
+}
+
 func compositeErr(errorMessages []string) error {
 	if len(errorMessages) == 0 {
 		return nil
@@ -497,6 +538,47 @@ func validateDisplay(c atc.Config) ([]atc.ConfigWarning, error) {
 	return warnings, nil
 }
 
+func validateEgress(c atc.Config) ([]atc.ConfigWarning, error) {
+	var warnings []atc.ConfigWarning
+
+	if len(c.Egress) == 0 {
+		return warnings, nil
+	}
+
+	errorMessages := []string{}
+	for _, rule := range c.Egress {
+		if err := rule.Validate(); err != nil {
+			errorMessages = append(errorMessages, err.Error())
+		}
+	}
+
+	warnings = append(warnings, atc.ConfigWarning{
+		Type:    "pipeline",
+		Message: "egress only works against worker containerd runtime",
+	})
+
+	return warnings, compositeErr(errorMessages)
+}
+
+func validateLint(c atc.Config) []atc.ConfigWarning {
+	var warnings []atc.ConfigWarning
+
+	if c.Lint == nil {
+		return warnings
+	}
+
+	for _, id := range c.Lint.Disable {
+		if _, found := configlint.LookupRule(id); !found {
+			warnings = append(warnings, atc.ConfigWarning{
+				Type:    "pipeline",
+				Message: fmt.Sprintf("lint config disables unknown rule '%s'", id),
+			})
+		}
+	}
+
+	return warnings
+}
+
 func detectCycle(j atc.JobConfig, visited map[string]int, pipelineConfig atc.Config) error {
 	const (
 		nonVisited     = 0
@@ -1142,6 +1142,111 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a task plan sets runtime_class", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.TaskStep{
+							Name:         "some-resource",
+							RuntimeClass: "runsc",
+							Config: &atc.TaskConfig{
+								Params: atc.TaskEnv{
+									"param1": "value1",
+								},
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns a warning", func() {
+					Expect(warnings).To(HaveLen(1))
+					Expect(warnings[0].Message).To(ContainSubstring("specifies `runtime_class: runsc`, so it will only run on workers using the containerd runtime with that runtime class configured"))
+				})
+			})
+
+			Context("when a task plan is checkpointable", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.TaskStep{
+							Name:           "some-resource",
+							Checkpointable: true,
+							Config: &atc.TaskConfig{
+								Params: atc.TaskEnv{
+									"param1": "value1",
+								},
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns a warning", func() {
+					Expect(warnings).To(HaveLen(1))
+					Expect(warnings[0].Message).To(ContainSubstring("specifies `checkpointable:` only works against worker containerd runtime"))
+				})
+			})
+
+			Context("when a task plan sets egress", func() {
+				var step *atc.TaskStep
+
+				BeforeEach(func() {
+					step = &atc.TaskStep{
+						Name:   "some-resource",
+						Egress: atc.EgressRules{"10.0.0.0/8", "artifacts.example.com:443"},
+						Config: &atc.TaskConfig{
+							Params: atc.TaskEnv{
+								"param1": "value1",
+							},
+						},
+					}
+
+					job.PlanSequence = append(job.PlanSequence, atc.Step{Config: step})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns a warning", func() {
+					Expect(warnings).To(HaveLen(1))
+					Expect(warnings[0].Message).To(ContainSubstring("specifies `egress:` only works against worker containerd runtime"))
+				})
+
+				Context("when a rule is invalid", func() {
+					BeforeEach(func() {
+						step.Egress = atc.EgressRules{"artifacts.example.com:https"}
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("egress rule 'artifacts.example.com:https' has invalid port 'https'"))
+					})
+				})
+			})
+
+			Context("when a task plan has a report outside of its outputs", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.TaskStep{
+							Name:    "some-resource",
+							Reports: []string{"reports/junit.xml", "../junit.xml"},
+							Config: &atc.TaskConfig{
+								Params: atc.TaskEnv{
+									"param1": "value1",
+								},
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("task(some-resource): report '../junit.xml' must be within one of the task's outputs"))
+				})
+			})
+
 			Context("when a put plan has refers to a resource that does exist", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1158,6 +1263,43 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a put plan names its provenance artifact after itself", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.PutStep{
+							Name:       "some-resource",
+							Provenance: "some-resource",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("put(some-resource): provenance artifact 'some-resource' conflicts with the artifact fetched after the put"))
+				})
+			})
+
+			Context("when a put plan has an invalid provenance artifact name", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.PutStep{
+							Name:       "some-resource",
+							Provenance: "Some_Provenance",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns a warning", func() {
+					Expect(errorMessages).To(HaveLen(0))
+					Expect(warnings).To(HaveLen(1))
+					Expect(warnings[0].Message).To(ContainSubstring("put(some-resource).provenance: 'Some_Provenance' is not a valid identifier"))
+				})
+			})
+
 			Context("when a get plan has refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -2213,6 +2355,53 @@ var _ = Describe("ValidateConfig", func() {
 				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has negative build_log_retention.days: -1"))
 			})
 		})
+
+		Context("when a job has a valid slo", func() {
+			BeforeEach(func() {
+				config.Jobs[0].SLO = &atc.JobSLO{
+					Duration:         "10m",
+					SuccessRate:      0.95,
+					Window:           30,
+					AnomalyThreshold: 2.5,
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job has an invalid slo duration", func() {
+			BeforeEach(func() {
+				config.Jobs[0].SLO = &atc.JobSLO{
+					Duration: "ten minutes",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has invalid slo.duration: 'ten minutes'"))
+			})
+		})
+
+		Context("when a job has out of range slo values", func() {
+			BeforeEach(func() {
+				config.Jobs[0].SLO = &atc.JobSLO{
+					Duration:         "-1m",
+					SuccessRate:      95,
+					Window:           -1,
+					AnomalyThreshold: -2,
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has non-positive slo.duration: '-1m'"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has slo.success_rate: 95 outside of 0 to 1"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has negative slo.window: -1"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has negative slo.anomaly_threshold: -2"))
+			})
+		})
 	})
 
 	Describe("validating display config", func() {
@@ -2269,6 +2458,48 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("validating egress config", func() {
+		Context("when the rules are valid", func() {
+			BeforeEach(func() {
+				config.Egress = atc.EgressRules{"192.168.1.10", "10.0.0.0/8:443", "artifacts.example.com"}
+			})
+
+			It("returns a warning", func() {
+				Expect(errorMessages).To(HaveLen(0))
+				Expect(warnings).To(ContainElement(atc.ConfigWarning{
+					Type:    "pipeline",
+					Message: "egress only works against worker containerd runtime",
+				}))
+			})
+		})
+
+		Context("when a rule is invalid", func() {
+			BeforeEach(func() {
+				config.Egress = atc.EgressRules{"not a host"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid egress config:"))
+				Expect(errorMessages[0]).To(ContainSubstring("egress rule 'not a host' must be an IP, CIDR or hostname"))
+			})
+		})
+	})
+
+	Describe("validating lint config", func() {
+		BeforeEach(func() {
+			config.Lint = &atc.LintConfig{Disable: []string{"privileged-task", "bogus"}}
+		})
+
+		It("warns about unknown rules", func() {
+			Expect(errorMessages).To(HaveLen(0))
+			Expect(warnings).To(ConsistOf(atc.ConfigWarning{
+				Type:    "pipeline",
+				Message: "lint config disables unknown rule 'bogus'",
+			}))
+		})
+	})
+
 	Describe("invalid pipeline", func() {
 		Context("contains zero jobs", func() {
 			BeforeEach(func() {
@@ -0,0 +1,179 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	activityEventsChannel = "activity_events_channel"
+
+	// activityQueueSize is how many events a slow subscriber can fall behind
+	// by before events are dropped.
+	activityQueueSize = 1024
+)
+
+// ActivityEvent is an event sent to the activity channel, along with the ID
+// of the team it happened on.
+type ActivityEvent struct {
+	TeamID int `json:"team_id"`
+
+	atc.ActivityEvent
+}
+
+// ActivityFeed subscribes to the changes made across the cluster, as sent by
+// the database triggers on builds, jobs and pipelines, and by
+// notifyResourceVersionsDiscovered.
+//
+//counterfeiter:generate . ActivityFeed
+type ActivityFeed interface {
+	Subscribe() (ActivitySubscription, error)
+}
+
+//counterfeiter:generate . ActivitySubscription
+type ActivitySubscription interface {
+	// Next blocks until there is an event or the context is done. A resync
+	// event is returned if events may have been missed.
+	Next(context.Context) (ActivityEvent, error)
+	Close() error
+}
+
+type activityFeed struct {
+	conn Conn
+}
+
+func NewActivityFeed(conn Conn) ActivityFeed {
+	return &activityFeed{
+		conn: conn,
+	}
+}
+
+func (feed *activityFeed) Subscribe() (ActivitySubscription, error) {
+	notifications, err := feed.conn.Bus().Listen(activityEventsChannel, activityQueueSize)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &activitySubscription{
+		bus:           feed.conn.Bus(),
+		notifications: notifications,
+		queue:         make(chan Notification, activityQueueSize),
+		closed:        make(chan struct{}),
+	}
+
+	go subscription.forward()
+
+	return subscription, nil
+}
+
+type activitySubscription struct {
+	bus           NotificationsBus
+	notifications chan Notification
+
+	// queue holds the notifications the subscriber has yet to read. The bus
+	// drops notifications without saying so when a listener falls behind, so
+	// they're moved off of it right away and queued here instead, where it's
+	// known when one is dropped.
+	queue   chan Notification
+	dropped atomic.Bool
+	closed  chan struct{}
+}
+
+func (subscription *activitySubscription) forward() {
+	for {
+		select {
+		case <-subscription.closed:
+			return
+
+		case notification := <-subscription.notifications:
+			select {
+			case subscription.queue <- notification:
+			default:
+				subscription.dropped.Store(true)
+			}
+		}
+	}
+}
+
+func (subscription *activitySubscription) Next(ctx context.Context) (ActivityEvent, error) {
+	for {
+		if subscription.dropped.Swap(false) {
+			// the subscriber has to resync anyway, so the events that were
+			// queued up are of no use
+			subscription.drain()
+			return resyncEvent(), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ActivityEvent{}, ctx.Err()
+
+		case notification := <-subscription.queue:
+			if !notification.Healthy {
+				return resyncEvent(), nil
+			}
+
+			var event ActivityEvent
+			err := json.Unmarshal([]byte(notification.Payload), &event)
+			if err != nil {
+				// not an activity event; ignore it
+				continue
+			}
+
+			return event, nil
+		}
+	}
+}
+
+func (subscription *activitySubscription) drain() {
+	for {
+		select {
+		case <-subscription.queue:
+		default:
+			return
+		}
+	}
+}
+
+func (subscription *activitySubscription) Close() error {
+	close(subscription.closed)
+	return subscription.bus.Unlisten(activityEventsChannel, subscription.notifications)
+}
+
+func resyncEvent() ActivityEvent {
+	return ActivityEvent{
+		ActivityEvent: atc.ActivityEvent{
+			Type: atc.ActivityResync,
+			Time: time.Now().Unix(),
+		},
+	}
+}
+
+// notifyResourceVersionsDiscovered sends a resource_versions_discovered event
+// for each active resource using the scope, which can be shared between
+// resources, even across teams. It is called within the transaction saving
+// the versions, so that the events are only sent once they're committed.
+func notifyResourceVersionsDiscovered(tx Tx, rcsID int) error {
+	_, err := tx.Exec(`
+		SELECT pg_notify($1, json_strip_nulls(json_build_object(
+			'type', $2::text,
+			'time', floor(extract(epoch FROM now()))::bigint,
+			'team_id', p.team_id,
+			'team_name', t.name,
+			'pipeline_id', r.pipeline_id,
+			'pipeline_name', p.name,
+			'pipeline_instance_vars', p.instance_vars,
+			'resource_name', r.name
+		))::text)
+		FROM resources r
+		JOIN pipelines p ON p.id = r.pipeline_id
+		JOIN teams t ON t.id = p.team_id
+		WHERE r.resource_config_scope_id = $3
+		AND r.active
+	`, activityEventsChannel, string(atc.ActivityResourceVersionsDiscovered), rcsID)
+	return err
+}
@@ -0,0 +1,133 @@
+package db_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ActivityFeed", func() {
+	var subscription db.ActivitySubscription
+
+	BeforeEach(func() {
+		var err error
+		subscription, err = db.NewActivityFeed(dbConn).Subscribe()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(subscription.Close()).To(Succeed())
+	})
+
+	next := func() db.ActivityEvent {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		event, err := subscription.Next(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		return event
+	}
+
+	It("sends events for builds starting and finishing", func() {
+		build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		found, err := build.Start(atc.Plan{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		event := next()
+		Expect(event.TeamID).To(Equal(defaultTeam.ID()))
+		Expect(event.Type).To(Equal(atc.ActivityBuildStarted))
+		Expect(event.TeamName).To(Equal(defaultTeam.Name()))
+		Expect(event.PipelineName).To(Equal(defaultPipeline.Name()))
+		Expect(event.JobName).To(Equal("some-job"))
+		Expect(event.BuildID).To(Equal(build.ID()))
+		Expect(event.BuildStatus).To(Equal(atc.StatusStarted))
+
+		err = build.Finish(db.BuildStatusFailed)
+		Expect(err).ToNot(HaveOccurred())
+
+		event = next()
+		Expect(event.Type).To(Equal(atc.ActivityBuildFinished))
+		Expect(event.BuildID).To(Equal(build.ID()))
+		Expect(event.BuildStatus).To(Equal(atc.StatusFailed))
+	})
+
+	It("sends events for pipelines being paused", func() {
+		err := defaultPipeline.Pause("some-user")
+		Expect(err).ToNot(HaveOccurred())
+
+		event := next()
+		Expect(event.Type).To(Equal(atc.ActivityPipelineChanged))
+		Expect(event.PipelineID).To(Equal(defaultPipeline.ID()))
+		Expect(*event.Paused).To(BeTrue())
+	})
+
+	It("sends events for jobs being paused", func() {
+		err := defaultJob.Pause("some-user")
+		Expect(err).ToNot(HaveOccurred())
+
+		event := next()
+		Expect(event.Type).To(Equal(atc.ActivityJobChanged))
+		Expect(event.JobName).To(Equal("some-job"))
+		Expect(*event.Paused).To(BeTrue())
+	})
+
+	It("sends one event for versions discovered by a check", func() {
+		resourceConfig, err := resourceConfigFactory.FindOrCreateResourceConfig(
+			defaultResource.Type(),
+			defaultResource.Source(),
+			nil,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		scope, err := resourceConfig.FindOrCreateScope(intptr(defaultResource.ID()))
+		Expect(err).ToNot(HaveOccurred())
+
+		err = defaultResource.SetResourceConfigScope(scope)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = scope.SaveVersions(nil, []atc.Version{{"ref": "v1"}, {"ref": "v2"}})
+		Expect(err).ToNot(HaveOccurred())
+
+		event := next()
+		Expect(event.Type).To(Equal(atc.ActivityResourceVersionsDiscovered))
+		Expect(event.TeamID).To(Equal(defaultTeam.ID()))
+		Expect(event.PipelineID).To(Equal(defaultPipeline.ID()))
+		Expect(event.ResourceName).To(Equal(defaultResource.Name()))
+
+		Consistently(func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			_, err := subscription.Next(ctx)
+			return err
+		}).Should(Equal(context.DeadlineExceeded))
+	})
+
+	It("sends a resync event once events have been dropped", func() {
+		_, err := dbConn.Exec(`
+			SELECT pg_notify('activity_events_channel', '{"type":"job_changed"}')
+			FROM generate_series(1, 5000)
+		`)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() atc.ActivityEventType {
+			return next().Type
+		}).Should(Equal(atc.ActivityResync))
+	})
+
+	It("returns once the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := subscription.Next(ctx)
+		Expect(err).To(Equal(context.Canceled))
+	})
+})
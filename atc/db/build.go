@@ -90,6 +90,7 @@ var buildsQuery = psql.Select(`
 		t.name,
 		b.nonce,
 		b.drained,
+		b.events_archive,
 		b.aborted,
 		b.completed,
 		b.inputs_ready,
@@ -97,7 +98,8 @@ var buildsQuery = psql.Select(`
 		rb.name,
 		b.rerun_number,
 		b.span_context,
-		COALESCE(bc.comment, '')
+		COALESCE(bc.comment, ''),
+		ba.annotations
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -105,7 +107,8 @@ var buildsQuery = psql.Select(`
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
 	JoinClause("LEFT OUTER JOIN teams t ON b.team_id = t.id").
 	JoinClause("LEFT OUTER JOIN builds rb ON rb.id = b.rerun_of").
-	JoinClause("LEFT OUTER JOIN build_comments bc ON b.id = bc.build_id")
+	JoinClause("LEFT OUTER JOIN build_comments bc ON b.id = bc.build_id").
+	JoinClause("LEFT OUTER JOIN build_annotations ba ON b.id = ba.build_id")
 
 var minMaxIdQuery = psql.Select("COALESCE(MAX(b.id), 0)", "COALESCE(MIN(b.id), 0)").
 	From("builds as b")
@@ -146,6 +149,7 @@ type Build interface {
 	PublicPlan() *json.RawMessage
 	HasPlan() bool
 	Comment() string
+	Annotations() []atc.BuildAnnotations
 	Status() BuildStatus
 	CreateTime() time.Time
 	StartTime() time.Time
@@ -182,6 +186,10 @@ type Build interface {
 
 	SetComment(string) error
 	SetInterceptible(bool) error
+	AddAnnotations(atc.BuildAnnotations) error
+
+	TestResults() ([]atc.TestResult, error)
+	SaveTestResults(step string, results []atc.TestResult) error
 
 	Events(uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
@@ -195,6 +203,13 @@ type Build interface {
 
 	Resources() ([]BuildInput, []BuildOutput, error)
 	SaveImageResourceVersion(ResourceCache) error
+	ImageVersions() ([]BuildImageVersion, error)
+	WorkerNames() ([]string, error)
+
+	Provenance() (atc.ProvenanceEnvelope, bool, error)
+	SaveProvenance(atc.ProvenanceEnvelope) error
+
+	SaveSLOBreach(atc.JobSLOStats, []atc.JobSLOViolation) error
 
 	Delete() (bool, error)
 	MarkAsAborted() error
@@ -204,6 +219,9 @@ type Build interface {
 	IsDrained() bool
 	SetDrained(bool) error
 
+	EventsArchive() string
+	SetEventsArchive(string) error
+
 	SpanContext() propagation.TextMapCarrier
 
 	SavePipeline(
@@ -229,9 +247,10 @@ type build struct {
 	scheduled   bool
 	inputsReady bool
 
-	teamID   int
-	teamName string
-	comment  string
+	teamID      int
+	teamName    string
+	comment     string
+	annotations []atc.BuildAnnotations
 
 	jobID   int
 	jobName string
@@ -262,6 +281,8 @@ type build struct {
 	aborted   bool
 	completed bool
 
+	eventsArchive string
+
 	spanContext SpanContext
 
 	eventIdSeq util.SequenceGenerator
@@ -379,6 +400,7 @@ func (b *build) Comment() string                  { return b.comment }
 func (b *build) Status() BuildStatus              { return b.status }
 func (b *build) IsScheduled() bool                { return b.scheduled }
 func (b *build) IsDrained() bool                  { return b.drained }
+func (b *build) EventsArchive() string            { return b.eventsArchive }
 func (b *build) IsRunning() bool                  { return !b.completed }
 func (b *build) IsAborted() bool                  { return b.aborted }
 func (b *build) IsCompleted() bool                { return b.completed }
@@ -477,6 +499,33 @@ func (b *build) SetComment(comment string) error {
 	return nil
 }
 
+func (b *build) Annotations() []atc.BuildAnnotations {
+	return b.annotations
+}
+
+// AddAnnotations appends to the build's annotations. Each task that annotates
+// the build adds its own entry.
+func (b *build) AddAnnotations(annotations atc.BuildAnnotations) error {
+	payload, err := json.Marshal([]atc.BuildAnnotations{annotations})
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("build_annotations").
+		Columns("build_id", "annotations").
+		Values(b.id, string(payload)).
+		Suffix("ON CONFLICT (build_id) DO UPDATE SET annotations = build_annotations.annotations || EXCLUDED.annotations").
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	b.annotations = append(b.annotations, annotations)
+
+	return nil
+}
+
 func (b *build) SetInterceptible(i bool) error {
 	rows, err := psql.Update("builds").
 		Set("interceptible", i).
@@ -602,6 +651,14 @@ func (b *build) start(tx Tx, plan atc.Plan) (bool, error) {
 		return false, err
 	}
 
+	// check builds are left out, as they are from the activity feed
+	if !b.isForCheck() {
+		err = enqueueWebhookEvent(tx, b.teamID, atc.WebhookEventBuildStatus, b.webhookBuild(BuildStatusStarted, startTime, time.Time{}))
+		if err != nil {
+			return false, err
+		}
+	}
+
 	return true, nil
 }
 
@@ -638,6 +695,14 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	// check builds are left out, as they are from the activity feed
+	if !b.isForCheck() {
+		err = enqueueWebhookEvent(tx, b.teamID, atc.WebhookEventBuildStatus, b.webhookBuild(status, b.startTime, endTime))
+		if err != nil {
+			return err
+		}
+	}
+
 	if b.jobID != 0 && status == BuildStatusSucceeded {
 		_, err = psql.Delete("build_image_resource_caches").
 			Where(sq.And{
@@ -844,6 +909,21 @@ func (b *build) SetDrained(drained bool) error {
 	return err
 }
 
+// SetEventsArchive records where the build's events were archived to, so that
+// they can still be served once they have been reaped from the database.
+func (b *build) SetEventsArchive(key string) error {
+	_, err := psql.Update("builds").
+		Set("events_archive", key).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+
+	if err == nil {
+		b.eventsArchive = key
+	}
+	return err
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -1331,6 +1411,11 @@ func (b *build) SaveOutput(
 		if err != nil {
 			return err
 		}
+
+		err = notifyResourceVersionsDiscovered(tx, resourceConfigScope.ID())
+		if err != nil {
+			return err
+		}
 	}
 
 	err = tx.Commit()
@@ -1665,9 +1750,6 @@ func (b *build) AdoptRerunInputsAndPipes() ([]BuildInput, bool, error) {
 }
 
 func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
-	inputs := []BuildInput{}
-	outputs := []BuildOutput{}
-
 	tx, err := b.conn.Begin()
 	if err != nil {
 		return nil, nil, err
@@ -1675,7 +1757,36 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 
 	defer Rollback(tx)
 
-	rows, err := psql.Select("inputs.name", "resources.id", "versions.version", `COALESCE(inputs.first_occurrence, NOT EXISTS (
+	inputs, outputs, err := buildsResources(tx, []int{b.id})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buildInputs := inputs[b.id]
+	if buildInputs == nil {
+		buildInputs = []BuildInput{}
+	}
+
+	buildOutputs := outputs[b.id]
+	if buildOutputs == nil {
+		buildOutputs = []BuildOutput{}
+	}
+
+	return buildInputs, buildOutputs, nil
+}
+
+// buildsResources returns the inputs and outputs of each of the builds,
+// keyed by build ID.
+func buildsResources(tx Tx, buildIDs []int) (map[int][]BuildInput, map[int][]BuildOutput, error) {
+	inputs := map[int][]BuildInput{}
+	outputs := map[int][]BuildOutput{}
+
+	rows, err := psql.Select("builds.id", "inputs.name", "resources.id", "versions.version", `COALESCE(inputs.first_occurrence, NOT EXISTS (
 			SELECT 1
 			FROM build_resource_config_version_inputs i, builds b
 			WHERE versions.version_md5 = i.version_md5
@@ -1686,7 +1797,7 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 			AND i.build_id < builds.id
 		))`).
 		From("resource_config_versions versions, build_resource_config_version_inputs inputs, builds, resources").
-		Where(sq.Eq{"builds.id": b.id}).
+		Where(sq.Eq{"builds.id": buildIDs}).
 		Where(sq.Expr("inputs.build_id = builds.id")).
 		Where(sq.Expr("inputs.version_md5 = versions.version_md5")).
 		Where(sq.Expr("resources.resource_config_scope_id = versions.resource_config_scope_id")).
@@ -1709,6 +1820,7 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 
 	for rows.Next() {
 		var (
+			buildID     int
 			inputName   string
 			firstOcc    bool
 			versionBlob string
@@ -1716,7 +1828,7 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 			resourceID  int
 		)
 
-		err = rows.Scan(&inputName, &resourceID, &versionBlob, &firstOcc)
+		err = rows.Scan(&buildID, &inputName, &resourceID, &versionBlob, &firstOcc)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1726,7 +1838,7 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 			return nil, nil, err
 		}
 
-		inputs = append(inputs, BuildInput{
+		inputs[buildID] = append(inputs[buildID], BuildInput{
 			Name:            inputName,
 			Version:         version,
 			ResourceID:      resourceID,
@@ -1734,9 +1846,9 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 		})
 	}
 
-	rows, err = psql.Select("outputs.name", "versions.version").
+	rows, err = psql.Select("builds.id", "outputs.name", "versions.version").
 		From("resource_config_versions versions, build_resource_config_version_outputs outputs, builds, resources").
-		Where(sq.Eq{"builds.id": b.id}).
+		Where(sq.Eq{"builds.id": buildIDs}).
 		Where(sq.Expr("outputs.build_id = builds.id")).
 		Where(sq.Expr("outputs.version_md5 = versions.version_md5")).
 		Where(sq.Expr("outputs.resource_id = resources.id")).
@@ -1752,12 +1864,13 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 
 	for rows.Next() {
 		var (
+			buildID     int
 			outputName  string
 			versionBlob string
 			version     atc.Version
 		)
 
-		err := rows.Scan(&outputName, &versionBlob)
+		err := rows.Scan(&buildID, &outputName, &versionBlob)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1767,17 +1880,12 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 			return nil, nil, err
 		}
 
-		outputs = append(outputs, BuildOutput{
+		outputs[buildID] = append(outputs[buildID], BuildOutput{
 			Name:    outputName,
 			Version: version,
 		})
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return nil, nil, err
-	}
-
 	return inputs, outputs, nil
 }
 
@@ -1801,7 +1909,12 @@ func (b *build) SavePipeline(
 
 	jobID := newNullInt64(b.jobID)
 	buildID := newNullInt64(b.id)
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, teamID, jobID, buildID)
+	var createdBy sql.NullString
+	if b.createdBy != nil {
+		createdBy = sql.NullString{String: *b.createdBy, Valid: true}
+	}
+
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, teamID, jobID, buildID, createdBy)
 	if err != nil {
 		return nil, false, err
 	}
@@ -1852,10 +1965,10 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		jobID, resourceID, resourceTypeID, pipelineID, rerunOf, rerunNumber               sql.NullInt64
 		schema, privatePlan, jobName, resourceName, pipelineName, publicPlan, rerunOfName sql.NullString
 		createTime, startTime, endTime, reapTime                                          pq.NullTime
-		nonce, spanContext, createdBy                                                     sql.NullString
+		nonce, spanContext, createdBy, eventsArchive                                      sql.NullString
 		drained, aborted, completed                                                       bool
 		status                                                                            string
-		pipelineInstanceVars, comment                                                     sql.NullString
+		pipelineInstanceVars, comment, annotations                                        sql.NullString
 	)
 
 	err := row.Scan(
@@ -1884,6 +1997,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&b.teamName,
 		&nonce,
 		&drained,
+		&eventsArchive,
 		&aborted,
 		&completed,
 		&b.inputsReady,
@@ -1892,6 +2006,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunNumber,
 		&spanContext,
 		&comment,
+		&annotations,
 	)
 	if err != nil {
 		return err
@@ -1911,6 +2026,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
 	b.drained = drained
+	b.eventsArchive = eventsArchive.String
 	b.aborted = aborted
 	b.completed = completed
 	b.rerunOf = int(rerunOf.Int64)
@@ -1918,6 +2034,13 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.rerunNumber = int(rerunNumber.Int64)
 	b.comment = comment.String
 
+	if annotations.Valid {
+		err = json.Unmarshal([]byte(annotations.String), &b.annotations)
+		if err != nil {
+			return err
+		}
+	}
+
 	var (
 		noncense      *string
 		decryptedPlan []byte
@@ -1981,12 +2104,22 @@ func (b *build) saveEvent(tx Tx, event atc.Event) error {
 		}
 	}
 
+	eventID := b.eventIdSeq.Next()
+
 	_, err = psql.Insert(b.eventsTable()).
 		Columns("event_id", "build_id", "type", "version", "payload").
-		Values(b.eventIdSeq.Next(), b.id, string(event.EventType()), string(event.Version()), payload).
+		Values(eventID, b.id, string(event.EventType()), string(event.Version()), payload).
 		RunWith(tx).
 		Exec()
-	return err
+	if err != nil {
+		return err
+	}
+
+	if atc.EnableBuildLogSearch && !b.isForCheck() {
+		return b.indexLogEvent(tx, eventID, event)
+	}
+
+	return nil
 }
 
 func (b *build) isForCheck() bool {
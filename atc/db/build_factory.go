@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/lager/v3"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
 )
 
@@ -45,6 +46,8 @@ type BuildForAPI interface {
 	HasPlan() bool
 
 	Comment() string
+	Annotations() []atc.BuildAnnotations
+	TestResults() ([]atc.TestResult, error)
 	StartTime() time.Time
 	EndTime() time.Time
 	ReapTime() time.Time
@@ -57,9 +60,13 @@ type BuildForAPI interface {
 	IsDrained() bool
 	IsRunning() bool
 
+	EventsArchive() string
+	SetEventsArchive(string) error
+
 	Artifacts() ([]WorkerArtifact, error)
 	Events(uint) (EventSource, error)
 	Resources() ([]BuildInput, []BuildOutput, error)
+	Provenance() (atc.ProvenanceEnvelope, bool, error)
 	Preparation() (BuildPreparation, bool, error)
 
 	MarkAsAborted() error
@@ -73,6 +80,9 @@ type BuildFactory interface {
 	AllBuilds(Page) ([]BuildForAPI, Pagination, error)
 	PublicBuilds(Page) ([]BuildForAPI, Pagination, error)
 
+	JobsBuilds([]int, Page) (map[int][]BuildForAPI, error)
+	BuildsResources([]int) (map[int][]BuildInput, map[int][]BuildOutput, error)
+
 	Build(int) (Build, bool, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
@@ -182,6 +192,91 @@ func (f *buildFactory) PublicBuilds(page Page) ([]BuildForAPI, Pagination, error
 		page, f.conn, f.lockFactory, false)
 }
 
+// JobsBuilds returns a page of builds for each of the given jobs at once,
+// newest first, for loading them in batches. The page's limit applies to each
+// job. Unlike a single job's builds, they are ordered by ID alone.
+func (f *buildFactory) JobsBuilds(jobIDs []int, page Page) (map[int][]BuildForAPI, error) {
+	query := buildsQuery.Where(sq.Eq{"b.job_id": jobIDs})
+
+	order := "b.id DESC"
+	if page.From != nil {
+		query = query.Where(sq.GtOrEq{"b.id": *page.From})
+		order = "b.id ASC"
+	}
+
+	if page.To != nil {
+		query = query.Where(sq.LtOrEq{"b.id": *page.To})
+	}
+
+	ranked := psql.Select("*").
+		FromSelect(
+			query.Column(fmt.Sprintf("row_number() OVER (PARTITION BY b.job_id ORDER BY %s) AS job_rank", order)),
+			"ranked",
+		).
+		OrderBy("job_rank")
+
+	if page.Limit > 0 {
+		ranked = ranked.Where(sq.LtOrEq{"job_rank": page.Limit})
+	}
+
+	rows, err := ranked.RunWith(f.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	builds := map[int][]BuildForAPI{}
+	for rows.Next() {
+		var rank int
+
+		build := newEmptyBuild(f.conn, f.lockFactory)
+		err = scanBuild(build, extraColumnsScanner{rows, []interface{}{&rank}}, f.conn.EncryptionStrategy())
+		if err != nil {
+			return nil, err
+		}
+
+		builds[build.JobID()] = append(builds[build.JobID()], build)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if page.From != nil {
+		for _, jobBuilds := range builds {
+			for i, j := 0, len(jobBuilds)-1; i < j; i, j = i+1, j-1 {
+				jobBuilds[i], jobBuilds[j] = jobBuilds[j], jobBuilds[i]
+			}
+		}
+	}
+
+	return builds, nil
+}
+
+// BuildsResources returns the inputs and outputs of each of the builds,
+// keyed by build ID, for loading them in batches.
+func (f *buildFactory) BuildsResources(buildIDs []int) (map[int][]BuildInput, map[int][]BuildOutput, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer Rollback(tx)
+
+	inputs, outputs, err := buildsResources(tx, buildIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inputs, outputs, nil
+}
+
 func (f *buildFactory) MarkNonInterceptibleBuilds() error {
 	_, err := psql.Update("builds b").
 		Set("interceptible", false).
@@ -125,7 +125,7 @@ var _ = Describe("BuildFactory", func() {
 							Name: "some-other-job",
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				j, found, err := p.Job("some-other-job")
@@ -254,7 +254,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false)
+			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -264,7 +264,7 @@ var _ = Describe("BuildFactory", func() {
 			build2, err = privateJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false)
+			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -313,7 +313,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false)
+			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -323,7 +323,7 @@ var _ = Describe("BuildFactory", func() {
 			build2, err = privateJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false)
+			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -359,7 +359,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false)
+			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -369,7 +369,7 @@ var _ = Describe("BuildFactory", func() {
 			_, err = privateJob.CreateBuild(defaultBuildCreatedBy)
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false)
+			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -419,7 +419,7 @@ var _ = Describe("BuildFactory", func() {
 						},
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -518,7 +518,7 @@ var _ = Describe("BuildFactory", func() {
 						Name: "some-job",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -572,7 +572,7 @@ var _ = Describe("BuildFactory", func() {
 						Name: "some-job",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
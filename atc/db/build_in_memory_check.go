@@ -74,6 +74,7 @@ func (b *inMemoryCheckBuildForApi) CreatedBy() *string                { return n
 func (b *inMemoryCheckBuildForApi) Schema() string                    { return schema }
 func (b *inMemoryCheckBuildForApi) IsRunning() bool                   { return b.status == BuildStatusStarted }
 func (b *inMemoryCheckBuildForApi) IsDrained() bool                   { return false }
+func (b *inMemoryCheckBuildForApi) EventsArchive() string             { return "" }
 func (b *inMemoryCheckBuildForApi) PipelineInstanceVars() atc.InstanceVars {
 	return b.checkable.PipelineInstanceVars()
 }
@@ -235,6 +236,15 @@ func (b *inMemoryCheckBuildForApi) Job() (Job, bool, error) {
 func (b *inMemoryCheckBuildForApi) Comment() string {
 	return ""
 }
+func (b *inMemoryCheckBuildForApi) Annotations() []atc.BuildAnnotations {
+	return nil
+}
+func (b *inMemoryCheckBuildForApi) TestResults() ([]atc.TestResult, error) {
+	return nil, nil
+}
+func (b *inMemoryCheckBuildForApi) Provenance() (atc.ProvenanceEnvelope, bool, error) {
+	return atc.ProvenanceEnvelope{}, false, nil
+}
 func (b *inMemoryCheckBuildForApi) Artifacts() ([]WorkerArtifact, error) {
 	return nil, errors.New("not implemented for in memory build")
 }
@@ -569,6 +579,14 @@ func (b *inMemoryCheckBuild) SaveImageResourceVersion(ResourceCache) error {
 	return nil
 }
 
+func (b *inMemoryCheckBuild) ImageVersions() ([]BuildImageVersion, error) {
+	return nil, nil
+}
+
+func (b *inMemoryCheckBuild) WorkerNames() ([]string, error) {
+	return nil, nil
+}
+
 func (b *inMemoryCheckBuild) initDbStuff(tx Tx) error {
 	var nextBuildId int
 	err := psql.Select("nextval('builds_id_seq'::regclass)").RunWith(tx).QueryRow().Scan(&nextBuildId)
@@ -631,6 +649,10 @@ func (b *inMemoryCheckBuild) IsAborted() bool       { return false }
 func (b *inMemoryCheckBuild) IsCompleted() bool     { return false }
 func (b *inMemoryCheckBuild) InputsReady() bool     { return false }
 
+func (b *inMemoryCheckBuildForApi) SetEventsArchive(string) error {
+	return errors.New("not implemented for in memory build")
+}
+
 func (b *inMemoryCheckBuild) SetDrained(bool) error {
 	return errors.New("not implemented for in memory build")
 }
@@ -644,6 +666,29 @@ func (b *inMemoryCheckBuild) Interceptible() (bool, error) {
 func (b *inMemoryCheckBuild) SetInterceptible(bool) error {
 	return errors.New("not implemented for in memory build")
 }
+func (b *inMemoryCheckBuild) AddAnnotations(atc.BuildAnnotations) error {
+	return errors.New("not implemented for in memory build")
+}
+
+func (b *inMemoryCheckBuild) TestResults() ([]atc.TestResult, error) {
+	return nil, nil
+}
+
+func (b *inMemoryCheckBuild) SaveTestResults(string, []atc.TestResult) error {
+	return errors.New("not implemented for in memory build")
+}
+
+func (b *inMemoryCheckBuild) Provenance() (atc.ProvenanceEnvelope, bool, error) {
+	return atc.ProvenanceEnvelope{}, false, nil
+}
+
+func (b *inMemoryCheckBuild) SaveProvenance(atc.ProvenanceEnvelope) error {
+	return errors.New("not implemented for in memory build")
+}
+
+func (b *inMemoryCheckBuild) SaveSLOBreach(atc.JobSLOStats, []atc.JobSLOViolation) error {
+	return errors.New("not implemented for in memory build")
+}
 
 func (b *inMemoryCheckBuild) Artifact(int) (WorkerArtifact, error) {
 	return nil, errors.New("not implemented for in memory build")
@@ -0,0 +1,152 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/lib/pq"
+)
+
+// ansiEscape matches the color and cursor codes in build output, which would
+// otherwise be indexed as part of the words they precede.
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// BuildLogSearch is a search of a team's build logs. The query is in the
+// same syntax as web search engines: words, "quoted phrases", and -excluded
+// words.
+type BuildLogSearch struct {
+	Query string
+	JobID int
+	Since time.Time
+	Until time.Time
+	Limit int
+}
+
+// indexLogEvent adds a build's log output to the search index. Only log
+// events are indexed; the rest of a build's events aren't searchable.
+func (b *build) indexLogEvent(tx Tx, eventID int, ev atc.Event) error {
+	log, ok := ev.(event.Log)
+	if !ok {
+		return nil
+	}
+
+	payload := ansiEscape.ReplaceAllString(log.Payload, "")
+
+	_, err := psql.Insert("build_log_search").
+		Columns("build_id", "event_id", "origin", "time", "payload", "tsv").
+		Values(b.id, eventID, string(log.Origin.ID), time.Unix(log.Time, 0), payload, sq.Expr("to_tsvector('simple', ?)", payload)).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+// deleteBuildLogSearch removes reaped builds' logs from the search index.
+func deleteBuildLogSearch(tx Tx, buildIDs []int) error {
+	_, err := tx.Exec(`
+		DELETE FROM build_log_search
+		WHERE build_id = ANY($1)
+	`, pq.Array(buildIDs))
+	return err
+}
+
+// SearchBuildLogs returns the chunks of the team's build logs that match the
+// search, newest builds first.
+func (t *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	limit := search.Limit
+	if limit <= 0 {
+		limit = atc.BuildLogSearchDefaultLimit
+	}
+
+	if limit > atc.BuildLogSearchMaxLimit {
+		limit = atc.BuildLogSearchMaxLimit
+	}
+
+	query := psql.Select(
+		"s.build_id",
+		"b.name",
+		"j.name",
+		"p.id",
+		"p.name",
+		"p.instance_vars",
+		"t.name",
+		"s.origin",
+		"s.time",
+		"s.payload",
+	).
+		From("build_log_search s").
+		Join("builds b ON b.id = s.build_id").
+		Join("teams t ON t.id = b.team_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		LeftJoin("pipelines p ON p.id = b.pipeline_id").
+		Where(sq.Eq{"b.team_id": t.id}).
+		Where(sq.Expr("s.tsv @@ websearch_to_tsquery('simple', ?)", search.Query)).
+		OrderBy("s.build_id DESC", "s.event_id").
+		Limit(uint64(limit))
+
+	if search.JobID != 0 {
+		query = query.Where(sq.Eq{"b.job_id": search.JobID})
+	}
+
+	if !search.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"s.time": search.Since})
+	}
+
+	if !search.Until.IsZero() {
+		query = query.Where(sq.Lt{"s.time": search.Until})
+	}
+
+	rows, err := query.RunWith(t.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	matches := []atc.BuildLogMatch{}
+	for rows.Next() {
+		var (
+			match                 atc.BuildLogMatch
+			jobName, pipelineName sql.NullString
+			pipelineID            sql.NullInt64
+			pipelineInstanceVars  sql.NullString
+			logTime               time.Time
+		)
+
+		err = rows.Scan(
+			&match.BuildID,
+			&match.BuildName,
+			&jobName,
+			&pipelineID,
+			&pipelineName,
+			&pipelineInstanceVars,
+			&match.TeamName,
+			&match.Origin,
+			&logTime,
+			&match.Payload,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		match.JobName = jobName.String
+		match.PipelineID = int(pipelineID.Int64)
+		match.PipelineName = pipelineName.String
+		match.Time = logTime.Unix()
+
+		if pipelineInstanceVars.Valid {
+			err = json.Unmarshal([]byte(pipelineInstanceVars.String), &match.PipelineInstanceVars)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches, rows.Err()
+}
@@ -0,0 +1,110 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build log search", func() {
+	var build db.Build
+
+	BeforeEach(func() {
+		atc.EnableBuildLogSearch = true
+
+		var err error
+		build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.SaveEvent(event.Log{
+			Time:    100,
+			Origin:  event.Origin{ID: "some-origin"},
+			Payload: "\x1b[31merror:\x1b[0m connection refused\n",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.SaveEvent(event.Log{
+			Time:    200,
+			Origin:  event.Origin{ID: "some-origin"},
+			Payload: "all tests passed\n",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		atc.EnableBuildLogSearch = false
+	})
+
+	search := func(query db.BuildLogSearch) []atc.BuildLogMatch {
+		matches, err := defaultTeam.SearchBuildLogs(query)
+		Expect(err).ToNot(HaveOccurred())
+		return matches
+	}
+
+	It("finds the log output matching the query, without color codes", func() {
+		Expect(search(db.BuildLogSearch{Query: "refused"})).To(Equal([]atc.BuildLogMatch{
+			{
+				BuildID:              build.ID(),
+				BuildName:            build.Name(),
+				JobName:              defaultJob.Name(),
+				PipelineID:           defaultPipeline.ID(),
+				PipelineName:         defaultPipeline.Name(),
+				PipelineInstanceVars: defaultPipeline.InstanceVars(),
+				TeamName:             defaultTeam.Name(),
+				Origin:               "some-origin",
+				Time:                 100,
+				Payload:              "error: connection refused\n",
+			},
+		}))
+	})
+
+	It("supports phrases and excluded words", func() {
+		Expect(search(db.BuildLogSearch{Query: `"connection refused"`})).To(HaveLen(1))
+		Expect(search(db.BuildLogSearch{Query: `"refused connection"`})).To(BeEmpty())
+		Expect(search(db.BuildLogSearch{Query: "tests -passed"})).To(BeEmpty())
+	})
+
+	It("filters by job and time", func() {
+		Expect(search(db.BuildLogSearch{Query: "refused", JobID: defaultJob.ID()})).To(HaveLen(1))
+		Expect(search(db.BuildLogSearch{Query: "refused", JobID: defaultJob.ID() + 1})).To(BeEmpty())
+		Expect(search(db.BuildLogSearch{Query: "refused", Since: time.Unix(150, 0)})).To(BeEmpty())
+		Expect(search(db.BuildLogSearch{Query: "passed", Since: time.Unix(150, 0), Until: time.Unix(250, 0)})).To(HaveLen(1))
+	})
+
+	It("only searches the team's own builds", func() {
+		otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-search-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		matches, err := otherTeam.SearchBuildLogs(db.BuildLogSearch{Query: "refused"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matches).To(BeEmpty())
+	})
+
+	It("forgets the logs of reaped builds", func() {
+		err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(search(db.BuildLogSearch{Query: "refused"})).To(BeEmpty())
+	})
+
+	Context("when the feature is disabled", func() {
+		BeforeEach(func() {
+			atc.EnableBuildLogSearch = false
+
+			err := build.SaveEvent(event.Log{
+				Time:    300,
+				Payload: "unindexed output\n",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not index new logs", func() {
+			Expect(search(db.BuildLogSearch{Query: "unindexed"})).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// BuildImageVersion is a version of an image resource that one of a build's
+// steps ran with. Type is empty for images fetched using a custom resource
+// type.
+type BuildImageVersion struct {
+	Type    string
+	Version atc.Version
+}
+
+// Provenance returns the latest provenance recorded for the build, if any.
+func (b *build) Provenance() (atc.ProvenanceEnvelope, bool, error) {
+	var payload string
+	err := psql.Select("envelope").
+		From("build_provenance").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&payload)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.ProvenanceEnvelope{}, false, nil
+		}
+
+		return atc.ProvenanceEnvelope{}, false, err
+	}
+
+	var envelope atc.ProvenanceEnvelope
+	err = json.Unmarshal([]byte(payload), &envelope)
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, false, err
+	}
+
+	return envelope, true, nil
+}
+
+// SaveProvenance records the build's provenance, unless provenance covering
+// more of its outputs has already been recorded.
+//
+// Puts running in parallel each record provenance covering every output
+// saved by the time they generate it. As outputs are only ever added, the
+// provenance covering the most outputs covers all of the others, so it wins
+// regardless of which put saves last.
+func (b *build) SaveProvenance(envelope atc.ProvenanceEnvelope) error {
+	statement, err := envelope.Statement()
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("build_provenance").
+		Columns("build_id", "envelope", "subjects").
+		Values(b.id, string(payload), len(statement.Subject)).
+		Suffix(`ON CONFLICT (build_id) DO UPDATE SET envelope = EXCLUDED.envelope, subjects = EXCLUDED.subjects
+			WHERE build_provenance.subjects <= EXCLUDED.subjects`).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// ImageVersions returns the versions of the images the build's steps have run
+// with so far.
+func (b *build) ImageVersions() ([]BuildImageVersion, error) {
+	rows, err := psql.Select("COALESCE(brt.name, '')", "rc.version").
+		From("build_image_resource_caches birc").
+		Join("resource_caches rc ON rc.id = birc.resource_cache_id").
+		Join("resource_configs rcfg ON rcfg.id = rc.resource_config_id").
+		LeftJoin("base_resource_types brt ON brt.id = rcfg.base_resource_type_id").
+		Where(sq.Eq{"birc.build_id": b.id}).
+		OrderBy("rc.id").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var versions []BuildImageVersion
+	for rows.Next() {
+		var (
+			imageVersion BuildImageVersion
+			versionBlob  string
+		)
+
+		err = rows.Scan(&imageVersion.Type, &versionBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(versionBlob), &imageVersion.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, imageVersion)
+	}
+
+	return versions, rows.Err()
+}
+
+// WorkerNames returns the names of the workers that the build's containers
+// have been placed on.
+func (b *build) WorkerNames() ([]string, error) {
+	rows, err := psql.Select("DISTINCT worker_name").
+		From("containers").
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("worker_name").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var names []string
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+	}
+
+	return names, rows.Err()
+}
@@ -54,7 +54,7 @@ var _ = Describe("Build", func() {
 			},
 		}
 
-		pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-build-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
+		pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-build-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 		Expect(err).ToNot(HaveOccurred())
 
 		job, found, err = pipeline.Job("some-job")
@@ -106,6 +106,39 @@ var _ = Describe("Build", func() {
 		Expect(build.Comment()).To(Equal(comment))
 	})
 
+	It("can have annotations added by each of its tasks", func() {
+		Expect(build.Annotations()).To(BeEmpty())
+
+		err := build.AddAnnotations(atc.BuildAnnotations{
+			Step:    "unit",
+			Summary: "all good",
+			Tests:   &atc.BuildAnnotationTests{Passed: 1234},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.AddAnnotations(atc.BuildAnnotations{
+			Step:  "deploy",
+			Links: []atc.BuildAnnotationLink{{Name: "preview", URL: "https://example.com"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		found, err := build.Reload()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		Expect(build.Annotations()).To(Equal([]atc.BuildAnnotations{
+			{
+				Step:    "unit",
+				Summary: "all good",
+				Tests:   &atc.BuildAnnotationTests{Passed: 1234},
+			},
+			{
+				Step:  "deploy",
+				Links: []atc.BuildAnnotationLink{{Name: "preview", URL: "https://example.com"}},
+			},
+		}))
+	})
+
 	It("has run state id", func() {
 		Expect(build.RunStateID()).To(Equal(fmt.Sprintf("build:%v", build.ID())))
 	})
@@ -334,6 +367,127 @@ var _ = Describe("Build", func() {
 			drained = build.IsDrained()
 			Expect(drained).To(BeTrue())
 		})
+
+		It("keeps the public plan naming the steps of a finished build", func() {
+			plan := atc.Plan{
+				ID: "some-plan",
+				Do: &atc.DoPlan{
+					{ID: "get-plan", Get: &atc.GetPlan{Name: "some-input", Type: "some-type"}},
+					{ID: "task-plan", Task: &atc.TaskPlan{Name: "unit"}},
+				},
+			}
+
+			started, err := build.Start(plan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			Expect(build.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			builds, err := buildFactory.GetDrainableBuilds()
+			Expect(err).NotTo(HaveOccurred())
+
+			var drainable db.Build
+			for _, b := range builds {
+				if b.ID() == build.ID() {
+					drainable = b
+				}
+			}
+			Expect(drainable).ToNot(BeNil())
+
+			Expect(drainable.PrivatePlan()).To(Equal(atc.Plan{}))
+			Expect(*drainable.PublicPlan()).To(MatchJSON(*plan.Public()))
+		})
+	})
+
+	Describe("EventsArchive", func() {
+		It("is empty until the events are archived", func() {
+			Expect(build.EventsArchive()).To(BeEmpty())
+		})
+
+		It("records where the events were archived to", func() {
+			err := build.SetEventsArchive("builds/1/events.ndjson.gz")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.EventsArchive()).To(Equal("builds/1/events.ndjson.gz"))
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.EventsArchive()).To(Equal("builds/1/events.ndjson.gz"))
+		})
+	})
+
+	Describe("Provenance", func() {
+		var envelope atc.ProvenanceEnvelope
+
+		statementWithSubjects := func(names ...string) []byte {
+			statement := atc.ProvenanceStatement{Type: atc.ProvenanceStatementType}
+			for _, name := range names {
+				statement.Subject = append(statement.Subject, atc.ProvenanceResourceDescriptor{Name: name})
+			}
+
+			payload, err := json.Marshal(statement)
+			Expect(err).NotTo(HaveOccurred())
+
+			return payload
+		}
+
+		BeforeEach(func() {
+			envelope = atc.ProvenanceEnvelope{
+				PayloadType: atc.ProvenancePayloadType,
+				Payload:     statementWithSubjects("some-output"),
+				Signatures:  []atc.ProvenanceSignature{{KeyID: "some-key", Sig: []byte("some-sig")}},
+			}
+		})
+
+		It("is not found until provenance is saved", func() {
+			_, found, err := build.Provenance()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns the latest saved provenance", func() {
+			err := build.SaveProvenance(envelope)
+			Expect(err).NotTo(HaveOccurred())
+
+			envelope.Payload = statementWithSubjects("some-output", "other-output")
+			err = build.SaveProvenance(envelope)
+			Expect(err).NotTo(HaveOccurred())
+
+			saved, found, err := build.Provenance()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(saved).To(Equal(envelope))
+		})
+
+		It("keeps provenance covering more outputs than the provenance being saved", func() {
+			covering := envelope
+			covering.Payload = statementWithSubjects("some-output", "other-output")
+
+			err := build.SaveProvenance(covering)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveProvenance(envelope)
+			Expect(err).NotTo(HaveOccurred())
+
+			saved, found, err := build.Provenance()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(saved).To(Equal(covering))
+		})
+	})
+
+	Describe("ImageVersions", func() {
+		It("returns the versions of the images the build ran with", func() {
+			resourceCache := createResourceCacheWithUser(db.ForBuild(build.ID()))
+
+			err := build.SaveImageResourceVersion(resourceCache)
+			Expect(err).NotTo(HaveOccurred())
+
+			versions, err := build.ImageVersions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(Equal([]db.BuildImageVersion{
+				{Type: "some-base-resource-type", Version: atc.Version{"some": "version"}},
+			}))
+		})
 	})
 
 	Describe("Start", func() {
@@ -852,7 +1006,7 @@ var _ = Describe("Build", func() {
 				Context("when the pipeline is not set by build", func() {
 					It("never gets archived", func() {
 						build, _ := defaultJob.CreateBuild(defaultBuildCreatedBy)
-						teamPipeline, _, _ := defaultTeam.SavePipeline(atc.PipelineRef{Name: "team-pipeline"}, defaultPipelineConfig, db.ConfigVersion(0), false)
+						teamPipeline, _, _ := defaultTeam.SavePipeline(atc.PipelineRef{Name: "team-pipeline"}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 						build.Finish(db.BuildStatusSucceeded)
 
 						teamPipeline.Reload()
@@ -930,7 +1084,7 @@ var _ = Describe("Build", func() {
 					},
 				})
 
-				pipeline, _, err := defaultTeam.SavePipeline(defaultPipelineRef, config, defaultPipeline.ConfigVersion(), false)
+				pipeline, _, err := defaultTeam.SavePipeline(defaultPipelineRef, config, defaultPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job(defaultJob.Name())
@@ -1561,7 +1715,7 @@ var _ = Describe("Build", func() {
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := createdPipeline.Job("some-job")
@@ -2504,7 +2658,7 @@ var _ = Describe("Build", func() {
 
 		It("unpauses the pipeline if it was previously archived", func() {
 			By("creating and archiving a pipeline")
-			pipeline, _, err := defaultTeam.SavePipeline(defaultPipelineRef, defaultPipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err := defaultTeam.SavePipeline(defaultPipelineRef, defaultPipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = pipeline.Archive()
@@ -2524,7 +2678,7 @@ var _ = Describe("Build", func() {
 
 		It("does not unpause the pipeline if it was previously paused", func() {
 			By("creating and pausing a pipeline")
-			pipeline, _, err := defaultTeam.SavePipeline(defaultPipelineRef, defaultPipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err := defaultTeam.SavePipeline(defaultPipelineRef, defaultPipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = pipeline.Pause("")
@@ -426,7 +426,7 @@ var _ = Describe("CheckFactory", func() {
 			}
 
 			defaultPipelineRef = atc.PipelineRef{Name: "default-pipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
-			defaultPipeline, _, err = defaultTeam.SavePipeline(defaultPipelineRef, defaultPipelineConfig, db.ConfigVersion(1), false)
+			defaultPipeline, _, err = defaultTeam.SavePipeline(defaultPipelineRef, defaultPipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			var found bool
@@ -558,7 +558,7 @@ var _ = Describe("CheckFactory", func() {
 			}
 
 			somePipelineRef := atc.PipelineRef{Name: "some-pipeline"}
-			somePipeline, _, err = defaultTeam.SavePipeline(somePipelineRef, somePipelineConfig, db.ConfigVersion(1), false)
+			somePipeline, _, err = defaultTeam.SavePipeline(somePipelineRef, somePipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"database/sql"
 	"errors"
 	"time"
 
@@ -135,6 +136,17 @@ type CreatedContainer interface {
 	Destroying() (DestroyingContainer, error)
 	LastHijack() time.Time
 	UpdateLastHijack() error
+
+	// RecordCheckPoolUse marks a container in the check container pool as
+	// used to run a check, returning whether it had run one before, i.e.
+	// whether it was warm. The container is leased to the check until
+	// leaseUntil, keeping it from being garbage collected should it be
+	// evicted from the pool in the meantime.
+	RecordCheckPoolUse(leaseUntil time.Time) (bool, error)
+
+	// ReleaseCheckPoolLease ends the lease taken by RecordCheckPoolUse once
+	// the check is done.
+	ReleaseCheckPoolLease() error
 }
 
 type createdContainer struct {
@@ -209,6 +221,43 @@ func (container *createdContainer) Destroying() (DestroyingContainer, error) {
 	), nil
 }
 
+func (container *createdContainer) RecordCheckPoolUse(leaseUntil time.Time) (bool, error) {
+	var uses int
+	var pooled bool
+	err := psql.Update("containers").
+		Set("check_pool_last_used", sq.Expr("NOW()")).
+		Set("check_pool_leased_until", leaseUntil).
+		Set("check_pool_uses", sq.Expr("check_pool_uses + 1")).
+		Where(sq.Eq{
+			"id":          container.id,
+			"worker_name": container.workerName,
+			"state":       atc.ContainerStateCreated,
+		}).
+		Suffix("RETURNING check_pool_uses, check_pool_key IS NOT NULL").
+		RunWith(container.conn).
+		QueryRow().
+		Scan(&uses, &pooled)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, ErrContainerDisappeared
+		}
+		return false, err
+	}
+
+	// a container evicted from the pool since it was found is leased all the
+	// same, but doesn't count as warm
+	return pooled && uses > 1, nil
+}
+
+func (container *createdContainer) ReleaseCheckPoolLease() error {
+	_, err := psql.Update("containers").
+		Set("check_pool_leased_until", nil).
+		Where(sq.Eq{"id": container.id}).
+		RunWith(container.conn).
+		Exec()
+	return err
+}
+
 func (container *createdContainer) UpdateLastHijack() error {
 
 	rows, err := psql.Update("containers").
@@ -162,6 +162,50 @@ func (c resourceConfigCheckSessionContainerOwner) Create(tx Tx, workerName strin
 
 }
 
+// NewCheckPoolContainerOwner references a warm container in a worker's pool
+// of check containers, keyed by the image of the checks run in it. Each
+// worker holds at most size pooled containers; pooling another one evicts
+// the least recently used, leaving it to be garbage collected once no check
+// holds a lease on it.
+func NewCheckPoolContainerOwner(key string, size int) ContainerOwner {
+	return checkPoolContainerOwner{
+		Key:  key,
+		Size: size,
+	}
+}
+
+type checkPoolContainerOwner struct {
+	Key  string
+	Size int
+}
+
+// Find doesn't mark the container as used: that's left to
+// CreatedContainer.RecordCheckPoolUse once the check has the container.
+func (c checkPoolContainerOwner) Find(Conn) (sq.Eq, bool, error) {
+	return sq.Eq{"check_pool_key": c.Key}, true, nil
+}
+
+func (c checkPoolContainerOwner) Create(tx Tx, workerName string) (map[string]interface{}, error) {
+	_, err := psql.Update("containers").
+		Set("check_pool_key", nil).
+		Where(sq.Expr(`id IN (
+			SELECT id FROM containers
+			WHERE worker_name = ? AND check_pool_key IS NOT NULL
+			ORDER BY check_pool_last_used DESC
+			OFFSET ?
+		)`, workerName, max(c.Size-1, 0))).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("evict check pool containers: %w", err)
+	}
+
+	return map[string]interface{}{
+		"check_pool_key":       c.Key,
+		"check_pool_last_used": sq.Expr("NOW()"),
+	}, nil
+}
+
 // NewFixedHandleContainerOwner is used in testing to represent a container
 // with a fixed handle, rather than using the randomly generated UUID as a
 // handle.
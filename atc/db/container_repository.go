@@ -19,6 +19,7 @@ type ContainerRepository interface {
 	RemoveMissingContainers(time.Duration) (int, error)
 	DestroyUnknownContainers(workerName string, reportedHandles []string) (int, error)
 	DestroyDirtyInMemoryBuildContainers() (int, error)
+	EvictIdleCheckPoolContainers(idleTimeout time.Duration) (int, error)
 }
 
 type containerRepository struct {
@@ -225,10 +226,19 @@ func (repository *containerRepository) FindOrphanedContainers() ([]CreatingConta
 	query, args, err := selectContainers("c").
 		LeftJoin("builds b ON b.id = c.build_id").
 		Where(sq.Or{
-			sq.Eq{
-				"c.build_id":                         nil,
-				"c.resource_config_check_session_id": nil,
-				"c.in_memory_build_id":               nil,
+			sq.And{
+				sq.Eq{
+					"c.build_id":                         nil,
+					"c.resource_config_check_session_id": nil,
+					"c.in_memory_build_id":               nil,
+					"c.check_pool_key":                   nil,
+				},
+				// a check can still be running in a container evicted
+				// from the check container pool
+				sq.Or{
+					sq.Eq{"c.check_pool_leased_until": nil},
+					sq.Expr("c.check_pool_leased_until < NOW()"),
+				},
 			},
 			sq.And{
 				sq.NotEq{"c.build_id": nil},
@@ -444,3 +454,27 @@ func (repository *containerRepository) DestroyDirtyInMemoryBuildContainers() (in
 
 	return int(affected), nil
 }
+
+// EvictIdleCheckPoolContainers takes containers that haven't run a check for
+// longer than the idle timeout out of the check container pool, orphaning
+// them.
+func (repository *containerRepository) EvictIdleCheckPoolContainers(idleTimeout time.Duration) (int, error) {
+	result, err := psql.Update("containers").
+		Set("check_pool_key", nil).
+		Where(sq.And{
+			sq.NotEq{"check_pool_key": nil},
+			sq.Expr(fmt.Sprintf("check_pool_last_used < NOW() - '%d seconds'::interval", int(idleTimeout.Seconds()))),
+		}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
@@ -1068,4 +1068,190 @@ var _ = Describe("ContainerRepository", func() {
 			})
 		})
 	})
+
+	Describe("check container pool", func() {
+		var pooled db.CreatingContainer
+
+		poolKey := func(container db.CreatingContainer) *string {
+			var key *string
+			err := psql.Select("check_pool_key").
+				From("containers").
+				Where(sq.Eq{"id": container.ID()}).
+				RunWith(dbConn).
+				QueryRow().
+				Scan(&key)
+			Expect(err).ToNot(HaveOccurred())
+			return key
+		}
+
+		BeforeEach(func() {
+			var err error
+			pooled, err = defaultWorker.CreateContainer(
+				db.NewCheckPoolContainerOwner("some-key", 1),
+				fullMetadata,
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("is not orphaned", func() {
+			creatingContainers, _, _, err := containerRepository.FindOrphanedContainers()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(creatingContainers).To(BeEmpty())
+		})
+
+		It("evicts the least recently used container once the pool is full", func() {
+			_, err := defaultWorker.CreateContainer(
+				db.NewCheckPoolContainerOwner("some-other-key", 1),
+				fullMetadata,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(poolKey(pooled)).To(BeNil())
+
+			creatingContainers, _, _, err := containerRepository.FindOrphanedContainers()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(creatingContainers).To(HaveLen(1))
+			Expect(creatingContainers[0].ID()).To(Equal(pooled.ID()))
+		})
+
+		Describe("RecordCheckPoolUse", func() {
+			var created db.CreatedContainer
+
+			lastUsed := func(container db.Container) time.Time {
+				var lastUsed time.Time
+				err := psql.Select("check_pool_last_used").
+					From("containers").
+					Where(sq.Eq{"id": container.ID()}).
+					RunWith(dbConn).
+					QueryRow().
+					Scan(&lastUsed)
+				Expect(err).ToNot(HaveOccurred())
+				return lastUsed
+			}
+
+			BeforeEach(func() {
+				var err error
+				created, err = pooled.Created()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("reports the container as warm from its second use", func() {
+				warm, err := created.RecordCheckPoolUse(time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warm).To(BeFalse())
+
+				warm, err = created.RecordCheckPoolUse(time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warm).To(BeTrue())
+			})
+
+			It("only marks the container itself as used", func() {
+				other, err := otherWorker.CreateContainer(
+					db.NewCheckPoolContainerOwner("some-key", 1),
+					fullMetadata,
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = psql.Update("containers").
+					Set("check_pool_last_used", sq.Expr("NOW() - '2 hours'::interval")).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = created.RecordCheckPoolUse(time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(lastUsed(created)).To(BeTemporally("~", time.Now(), time.Minute))
+				Expect(lastUsed(other)).To(BeTemporally("<", time.Now().Add(-time.Hour)))
+			})
+
+			It("does not count containers that were evicted from the pool as warm", func() {
+				_, err := created.RecordCheckPoolUse(time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = psql.Update("containers").
+					Set("check_pool_key", nil).
+					Where(sq.Eq{"id": created.ID()}).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).ToNot(HaveOccurred())
+
+				warm, err := created.RecordCheckPoolUse(time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warm).To(BeFalse())
+			})
+
+			Context("when the container is evicted from the pool during a check", func() {
+				BeforeEach(func() {
+					_, err := created.RecordCheckPoolUse(time.Now().Add(time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = defaultWorker.CreateContainer(
+						db.NewCheckPoolContainerOwner("some-other-key", 1),
+						fullMetadata,
+					)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(poolKey(pooled)).To(BeNil())
+				})
+
+				It("is not orphaned until the lease is released", func() {
+					_, createdContainers, _, err := containerRepository.FindOrphanedContainers()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(createdContainers).To(BeEmpty())
+
+					err = created.ReleaseCheckPoolLease()
+					Expect(err).ToNot(HaveOccurred())
+
+					_, createdContainers, _, err = containerRepository.FindOrphanedContainers()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(createdContainers).To(HaveLen(1))
+					Expect(createdContainers[0].ID()).To(Equal(created.ID()))
+				})
+
+				It("is orphaned once the lease expires", func() {
+					_, err := psql.Update("containers").
+						Set("check_pool_leased_until", sq.Expr("NOW() - '1 minute'::interval")).
+						Where(sq.Eq{"id": created.ID()}).
+						RunWith(dbConn).
+						Exec()
+					Expect(err).ToNot(HaveOccurred())
+
+					_, createdContainers, _, err := containerRepository.FindOrphanedContainers()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(createdContainers).To(HaveLen(1))
+				})
+			})
+
+			It("fails once the container is being destroyed", func() {
+				_, err := created.Destroying()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = created.RecordCheckPoolUse(time.Now().Add(time.Hour))
+				Expect(err).To(Equal(db.ErrContainerDisappeared))
+			})
+		})
+
+		Describe("EvictIdleCheckPoolContainers", func() {
+			It("evicts containers that have been idle for too long", func() {
+				_, err := psql.Update("containers").
+					Set("check_pool_last_used", sq.Expr("NOW() - '2 hours'::interval")).
+					Where(sq.Eq{"id": pooled.ID()}).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).ToNot(HaveOccurred())
+
+				evicted, err := containerRepository.EvictIdleCheckPoolContainers(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(evicted).To(Equal(1))
+				Expect(poolKey(pooled)).To(BeNil())
+			})
+
+			It("keeps containers that were used recently", func() {
+				evicted, err := containerRepository.EvictIdleCheckPoolContainers(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(evicted).To(Equal(0))
+				Expect(poolKey(pooled)).To(HaveValue(Equal("some-key")))
+			})
+		})
+	})
 })
@@ -46,6 +46,17 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	AddAnnotationsStub        func(atc.BuildAnnotations) error
+	addAnnotationsMutex       sync.RWMutex
+	addAnnotationsArgsForCall []struct {
+		arg1 atc.BuildAnnotations
+	}
+	addAnnotationsReturns struct {
+		result1 error
+	}
+	addAnnotationsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	AdoptInputsAndPipesStub        func() ([]db.BuildInput, bool, error)
 	adoptInputsAndPipesMutex       sync.RWMutex
 	adoptInputsAndPipesArgsForCall []struct {
@@ -84,6 +95,16 @@ type FakeBuild struct {
 	allAssociatedTeamNamesReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	AnnotationsStub        func() []atc.BuildAnnotations
+	annotationsMutex       sync.RWMutex
+	annotationsArgsForCall []struct {
+	}
+	annotationsReturns struct {
+		result1 []atc.BuildAnnotations
+	}
+	annotationsReturnsOnCall map[int]struct {
+		result1 []atc.BuildAnnotations
+	}
 	ArtifactStub        func(int) (db.WorkerArtifact, error)
 	artifactMutex       sync.RWMutex
 	artifactArgsForCall []struct {
@@ -185,6 +206,16 @@ type FakeBuild struct {
 		result1 db.EventSource
 		result2 error
 	}
+	EventsArchiveStub        func() string
+	eventsArchiveMutex       sync.RWMutex
+	eventsArchiveArgsForCall []struct {
+	}
+	eventsArchiveReturns struct {
+		result1 string
+	}
+	eventsArchiveReturnsOnCall map[int]struct {
+		result1 string
+	}
 	FinishStub        func(db.BuildStatus) error
 	finishMutex       sync.RWMutex
 	finishArgsForCall []struct {
@@ -216,6 +247,18 @@ type FakeBuild struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ImageVersionsStub        func() ([]db.BuildImageVersion, error)
+	imageVersionsMutex       sync.RWMutex
+	imageVersionsArgsForCall []struct {
+	}
+	imageVersionsReturns struct {
+		result1 []db.BuildImageVersion
+		result2 error
+	}
+	imageVersionsReturnsOnCall map[int]struct {
+		result1 []db.BuildImageVersion
+		result2 error
+	}
 	InputsReadyStub        func() bool
 	inputsReadyMutex       sync.RWMutex
 	inputsReadyArgsForCall []struct {
@@ -450,6 +493,20 @@ type FakeBuild struct {
 	privatePlanReturnsOnCall map[int]struct {
 		result1 atc.Plan
 	}
+	ProvenanceStub        func() (atc.ProvenanceEnvelope, bool, error)
+	provenanceMutex       sync.RWMutex
+	provenanceArgsForCall []struct {
+	}
+	provenanceReturns struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
+	provenanceReturnsOnCall map[int]struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
 	PublicPlanStub        func() *json.RawMessage
 	publicPlanMutex       sync.RWMutex
 	publicPlanArgsForCall []struct {
@@ -646,6 +703,41 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	SaveProvenanceStub        func(atc.ProvenanceEnvelope) error
+	saveProvenanceMutex       sync.RWMutex
+	saveProvenanceArgsForCall []struct {
+		arg1 atc.ProvenanceEnvelope
+	}
+	saveProvenanceReturns struct {
+		result1 error
+	}
+	saveProvenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveSLOBreachStub        func(atc.JobSLOStats, []atc.JobSLOViolation) error
+	saveSLOBreachMutex       sync.RWMutex
+	saveSLOBreachArgsForCall []struct {
+		arg1 atc.JobSLOStats
+		arg2 []atc.JobSLOViolation
+	}
+	saveSLOBreachReturns struct {
+		result1 error
+	}
+	saveSLOBreachReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveTestResultsStub        func(string, []atc.TestResult) error
+	saveTestResultsMutex       sync.RWMutex
+	saveTestResultsArgsForCall []struct {
+		arg1 string
+		arg2 []atc.TestResult
+	}
+	saveTestResultsReturns struct {
+		result1 error
+	}
+	saveTestResultsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SchemaStub        func() string
 	schemaMutex       sync.RWMutex
 	schemaArgsForCall []struct {
@@ -678,6 +770,17 @@ type FakeBuild struct {
 	setDrainedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetEventsArchiveStub        func(string) error
+	setEventsArchiveMutex       sync.RWMutex
+	setEventsArchiveArgsForCall []struct {
+		arg1 string
+	}
+	setEventsArchiveReturns struct {
+		result1 error
+	}
+	setEventsArchiveReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetInterceptibleStub        func(bool) error
 	setInterceptibleMutex       sync.RWMutex
 	setInterceptibleArgsForCall []struct {
@@ -763,6 +866,18 @@ type FakeBuild struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestResultsStub        func() ([]atc.TestResult, error)
+	testResultsMutex       sync.RWMutex
+	testResultsArgsForCall []struct {
+	}
+	testResultsReturns struct {
+		result1 []atc.TestResult
+		result2 error
+	}
+	testResultsReturnsOnCall map[int]struct {
+		result1 []atc.TestResult
+		result2 error
+	}
 	TracingAttrsStub        func() tracing.Attrs
 	tracingAttrsMutex       sync.RWMutex
 	tracingAttrsArgsForCall []struct {
@@ -788,6 +903,18 @@ type FakeBuild struct {
 		result1 vars.Variables
 		result2 error
 	}
+	WorkerNamesStub        func() ([]string, error)
+	workerNamesMutex       sync.RWMutex
+	workerNamesArgsForCall []struct {
+	}
+	workerNamesReturns struct {
+		result1 []string
+		result2 error
+	}
+	workerNamesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -916,6 +1043,67 @@ func (fake *FakeBuild) AcquireTrackingLockReturnsOnCall(i int, result1 lock.Lock
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) AddAnnotations(arg1 atc.BuildAnnotations) error {
+	fake.addAnnotationsMutex.Lock()
+	ret, specificReturn := fake.addAnnotationsReturnsOnCall[len(fake.addAnnotationsArgsForCall)]
+	fake.addAnnotationsArgsForCall = append(fake.addAnnotationsArgsForCall, struct {
+		arg1 atc.BuildAnnotations
+	}{arg1})
+	stub := fake.AddAnnotationsStub
+	fakeReturns := fake.addAnnotationsReturns
+	fake.recordInvocation("AddAnnotations", []interface{}{arg1})
+	fake.addAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AddAnnotationsCallCount() int {
+	fake.addAnnotationsMutex.RLock()
+	defer fake.addAnnotationsMutex.RUnlock()
+	return len(fake.addAnnotationsArgsForCall)
+}
+
+func (fake *FakeBuild) AddAnnotationsCalls(stub func(atc.BuildAnnotations) error) {
+	fake.addAnnotationsMutex.Lock()
+	defer fake.addAnnotationsMutex.Unlock()
+	fake.AddAnnotationsStub = stub
+}
+
+func (fake *FakeBuild) AddAnnotationsArgsForCall(i int) atc.BuildAnnotations {
+	fake.addAnnotationsMutex.RLock()
+	defer fake.addAnnotationsMutex.RUnlock()
+	argsForCall := fake.addAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) AddAnnotationsReturns(result1 error) {
+	fake.addAnnotationsMutex.Lock()
+	defer fake.addAnnotationsMutex.Unlock()
+	fake.AddAnnotationsStub = nil
+	fake.addAnnotationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) AddAnnotationsReturnsOnCall(i int, result1 error) {
+	fake.addAnnotationsMutex.Lock()
+	defer fake.addAnnotationsMutex.Unlock()
+	fake.AddAnnotationsStub = nil
+	if fake.addAnnotationsReturnsOnCall == nil {
+		fake.addAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addAnnotationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) AdoptInputsAndPipes() ([]db.BuildInput, bool, error) {
 	fake.adoptInputsAndPipesMutex.Lock()
 	ret, specificReturn := fake.adoptInputsAndPipesReturnsOnCall[len(fake.adoptInputsAndPipesArgsForCall)]
@@ -1087,6 +1275,59 @@ func (fake *FakeBuild) AllAssociatedTeamNamesReturnsOnCall(i int, result1 []stri
 	}{result1}
 }
 
+func (fake *FakeBuild) Annotations() []atc.BuildAnnotations {
+	fake.annotationsMutex.Lock()
+	ret, specificReturn := fake.annotationsReturnsOnCall[len(fake.annotationsArgsForCall)]
+	fake.annotationsArgsForCall = append(fake.annotationsArgsForCall, struct {
+	}{})
+	stub := fake.AnnotationsStub
+	fakeReturns := fake.annotationsReturns
+	fake.recordInvocation("Annotations", []interface{}{})
+	fake.annotationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AnnotationsCallCount() int {
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	return len(fake.annotationsArgsForCall)
+}
+
+func (fake *FakeBuild) AnnotationsCalls(stub func() []atc.BuildAnnotations) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = stub
+}
+
+func (fake *FakeBuild) AnnotationsReturns(result1 []atc.BuildAnnotations) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = nil
+	fake.annotationsReturns = struct {
+		result1 []atc.BuildAnnotations
+	}{result1}
+}
+
+func (fake *FakeBuild) AnnotationsReturnsOnCall(i int, result1 []atc.BuildAnnotations) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = nil
+	if fake.annotationsReturnsOnCall == nil {
+		fake.annotationsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildAnnotations
+		})
+	}
+	fake.annotationsReturnsOnCall[i] = struct {
+		result1 []atc.BuildAnnotations
+	}{result1}
+}
+
 func (fake *FakeBuild) Artifact(arg1 int) (db.WorkerArtifact, error) {
 	fake.artifactMutex.Lock()
 	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
@@ -1600,6 +1841,59 @@ func (fake *FakeBuild) EventsReturnsOnCall(i int, result1 db.EventSource, result
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) EventsArchive() string {
+	fake.eventsArchiveMutex.Lock()
+	ret, specificReturn := fake.eventsArchiveReturnsOnCall[len(fake.eventsArchiveArgsForCall)]
+	fake.eventsArchiveArgsForCall = append(fake.eventsArchiveArgsForCall, struct {
+	}{})
+	stub := fake.EventsArchiveStub
+	fakeReturns := fake.eventsArchiveReturns
+	fake.recordInvocation("EventsArchive", []interface{}{})
+	fake.eventsArchiveMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) EventsArchiveCallCount() int {
+	fake.eventsArchiveMutex.RLock()
+	defer fake.eventsArchiveMutex.RUnlock()
+	return len(fake.eventsArchiveArgsForCall)
+}
+
+func (fake *FakeBuild) EventsArchiveCalls(stub func() string) {
+	fake.eventsArchiveMutex.Lock()
+	defer fake.eventsArchiveMutex.Unlock()
+	fake.EventsArchiveStub = stub
+}
+
+func (fake *FakeBuild) EventsArchiveReturns(result1 string) {
+	fake.eventsArchiveMutex.Lock()
+	defer fake.eventsArchiveMutex.Unlock()
+	fake.EventsArchiveStub = nil
+	fake.eventsArchiveReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) EventsArchiveReturnsOnCall(i int, result1 string) {
+	fake.eventsArchiveMutex.Lock()
+	defer fake.eventsArchiveMutex.Unlock()
+	fake.EventsArchiveStub = nil
+	if fake.eventsArchiveReturnsOnCall == nil {
+		fake.eventsArchiveReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.eventsArchiveReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) Finish(arg1 db.BuildStatus) error {
 	fake.finishMutex.Lock()
 	ret, specificReturn := fake.finishReturnsOnCall[len(fake.finishArgsForCall)]
@@ -1767,6 +2061,62 @@ func (fake *FakeBuild) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) ImageVersions() ([]db.BuildImageVersion, error) {
+	fake.imageVersionsMutex.Lock()
+	ret, specificReturn := fake.imageVersionsReturnsOnCall[len(fake.imageVersionsArgsForCall)]
+	fake.imageVersionsArgsForCall = append(fake.imageVersionsArgsForCall, struct {
+	}{})
+	stub := fake.ImageVersionsStub
+	fakeReturns := fake.imageVersionsReturns
+	fake.recordInvocation("ImageVersions", []interface{}{})
+	fake.imageVersionsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ImageVersionsCallCount() int {
+	fake.imageVersionsMutex.RLock()
+	defer fake.imageVersionsMutex.RUnlock()
+	return len(fake.imageVersionsArgsForCall)
+}
+
+func (fake *FakeBuild) ImageVersionsCalls(stub func() ([]db.BuildImageVersion, error)) {
+	fake.imageVersionsMutex.Lock()
+	defer fake.imageVersionsMutex.Unlock()
+	fake.ImageVersionsStub = stub
+}
+
+func (fake *FakeBuild) ImageVersionsReturns(result1 []db.BuildImageVersion, result2 error) {
+	fake.imageVersionsMutex.Lock()
+	defer fake.imageVersionsMutex.Unlock()
+	fake.ImageVersionsStub = nil
+	fake.imageVersionsReturns = struct {
+		result1 []db.BuildImageVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ImageVersionsReturnsOnCall(i int, result1 []db.BuildImageVersion, result2 error) {
+	fake.imageVersionsMutex.Lock()
+	defer fake.imageVersionsMutex.Unlock()
+	fake.ImageVersionsStub = nil
+	if fake.imageVersionsReturnsOnCall == nil {
+		fake.imageVersionsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildImageVersion
+			result2 error
+		})
+	}
+	fake.imageVersionsReturnsOnCall[i] = struct {
+		result1 []db.BuildImageVersion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) InputsReady() bool {
 	fake.inputsReadyMutex.Lock()
 	ret, specificReturn := fake.inputsReadyReturnsOnCall[len(fake.inputsReadyArgsForCall)]
@@ -2954,6 +3304,65 @@ func (fake *FakeBuild) PrivatePlanReturnsOnCall(i int, result1 atc.Plan) {
 	}{result1}
 }
 
+func (fake *FakeBuild) Provenance() (atc.ProvenanceEnvelope, bool, error) {
+	fake.provenanceMutex.Lock()
+	ret, specificReturn := fake.provenanceReturnsOnCall[len(fake.provenanceArgsForCall)]
+	fake.provenanceArgsForCall = append(fake.provenanceArgsForCall, struct {
+	}{})
+	stub := fake.ProvenanceStub
+	fakeReturns := fake.provenanceReturns
+	fake.recordInvocation("Provenance", []interface{}{})
+	fake.provenanceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) ProvenanceCallCount() int {
+	fake.provenanceMutex.RLock()
+	defer fake.provenanceMutex.RUnlock()
+	return len(fake.provenanceArgsForCall)
+}
+
+func (fake *FakeBuild) ProvenanceCalls(stub func() (atc.ProvenanceEnvelope, bool, error)) {
+	fake.provenanceMutex.Lock()
+	defer fake.provenanceMutex.Unlock()
+	fake.ProvenanceStub = stub
+}
+
+func (fake *FakeBuild) ProvenanceReturns(result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.provenanceMutex.Lock()
+	defer fake.provenanceMutex.Unlock()
+	fake.ProvenanceStub = nil
+	fake.provenanceReturns = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ProvenanceReturnsOnCall(i int, result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.provenanceMutex.Lock()
+	defer fake.provenanceMutex.Unlock()
+	fake.ProvenanceStub = nil
+	if fake.provenanceReturnsOnCall == nil {
+		fake.provenanceReturnsOnCall = make(map[int]struct {
+			result1 atc.ProvenanceEnvelope
+			result2 bool
+			result3 error
+		})
+	}
+	fake.provenanceReturnsOnCall[i] = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) PublicPlan() *json.RawMessage {
 	fake.publicPlanMutex.Lock()
 	ret, specificReturn := fake.publicPlanReturnsOnCall[len(fake.publicPlanArgsForCall)]
@@ -3915,6 +4324,201 @@ func (fake *FakeBuild) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) SaveProvenance(arg1 atc.ProvenanceEnvelope) error {
+	fake.saveProvenanceMutex.Lock()
+	ret, specificReturn := fake.saveProvenanceReturnsOnCall[len(fake.saveProvenanceArgsForCall)]
+	fake.saveProvenanceArgsForCall = append(fake.saveProvenanceArgsForCall, struct {
+		arg1 atc.ProvenanceEnvelope
+	}{arg1})
+	stub := fake.SaveProvenanceStub
+	fakeReturns := fake.saveProvenanceReturns
+	fake.recordInvocation("SaveProvenance", []interface{}{arg1})
+	fake.saveProvenanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveProvenanceCallCount() int {
+	fake.saveProvenanceMutex.RLock()
+	defer fake.saveProvenanceMutex.RUnlock()
+	return len(fake.saveProvenanceArgsForCall)
+}
+
+func (fake *FakeBuild) SaveProvenanceCalls(stub func(atc.ProvenanceEnvelope) error) {
+	fake.saveProvenanceMutex.Lock()
+	defer fake.saveProvenanceMutex.Unlock()
+	fake.SaveProvenanceStub = stub
+}
+
+func (fake *FakeBuild) SaveProvenanceArgsForCall(i int) atc.ProvenanceEnvelope {
+	fake.saveProvenanceMutex.RLock()
+	defer fake.saveProvenanceMutex.RUnlock()
+	argsForCall := fake.saveProvenanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveProvenanceReturns(result1 error) {
+	fake.saveProvenanceMutex.Lock()
+	defer fake.saveProvenanceMutex.Unlock()
+	fake.SaveProvenanceStub = nil
+	fake.saveProvenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveProvenanceReturnsOnCall(i int, result1 error) {
+	fake.saveProvenanceMutex.Lock()
+	defer fake.saveProvenanceMutex.Unlock()
+	fake.SaveProvenanceStub = nil
+	if fake.saveProvenanceReturnsOnCall == nil {
+		fake.saveProvenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveProvenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveSLOBreach(arg1 atc.JobSLOStats, arg2 []atc.JobSLOViolation) error {
+	var arg2Copy []atc.JobSLOViolation
+	if arg2 != nil {
+		arg2Copy = make([]atc.JobSLOViolation, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.saveSLOBreachMutex.Lock()
+	ret, specificReturn := fake.saveSLOBreachReturnsOnCall[len(fake.saveSLOBreachArgsForCall)]
+	fake.saveSLOBreachArgsForCall = append(fake.saveSLOBreachArgsForCall, struct {
+		arg1 atc.JobSLOStats
+		arg2 []atc.JobSLOViolation
+	}{arg1, arg2Copy})
+	stub := fake.SaveSLOBreachStub
+	fakeReturns := fake.saveSLOBreachReturns
+	fake.recordInvocation("SaveSLOBreach", []interface{}{arg1, arg2Copy})
+	fake.saveSLOBreachMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveSLOBreachCallCount() int {
+	fake.saveSLOBreachMutex.RLock()
+	defer fake.saveSLOBreachMutex.RUnlock()
+	return len(fake.saveSLOBreachArgsForCall)
+}
+
+func (fake *FakeBuild) SaveSLOBreachCalls(stub func(atc.JobSLOStats, []atc.JobSLOViolation) error) {
+	fake.saveSLOBreachMutex.Lock()
+	defer fake.saveSLOBreachMutex.Unlock()
+	fake.SaveSLOBreachStub = stub
+}
+
+func (fake *FakeBuild) SaveSLOBreachArgsForCall(i int) (atc.JobSLOStats, []atc.JobSLOViolation) {
+	fake.saveSLOBreachMutex.RLock()
+	defer fake.saveSLOBreachMutex.RUnlock()
+	argsForCall := fake.saveSLOBreachArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SaveSLOBreachReturns(result1 error) {
+	fake.saveSLOBreachMutex.Lock()
+	defer fake.saveSLOBreachMutex.Unlock()
+	fake.SaveSLOBreachStub = nil
+	fake.saveSLOBreachReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveSLOBreachReturnsOnCall(i int, result1 error) {
+	fake.saveSLOBreachMutex.Lock()
+	defer fake.saveSLOBreachMutex.Unlock()
+	fake.SaveSLOBreachStub = nil
+	if fake.saveSLOBreachReturnsOnCall == nil {
+		fake.saveSLOBreachReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveSLOBreachReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTestResults(arg1 string, arg2 []atc.TestResult) error {
+	var arg2Copy []atc.TestResult
+	if arg2 != nil {
+		arg2Copy = make([]atc.TestResult, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.saveTestResultsMutex.Lock()
+	ret, specificReturn := fake.saveTestResultsReturnsOnCall[len(fake.saveTestResultsArgsForCall)]
+	fake.saveTestResultsArgsForCall = append(fake.saveTestResultsArgsForCall, struct {
+		arg1 string
+		arg2 []atc.TestResult
+	}{arg1, arg2Copy})
+	stub := fake.SaveTestResultsStub
+	fakeReturns := fake.saveTestResultsReturns
+	fake.recordInvocation("SaveTestResults", []interface{}{arg1, arg2Copy})
+	fake.saveTestResultsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveTestResultsCallCount() int {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	return len(fake.saveTestResultsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveTestResultsCalls(stub func(string, []atc.TestResult) error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = stub
+}
+
+func (fake *FakeBuild) SaveTestResultsArgsForCall(i int) (string, []atc.TestResult) {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	argsForCall := fake.saveTestResultsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SaveTestResultsReturns(result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	fake.saveTestResultsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTestResultsReturnsOnCall(i int, result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	if fake.saveTestResultsReturnsOnCall == nil {
+		fake.saveTestResultsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTestResultsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schema() string {
 	fake.schemaMutex.Lock()
 	ret, specificReturn := fake.schemaReturnsOnCall[len(fake.schemaArgsForCall)]
@@ -4090,6 +4694,67 @@ func (fake *FakeBuild) SetDrainedReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetEventsArchive(arg1 string) error {
+	fake.setEventsArchiveMutex.Lock()
+	ret, specificReturn := fake.setEventsArchiveReturnsOnCall[len(fake.setEventsArchiveArgsForCall)]
+	fake.setEventsArchiveArgsForCall = append(fake.setEventsArchiveArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetEventsArchiveStub
+	fakeReturns := fake.setEventsArchiveReturns
+	fake.recordInvocation("SetEventsArchive", []interface{}{arg1})
+	fake.setEventsArchiveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetEventsArchiveCallCount() int {
+	fake.setEventsArchiveMutex.RLock()
+	defer fake.setEventsArchiveMutex.RUnlock()
+	return len(fake.setEventsArchiveArgsForCall)
+}
+
+func (fake *FakeBuild) SetEventsArchiveCalls(stub func(string) error) {
+	fake.setEventsArchiveMutex.Lock()
+	defer fake.setEventsArchiveMutex.Unlock()
+	fake.SetEventsArchiveStub = stub
+}
+
+func (fake *FakeBuild) SetEventsArchiveArgsForCall(i int) string {
+	fake.setEventsArchiveMutex.RLock()
+	defer fake.setEventsArchiveMutex.RUnlock()
+	argsForCall := fake.setEventsArchiveArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetEventsArchiveReturns(result1 error) {
+	fake.setEventsArchiveMutex.Lock()
+	defer fake.setEventsArchiveMutex.Unlock()
+	fake.SetEventsArchiveStub = nil
+	fake.setEventsArchiveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetEventsArchiveReturnsOnCall(i int, result1 error) {
+	fake.setEventsArchiveMutex.Lock()
+	defer fake.setEventsArchiveMutex.Unlock()
+	fake.SetEventsArchiveStub = nil
+	if fake.setEventsArchiveReturnsOnCall == nil {
+		fake.setEventsArchiveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setEventsArchiveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SetInterceptible(arg1 bool) error {
 	fake.setInterceptibleMutex.Lock()
 	ret, specificReturn := fake.setInterceptibleReturnsOnCall[len(fake.setInterceptibleArgsForCall)]
@@ -4541,6 +5206,62 @@ func (fake *FakeBuild) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) TestResults() ([]atc.TestResult, error) {
+	fake.testResultsMutex.Lock()
+	ret, specificReturn := fake.testResultsReturnsOnCall[len(fake.testResultsArgsForCall)]
+	fake.testResultsArgsForCall = append(fake.testResultsArgsForCall, struct {
+	}{})
+	stub := fake.TestResultsStub
+	fakeReturns := fake.testResultsReturns
+	fake.recordInvocation("TestResults", []interface{}{})
+	fake.testResultsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) TestResultsCallCount() int {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	return len(fake.testResultsArgsForCall)
+}
+
+func (fake *FakeBuild) TestResultsCalls(stub func() ([]atc.TestResult, error)) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = stub
+}
+
+func (fake *FakeBuild) TestResultsReturns(result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	fake.testResultsReturns = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) TestResultsReturnsOnCall(i int, result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	if fake.testResultsReturnsOnCall == nil {
+		fake.testResultsReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestResult
+			result2 error
+		})
+	}
+	fake.testResultsReturnsOnCall[i] = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) TracingAttrs() tracing.Attrs {
 	fake.tracingAttrsMutex.Lock()
 	ret, specificReturn := fake.tracingAttrsReturnsOnCall[len(fake.tracingAttrsArgsForCall)]
@@ -4660,6 +5381,62 @@ func (fake *FakeBuild) VariablesReturnsOnCall(i int, result1 vars.Variables, res
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) WorkerNames() ([]string, error) {
+	fake.workerNamesMutex.Lock()
+	ret, specificReturn := fake.workerNamesReturnsOnCall[len(fake.workerNamesArgsForCall)]
+	fake.workerNamesArgsForCall = append(fake.workerNamesArgsForCall, struct {
+	}{})
+	stub := fake.WorkerNamesStub
+	fakeReturns := fake.workerNamesReturns
+	fake.recordInvocation("WorkerNames", []interface{}{})
+	fake.workerNamesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) WorkerNamesCallCount() int {
+	fake.workerNamesMutex.RLock()
+	defer fake.workerNamesMutex.RUnlock()
+	return len(fake.workerNamesArgsForCall)
+}
+
+func (fake *FakeBuild) WorkerNamesCalls(stub func() ([]string, error)) {
+	fake.workerNamesMutex.Lock()
+	defer fake.workerNamesMutex.Unlock()
+	fake.WorkerNamesStub = stub
+}
+
+func (fake *FakeBuild) WorkerNamesReturns(result1 []string, result2 error) {
+	fake.workerNamesMutex.Lock()
+	defer fake.workerNamesMutex.Unlock()
+	fake.WorkerNamesStub = nil
+	fake.workerNamesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) WorkerNamesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.workerNamesMutex.Lock()
+	defer fake.workerNamesMutex.Unlock()
+	fake.WorkerNamesStub = nil
+	if fake.workerNamesReturnsOnCall == nil {
+		fake.workerNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.workerNamesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -4667,12 +5444,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.abortNotifierMutex.RUnlock()
 	fake.acquireTrackingLockMutex.RLock()
 	defer fake.acquireTrackingLockMutex.RUnlock()
+	fake.addAnnotationsMutex.RLock()
+	defer fake.addAnnotationsMutex.RUnlock()
 	fake.adoptInputsAndPipesMutex.RLock()
 	defer fake.adoptInputsAndPipesMutex.RUnlock()
 	fake.adoptRerunInputsAndPipesMutex.RLock()
 	defer fake.adoptRerunInputsAndPipesMutex.RUnlock()
 	fake.allAssociatedTeamNamesMutex.RLock()
 	defer fake.allAssociatedTeamNamesMutex.RUnlock()
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
 	fake.artifactMutex.RLock()
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
@@ -4691,12 +5472,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.endTimeMutex.RUnlock()
 	fake.eventsMutex.RLock()
 	defer fake.eventsMutex.RUnlock()
+	fake.eventsArchiveMutex.RLock()
+	defer fake.eventsArchiveMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
 	fake.hasPlanMutex.RLock()
 	defer fake.hasPlanMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.imageVersionsMutex.RLock()
+	defer fake.imageVersionsMutex.RUnlock()
 	fake.inputsReadyMutex.RLock()
 	defer fake.inputsReadyMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
@@ -4741,6 +5526,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.preparationMutex.RUnlock()
 	fake.privatePlanMutex.RLock()
 	defer fake.privatePlanMutex.RUnlock()
+	fake.provenanceMutex.RLock()
+	defer fake.provenanceMutex.RUnlock()
 	fake.publicPlanMutex.RLock()
 	defer fake.publicPlanMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
@@ -4775,12 +5562,20 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveOutputMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
+	fake.saveProvenanceMutex.RLock()
+	defer fake.saveProvenanceMutex.RUnlock()
+	fake.saveSLOBreachMutex.RLock()
+	defer fake.saveSLOBreachMutex.RUnlock()
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
 	fake.setCommentMutex.RLock()
 	defer fake.setCommentMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
 	defer fake.setDrainedMutex.RUnlock()
+	fake.setEventsArchiveMutex.RLock()
+	defer fake.setEventsArchiveMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
 	defer fake.setInterceptibleMutex.RUnlock()
 	fake.spanContextMutex.RLock()
@@ -4797,10 +5592,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
 	fake.tracingAttrsMutex.RLock()
 	defer fake.tracingAttrsMutex.RUnlock()
 	fake.variablesMutex.RLock()
 	defer fake.variablesMutex.RUnlock()
+	fake.workerNamesMutex.RLock()
+	defer fake.workerNamesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -53,6 +53,21 @@ type FakeBuildFactory struct {
 		result2 bool
 		result3 error
 	}
+	BuildsResourcesStub        func([]int) (map[int][]db.BuildInput, map[int][]db.BuildOutput, error)
+	buildsResourcesMutex       sync.RWMutex
+	buildsResourcesArgsForCall []struct {
+		arg1 []int
+	}
+	buildsResourcesReturns struct {
+		result1 map[int][]db.BuildInput
+		result2 map[int][]db.BuildOutput
+		result3 error
+	}
+	buildsResourcesReturnsOnCall map[int]struct {
+		result1 map[int][]db.BuildInput
+		result2 map[int][]db.BuildOutput
+		result3 error
+	}
 	GetAllStartedBuildsStub        func() ([]db.Build, error)
 	getAllStartedBuildsMutex       sync.RWMutex
 	getAllStartedBuildsArgsForCall []struct {
@@ -77,6 +92,20 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	JobsBuildsStub        func([]int, db.Page) (map[int][]db.BuildForAPI, error)
+	jobsBuildsMutex       sync.RWMutex
+	jobsBuildsArgsForCall []struct {
+		arg1 []int
+		arg2 db.Page
+	}
+	jobsBuildsReturns struct {
+		result1 map[int][]db.BuildForAPI
+		result2 error
+	}
+	jobsBuildsReturnsOnCall map[int]struct {
+		result1 map[int][]db.BuildForAPI
+		result2 error
+	}
 	MarkNonInterceptibleBuildsStub        func() error
 	markNonInterceptibleBuildsMutex       sync.RWMutex
 	markNonInterceptibleBuildsArgsForCall []struct {
@@ -323,6 +352,78 @@ func (fake *FakeBuildFactory) BuildForAPIReturnsOnCall(i int, result1 db.BuildFo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildFactory) BuildsResources(arg1 []int) (map[int][]db.BuildInput, map[int][]db.BuildOutput, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.buildsResourcesMutex.Lock()
+	ret, specificReturn := fake.buildsResourcesReturnsOnCall[len(fake.buildsResourcesArgsForCall)]
+	fake.buildsResourcesArgsForCall = append(fake.buildsResourcesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	stub := fake.BuildsResourcesStub
+	fakeReturns := fake.buildsResourcesReturns
+	fake.recordInvocation("BuildsResources", []interface{}{arg1Copy})
+	fake.buildsResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuildFactory) BuildsResourcesCallCount() int {
+	fake.buildsResourcesMutex.RLock()
+	defer fake.buildsResourcesMutex.RUnlock()
+	return len(fake.buildsResourcesArgsForCall)
+}
+
+func (fake *FakeBuildFactory) BuildsResourcesCalls(stub func([]int) (map[int][]db.BuildInput, map[int][]db.BuildOutput, error)) {
+	fake.buildsResourcesMutex.Lock()
+	defer fake.buildsResourcesMutex.Unlock()
+	fake.BuildsResourcesStub = stub
+}
+
+func (fake *FakeBuildFactory) BuildsResourcesArgsForCall(i int) []int {
+	fake.buildsResourcesMutex.RLock()
+	defer fake.buildsResourcesMutex.RUnlock()
+	argsForCall := fake.buildsResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) BuildsResourcesReturns(result1 map[int][]db.BuildInput, result2 map[int][]db.BuildOutput, result3 error) {
+	fake.buildsResourcesMutex.Lock()
+	defer fake.buildsResourcesMutex.Unlock()
+	fake.BuildsResourcesStub = nil
+	fake.buildsResourcesReturns = struct {
+		result1 map[int][]db.BuildInput
+		result2 map[int][]db.BuildOutput
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuildFactory) BuildsResourcesReturnsOnCall(i int, result1 map[int][]db.BuildInput, result2 map[int][]db.BuildOutput, result3 error) {
+	fake.buildsResourcesMutex.Lock()
+	defer fake.buildsResourcesMutex.Unlock()
+	fake.BuildsResourcesStub = nil
+	if fake.buildsResourcesReturnsOnCall == nil {
+		fake.buildsResourcesReturnsOnCall = make(map[int]struct {
+			result1 map[int][]db.BuildInput
+			result2 map[int][]db.BuildOutput
+			result3 error
+		})
+	}
+	fake.buildsResourcesReturnsOnCall[i] = struct {
+		result1 map[int][]db.BuildInput
+		result2 map[int][]db.BuildOutput
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuildFactory) GetAllStartedBuilds() ([]db.Build, error) {
 	fake.getAllStartedBuildsMutex.Lock()
 	ret, specificReturn := fake.getAllStartedBuildsReturnsOnCall[len(fake.getAllStartedBuildsArgsForCall)]
@@ -435,6 +536,76 @@ func (fake *FakeBuildFactory) GetDrainableBuildsReturnsOnCall(i int, result1 []d
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) JobsBuilds(arg1 []int, arg2 db.Page) (map[int][]db.BuildForAPI, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.jobsBuildsMutex.Lock()
+	ret, specificReturn := fake.jobsBuildsReturnsOnCall[len(fake.jobsBuildsArgsForCall)]
+	fake.jobsBuildsArgsForCall = append(fake.jobsBuildsArgsForCall, struct {
+		arg1 []int
+		arg2 db.Page
+	}{arg1Copy, arg2})
+	stub := fake.JobsBuildsStub
+	fakeReturns := fake.jobsBuildsReturns
+	fake.recordInvocation("JobsBuilds", []interface{}{arg1Copy, arg2})
+	fake.jobsBuildsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) JobsBuildsCallCount() int {
+	fake.jobsBuildsMutex.RLock()
+	defer fake.jobsBuildsMutex.RUnlock()
+	return len(fake.jobsBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) JobsBuildsCalls(stub func([]int, db.Page) (map[int][]db.BuildForAPI, error)) {
+	fake.jobsBuildsMutex.Lock()
+	defer fake.jobsBuildsMutex.Unlock()
+	fake.JobsBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) JobsBuildsArgsForCall(i int) ([]int, db.Page) {
+	fake.jobsBuildsMutex.RLock()
+	defer fake.jobsBuildsMutex.RUnlock()
+	argsForCall := fake.jobsBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuildFactory) JobsBuildsReturns(result1 map[int][]db.BuildForAPI, result2 error) {
+	fake.jobsBuildsMutex.Lock()
+	defer fake.jobsBuildsMutex.Unlock()
+	fake.JobsBuildsStub = nil
+	fake.jobsBuildsReturns = struct {
+		result1 map[int][]db.BuildForAPI
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) JobsBuildsReturnsOnCall(i int, result1 map[int][]db.BuildForAPI, result2 error) {
+	fake.jobsBuildsMutex.Lock()
+	defer fake.jobsBuildsMutex.Unlock()
+	fake.JobsBuildsStub = nil
+	if fake.jobsBuildsReturnsOnCall == nil {
+		fake.jobsBuildsReturnsOnCall = make(map[int]struct {
+			result1 map[int][]db.BuildForAPI
+			result2 error
+		})
+	}
+	fake.jobsBuildsReturnsOnCall[i] = struct {
+		result1 map[int][]db.BuildForAPI
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) MarkNonInterceptibleBuilds() error {
 	fake.markNonInterceptibleBuildsMutex.Lock()
 	ret, specificReturn := fake.markNonInterceptibleBuildsReturnsOnCall[len(fake.markNonInterceptibleBuildsArgsForCall)]
@@ -637,10 +808,14 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.buildMutex.RUnlock()
 	fake.buildForAPIMutex.RLock()
 	defer fake.buildForAPIMutex.RUnlock()
+	fake.buildsResourcesMutex.RLock()
+	defer fake.buildsResourcesMutex.RUnlock()
 	fake.getAllStartedBuildsMutex.RLock()
 	defer fake.getAllStartedBuildsMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
 	defer fake.getDrainableBuildsMutex.RUnlock()
+	fake.jobsBuildsMutex.RLock()
+	defer fake.jobsBuildsMutex.RUnlock()
 	fake.markNonInterceptibleBuildsMutex.RLock()
 	defer fake.markNonInterceptibleBuildsMutex.RUnlock()
 	fake.publicBuildsMutex.RLock()
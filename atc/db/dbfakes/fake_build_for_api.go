@@ -22,6 +22,16 @@ type FakeBuildForAPI struct {
 	allAssociatedTeamNamesReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	AnnotationsStub        func() []atc.BuildAnnotations
+	annotationsMutex       sync.RWMutex
+	annotationsArgsForCall []struct {
+	}
+	annotationsReturns struct {
+		result1 []atc.BuildAnnotations
+	}
+	annotationsReturnsOnCall map[int]struct {
+		result1 []atc.BuildAnnotations
+	}
 	ArtifactsStub        func() ([]db.WorkerArtifact, error)
 	artifactsMutex       sync.RWMutex
 	artifactsArgsForCall []struct {
@@ -77,6 +87,16 @@ type FakeBuildForAPI struct {
 		result1 db.EventSource
 		result2 error
 	}
+	EventsArchiveStub        func() string
+	eventsArchiveMutex       sync.RWMutex
+	eventsArchiveArgsForCall []struct {
+	}
+	eventsArchiveReturns struct {
+		result1 string
+	}
+	eventsArchiveReturnsOnCall map[int]struct {
+		result1 string
+	}
 	HasPlanStub        func() bool
 	hasPlanMutex       sync.RWMutex
 	hasPlanArgsForCall []struct {
@@ -249,6 +269,20 @@ type FakeBuildForAPI struct {
 		result2 bool
 		result3 error
 	}
+	ProvenanceStub        func() (atc.ProvenanceEnvelope, bool, error)
+	provenanceMutex       sync.RWMutex
+	provenanceArgsForCall []struct {
+	}
+	provenanceReturns struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
+	provenanceReturnsOnCall map[int]struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
 	PublicPlanStub        func() *json.RawMessage
 	publicPlanMutex       sync.RWMutex
 	publicPlanArgsForCall []struct {
@@ -354,6 +388,17 @@ type FakeBuildForAPI struct {
 	setCommentReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetEventsArchiveStub        func(string) error
+	setEventsArchiveMutex       sync.RWMutex
+	setEventsArchiveArgsForCall []struct {
+		arg1 string
+	}
+	setEventsArchiveReturns struct {
+		result1 error
+	}
+	setEventsArchiveReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartTimeStub        func() time.Time
 	startTimeMutex       sync.RWMutex
 	startTimeArgsForCall []struct {
@@ -394,6 +439,18 @@ type FakeBuildForAPI struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestResultsStub        func() ([]atc.TestResult, error)
+	testResultsMutex       sync.RWMutex
+	testResultsArgsForCall []struct {
+	}
+	testResultsReturns struct {
+		result1 []atc.TestResult
+		result2 error
+	}
+	testResultsReturnsOnCall map[int]struct {
+		result1 []atc.TestResult
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -451,6 +508,59 @@ func (fake *FakeBuildForAPI) AllAssociatedTeamNamesReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeBuildForAPI) Annotations() []atc.BuildAnnotations {
+	fake.annotationsMutex.Lock()
+	ret, specificReturn := fake.annotationsReturnsOnCall[len(fake.annotationsArgsForCall)]
+	fake.annotationsArgsForCall = append(fake.annotationsArgsForCall, struct {
+	}{})
+	stub := fake.AnnotationsStub
+	fakeReturns := fake.annotationsReturns
+	fake.recordInvocation("Annotations", []interface{}{})
+	fake.annotationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildForAPI) AnnotationsCallCount() int {
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	return len(fake.annotationsArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) AnnotationsCalls(stub func() []atc.BuildAnnotations) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = stub
+}
+
+func (fake *FakeBuildForAPI) AnnotationsReturns(result1 []atc.BuildAnnotations) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = nil
+	fake.annotationsReturns = struct {
+		result1 []atc.BuildAnnotations
+	}{result1}
+}
+
+func (fake *FakeBuildForAPI) AnnotationsReturnsOnCall(i int, result1 []atc.BuildAnnotations) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = nil
+	if fake.annotationsReturnsOnCall == nil {
+		fake.annotationsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildAnnotations
+		})
+	}
+	fake.annotationsReturnsOnCall[i] = struct {
+		result1 []atc.BuildAnnotations
+	}{result1}
+}
+
 func (fake *FakeBuildForAPI) Artifacts() ([]db.WorkerArtifact, error) {
 	fake.artifactsMutex.Lock()
 	ret, specificReturn := fake.artifactsReturnsOnCall[len(fake.artifactsArgsForCall)]
@@ -730,6 +840,59 @@ func (fake *FakeBuildForAPI) EventsReturnsOnCall(i int, result1 db.EventSource,
 	}{result1, result2}
 }
 
+func (fake *FakeBuildForAPI) EventsArchive() string {
+	fake.eventsArchiveMutex.Lock()
+	ret, specificReturn := fake.eventsArchiveReturnsOnCall[len(fake.eventsArchiveArgsForCall)]
+	fake.eventsArchiveArgsForCall = append(fake.eventsArchiveArgsForCall, struct {
+	}{})
+	stub := fake.EventsArchiveStub
+	fakeReturns := fake.eventsArchiveReturns
+	fake.recordInvocation("EventsArchive", []interface{}{})
+	fake.eventsArchiveMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildForAPI) EventsArchiveCallCount() int {
+	fake.eventsArchiveMutex.RLock()
+	defer fake.eventsArchiveMutex.RUnlock()
+	return len(fake.eventsArchiveArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) EventsArchiveCalls(stub func() string) {
+	fake.eventsArchiveMutex.Lock()
+	defer fake.eventsArchiveMutex.Unlock()
+	fake.EventsArchiveStub = stub
+}
+
+func (fake *FakeBuildForAPI) EventsArchiveReturns(result1 string) {
+	fake.eventsArchiveMutex.Lock()
+	defer fake.eventsArchiveMutex.Unlock()
+	fake.EventsArchiveStub = nil
+	fake.eventsArchiveReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuildForAPI) EventsArchiveReturnsOnCall(i int, result1 string) {
+	fake.eventsArchiveMutex.Lock()
+	defer fake.eventsArchiveMutex.Unlock()
+	fake.EventsArchiveStub = nil
+	if fake.eventsArchiveReturnsOnCall == nil {
+		fake.eventsArchiveReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.eventsArchiveReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuildForAPI) HasPlan() bool {
 	fake.hasPlanMutex.Lock()
 	ret, specificReturn := fake.hasPlanReturnsOnCall[len(fake.hasPlanArgsForCall)]
@@ -1596,6 +1759,65 @@ func (fake *FakeBuildForAPI) PreparationReturnsOnCall(i int, result1 db.BuildPre
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildForAPI) Provenance() (atc.ProvenanceEnvelope, bool, error) {
+	fake.provenanceMutex.Lock()
+	ret, specificReturn := fake.provenanceReturnsOnCall[len(fake.provenanceArgsForCall)]
+	fake.provenanceArgsForCall = append(fake.provenanceArgsForCall, struct {
+	}{})
+	stub := fake.ProvenanceStub
+	fakeReturns := fake.provenanceReturns
+	fake.recordInvocation("Provenance", []interface{}{})
+	fake.provenanceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuildForAPI) ProvenanceCallCount() int {
+	fake.provenanceMutex.RLock()
+	defer fake.provenanceMutex.RUnlock()
+	return len(fake.provenanceArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) ProvenanceCalls(stub func() (atc.ProvenanceEnvelope, bool, error)) {
+	fake.provenanceMutex.Lock()
+	defer fake.provenanceMutex.Unlock()
+	fake.ProvenanceStub = stub
+}
+
+func (fake *FakeBuildForAPI) ProvenanceReturns(result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.provenanceMutex.Lock()
+	defer fake.provenanceMutex.Unlock()
+	fake.ProvenanceStub = nil
+	fake.provenanceReturns = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuildForAPI) ProvenanceReturnsOnCall(i int, result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.provenanceMutex.Lock()
+	defer fake.provenanceMutex.Unlock()
+	fake.ProvenanceStub = nil
+	if fake.provenanceReturnsOnCall == nil {
+		fake.provenanceReturnsOnCall = make(map[int]struct {
+			result1 atc.ProvenanceEnvelope
+			result2 bool
+			result3 error
+		})
+	}
+	fake.provenanceReturnsOnCall[i] = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuildForAPI) PublicPlan() *json.RawMessage {
 	fake.publicPlanMutex.Lock()
 	ret, specificReturn := fake.publicPlanReturnsOnCall[len(fake.publicPlanArgsForCall)]
@@ -2140,6 +2362,67 @@ func (fake *FakeBuildForAPI) SetCommentReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuildForAPI) SetEventsArchive(arg1 string) error {
+	fake.setEventsArchiveMutex.Lock()
+	ret, specificReturn := fake.setEventsArchiveReturnsOnCall[len(fake.setEventsArchiveArgsForCall)]
+	fake.setEventsArchiveArgsForCall = append(fake.setEventsArchiveArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetEventsArchiveStub
+	fakeReturns := fake.setEventsArchiveReturns
+	fake.recordInvocation("SetEventsArchive", []interface{}{arg1})
+	fake.setEventsArchiveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildForAPI) SetEventsArchiveCallCount() int {
+	fake.setEventsArchiveMutex.RLock()
+	defer fake.setEventsArchiveMutex.RUnlock()
+	return len(fake.setEventsArchiveArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) SetEventsArchiveCalls(stub func(string) error) {
+	fake.setEventsArchiveMutex.Lock()
+	defer fake.setEventsArchiveMutex.Unlock()
+	fake.SetEventsArchiveStub = stub
+}
+
+func (fake *FakeBuildForAPI) SetEventsArchiveArgsForCall(i int) string {
+	fake.setEventsArchiveMutex.RLock()
+	defer fake.setEventsArchiveMutex.RUnlock()
+	argsForCall := fake.setEventsArchiveArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildForAPI) SetEventsArchiveReturns(result1 error) {
+	fake.setEventsArchiveMutex.Lock()
+	defer fake.setEventsArchiveMutex.Unlock()
+	fake.SetEventsArchiveStub = nil
+	fake.setEventsArchiveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildForAPI) SetEventsArchiveReturnsOnCall(i int, result1 error) {
+	fake.setEventsArchiveMutex.Lock()
+	defer fake.setEventsArchiveMutex.Unlock()
+	fake.SetEventsArchiveStub = nil
+	if fake.setEventsArchiveReturnsOnCall == nil {
+		fake.setEventsArchiveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setEventsArchiveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuildForAPI) StartTime() time.Time {
 	fake.startTimeMutex.Lock()
 	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
@@ -2352,11 +2635,69 @@ func (fake *FakeBuildForAPI) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuildForAPI) TestResults() ([]atc.TestResult, error) {
+	fake.testResultsMutex.Lock()
+	ret, specificReturn := fake.testResultsReturnsOnCall[len(fake.testResultsArgsForCall)]
+	fake.testResultsArgsForCall = append(fake.testResultsArgsForCall, struct {
+	}{})
+	stub := fake.TestResultsStub
+	fakeReturns := fake.testResultsReturns
+	fake.recordInvocation("TestResults", []interface{}{})
+	fake.testResultsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildForAPI) TestResultsCallCount() int {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	return len(fake.testResultsArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) TestResultsCalls(stub func() ([]atc.TestResult, error)) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = stub
+}
+
+func (fake *FakeBuildForAPI) TestResultsReturns(result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	fake.testResultsReturns = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildForAPI) TestResultsReturnsOnCall(i int, result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	if fake.testResultsReturnsOnCall == nil {
+		fake.testResultsReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestResult
+			result2 error
+		})
+	}
+	fake.testResultsReturnsOnCall[i] = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.allAssociatedTeamNamesMutex.RLock()
 	defer fake.allAssociatedTeamNamesMutex.RUnlock()
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
 	fake.commentMutex.RLock()
@@ -2367,6 +2708,8 @@ func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	defer fake.endTimeMutex.RUnlock()
 	fake.eventsMutex.RLock()
 	defer fake.eventsMutex.RUnlock()
+	fake.eventsArchiveMutex.RLock()
+	defer fake.eventsArchiveMutex.RUnlock()
 	fake.hasPlanMutex.RLock()
 	defer fake.hasPlanMutex.RUnlock()
 	fake.iDMutex.RLock()
@@ -2399,6 +2742,8 @@ func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	defer fake.pipelineRefMutex.RUnlock()
 	fake.preparationMutex.RLock()
 	defer fake.preparationMutex.RUnlock()
+	fake.provenanceMutex.RLock()
+	defer fake.provenanceMutex.RUnlock()
 	fake.publicPlanMutex.RLock()
 	defer fake.publicPlanMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
@@ -2419,6 +2764,8 @@ func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	defer fake.schemaMutex.RUnlock()
 	fake.setCommentMutex.RLock()
 	defer fake.setCommentMutex.RUnlock()
+	fake.setEventsArchiveMutex.RLock()
+	defer fake.setEventsArchiveMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.statusMutex.RLock()
@@ -2427,6 +2774,8 @@ func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -47,6 +47,19 @@ type FakeContainerRepository struct {
 		result1 int
 		result2 error
 	}
+	EvictIdleCheckPoolContainersStub        func(time.Duration) (int, error)
+	evictIdleCheckPoolContainersMutex       sync.RWMutex
+	evictIdleCheckPoolContainersArgsForCall []struct {
+		arg1 time.Duration
+	}
+	evictIdleCheckPoolContainersReturns struct {
+		result1 int
+		result2 error
+	}
+	evictIdleCheckPoolContainersReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	FindDestroyingContainersStub        func(string) ([]string, error)
 	findDestroyingContainersMutex       sync.RWMutex
 	findDestroyingContainersArgsForCall []struct {
@@ -301,6 +314,70 @@ func (fake *FakeContainerRepository) DestroyUnknownContainersReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeContainerRepository) EvictIdleCheckPoolContainers(arg1 time.Duration) (int, error) {
+	fake.evictIdleCheckPoolContainersMutex.Lock()
+	ret, specificReturn := fake.evictIdleCheckPoolContainersReturnsOnCall[len(fake.evictIdleCheckPoolContainersArgsForCall)]
+	fake.evictIdleCheckPoolContainersArgsForCall = append(fake.evictIdleCheckPoolContainersArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	stub := fake.EvictIdleCheckPoolContainersStub
+	fakeReturns := fake.evictIdleCheckPoolContainersReturns
+	fake.recordInvocation("EvictIdleCheckPoolContainers", []interface{}{arg1})
+	fake.evictIdleCheckPoolContainersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerRepository) EvictIdleCheckPoolContainersCallCount() int {
+	fake.evictIdleCheckPoolContainersMutex.RLock()
+	defer fake.evictIdleCheckPoolContainersMutex.RUnlock()
+	return len(fake.evictIdleCheckPoolContainersArgsForCall)
+}
+
+func (fake *FakeContainerRepository) EvictIdleCheckPoolContainersCalls(stub func(time.Duration) (int, error)) {
+	fake.evictIdleCheckPoolContainersMutex.Lock()
+	defer fake.evictIdleCheckPoolContainersMutex.Unlock()
+	fake.EvictIdleCheckPoolContainersStub = stub
+}
+
+func (fake *FakeContainerRepository) EvictIdleCheckPoolContainersArgsForCall(i int) time.Duration {
+	fake.evictIdleCheckPoolContainersMutex.RLock()
+	defer fake.evictIdleCheckPoolContainersMutex.RUnlock()
+	argsForCall := fake.evictIdleCheckPoolContainersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeContainerRepository) EvictIdleCheckPoolContainersReturns(result1 int, result2 error) {
+	fake.evictIdleCheckPoolContainersMutex.Lock()
+	defer fake.evictIdleCheckPoolContainersMutex.Unlock()
+	fake.EvictIdleCheckPoolContainersStub = nil
+	fake.evictIdleCheckPoolContainersReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerRepository) EvictIdleCheckPoolContainersReturnsOnCall(i int, result1 int, result2 error) {
+	fake.evictIdleCheckPoolContainersMutex.Lock()
+	defer fake.evictIdleCheckPoolContainersMutex.Unlock()
+	fake.EvictIdleCheckPoolContainersStub = nil
+	if fake.evictIdleCheckPoolContainersReturnsOnCall == nil {
+		fake.evictIdleCheckPoolContainersReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.evictIdleCheckPoolContainersReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeContainerRepository) FindDestroyingContainers(arg1 string) ([]string, error) {
 	fake.findDestroyingContainersMutex.Lock()
 	ret, specificReturn := fake.findDestroyingContainersReturnsOnCall[len(fake.findDestroyingContainersArgsForCall)]
@@ -637,6 +714,8 @@ func (fake *FakeContainerRepository) Invocations() map[string][][]interface{} {
 	defer fake.destroyFailedContainersMutex.RUnlock()
 	fake.destroyUnknownContainersMutex.RLock()
 	defer fake.destroyUnknownContainersMutex.RUnlock()
+	fake.evictIdleCheckPoolContainersMutex.RLock()
+	defer fake.evictIdleCheckPoolContainersMutex.RUnlock()
 	fake.findDestroyingContainersMutex.RLock()
 	defer fake.findDestroyingContainersMutex.RUnlock()
 	fake.findOrphanedContainersMutex.RLock()
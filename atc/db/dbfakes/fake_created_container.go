@@ -61,6 +61,29 @@ type FakeCreatedContainer struct {
 	metadataReturnsOnCall map[int]struct {
 		result1 db.ContainerMetadata
 	}
+	RecordCheckPoolUseStub        func(time.Time) (bool, error)
+	recordCheckPoolUseMutex       sync.RWMutex
+	recordCheckPoolUseArgsForCall []struct {
+		arg1 time.Time
+	}
+	recordCheckPoolUseReturns struct {
+		result1 bool
+		result2 error
+	}
+	recordCheckPoolUseReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ReleaseCheckPoolLeaseStub        func() error
+	releaseCheckPoolLeaseMutex       sync.RWMutex
+	releaseCheckPoolLeaseArgsForCall []struct {
+	}
+	releaseCheckPoolLeaseReturns struct {
+		result1 error
+	}
+	releaseCheckPoolLeaseReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StateStub        func() string
 	stateMutex       sync.RWMutex
 	stateArgsForCall []struct {
@@ -363,6 +386,123 @@ func (fake *FakeCreatedContainer) MetadataReturnsOnCall(i int, result1 db.Contai
 	}{result1}
 }
 
+func (fake *FakeCreatedContainer) RecordCheckPoolUse(arg1 time.Time) (bool, error) {
+	fake.recordCheckPoolUseMutex.Lock()
+	ret, specificReturn := fake.recordCheckPoolUseReturnsOnCall[len(fake.recordCheckPoolUseArgsForCall)]
+	fake.recordCheckPoolUseArgsForCall = append(fake.recordCheckPoolUseArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	stub := fake.RecordCheckPoolUseStub
+	fakeReturns := fake.recordCheckPoolUseReturns
+	fake.recordInvocation("RecordCheckPoolUse", []interface{}{arg1})
+	fake.recordCheckPoolUseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreatedContainer) RecordCheckPoolUseCallCount() int {
+	fake.recordCheckPoolUseMutex.RLock()
+	defer fake.recordCheckPoolUseMutex.RUnlock()
+	return len(fake.recordCheckPoolUseArgsForCall)
+}
+
+func (fake *FakeCreatedContainer) RecordCheckPoolUseCalls(stub func(time.Time) (bool, error)) {
+	fake.recordCheckPoolUseMutex.Lock()
+	defer fake.recordCheckPoolUseMutex.Unlock()
+	fake.RecordCheckPoolUseStub = stub
+}
+
+func (fake *FakeCreatedContainer) RecordCheckPoolUseArgsForCall(i int) time.Time {
+	fake.recordCheckPoolUseMutex.RLock()
+	defer fake.recordCheckPoolUseMutex.RUnlock()
+	argsForCall := fake.recordCheckPoolUseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreatedContainer) RecordCheckPoolUseReturns(result1 bool, result2 error) {
+	fake.recordCheckPoolUseMutex.Lock()
+	defer fake.recordCheckPoolUseMutex.Unlock()
+	fake.RecordCheckPoolUseStub = nil
+	fake.recordCheckPoolUseReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreatedContainer) RecordCheckPoolUseReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.recordCheckPoolUseMutex.Lock()
+	defer fake.recordCheckPoolUseMutex.Unlock()
+	fake.RecordCheckPoolUseStub = nil
+	if fake.recordCheckPoolUseReturnsOnCall == nil {
+		fake.recordCheckPoolUseReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.recordCheckPoolUseReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreatedContainer) ReleaseCheckPoolLease() error {
+	fake.releaseCheckPoolLeaseMutex.Lock()
+	ret, specificReturn := fake.releaseCheckPoolLeaseReturnsOnCall[len(fake.releaseCheckPoolLeaseArgsForCall)]
+	fake.releaseCheckPoolLeaseArgsForCall = append(fake.releaseCheckPoolLeaseArgsForCall, struct {
+	}{})
+	stub := fake.ReleaseCheckPoolLeaseStub
+	fakeReturns := fake.releaseCheckPoolLeaseReturns
+	fake.recordInvocation("ReleaseCheckPoolLease", []interface{}{})
+	fake.releaseCheckPoolLeaseMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCreatedContainer) ReleaseCheckPoolLeaseCallCount() int {
+	fake.releaseCheckPoolLeaseMutex.RLock()
+	defer fake.releaseCheckPoolLeaseMutex.RUnlock()
+	return len(fake.releaseCheckPoolLeaseArgsForCall)
+}
+
+func (fake *FakeCreatedContainer) ReleaseCheckPoolLeaseCalls(stub func() error) {
+	fake.releaseCheckPoolLeaseMutex.Lock()
+	defer fake.releaseCheckPoolLeaseMutex.Unlock()
+	fake.ReleaseCheckPoolLeaseStub = stub
+}
+
+func (fake *FakeCreatedContainer) ReleaseCheckPoolLeaseReturns(result1 error) {
+	fake.releaseCheckPoolLeaseMutex.Lock()
+	defer fake.releaseCheckPoolLeaseMutex.Unlock()
+	fake.ReleaseCheckPoolLeaseStub = nil
+	fake.releaseCheckPoolLeaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCreatedContainer) ReleaseCheckPoolLeaseReturnsOnCall(i int, result1 error) {
+	fake.releaseCheckPoolLeaseMutex.Lock()
+	defer fake.releaseCheckPoolLeaseMutex.Unlock()
+	fake.ReleaseCheckPoolLeaseStub = nil
+	if fake.releaseCheckPoolLeaseReturnsOnCall == nil {
+		fake.releaseCheckPoolLeaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseCheckPoolLeaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCreatedContainer) State() string {
 	fake.stateMutex.Lock()
 	ret, specificReturn := fake.stateReturnsOnCall[len(fake.stateArgsForCall)]
@@ -535,6 +675,10 @@ func (fake *FakeCreatedContainer) Invocations() map[string][][]interface{} {
 	defer fake.lastHijackMutex.RUnlock()
 	fake.metadataMutex.RLock()
 	defer fake.metadataMutex.RUnlock()
+	fake.recordCheckPoolUseMutex.RLock()
+	defer fake.recordCheckPoolUseMutex.RUnlock()
+	fake.releaseCheckPoolLeaseMutex.RLock()
+	defer fake.releaseCheckPoolLeaseMutex.RUnlock()
 	fake.stateMutex.RLock()
 	defer fake.stateMutex.RUnlock()
 	fake.updateLastHijackMutex.RLock()
@@ -439,6 +439,33 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	SLOBuildsStub        func(int, int) ([]atc.JobSLOBuild, error)
+	sLOBuildsMutex       sync.RWMutex
+	sLOBuildsArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	sLOBuildsReturns struct {
+		result1 []atc.JobSLOBuild
+		result2 error
+	}
+	sLOBuildsReturnsOnCall map[int]struct {
+		result1 []atc.JobSLOBuild
+		result2 error
+	}
+	SLOReportStub        func(int) (atc.JobSLOReport, error)
+	sLOReportMutex       sync.RWMutex
+	sLOReportArgsForCall []struct {
+		arg1 int
+	}
+	sLOReportReturns struct {
+		result1 atc.JobSLOReport
+		result2 error
+	}
+	sLOReportReturnsOnCall map[int]struct {
+		result1 atc.JobSLOReport
+		result2 error
+	}
 	SaveNextInputMappingStub        func(db.InputMapping, bool) error
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -515,6 +542,19 @@ type FakeJob struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestResultsStub        func(int) (atc.JobTestResults, error)
+	testResultsMutex       sync.RWMutex
+	testResultsArgsForCall []struct {
+		arg1 int
+	}
+	testResultsReturns struct {
+		result1 atc.JobTestResults
+		result2 error
+	}
+	testResultsReturnsOnCall map[int]struct {
+		result1 atc.JobTestResults
+		result2 error
+	}
 	UnpauseStub        func() error
 	unpauseMutex       sync.RWMutex
 	unpauseArgsForCall []struct {
@@ -2622,6 +2662,135 @@ func (fake *FakeJob) RerunBuildReturnsOnCall(i int, result1 db.Build, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeJob) SLOBuilds(arg1 int, arg2 int) ([]atc.JobSLOBuild, error) {
+	fake.sLOBuildsMutex.Lock()
+	ret, specificReturn := fake.sLOBuildsReturnsOnCall[len(fake.sLOBuildsArgsForCall)]
+	fake.sLOBuildsArgsForCall = append(fake.sLOBuildsArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.SLOBuildsStub
+	fakeReturns := fake.sLOBuildsReturns
+	fake.recordInvocation("SLOBuilds", []interface{}{arg1, arg2})
+	fake.sLOBuildsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) SLOBuildsCallCount() int {
+	fake.sLOBuildsMutex.RLock()
+	defer fake.sLOBuildsMutex.RUnlock()
+	return len(fake.sLOBuildsArgsForCall)
+}
+
+func (fake *FakeJob) SLOBuildsCalls(stub func(int, int) ([]atc.JobSLOBuild, error)) {
+	fake.sLOBuildsMutex.Lock()
+	defer fake.sLOBuildsMutex.Unlock()
+	fake.SLOBuildsStub = stub
+}
+
+func (fake *FakeJob) SLOBuildsArgsForCall(i int) (int, int) {
+	fake.sLOBuildsMutex.RLock()
+	defer fake.sLOBuildsMutex.RUnlock()
+	argsForCall := fake.sLOBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) SLOBuildsReturns(result1 []atc.JobSLOBuild, result2 error) {
+	fake.sLOBuildsMutex.Lock()
+	defer fake.sLOBuildsMutex.Unlock()
+	fake.SLOBuildsStub = nil
+	fake.sLOBuildsReturns = struct {
+		result1 []atc.JobSLOBuild
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) SLOBuildsReturnsOnCall(i int, result1 []atc.JobSLOBuild, result2 error) {
+	fake.sLOBuildsMutex.Lock()
+	defer fake.sLOBuildsMutex.Unlock()
+	fake.SLOBuildsStub = nil
+	if fake.sLOBuildsReturnsOnCall == nil {
+		fake.sLOBuildsReturnsOnCall = make(map[int]struct {
+			result1 []atc.JobSLOBuild
+			result2 error
+		})
+	}
+	fake.sLOBuildsReturnsOnCall[i] = struct {
+		result1 []atc.JobSLOBuild
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) SLOReport(arg1 int) (atc.JobSLOReport, error) {
+	fake.sLOReportMutex.Lock()
+	ret, specificReturn := fake.sLOReportReturnsOnCall[len(fake.sLOReportArgsForCall)]
+	fake.sLOReportArgsForCall = append(fake.sLOReportArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.SLOReportStub
+	fakeReturns := fake.sLOReportReturns
+	fake.recordInvocation("SLOReport", []interface{}{arg1})
+	fake.sLOReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) SLOReportCallCount() int {
+	fake.sLOReportMutex.RLock()
+	defer fake.sLOReportMutex.RUnlock()
+	return len(fake.sLOReportArgsForCall)
+}
+
+func (fake *FakeJob) SLOReportCalls(stub func(int) (atc.JobSLOReport, error)) {
+	fake.sLOReportMutex.Lock()
+	defer fake.sLOReportMutex.Unlock()
+	fake.SLOReportStub = stub
+}
+
+func (fake *FakeJob) SLOReportArgsForCall(i int) int {
+	fake.sLOReportMutex.RLock()
+	defer fake.sLOReportMutex.RUnlock()
+	argsForCall := fake.sLOReportArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) SLOReportReturns(result1 atc.JobSLOReport, result2 error) {
+	fake.sLOReportMutex.Lock()
+	defer fake.sLOReportMutex.Unlock()
+	fake.SLOReportStub = nil
+	fake.sLOReportReturns = struct {
+		result1 atc.JobSLOReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) SLOReportReturnsOnCall(i int, result1 atc.JobSLOReport, result2 error) {
+	fake.sLOReportMutex.Lock()
+	defer fake.sLOReportMutex.Unlock()
+	fake.SLOReportStub = nil
+	if fake.sLOReportReturnsOnCall == nil {
+		fake.sLOReportReturnsOnCall = make(map[int]struct {
+			result1 atc.JobSLOReport
+			result2 error
+		})
+	}
+	fake.sLOReportReturnsOnCall[i] = struct {
+		result1 atc.JobSLOReport
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) SaveNextInputMapping(arg1 db.InputMapping, arg2 bool) error {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -3021,6 +3190,70 @@ func (fake *FakeJob) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) TestResults(arg1 int) (atc.JobTestResults, error) {
+	fake.testResultsMutex.Lock()
+	ret, specificReturn := fake.testResultsReturnsOnCall[len(fake.testResultsArgsForCall)]
+	fake.testResultsArgsForCall = append(fake.testResultsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.TestResultsStub
+	fakeReturns := fake.testResultsReturns
+	fake.recordInvocation("TestResults", []interface{}{arg1})
+	fake.testResultsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) TestResultsCallCount() int {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	return len(fake.testResultsArgsForCall)
+}
+
+func (fake *FakeJob) TestResultsCalls(stub func(int) (atc.JobTestResults, error)) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = stub
+}
+
+func (fake *FakeJob) TestResultsArgsForCall(i int) int {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	argsForCall := fake.testResultsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) TestResultsReturns(result1 atc.JobTestResults, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	fake.testResultsReturns = struct {
+		result1 atc.JobTestResults
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) TestResultsReturnsOnCall(i int, result1 atc.JobTestResults, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	if fake.testResultsReturnsOnCall == nil {
+		fake.testResultsReturnsOnCall = make(map[int]struct {
+			result1 atc.JobTestResults
+			result2 error
+		})
+	}
+	fake.testResultsReturnsOnCall[i] = struct {
+		result1 atc.JobTestResults
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Unpause() error {
 	fake.unpauseMutex.Lock()
 	ret, specificReturn := fake.unpauseReturnsOnCall[len(fake.unpauseArgsForCall)]
@@ -3271,6 +3504,10 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.requestScheduleMutex.RUnlock()
 	fake.rerunBuildMutex.RLock()
 	defer fake.rerunBuildMutex.RUnlock()
+	fake.sLOBuildsMutex.RLock()
+	defer fake.sLOBuildsMutex.RUnlock()
+	fake.sLOReportMutex.RLock()
+	defer fake.sLOReportMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
@@ -3285,6 +3522,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
 	fake.updateFirstLoggedBuildIDMutex.RLock()
@@ -21,6 +21,19 @@ type FakeJobFactory struct {
 		result1 []atc.JobSummary
 		result2 error
 	}
+	JobsForPipelinesStub        func([]int) ([]atc.JobSummary, error)
+	jobsForPipelinesMutex       sync.RWMutex
+	jobsForPipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	jobsForPipelinesReturns struct {
+		result1 []atc.JobSummary
+		result2 error
+	}
+	jobsForPipelinesReturnsOnCall map[int]struct {
+		result1 []atc.JobSummary
+		result2 error
+	}
 	JobsToScheduleStub        func() (db.SchedulerJobs, error)
 	jobsToScheduleMutex       sync.RWMutex
 	jobsToScheduleArgsForCall []struct {
@@ -106,6 +119,75 @@ func (fake *FakeJobFactory) AllActiveJobsReturnsOnCall(i int, result1 []atc.JobS
 	}{result1, result2}
 }
 
+func (fake *FakeJobFactory) JobsForPipelines(arg1 []int) ([]atc.JobSummary, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.jobsForPipelinesMutex.Lock()
+	ret, specificReturn := fake.jobsForPipelinesReturnsOnCall[len(fake.jobsForPipelinesArgsForCall)]
+	fake.jobsForPipelinesArgsForCall = append(fake.jobsForPipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	stub := fake.JobsForPipelinesStub
+	fakeReturns := fake.jobsForPipelinesReturns
+	fake.recordInvocation("JobsForPipelines", []interface{}{arg1Copy})
+	fake.jobsForPipelinesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobFactory) JobsForPipelinesCallCount() int {
+	fake.jobsForPipelinesMutex.RLock()
+	defer fake.jobsForPipelinesMutex.RUnlock()
+	return len(fake.jobsForPipelinesArgsForCall)
+}
+
+func (fake *FakeJobFactory) JobsForPipelinesCalls(stub func([]int) ([]atc.JobSummary, error)) {
+	fake.jobsForPipelinesMutex.Lock()
+	defer fake.jobsForPipelinesMutex.Unlock()
+	fake.JobsForPipelinesStub = stub
+}
+
+func (fake *FakeJobFactory) JobsForPipelinesArgsForCall(i int) []int {
+	fake.jobsForPipelinesMutex.RLock()
+	defer fake.jobsForPipelinesMutex.RUnlock()
+	argsForCall := fake.jobsForPipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJobFactory) JobsForPipelinesReturns(result1 []atc.JobSummary, result2 error) {
+	fake.jobsForPipelinesMutex.Lock()
+	defer fake.jobsForPipelinesMutex.Unlock()
+	fake.JobsForPipelinesStub = nil
+	fake.jobsForPipelinesReturns = struct {
+		result1 []atc.JobSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobFactory) JobsForPipelinesReturnsOnCall(i int, result1 []atc.JobSummary, result2 error) {
+	fake.jobsForPipelinesMutex.Lock()
+	defer fake.jobsForPipelinesMutex.Unlock()
+	fake.JobsForPipelinesStub = nil
+	if fake.jobsForPipelinesReturnsOnCall == nil {
+		fake.jobsForPipelinesReturnsOnCall = make(map[int]struct {
+			result1 []atc.JobSummary
+			result2 error
+		})
+	}
+	fake.jobsForPipelinesReturnsOnCall[i] = struct {
+		result1 []atc.JobSummary
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJobFactory) JobsToSchedule() (db.SchedulerJobs, error) {
 	fake.jobsToScheduleMutex.Lock()
 	ret, specificReturn := fake.jobsToScheduleReturnsOnCall[len(fake.jobsToScheduleArgsForCall)]
@@ -236,6 +318,8 @@ func (fake *FakeJobFactory) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.allActiveJobsMutex.RLock()
 	defer fake.allActiveJobsMutex.RUnlock()
+	fake.jobsForPipelinesMutex.RLock()
+	defer fake.jobsForPipelinesMutex.RUnlock()
 	fake.jobsToScheduleMutex.RLock()
 	defer fake.jobsToScheduleMutex.RUnlock()
 	fake.visibleJobsMutex.RLock()
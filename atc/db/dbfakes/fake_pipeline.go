@@ -87,6 +87,33 @@ type FakePipeline struct {
 		result1 atc.Config
 		result2 error
 	}
+	ConfigAtVersionStub        func(int) (db.PipelineConfig, bool, error)
+	configAtVersionMutex       sync.RWMutex
+	configAtVersionArgsForCall []struct {
+		arg1 int
+	}
+	configAtVersionReturns struct {
+		result1 db.PipelineConfig
+		result2 bool
+		result3 error
+	}
+	configAtVersionReturnsOnCall map[int]struct {
+		result1 db.PipelineConfig
+		result2 bool
+		result3 error
+	}
+	ConfigHistoryStub        func() ([]db.PipelineConfig, error)
+	configHistoryMutex       sync.RWMutex
+	configHistoryArgsForCall []struct {
+	}
+	configHistoryReturns struct {
+		result1 []db.PipelineConfig
+		result2 error
+	}
+	configHistoryReturnsOnCall map[int]struct {
+		result1 []db.PipelineConfig
+		result2 error
+	}
 	ConfigVersionStub        func() db.ConfigVersion
 	configVersionMutex       sync.RWMutex
 	configVersionArgsForCall []struct {
@@ -165,6 +192,16 @@ type FakePipeline struct {
 	displayReturnsOnCall map[int]struct {
 		result1 *atc.DisplayConfig
 	}
+	EgressStub        func() atc.EgressRules
+	egressMutex       sync.RWMutex
+	egressArgsForCall []struct {
+	}
+	egressReturns struct {
+		result1 atc.EgressRules
+	}
+	egressReturnsOnCall map[int]struct {
+		result1 atc.EgressRules
+	}
 	ExposeStub        func() error
 	exposeMutex       sync.RWMutex
 	exposeArgsForCall []struct {
@@ -280,6 +317,16 @@ type FakePipeline struct {
 	lastUpdatedReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	LintStub        func() *atc.LintConfig
+	lintMutex       sync.RWMutex
+	lintArgsForCall []struct {
+	}
+	lintReturns struct {
+		result1 *atc.LintConfig
+	}
+	lintReturnsOnCall map[int]struct {
+		result1 *atc.LintConfig
+	}
 	LoadDebugVersionsDBStub        func() (*atc.DebugVersionsDB, error)
 	loadDebugVersionsDBMutex       sync.RWMutex
 	loadDebugVersionsDBArgsForCall []struct {
@@ -970,6 +1017,129 @@ func (fake *FakePipeline) ConfigReturnsOnCall(i int, result1 atc.Config, result2
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) ConfigAtVersion(arg1 int) (db.PipelineConfig, bool, error) {
+	fake.configAtVersionMutex.Lock()
+	ret, specificReturn := fake.configAtVersionReturnsOnCall[len(fake.configAtVersionArgsForCall)]
+	fake.configAtVersionArgsForCall = append(fake.configAtVersionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.ConfigAtVersionStub
+	fakeReturns := fake.configAtVersionReturns
+	fake.recordInvocation("ConfigAtVersion", []interface{}{arg1})
+	fake.configAtVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) ConfigAtVersionCallCount() int {
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	return len(fake.configAtVersionArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigAtVersionCalls(stub func(int) (db.PipelineConfig, bool, error)) {
+	fake.configAtVersionMutex.Lock()
+	defer fake.configAtVersionMutex.Unlock()
+	fake.ConfigAtVersionStub = stub
+}
+
+func (fake *FakePipeline) ConfigAtVersionArgsForCall(i int) int {
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	argsForCall := fake.configAtVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) ConfigAtVersionReturns(result1 db.PipelineConfig, result2 bool, result3 error) {
+	fake.configAtVersionMutex.Lock()
+	defer fake.configAtVersionMutex.Unlock()
+	fake.ConfigAtVersionStub = nil
+	fake.configAtVersionReturns = struct {
+		result1 db.PipelineConfig
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) ConfigAtVersionReturnsOnCall(i int, result1 db.PipelineConfig, result2 bool, result3 error) {
+	fake.configAtVersionMutex.Lock()
+	defer fake.configAtVersionMutex.Unlock()
+	fake.ConfigAtVersionStub = nil
+	if fake.configAtVersionReturnsOnCall == nil {
+		fake.configAtVersionReturnsOnCall = make(map[int]struct {
+			result1 db.PipelineConfig
+			result2 bool
+			result3 error
+		})
+	}
+	fake.configAtVersionReturnsOnCall[i] = struct {
+		result1 db.PipelineConfig
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) ConfigHistory() ([]db.PipelineConfig, error) {
+	fake.configHistoryMutex.Lock()
+	ret, specificReturn := fake.configHistoryReturnsOnCall[len(fake.configHistoryArgsForCall)]
+	fake.configHistoryArgsForCall = append(fake.configHistoryArgsForCall, struct {
+	}{})
+	stub := fake.ConfigHistoryStub
+	fakeReturns := fake.configHistoryReturns
+	fake.recordInvocation("ConfigHistory", []interface{}{})
+	fake.configHistoryMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) ConfigHistoryCallCount() int {
+	fake.configHistoryMutex.RLock()
+	defer fake.configHistoryMutex.RUnlock()
+	return len(fake.configHistoryArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigHistoryCalls(stub func() ([]db.PipelineConfig, error)) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = stub
+}
+
+func (fake *FakePipeline) ConfigHistoryReturns(result1 []db.PipelineConfig, result2 error) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = nil
+	fake.configHistoryReturns = struct {
+		result1 []db.PipelineConfig
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigHistoryReturnsOnCall(i int, result1 []db.PipelineConfig, result2 error) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = nil
+	if fake.configHistoryReturnsOnCall == nil {
+		fake.configHistoryReturnsOnCall = make(map[int]struct {
+			result1 []db.PipelineConfig
+			result2 error
+		})
+	}
+	fake.configHistoryReturnsOnCall[i] = struct {
+		result1 []db.PipelineConfig
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) ConfigVersion() db.ConfigVersion {
 	fake.configVersionMutex.Lock()
 	ret, specificReturn := fake.configVersionReturnsOnCall[len(fake.configVersionArgsForCall)]
@@ -1371,6 +1541,59 @@ func (fake *FakePipeline) DisplayReturnsOnCall(i int, result1 *atc.DisplayConfig
 	}{result1}
 }
 
+func (fake *FakePipeline) Egress() atc.EgressRules {
+	fake.egressMutex.Lock()
+	ret, specificReturn := fake.egressReturnsOnCall[len(fake.egressArgsForCall)]
+	fake.egressArgsForCall = append(fake.egressArgsForCall, struct {
+	}{})
+	stub := fake.EgressStub
+	fakeReturns := fake.egressReturns
+	fake.recordInvocation("Egress", []interface{}{})
+	fake.egressMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) EgressCallCount() int {
+	fake.egressMutex.RLock()
+	defer fake.egressMutex.RUnlock()
+	return len(fake.egressArgsForCall)
+}
+
+func (fake *FakePipeline) EgressCalls(stub func() atc.EgressRules) {
+	fake.egressMutex.Lock()
+	defer fake.egressMutex.Unlock()
+	fake.EgressStub = stub
+}
+
+func (fake *FakePipeline) EgressReturns(result1 atc.EgressRules) {
+	fake.egressMutex.Lock()
+	defer fake.egressMutex.Unlock()
+	fake.EgressStub = nil
+	fake.egressReturns = struct {
+		result1 atc.EgressRules
+	}{result1}
+}
+
+func (fake *FakePipeline) EgressReturnsOnCall(i int, result1 atc.EgressRules) {
+	fake.egressMutex.Lock()
+	defer fake.egressMutex.Unlock()
+	fake.EgressStub = nil
+	if fake.egressReturnsOnCall == nil {
+		fake.egressReturnsOnCall = make(map[int]struct {
+			result1 atc.EgressRules
+		})
+	}
+	fake.egressReturnsOnCall[i] = struct {
+		result1 atc.EgressRules
+	}{result1}
+}
+
 func (fake *FakePipeline) Expose() error {
 	fake.exposeMutex.Lock()
 	ret, specificReturn := fake.exposeReturnsOnCall[len(fake.exposeArgsForCall)]
@@ -1942,6 +2165,59 @@ func (fake *FakePipeline) LastUpdatedReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
+func (fake *FakePipeline) Lint() *atc.LintConfig {
+	fake.lintMutex.Lock()
+	ret, specificReturn := fake.lintReturnsOnCall[len(fake.lintArgsForCall)]
+	fake.lintArgsForCall = append(fake.lintArgsForCall, struct {
+	}{})
+	stub := fake.LintStub
+	fakeReturns := fake.lintReturns
+	fake.recordInvocation("Lint", []interface{}{})
+	fake.lintMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) LintCallCount() int {
+	fake.lintMutex.RLock()
+	defer fake.lintMutex.RUnlock()
+	return len(fake.lintArgsForCall)
+}
+
+func (fake *FakePipeline) LintCalls(stub func() *atc.LintConfig) {
+	fake.lintMutex.Lock()
+	defer fake.lintMutex.Unlock()
+	fake.LintStub = stub
+}
+
+func (fake *FakePipeline) LintReturns(result1 *atc.LintConfig) {
+	fake.lintMutex.Lock()
+	defer fake.lintMutex.Unlock()
+	fake.LintStub = nil
+	fake.lintReturns = struct {
+		result1 *atc.LintConfig
+	}{result1}
+}
+
+func (fake *FakePipeline) LintReturnsOnCall(i int, result1 *atc.LintConfig) {
+	fake.lintMutex.Lock()
+	defer fake.lintMutex.Unlock()
+	fake.LintStub = nil
+	if fake.lintReturnsOnCall == nil {
+		fake.lintReturnsOnCall = make(map[int]struct {
+			result1 *atc.LintConfig
+		})
+	}
+	fake.lintReturnsOnCall[i] = struct {
+		result1 *atc.LintConfig
+	}{result1}
+}
+
 func (fake *FakePipeline) LoadDebugVersionsDB() (*atc.DebugVersionsDB, error) {
 	fake.loadDebugVersionsDBMutex.Lock()
 	ret, specificReturn := fake.loadDebugVersionsDBReturnsOnCall[len(fake.loadDebugVersionsDBArgsForCall)]
@@ -1961,6 +2237,8 @@ func (fake *FakePipeline) LoadDebugVersionsDB() (*atc.DebugVersionsDB, error) {
 }
 
 func (fake *FakePipeline) LoadDebugVersionsDBCallCount() int {
+	fake.lintMutex.RLock()
+	defer fake.lintMutex.RUnlock()
 	fake.loadDebugVersionsDBMutex.RLock()
 	defer fake.loadDebugVersionsDBMutex.RUnlock()
 	return len(fake.loadDebugVersionsDBArgsForCall)
@@ -3597,6 +3875,10 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.checkPausedMutex.RUnlock()
 	fake.configMutex.RLock()
 	defer fake.configMutex.RUnlock()
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	fake.configHistoryMutex.RLock()
+	defer fake.configHistoryMutex.RUnlock()
 	fake.configVersionMutex.RLock()
 	defer fake.configVersionMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
@@ -3611,6 +3893,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.destroyMutex.RUnlock()
 	fake.displayMutex.RLock()
 	defer fake.displayMutex.RUnlock()
+	fake.egressMutex.RLock()
+	defer fake.egressMutex.RUnlock()
 	fake.exposeMutex.RLock()
 	defer fake.exposeMutex.RUnlock()
 	fake.getBuildsWithVersionAsInputMutex.RLock()
@@ -35,6 +35,19 @@ type FakeResourceFactory struct {
 		result2 bool
 		result3 error
 	}
+	ResourcesForPipelinesStub        func([]int) ([]db.Resource, error)
+	resourcesForPipelinesMutex       sync.RWMutex
+	resourcesForPipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	resourcesForPipelinesReturns struct {
+		result1 []db.Resource
+		result2 error
+	}
+	resourcesForPipelinesReturnsOnCall map[int]struct {
+		result1 []db.Resource
+		result2 error
+	}
 	VisibleResourcesStub        func([]string) ([]db.Resource, error)
 	visibleResourcesMutex       sync.RWMutex
 	visibleResourcesArgsForCall []struct {
@@ -175,6 +188,75 @@ func (fake *FakeResourceFactory) ResourceReturnsOnCall(i int, result1 db.Resourc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeResourceFactory) ResourcesForPipelines(arg1 []int) ([]db.Resource, error) {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.resourcesForPipelinesMutex.Lock()
+	ret, specificReturn := fake.resourcesForPipelinesReturnsOnCall[len(fake.resourcesForPipelinesArgsForCall)]
+	fake.resourcesForPipelinesArgsForCall = append(fake.resourcesForPipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	stub := fake.ResourcesForPipelinesStub
+	fakeReturns := fake.resourcesForPipelinesReturns
+	fake.recordInvocation("ResourcesForPipelines", []interface{}{arg1Copy})
+	fake.resourcesForPipelinesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceFactory) ResourcesForPipelinesCallCount() int {
+	fake.resourcesForPipelinesMutex.RLock()
+	defer fake.resourcesForPipelinesMutex.RUnlock()
+	return len(fake.resourcesForPipelinesArgsForCall)
+}
+
+func (fake *FakeResourceFactory) ResourcesForPipelinesCalls(stub func([]int) ([]db.Resource, error)) {
+	fake.resourcesForPipelinesMutex.Lock()
+	defer fake.resourcesForPipelinesMutex.Unlock()
+	fake.ResourcesForPipelinesStub = stub
+}
+
+func (fake *FakeResourceFactory) ResourcesForPipelinesArgsForCall(i int) []int {
+	fake.resourcesForPipelinesMutex.RLock()
+	defer fake.resourcesForPipelinesMutex.RUnlock()
+	argsForCall := fake.resourcesForPipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceFactory) ResourcesForPipelinesReturns(result1 []db.Resource, result2 error) {
+	fake.resourcesForPipelinesMutex.Lock()
+	defer fake.resourcesForPipelinesMutex.Unlock()
+	fake.ResourcesForPipelinesStub = nil
+	fake.resourcesForPipelinesReturns = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceFactory) ResourcesForPipelinesReturnsOnCall(i int, result1 []db.Resource, result2 error) {
+	fake.resourcesForPipelinesMutex.Lock()
+	defer fake.resourcesForPipelinesMutex.Unlock()
+	fake.ResourcesForPipelinesStub = nil
+	if fake.resourcesForPipelinesReturnsOnCall == nil {
+		fake.resourcesForPipelinesReturnsOnCall = make(map[int]struct {
+			result1 []db.Resource
+			result2 error
+		})
+	}
+	fake.resourcesForPipelinesReturnsOnCall[i] = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceFactory) VisibleResources(arg1 []string) ([]db.Resource, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -251,6 +333,8 @@ func (fake *FakeResourceFactory) Invocations() map[string][][]interface{} {
 	defer fake.allResourcesMutex.RUnlock()
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
+	fake.resourcesForPipelinesMutex.RLock()
+	defer fake.resourcesForPipelinesMutex.RUnlock()
 	fake.visibleResourcesMutex.RLock()
 	defer fake.visibleResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -376,13 +376,14 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
 		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
+		arg5 string
 	}
 	savePipelineReturns struct {
 		result1 db.Pipeline
@@ -408,6 +409,19 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SearchBuildLogsStub        func(db.BuildLogSearch) ([]atc.BuildLogMatch, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		arg1 db.BuildLogSearch
+	}
+	searchBuildLogsReturns struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -2177,7 +2191,7 @@ func (fake *FakeTeam) RenamePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool, arg5 string) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
@@ -2185,13 +2199,14 @@ func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 d
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
-	}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.SavePipelineStub
 	fakeReturns := fake.savePipelineReturns
-	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.savePipelineMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -2205,17 +2220,17 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeTeam) SavePipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
@@ -2312,6 +2327,70 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SearchBuildLogs(arg1 db.BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		arg1 db.BuildLogSearch
+	}{arg1})
+	stub := fake.SearchBuildLogsStub
+	fakeReturns := fake.searchBuildLogsReturns
+	fake.recordInvocation("SearchBuildLogs", []interface{}{arg1})
+	fake.searchBuildLogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeTeam) SearchBuildLogsCalls(stub func(db.BuildLogSearch) ([]atc.BuildLogMatch, error)) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = stub
+}
+
+func (fake *FakeTeam) SearchBuildLogsArgsForCall(i int) db.BuildLogSearch {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	argsForCall := fake.searchBuildLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturns(result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturnsOnCall(i int, result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildLogMatch
+			result2 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2492,6 +2571,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
 	fake.workersMutex.RLock()
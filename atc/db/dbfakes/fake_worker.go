@@ -42,6 +42,16 @@ type FakeWorker struct {
 	activeVolumesReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ArchitectureStub        func() string
+	architectureMutex       sync.RWMutex
+	architectureArgsForCall []struct {
+	}
+	architectureReturns struct {
+		result1 string
+	}
+	architectureReturnsOnCall map[int]struct {
+		result1 string
+	}
 	BaggageclaimURLStub        func() *string
 	baggageclaimURLMutex       sync.RWMutex
 	baggageclaimURLArgsForCall []struct {
@@ -272,6 +282,16 @@ type FakeWorker struct {
 	retireReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RuntimeClassesStub        func() []string
+	runtimeClassesMutex       sync.RWMutex
+	runtimeClassesArgsForCall []struct {
+	}
+	runtimeClassesReturns struct {
+		result1 []string
+	}
+	runtimeClassesReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	StartTimeStub        func() time.Time
 	startTimeMutex       sync.RWMutex
 	startTimeArgsForCall []struct {
@@ -322,6 +342,16 @@ type FakeWorker struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	VariantStub        func() string
+	variantMutex       sync.RWMutex
+	variantArgsForCall []struct {
+	}
+	variantReturns struct {
+		result1 string
+	}
+	variantReturnsOnCall map[int]struct {
+		result1 string
+	}
 	VersionStub        func() *string
 	versionMutex       sync.RWMutex
 	versionArgsForCall []struct {
@@ -498,6 +528,59 @@ func (fake *FakeWorker) ActiveVolumesReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Architecture() string {
+	fake.architectureMutex.Lock()
+	ret, specificReturn := fake.architectureReturnsOnCall[len(fake.architectureArgsForCall)]
+	fake.architectureArgsForCall = append(fake.architectureArgsForCall, struct {
+	}{})
+	stub := fake.ArchitectureStub
+	fakeReturns := fake.architectureReturns
+	fake.recordInvocation("Architecture", []interface{}{})
+	fake.architectureMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ArchitectureCallCount() int {
+	fake.architectureMutex.RLock()
+	defer fake.architectureMutex.RUnlock()
+	return len(fake.architectureArgsForCall)
+}
+
+func (fake *FakeWorker) ArchitectureCalls(stub func() string) {
+	fake.architectureMutex.Lock()
+	defer fake.architectureMutex.Unlock()
+	fake.ArchitectureStub = stub
+}
+
+func (fake *FakeWorker) ArchitectureReturns(result1 string) {
+	fake.architectureMutex.Lock()
+	defer fake.architectureMutex.Unlock()
+	fake.ArchitectureStub = nil
+	fake.architectureReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) ArchitectureReturnsOnCall(i int, result1 string) {
+	fake.architectureMutex.Lock()
+	defer fake.architectureMutex.Unlock()
+	fake.ArchitectureStub = nil
+	if fake.architectureReturnsOnCall == nil {
+		fake.architectureReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.architectureReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeWorker) BaggageclaimURL() *string {
 	fake.baggageclaimURLMutex.Lock()
 	ret, specificReturn := fake.baggageclaimURLReturnsOnCall[len(fake.baggageclaimURLArgsForCall)]
@@ -1660,6 +1743,59 @@ func (fake *FakeWorker) RetireReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) RuntimeClasses() []string {
+	fake.runtimeClassesMutex.Lock()
+	ret, specificReturn := fake.runtimeClassesReturnsOnCall[len(fake.runtimeClassesArgsForCall)]
+	fake.runtimeClassesArgsForCall = append(fake.runtimeClassesArgsForCall, struct {
+	}{})
+	stub := fake.RuntimeClassesStub
+	fakeReturns := fake.runtimeClassesReturns
+	fake.recordInvocation("RuntimeClasses", []interface{}{})
+	fake.runtimeClassesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) RuntimeClassesCallCount() int {
+	fake.runtimeClassesMutex.RLock()
+	defer fake.runtimeClassesMutex.RUnlock()
+	return len(fake.runtimeClassesArgsForCall)
+}
+
+func (fake *FakeWorker) RuntimeClassesCalls(stub func() []string) {
+	fake.runtimeClassesMutex.Lock()
+	defer fake.runtimeClassesMutex.Unlock()
+	fake.RuntimeClassesStub = stub
+}
+
+func (fake *FakeWorker) RuntimeClassesReturns(result1 []string) {
+	fake.runtimeClassesMutex.Lock()
+	defer fake.runtimeClassesMutex.Unlock()
+	fake.RuntimeClassesStub = nil
+	fake.runtimeClassesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeWorker) RuntimeClassesReturnsOnCall(i int, result1 []string) {
+	fake.runtimeClassesMutex.Lock()
+	defer fake.runtimeClassesMutex.Unlock()
+	fake.RuntimeClassesStub = nil
+	if fake.runtimeClassesReturnsOnCall == nil {
+		fake.runtimeClassesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.runtimeClassesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeWorker) StartTime() time.Time {
 	fake.startTimeMutex.Lock()
 	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
@@ -1925,6 +2061,59 @@ func (fake *FakeWorker) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Variant() string {
+	fake.variantMutex.Lock()
+	ret, specificReturn := fake.variantReturnsOnCall[len(fake.variantArgsForCall)]
+	fake.variantArgsForCall = append(fake.variantArgsForCall, struct {
+	}{})
+	stub := fake.VariantStub
+	fakeReturns := fake.variantReturns
+	fake.recordInvocation("Variant", []interface{}{})
+	fake.variantMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) VariantCallCount() int {
+	fake.variantMutex.RLock()
+	defer fake.variantMutex.RUnlock()
+	return len(fake.variantArgsForCall)
+}
+
+func (fake *FakeWorker) VariantCalls(stub func() string) {
+	fake.variantMutex.Lock()
+	defer fake.variantMutex.Unlock()
+	fake.VariantStub = stub
+}
+
+func (fake *FakeWorker) VariantReturns(result1 string) {
+	fake.variantMutex.Lock()
+	defer fake.variantMutex.Unlock()
+	fake.VariantStub = nil
+	fake.variantReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) VariantReturnsOnCall(i int, result1 string) {
+	fake.variantMutex.Lock()
+	defer fake.variantMutex.Unlock()
+	fake.VariantStub = nil
+	if fake.variantReturnsOnCall == nil {
+		fake.variantReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.variantReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeWorker) Version() *string {
 	fake.versionMutex.Lock()
 	ret, specificReturn := fake.versionReturnsOnCall[len(fake.versionArgsForCall)]
@@ -1987,6 +2176,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.activeTasksMutex.RUnlock()
 	fake.activeVolumesMutex.RLock()
 	defer fake.activeVolumesMutex.RUnlock()
+	fake.architectureMutex.RLock()
+	defer fake.architectureMutex.RUnlock()
 	fake.baggageclaimURLMutex.RLock()
 	defer fake.baggageclaimURLMutex.RUnlock()
 	fake.certsPathMutex.RLock()
@@ -2029,6 +2220,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.resourceTypesMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
+	fake.runtimeClassesMutex.RLock()
+	defer fake.runtimeClassesMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.stateMutex.RLock()
@@ -2039,6 +2232,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.variantMutex.RLock()
+	defer fake.variantMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,323 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// InterceptSession is a shell opened in a container with `fly intercept`,
+// kept so that what was run in it can be audited. The build fields are
+// copied from the container's metadata, so that they outlive the container.
+type InterceptSession struct {
+	ID              int
+	TeamID          int
+	ContainerHandle string
+	CreatedBy       string
+	Command         []string
+
+	BuildID      int
+	BuildName    string
+	PipelineName string
+	JobName      string
+	StepName     string
+
+	StartedAt  time.Time
+	EndedAt    time.Time
+	ExitStatus *int
+
+	// Recorded is whether any of the session's recording has been saved. It
+	// is saved in chunks as the session runs.
+	Recorded bool
+}
+
+//counterfeiter:generate . InterceptSessionFactory
+type InterceptSessionFactory interface {
+	CreateInterceptSession(teamID int, handle string, metadata ContainerMetadata, createdBy string, command []string) (InterceptSession, error)
+	AppendInterceptSessionRecording(id int, chunk []byte) error
+	FinishInterceptSession(id int, exitStatus *int) error
+
+	InterceptSessions(teamID int, limit int) ([]InterceptSession, error)
+	InterceptSession(teamID int, id int) (InterceptSession, bool, error)
+	InterceptSessionRecording(teamID int, id int) ([]byte, bool, error)
+
+	DeleteInterceptSessionsBefore(time.Time) (int, error)
+}
+
+type interceptSessionFactory struct {
+	conn Conn
+}
+
+func NewInterceptSessionFactory(conn Conn) InterceptSessionFactory {
+	return &interceptSessionFactory{
+		conn: conn,
+	}
+}
+
+var interceptSessionsQuery = psql.Select(
+	"s.id",
+	"s.team_id",
+	"s.container_handle",
+	"s.created_by",
+	"s.command",
+	"s.build_id",
+	"s.build_name",
+	"s.pipeline_name",
+	"s.job_name",
+	"s.step_name",
+	"s.started_at",
+	"s.ended_at",
+	"s.exit_status",
+	"EXISTS (SELECT 1 FROM intercept_session_chunks c WHERE c.session_id = s.id)",
+).
+	From("intercept_sessions s")
+
+func (f *interceptSessionFactory) CreateInterceptSession(
+	teamID int,
+	handle string,
+	metadata ContainerMetadata,
+	createdBy string,
+	command []string,
+) (InterceptSession, error) {
+	payload, err := json.Marshal(command)
+	if err != nil {
+		return InterceptSession{}, err
+	}
+
+	var session InterceptSession
+	err = scanInterceptSession(
+		&session,
+		psql.Insert("intercept_sessions").
+			SetMap(map[string]interface{}{
+				"team_id":          teamID,
+				"container_handle": handle,
+				"created_by":       optionalString(createdBy),
+				"command":          string(payload),
+				"build_id":         sql.NullInt64{Int64: int64(metadata.BuildID), Valid: metadata.BuildID != 0},
+				"build_name":       optionalString(metadata.BuildName),
+				"pipeline_name":    optionalString(metadata.PipelineName),
+				"job_name":         optionalString(metadata.JobName),
+				"step_name":        optionalString(metadata.StepName),
+			}).
+			Suffix(`RETURNING id, team_id, container_handle, created_by, command,
+				build_id, build_name, pipeline_name, job_name, step_name,
+				started_at, ended_at, exit_status, false`).
+			RunWith(f.conn).
+			QueryRow(),
+	)
+	if err != nil {
+		return InterceptSession{}, err
+	}
+
+	return session, nil
+}
+
+// AppendInterceptSessionRecording saves the next chunk of the session's
+// recording.
+func (f *interceptSessionFactory) AppendInterceptSessionRecording(id int, chunk []byte) error {
+	encryptedChunk, nonce, err := f.conn.EncryptionStrategy().Encrypt(chunk)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("intercept_session_chunks").
+		Columns("session_id", "data", "nonce").
+		Values(id, encryptedChunk, nonce).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *interceptSessionFactory) FinishInterceptSession(id int, exitStatus *int) error {
+	var status sql.NullInt64
+	if exitStatus != nil {
+		status = sql.NullInt64{Int64: int64(*exitStatus), Valid: true}
+	}
+
+	_, err := psql.Update("intercept_sessions").
+		SetMap(map[string]interface{}{
+			"ended_at":    sq.Expr("now()"),
+			"exit_status": status,
+		}).
+		Where(sq.Eq{"id": id}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *interceptSessionFactory) InterceptSessions(teamID int, limit int) ([]InterceptSession, error) {
+	query := interceptSessionsQuery.
+		Where(sq.Eq{"s.team_id": teamID}).
+		OrderBy("s.id DESC")
+
+	if limit > 0 {
+		query = query.Limit(uint64(limit))
+	}
+
+	rows, err := query.RunWith(f.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var sessions []InterceptSession
+	for rows.Next() {
+		var session InterceptSession
+		err = scanInterceptSession(&session, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
+func (f *interceptSessionFactory) InterceptSession(teamID int, id int) (InterceptSession, bool, error) {
+	var session InterceptSession
+	err := scanInterceptSession(
+		&session,
+		interceptSessionsQuery.
+			Where(sq.Eq{
+				"s.team_id": teamID,
+				"s.id":      id,
+			}).
+			RunWith(f.conn).
+			QueryRow(),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return InterceptSession{}, false, nil
+		}
+
+		return InterceptSession{}, false, err
+	}
+
+	return session, true, nil
+}
+
+// InterceptSessionRecording returns the session's recording so far, joined
+// up from the chunks saved as it ran.
+func (f *interceptSessionFactory) InterceptSessionRecording(teamID int, id int) ([]byte, bool, error) {
+	rows, err := psql.Select("c.data", "c.nonce").
+		From("intercept_session_chunks c").
+		Join("intercept_sessions s ON s.id = c.session_id").
+		Where(sq.Eq{
+			"s.team_id": teamID,
+			"s.id":      id,
+		}).
+		OrderBy("c.id").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Close(rows)
+
+	var recording []byte
+	var found bool
+	for rows.Next() {
+		var data string
+		var nonce sql.NullString
+		err = rows.Scan(&data, &nonce)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		chunk, err := f.conn.EncryptionStrategy().Decrypt(data, noncense)
+		if err != nil {
+			return nil, false, err
+		}
+
+		recording = append(recording, chunk...)
+		found = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	return recording, found, nil
+}
+
+// DeleteInterceptSessionsBefore deletes the sessions started before the
+// given time, along with their recordings.
+func (f *interceptSessionFactory) DeleteInterceptSessionsBefore(before time.Time) (int, error) {
+	result, err := psql.Delete("intercept_sessions").
+		Where(sq.Lt{"started_at": before}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
+
+func scanInterceptSession(session *InterceptSession, row scannable) error {
+	var (
+		createdBy, buildName, pipelineName, jobName, stepName sql.NullString
+		buildID, exitStatus                                   sql.NullInt64
+		endedAt                                               sql.NullTime
+		command                                               string
+	)
+
+	err := row.Scan(
+		&session.ID,
+		&session.TeamID,
+		&session.ContainerHandle,
+		&createdBy,
+		&command,
+		&buildID,
+		&buildName,
+		&pipelineName,
+		&jobName,
+		&stepName,
+		&session.StartedAt,
+		&endedAt,
+		&exitStatus,
+		&session.Recorded,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal([]byte(command), &session.Command)
+	if err != nil {
+		return err
+	}
+
+	session.CreatedBy = createdBy.String
+	session.BuildID = int(buildID.Int64)
+	session.BuildName = buildName.String
+	session.PipelineName = pipelineName.String
+	session.JobName = jobName.String
+	session.StepName = stepName.String
+	session.EndedAt = endedAt.Time
+
+	if exitStatus.Valid {
+		status := int(exitStatus.Int64)
+		session.ExitStatus = &status
+	}
+
+	return nil
+}
+
+func optionalString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
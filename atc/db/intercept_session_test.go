@@ -0,0 +1,151 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Intercept sessions", func() {
+	var (
+		factory db.InterceptSessionFactory
+		build   db.Build
+		session db.InterceptSession
+	)
+
+	BeforeEach(func() {
+		factory = db.NewInterceptSessionFactory(dbConn)
+
+		var err error
+		build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		session, err = factory.CreateInterceptSession(
+			defaultTeam.ID(),
+			"some-handle",
+			db.ContainerMetadata{
+				BuildID:      build.ID(),
+				BuildName:    build.Name(),
+				PipelineName: defaultPipeline.Name(),
+				JobName:      defaultJob.Name(),
+				StepName:     "some-task",
+			},
+			"some-user",
+			[]string{"bash", "-l"},
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("saves who started the session and where", func() {
+		Expect(session.ID).ToNot(BeZero())
+		Expect(session.TeamID).To(Equal(defaultTeam.ID()))
+		Expect(session.ContainerHandle).To(Equal("some-handle"))
+		Expect(session.CreatedBy).To(Equal("some-user"))
+		Expect(session.Command).To(Equal([]string{"bash", "-l"}))
+		Expect(session.BuildID).To(Equal(build.ID()))
+		Expect(session.JobName).To(Equal(defaultJob.Name()))
+		Expect(session.StepName).To(Equal("some-task"))
+		Expect(session.StartedAt).ToNot(BeZero())
+		Expect(session.EndedAt).To(BeZero())
+		Expect(session.Recorded).To(BeFalse())
+	})
+
+	It("has no recording until some of it is saved", func() {
+		_, found, err := factory.InterceptSessionRecording(defaultTeam.ID(), session.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	Context("when the recording is saved as the session runs", func() {
+		BeforeEach(func() {
+			err := factory.AppendInterceptSessionRecording(session.ID, []byte("some-"))
+			Expect(err).ToNot(HaveOccurred())
+
+			err = factory.AppendInterceptSessionRecording(session.ID, []byte("recording"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the chunks saved so far, in order", func() {
+			found, exists, err := factory.InterceptSession(defaultTeam.ID(), session.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(found.Recorded).To(BeTrue())
+			Expect(found.EndedAt).To(BeZero())
+
+			recording, exists, err := factory.InterceptSessionRecording(defaultTeam.ID(), session.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(string(recording)).To(Equal("some-recording"))
+		})
+	})
+
+	Context("when the session finishes", func() {
+		BeforeEach(func() {
+			exitStatus := 3
+			err := factory.FinishInterceptSession(session.ID, &exitStatus)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("saves the exit status", func() {
+			found, exists, err := factory.InterceptSession(defaultTeam.ID(), session.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(found.EndedAt).ToNot(BeZero())
+			Expect(found.ExitStatus).ToNot(BeNil())
+			Expect(*found.ExitStatus).To(Equal(3))
+		})
+	})
+
+	It("deletes the sessions started before a given time, along with their recordings", func() {
+		err := factory.AppendInterceptSessionRecording(session.ID, []byte("some-recording"))
+		Expect(err).ToNot(HaveOccurred())
+
+		deleted, err := factory.DeleteInterceptSessionsBefore(session.StartedAt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeZero())
+
+		deleted, err = factory.DeleteInterceptSessionsBefore(session.StartedAt.Add(time.Second))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(Equal(1))
+
+		_, found, err := factory.InterceptSession(defaultTeam.ID(), session.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+
+		_, found, err = factory.InterceptSessionRecording(defaultTeam.ID(), session.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("lists the team's sessions newest first", func() {
+		other, err := factory.CreateInterceptSession(defaultTeam.ID(), "other-handle", db.ContainerMetadata{}, "", []string{"sh"})
+		Expect(err).ToNot(HaveOccurred())
+
+		sessions, err := factory.InterceptSessions(defaultTeam.ID(), 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sessions).To(HaveLen(2))
+		Expect(sessions[0].ID).To(Equal(other.ID))
+		Expect(sessions[1].ID).To(Equal(session.ID))
+
+		sessions, err = factory.InterceptSessions(defaultTeam.ID(), 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sessions).To(HaveLen(1))
+	})
+
+	It("doesn't show the sessions to other teams", func() {
+		otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		sessions, err := factory.InterceptSessions(otherTeam.ID(), 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sessions).To(BeEmpty())
+
+		_, found, err := factory.InterceptSessionRecording(otherTeam.ID(), session.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+})
@@ -102,6 +102,11 @@ type Job interface {
 
 	ClearTaskCache(string, string) (int64, error)
 
+	TestResults(builds int) (atc.JobTestResults, error)
+
+	SLOBuilds(untilBuildID int, limit int) ([]atc.JobSLOBuild, error)
+	SLOReport(breaches int) (atc.JobSLOReport, error)
+
 	AcquireSchedulingLock(lager.Logger) (lock.Lock, bool, error)
 
 	SetHasNewInputs(bool) error
@@ -1426,7 +1431,7 @@ func (j *job) isPipelineOrJobPaused(tx Tx) (bool, error) {
 	return paused, nil
 }
 
-func scanJob(j *job, row scannable) error {
+func scanJob(j *job, row scannable, extra ...interface{}) error {
 	var (
 		config               sql.NullString
 		nonce                sql.NullString
@@ -1435,7 +1440,9 @@ func scanJob(j *job, row scannable) error {
 		pausedAt             sql.NullTime
 	)
 
-	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &pausedBy, &pausedAt)
+	dest := append([]interface{}{&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &pausedBy, &pausedAt}, extra...)
+
+	err := row.Scan(dest...)
 	if err != nil {
 		return err
 	}
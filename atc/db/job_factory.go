@@ -20,6 +20,7 @@ import (
 type JobFactory interface {
 	VisibleJobs([]string) ([]atc.JobSummary, error)
 	AllActiveJobs() ([]atc.JobSummary, error)
+	JobsForPipelines([]int) ([]atc.JobSummary, error)
 	JobsToSchedule() (SchedulerJobs, error)
 }
 
@@ -42,6 +43,7 @@ type SchedulerJob struct {
 	Resources     SchedulerResources
 	ResourceTypes atc.ResourceTypes
 	Prototypes    atc.Prototypes
+	Egress        atc.EgressRules
 }
 
 type SchedulerResources []SchedulerResource
@@ -84,6 +86,7 @@ func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 	defer tx.Rollback()
 
 	rows, err := jobsQuery.
+		Column("p.egress").
 		Where(sq.Expr("j.schedule_requested > j.last_scheduled")).
 		Where(sq.Eq{
 			"j.active": true,
@@ -96,11 +99,36 @@ func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 		return nil, err
 	}
 
-	jobs, err := scanJobs(j.conn, j.lockFactory, rows)
-	if err != nil {
-		return nil, err
+	jobs := Jobs{}
+	pipelineEgress := make(map[int]atc.EgressRules)
+	for rows.Next() {
+		var egressPayload sql.NullString
+
+		job := newEmptyJob(j.conn, j.lockFactory)
+		err = scanJob(job, rows, &egressPayload)
+		if err != nil {
+			Close(rows)
+			return nil, err
+		}
+
+		if _, found := pipelineEgress[job.PipelineID()]; !found {
+			var egress atc.EgressRules
+			if egressPayload.Valid {
+				err = json.Unmarshal([]byte(egressPayload.String), &egress)
+				if err != nil {
+					Close(rows)
+					return nil, err
+				}
+			}
+
+			pipelineEgress[job.PipelineID()] = egress
+		}
+
+		jobs = append(jobs, job)
 	}
 
+	Close(rows)
+
 	var schedulerJobs SchedulerJobs
 	pipelineResourceTypes := make(map[int]ResourceTypes)
 	pipelinePrototypes := make(map[int]Prototypes)
@@ -213,6 +241,7 @@ func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 			Resources:     schedulerResources,
 			ResourceTypes: resourceTypes.Deserialize(),
 			Prototypes:    prototypes.Configs(),
+			Egress:        pipelineEgress[job.PipelineID()],
 		})
 	}
 
@@ -272,6 +301,30 @@ func (j *jobFactory) AllActiveJobs() ([]atc.JobSummary, error) {
 	return dashboard, nil
 }
 
+// JobsForPipelines returns the active jobs of all of the given pipelines at
+// once, for loading them in batches.
+func (j *jobFactory) JobsForPipelines(pipelineIDs []int) ([]atc.JobSummary, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	dashboardFactory := newDashboardFactory(tx, sq.Eq{"p.id": pipelineIDs})
+	dashboard, err := dashboardFactory.buildDashboard()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return dashboard, nil
+}
+
 type dashboardFactory struct {
 	// Constraints that are used by the dashboard queries. For example, a job ID
 	// constraint so that the dashboard will only return the job I have access to
@@ -0,0 +1,166 @@
+package db
+
+import (
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// DefaultJobSLOBreaches is the number of breaches a job's SLO report lists
+// when not asked for a number.
+const DefaultJobSLOBreaches = 10
+
+// SLOBuilds returns up to limit of the job's completed builds, most recent
+// first, starting from the given build or from the latest if it's zero.
+// Aborted builds are left out, as they say nothing about how the job is doing.
+func (j *job) SLOBuilds(untilBuildID int, limit int) ([]atc.JobSLOBuild, error) {
+	query := psql.Select("id", "name", "status", "COALESCE(EXTRACT(EPOCH FROM end_time - start_time), 0)").
+		From("builds").
+		Where(sq.Eq{
+			"job_id":    j.id,
+			"completed": true,
+		}).
+		Where(sq.NotEq{"status": BuildStatusAborted}).
+		OrderBy("id DESC").
+		Limit(uint64(limit))
+
+	if untilBuildID != 0 {
+		query = query.Where(sq.LtOrEq{"id": untilBuildID})
+	}
+
+	rows, err := query.RunWith(j.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var builds []atc.JobSLOBuild
+	for rows.Next() {
+		var (
+			build   atc.JobSLOBuild
+			status  string
+			seconds float64
+		)
+
+		err = rows.Scan(&build.ID, &build.Name, &status, &seconds)
+		if err != nil {
+			return nil, err
+		}
+
+		build.Status = atc.BuildStatus(status)
+		build.Duration = time.Duration(seconds * float64(time.Second))
+
+		builds = append(builds, build)
+	}
+
+	return builds, rows.Err()
+}
+
+// SLOReport returns the job's SLO along with its stats over the SLO's window
+// and the most recent builds that breached it. The stats are measured over
+// the default window for jobs without an SLO.
+func (j *job) SLOReport(breaches int) (atc.JobSLOReport, error) {
+	if breaches <= 0 {
+		breaches = DefaultJobSLOBreaches
+	}
+
+	config, err := j.Config()
+	if err != nil {
+		return atc.JobSLOReport{}, err
+	}
+
+	report := atc.JobSLOReport{
+		SLO:      config.SLO,
+		Breaches: []atc.JobSLOBreach{},
+	}
+
+	window := atc.DefaultJobSLOWindow
+	if config.SLO != nil {
+		window = config.SLO.WindowSize()
+	}
+
+	builds, err := j.SLOBuilds(0, window)
+	if err != nil {
+		return atc.JobSLOReport{}, err
+	}
+
+	report.Stats = atc.NewJobSLOStats(builds)
+
+	rows, err := psql.Select("breach").
+		From("job_slo_breaches").
+		Where(sq.Eq{"job_id": j.id}).
+		OrderBy("build_id DESC").
+		Limit(uint64(breaches)).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return atc.JobSLOReport{}, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var payload string
+		err = rows.Scan(&payload)
+		if err != nil {
+			return atc.JobSLOReport{}, err
+		}
+
+		var breach atc.JobSLOBreach
+		err = json.Unmarshal([]byte(payload), &breach)
+		if err != nil {
+			return atc.JobSLOReport{}, err
+		}
+
+		report.Breaches = append(report.Breaches, breach)
+	}
+
+	if err := rows.Err(); err != nil {
+		return atc.JobSLOReport{}, err
+	}
+
+	return report, nil
+}
+
+// SaveSLOBreach records that the build missed its job's SLO and sends a
+// job_slo_breach event to the team's webhooks. The build should have been
+// reloaded since it finished.
+func (b *build) SaveSLOBreach(stats atc.JobSLOStats, violations []atc.JobSLOViolation) error {
+	breach := atc.JobSLOBreach{
+		Build:      b.webhookBuild(b.status, b.startTime, b.endTime),
+		Stats:      stats,
+		Violations: violations,
+	}
+
+	payload, err := json.Marshal(breach)
+	if err != nil {
+		return err
+	}
+
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("job_slo_breaches").
+		Columns("build_id", "job_id", "breach").
+		Values(b.id, b.jobID, string(payload)).
+		Suffix("ON CONFLICT (build_id) DO UPDATE SET breach = EXCLUDED.breach").
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = enqueueWebhookEvent(tx, b.teamID, atc.WebhookEventJobSLOBreach, breach)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,112 @@
+package db_test
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Job SLOs", func() {
+	var builds []db.Build
+
+	BeforeEach(func() {
+		builds = nil
+
+		for _, status := range []db.BuildStatus{
+			db.BuildStatusSucceeded,
+			db.BuildStatusAborted,
+			db.BuildStatusFailed,
+			db.BuildStatusSucceeded,
+		} {
+			build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(status)
+			Expect(err).ToNot(HaveOccurred())
+
+			builds = append(builds, build)
+		}
+
+		_, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("SLOBuilds", func() {
+		It("returns the completed builds that weren't aborted, most recent first", func() {
+			sloBuilds, err := defaultJob.SLOBuilds(0, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sloBuilds).To(HaveLen(3))
+			Expect(sloBuilds[0].ID).To(Equal(builds[3].ID()))
+			Expect(sloBuilds[0].Status).To(Equal(atc.StatusSucceeded))
+			Expect(sloBuilds[1].ID).To(Equal(builds[2].ID()))
+			Expect(sloBuilds[1].Status).To(Equal(atc.StatusFailed))
+			Expect(sloBuilds[2].ID).To(Equal(builds[0].ID()))
+		})
+
+		It("starts from the given build", func() {
+			sloBuilds, err := defaultJob.SLOBuilds(builds[2].ID(), 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sloBuilds).To(HaveLen(1))
+			Expect(sloBuilds[0].ID).To(Equal(builds[2].ID()))
+		})
+	})
+
+	Describe("SaveSLOBreach", func() {
+		var webhookFactory db.WebhookFactory
+
+		BeforeEach(func() {
+			webhookFactory = db.NewWebhookFactory(dbConn)
+
+			_, err := webhookFactory.SaveWebhook(
+				defaultTeam.ID(),
+				"some-webhook",
+				"https://example.com/hook",
+				"some-secret",
+				[]string{string(atc.WebhookEventJobSLOBreach)},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := builds[2].Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = builds[2].SaveSLOBreach(atc.JobSLOStats{Builds: 3, Succeeded: 2}, []atc.JobSLOViolation{
+				{Kind: atc.JobSLOViolationSuccessRate, Value: 0.66, Target: 0.9},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("is listed in the job's SLO report", func() {
+			report, err := defaultJob.SLOReport(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.SLO).To(BeNil())
+			Expect(report.Stats.Builds).To(Equal(3))
+			Expect(report.Stats.Succeeded).To(Equal(2))
+			Expect(report.Breaches).To(HaveLen(1))
+			Expect(report.Breaches[0].Build.ID).To(Equal(builds[2].ID()))
+			Expect(report.Breaches[0].Build.Status).To(Equal(atc.StatusFailed))
+			Expect(report.Breaches[0].Violations).To(Equal([]atc.JobSLOViolation{
+				{Kind: atc.JobSLOViolationSuccessRate, Value: 0.66, Target: 0.9},
+			}))
+		})
+
+		It("queues a job_slo_breach event", func() {
+			deliveries, err := webhookFactory.PendingWebhookDeliveries(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].Event).To(Equal("job_slo_breach"))
+
+			var payload struct {
+				Data atc.JobSLOBreach `json:"data"`
+			}
+			err = json.Unmarshal([]byte(deliveries[0].Payload), &payload)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(payload.Data.Build.JobName).To(Equal(defaultJob.Name()))
+			Expect(payload.Data.Stats.Builds).To(Equal(3))
+		})
+	})
+})
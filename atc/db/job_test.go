@@ -101,7 +101,7 @@ var _ = Describe("Job", func() {
 					Type: "some-type",
 				},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -243,7 +243,7 @@ var _ = Describe("Job", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -533,7 +533,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -841,7 +841,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -926,7 +926,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -961,7 +961,7 @@ var _ = Describe("Job", func() {
 								Name: "some-job",
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -1774,7 +1774,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild(defaultBuildCreatedBy)
@@ -2662,7 +2662,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2767,7 +2767,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
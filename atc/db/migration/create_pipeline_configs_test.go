@@ -0,0 +1,79 @@
+package migration_test
+
+import (
+	"database/sql"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Create pipeline configs", func() {
+	const preMigrationVersion = 1792454400
+	const postMigrationVersion = 1792540800
+
+	var (
+		db *sql.DB
+	)
+
+	Context("Up", func() {
+		It("saves the current config of each pipeline as its first version", func() {
+			db = postgresRunner.OpenDBAtVersion(preMigrationVersion)
+
+			_, err := db.Exec(`
+				INSERT INTO teams(id, name) VALUES (1, 'some-team');
+
+				INSERT INTO pipelines(id, name, team_id, groups) VALUES
+				(1, 'some-pipeline', 1, '[{"name":"some-group","jobs":["some-job"]}]'),
+				(2, 'empty-pipeline', 1, NULL);
+
+				INSERT INTO resources(name, type, config, pipeline_id, active) VALUES
+				('some-resource', 'git', '{"name":"some-resource","type":"git"}', 1, true),
+				('removed-resource', 'git', '{"name":"removed-resource","type":"git"}', 1, false);
+
+				INSERT INTO jobs(name, config, pipeline_id, active) VALUES
+				('some-job', '{"name":"some-job"}', 1, true);
+			`)
+			Expect(err).NotTo(HaveOccurred())
+
+			_ = db.Close()
+
+			db = postgresRunner.OpenDBAtVersion(postMigrationVersion)
+
+			rows, err := db.Query(`SELECT pipeline_id, version, config, created_by FROM pipeline_configs ORDER BY pipeline_id`)
+			Expect(err).NotTo(HaveOccurred())
+
+			type pipelineConfig struct {
+				pipelineID int
+				version    int
+				config     string
+				createdBy  sql.NullString
+			}
+
+			var configs []pipelineConfig
+			for rows.Next() {
+				var c pipelineConfig
+				err := rows.Scan(&c.pipelineID, &c.version, &c.config, &c.createdBy)
+				Expect(err).NotTo(HaveOccurred())
+
+				configs = append(configs, c)
+			}
+
+			_ = db.Close()
+
+			Expect(configs).To(HaveLen(2))
+
+			Expect(configs[0].pipelineID).To(Equal(1))
+			Expect(configs[0].version).To(Equal(1))
+			Expect(configs[0].createdBy.Valid).To(BeFalse())
+			Expect(configs[0].config).To(MatchJSON(`{
+				"groups": [{"name":"some-group","jobs":["some-job"]}],
+				"resources": [{"name":"some-resource","type":"git"}],
+				"jobs": [{"name":"some-job"}]
+			}`))
+
+			Expect(configs[1].pipelineID).To(Equal(2))
+			Expect(configs[1].version).To(Equal(1))
+			Expect(configs[1].config).To(MatchJSON(`{}`))
+		})
+	})
+})
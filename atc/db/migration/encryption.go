@@ -17,6 +17,9 @@ var encryptedColumns = []encryptedColumn{
 	{"builds", "private_plan", "id"},
 	{"cert_cache", "cert", "domain"},
 	{"pipelines", "var_sources", "id"},
+	{"pipeline_configs", "config", "id"},
+	{"intercept_session_chunks", "data", "id"},
+	{"webhooks", "secret", "id"},
 }
 
 type encryptedColumn struct {
@@ -0,0 +1,2 @@
+ALTER TABLE pipelines
+    ADD COLUMN egress text;
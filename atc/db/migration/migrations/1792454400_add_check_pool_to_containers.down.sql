@@ -0,0 +1,5 @@
+DROP INDEX containers_check_pool_key_idx;
+
+ALTER TABLE containers
+    DROP COLUMN check_pool_key,
+    DROP COLUMN check_pool_last_used;
@@ -0,0 +1,5 @@
+ALTER TABLE containers
+    ADD COLUMN check_pool_key text,
+    ADD COLUMN check_pool_last_used timestamp with time zone;
+
+CREATE INDEX containers_check_pool_key_idx ON containers (worker_name, check_pool_key);
@@ -0,0 +1 @@
+DROP TABLE pipeline_configs;
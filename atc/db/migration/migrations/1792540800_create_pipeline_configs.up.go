@@ -0,0 +1,220 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+func (m *migrations) Up_1792540800() error {
+
+	type pipeline struct {
+		id          int
+		groups      sql.NullString
+		varSources  sql.NullString
+		display     sql.NullString
+		egress      sql.NullString
+		nonce       sql.NullString
+		lastUpdated sql.NullTime
+	}
+
+	// the keys of atc.Config, which the history is saved as
+	type pipelineConfig struct {
+		Groups        json.RawMessage   `json:"groups,omitempty"`
+		VarSources    json.RawMessage   `json:"var_sources,omitempty"`
+		Resources     []json.RawMessage `json:"resources,omitempty"`
+		ResourceTypes []json.RawMessage `json:"resource_types,omitempty"`
+		Prototypes    []json.RawMessage `json:"prototypes,omitempty"`
+		Jobs          []json.RawMessage `json:"jobs,omitempty"`
+		Display       json.RawMessage   `json:"display,omitempty"`
+		Egress        json.RawMessage   `json:"egress,omitempty"`
+	}
+
+	tx := m.Tx
+
+	_, err := tx.Exec(`
+		CREATE TABLE pipeline_configs (
+			id serial PRIMARY KEY,
+			pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+			version integer NOT NULL,
+			config text NOT NULL,
+			nonce text,
+			created_by text,
+			build_id integer REFERENCES builds (id) ON DELETE SET NULL,
+			created_at timestamp with time zone DEFAULT now() NOT NULL
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE UNIQUE INDEX pipeline_configs_pipeline_id_version_uniq
+			ON pipeline_configs (pipeline_id, version)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX pipeline_configs_build_id
+			ON pipeline_configs (build_id)
+	`)
+	if err != nil {
+		return err
+	}
+
+	// empty and null parts of the config are left out, as they're omitted
+	// when a config is saved
+	plainConfig := func(value sql.NullString) json.RawMessage {
+		if !value.Valid || value.String == "" || value.String == "null" {
+			return nil
+		}
+
+		return json.RawMessage(value.String)
+	}
+
+	decrypt := func(value sql.NullString, nonce sql.NullString) (json.RawMessage, error) {
+		if !value.Valid {
+			return nil, nil
+		}
+
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decrypted, err := m.Strategy.Decrypt(value.String, noncense)
+		if err != nil {
+			return nil, err
+		}
+
+		return plainConfig(sql.NullString{String: string(decrypted), Valid: true}), nil
+	}
+
+	// configs are ordered the same as when the config of a pipeline is loaded
+	configs := func(table string, orderBy string, pipelineID int) ([]json.RawMessage, error) {
+		rows, err := tx.Query(fmt.Sprintf(`
+			SELECT config, nonce
+			FROM %s
+			WHERE pipeline_id = $1
+			AND active
+			ORDER BY %s
+		`, table, orderBy), pipelineID)
+		if err != nil {
+			return nil, err
+		}
+
+		defer rows.Close()
+
+		type config struct {
+			value sql.NullString
+			nonce sql.NullString
+		}
+
+		var encrypted []config
+		for rows.Next() {
+			var c config
+			if err = rows.Scan(&c.value, &c.nonce); err != nil {
+				return nil, err
+			}
+
+			encrypted = append(encrypted, c)
+		}
+
+		if err = rows.Err(); err != nil {
+			return nil, err
+		}
+
+		var decrypted []json.RawMessage
+		for _, c := range encrypted {
+			d, err := decrypt(c.value, c.nonce)
+			if err != nil {
+				return nil, err
+			}
+
+			if d != nil {
+				decrypted = append(decrypted, d)
+			}
+		}
+
+		return decrypted, nil
+	}
+
+	rows, err := tx.Query(`
+		SELECT id, groups, var_sources, display, egress, nonce, last_updated
+		FROM pipelines
+	`)
+	if err != nil {
+		return err
+	}
+
+	pipelines := []pipeline{}
+	for rows.Next() {
+
+		p := pipeline{}
+		if err = rows.Scan(&p.id, &p.groups, &p.varSources, &p.display, &p.egress, &p.nonce, &p.lastUpdated); err != nil {
+			rows.Close()
+			return err
+		}
+
+		pipelines = append(pipelines, p)
+	}
+
+	rows.Close()
+
+	// the current config of each pipeline becomes the first version in its
+	// history, so that there's always a version to compare with or go back to
+	for _, p := range pipelines {
+		var config pipelineConfig
+
+		config.Groups = plainConfig(p.groups)
+		config.Display = plainConfig(p.display)
+		config.Egress = plainConfig(p.egress)
+
+		config.VarSources, err = decrypt(p.varSources, p.nonce)
+		if err != nil {
+			return err
+		}
+
+		config.Resources, err = configs("resources", "name", p.id)
+		if err != nil {
+			return err
+		}
+
+		config.ResourceTypes, err = configs("resource_types", "name", p.id)
+		if err != nil {
+			return err
+		}
+
+		config.Prototypes, err = configs("prototypes", "name", p.id)
+		if err != nil {
+			return err
+		}
+
+		config.Jobs, err = configs("jobs", "id", p.id)
+		if err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(config)
+		if err != nil {
+			return err
+		}
+
+		encrypted, nonce, err := m.Strategy.Encrypt(payload)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO pipeline_configs (pipeline_id, version, config, nonce, created_at)
+			VALUES ($1, 1, $2, $3, COALESCE($4, now()))
+		`, p.id, encrypted, nonce, p.lastUpdated)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,2 @@
+DROP TABLE intercept_session_chunks;
+DROP TABLE intercept_sessions;
@@ -0,0 +1,36 @@
+CREATE TABLE intercept_sessions (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    container_handle text NOT NULL,
+    created_by text,
+    command text NOT NULL,
+    build_id integer REFERENCES builds (id) ON DELETE SET NULL,
+    build_name text,
+    pipeline_name text,
+    job_name text,
+    step_name text,
+    started_at timestamp with time zone DEFAULT now() NOT NULL,
+    ended_at timestamp with time zone,
+    exit_status integer
+);
+
+CREATE INDEX intercept_sessions_team_id
+    ON intercept_sessions (team_id);
+
+CREATE INDEX intercept_sessions_build_id
+    ON intercept_sessions (build_id);
+
+-- recordings are saved in chunks as the session runs, rather than all at
+-- once when it ends
+CREATE TABLE intercept_session_chunks (
+    id serial PRIMARY KEY,
+    session_id integer NOT NULL REFERENCES intercept_sessions (id) ON DELETE CASCADE,
+    data text NOT NULL,
+    nonce text
+);
+
+CREATE INDEX intercept_session_chunks_session_id
+    ON intercept_session_chunks (session_id);
+
+CREATE INDEX intercept_sessions_started_at
+    ON intercept_sessions (started_at);
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS workers_insert_webhooks_trigger ON workers;
+DROP TRIGGER IF EXISTS workers_update_webhooks_trigger ON workers;
+DROP TRIGGER IF EXISTS workers_delete_webhooks_trigger ON workers;
+
+DROP FUNCTION IF EXISTS enqueue_worker_state_webhooks();
+
+DROP TABLE webhook_deliveries;
+DROP TABLE webhooks;
@@ -0,0 +1,92 @@
+CREATE TABLE webhooks (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    url text NOT NULL,
+    secret text NOT NULL,
+    nonce text,
+    events text[] DEFAULT '{}' NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    UNIQUE (team_id, name)
+);
+
+CREATE TABLE webhook_deliveries (
+    id serial PRIMARY KEY,
+    webhook_id integer NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event text NOT NULL,
+    payload text NOT NULL,
+    status text DEFAULT 'pending' NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    response_status integer,
+    error text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_attempt_at timestamp with time zone,
+    next_attempt_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+CREATE INDEX webhook_deliveries_webhook_id
+    ON webhook_deliveries (webhook_id);
+
+CREATE INDEX webhook_deliveries_pending
+    ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+-- worker states are changed all over (registration, heartbeats, landing,
+-- retiring, the worker collector), so their events are queued here rather
+-- than by each of them
+CREATE FUNCTION enqueue_worker_state_webhooks() RETURNS trigger AS $trigger$
+DECLARE
+  rec RECORD;
+  new_state TEXT;
+  old_state TEXT;
+  worker_team TEXT;
+  body TEXT;
+BEGIN
+  CASE TG_OP
+  WHEN 'INSERT' THEN
+    rec := NEW;
+    new_state := NEW.state;
+  WHEN 'UPDATE' THEN
+    rec := NEW;
+    new_state := NEW.state;
+    old_state := OLD.state;
+  WHEN 'DELETE' THEN
+    rec := OLD;
+    new_state := 'removed';
+    old_state := OLD.state;
+  ELSE
+    RAISE EXCEPTION 'Unknown TG_OP: "%". Should not occur!', TG_OP;
+  END CASE;
+
+  IF rec.team_id IS NOT NULL THEN
+    SELECT t.name INTO worker_team FROM teams t WHERE t.id = rec.team_id;
+  END IF;
+
+  body := json_build_object(
+    'event', 'worker_state',
+    'timestamp', floor(extract(epoch FROM now()))::bigint,
+    'data', json_strip_nulls(json_build_object(
+      'name', rec.name,
+      'team', worker_team,
+      'state', new_state,
+      'previous_state', old_state
+    ))
+  );
+
+  INSERT INTO webhook_deliveries (webhook_id, event, payload)
+  SELECT w.id, 'worker_state', body
+  FROM webhooks w
+  WHERE (rec.team_id IS NULL OR w.team_id = rec.team_id)
+  AND (cardinality(w.events) = 0 OR 'worker_state' = ANY(w.events));
+
+  RETURN NULL;
+END;
+$trigger$ LANGUAGE plpgsql;
+
+CREATE TRIGGER workers_insert_webhooks_trigger AFTER INSERT ON workers
+  FOR EACH ROW EXECUTE PROCEDURE enqueue_worker_state_webhooks();
+
+CREATE TRIGGER workers_update_webhooks_trigger AFTER UPDATE OF state ON workers
+  FOR EACH ROW WHEN (OLD.state IS DISTINCT FROM NEW.state) EXECUTE PROCEDURE enqueue_worker_state_webhooks();
+
+CREATE TRIGGER workers_delete_webhooks_trigger AFTER DELETE ON workers
+  FOR EACH ROW EXECUTE PROCEDURE enqueue_worker_state_webhooks();
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS builds_activity_trigger ON builds;
+DROP TRIGGER IF EXISTS jobs_activity_trigger ON jobs;
+DROP TRIGGER IF EXISTS pipelines_insert_or_delete_activity_trigger ON pipelines;
+DROP TRIGGER IF EXISTS pipelines_update_activity_trigger ON pipelines;
+
+DROP FUNCTION IF EXISTS notify_build_activity();
+DROP FUNCTION IF EXISTS notify_job_activity();
+DROP FUNCTION IF EXISTS notify_pipeline_activity();
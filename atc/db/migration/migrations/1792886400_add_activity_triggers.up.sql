@@ -0,0 +1,137 @@
+-- Activity events are sent to the activity_events_channel for the activity
+-- streams to pick up. They are kept small, as notification payloads are
+-- limited to 8000 bytes; identical payloads sent in the same transaction are
+-- only delivered once.
+--
+-- Discovered resource versions are sent by the ATC once it has saved them,
+-- rather than by a trigger, so that a check saving many versions only looks
+-- up the resources to notify once.
+
+CREATE FUNCTION notify_build_activity() RETURNS trigger AS $trigger$
+DECLARE
+  event_type TEXT;
+  team_name TEXT;
+  job_name TEXT;
+  pipeline_name TEXT;
+  pipeline_instance_vars JSONB;
+BEGIN
+  -- builds of checks are not activity anyone is interested in
+  IF NEW.resource_id IS NOT NULL OR NEW.resource_type_id IS NOT NULL THEN
+    RETURN NULL;
+  END IF;
+
+  IF NEW.status = 'started' THEN
+    event_type := 'build_started';
+  ELSIF NEW.status IN ('succeeded', 'failed', 'errored', 'aborted') THEN
+    event_type := 'build_finished';
+  ELSE
+    RETURN NULL;
+  END IF;
+
+  SELECT t.name INTO team_name FROM teams t WHERE t.id = NEW.team_id;
+
+  IF NEW.job_id IS NOT NULL THEN
+    SELECT j.name INTO job_name FROM jobs j WHERE j.id = NEW.job_id;
+  END IF;
+
+  IF NEW.pipeline_id IS NOT NULL THEN
+    SELECT p.name, p.instance_vars INTO pipeline_name, pipeline_instance_vars FROM pipelines p WHERE p.id = NEW.pipeline_id;
+  END IF;
+
+  PERFORM pg_notify('activity_events_channel', json_strip_nulls(json_build_object(
+    'type', event_type,
+    'time', floor(extract(epoch FROM now()))::bigint,
+    'team_id', NEW.team_id,
+    'team_name', team_name,
+    'pipeline_id', NEW.pipeline_id,
+    'pipeline_name', pipeline_name,
+    'pipeline_instance_vars', pipeline_instance_vars,
+    'job_name', job_name,
+    'build_id', NEW.id,
+    'build_name', NEW.name,
+    'build_status', NEW.status
+  ))::text);
+
+  RETURN NULL;
+END;
+$trigger$ LANGUAGE plpgsql;
+
+CREATE FUNCTION notify_job_activity() RETURNS trigger AS $trigger$
+DECLARE
+  pipeline RECORD;
+BEGIN
+  SELECT p.name, p.instance_vars, p.team_id, t.name AS team_name INTO pipeline
+  FROM pipelines p
+  JOIN teams t ON t.id = p.team_id
+  WHERE p.id = NEW.pipeline_id;
+
+  PERFORM pg_notify('activity_events_channel', json_strip_nulls(json_build_object(
+    'type', 'job_changed',
+    'time', floor(extract(epoch FROM now()))::bigint,
+    'team_id', pipeline.team_id,
+    'team_name', pipeline.team_name,
+    'pipeline_id', NEW.pipeline_id,
+    'pipeline_name', pipeline.name,
+    'pipeline_instance_vars', pipeline.instance_vars,
+    'job_name', NEW.name,
+    'paused', NEW.paused,
+    'active', NEW.active
+  ))::text);
+
+  RETURN NULL;
+END;
+$trigger$ LANGUAGE plpgsql;
+
+CREATE FUNCTION notify_pipeline_activity() RETURNS trigger AS $trigger$
+DECLARE
+  rec RECORD;
+  event_type TEXT;
+  team_name TEXT;
+BEGIN
+  CASE TG_OP
+  WHEN 'INSERT', 'UPDATE' THEN
+    rec := NEW;
+    event_type := 'pipeline_changed';
+  WHEN 'DELETE' THEN
+    rec := OLD;
+    event_type := 'pipeline_destroyed';
+  ELSE
+    RAISE EXCEPTION 'Unknown TG_OP: "%". Should not occur!', TG_OP;
+  END CASE;
+
+  SELECT t.name INTO team_name FROM teams t WHERE t.id = rec.team_id;
+
+  PERFORM pg_notify('activity_events_channel', json_strip_nulls(json_build_object(
+    'type', event_type,
+    'time', floor(extract(epoch FROM now()))::bigint,
+    'team_id', rec.team_id,
+    'team_name', team_name,
+    'pipeline_id', rec.id,
+    'pipeline_name', rec.name,
+    'pipeline_instance_vars', rec.instance_vars,
+    'paused', rec.paused,
+    'archived', rec.archived,
+    'public', rec.public
+  ))::text);
+
+  RETURN NULL;
+END;
+$trigger$ LANGUAGE plpgsql;
+
+CREATE TRIGGER builds_activity_trigger AFTER UPDATE OF status ON builds
+  FOR EACH ROW WHEN (OLD.status IS DISTINCT FROM NEW.status) EXECUTE PROCEDURE notify_build_activity();
+
+CREATE TRIGGER jobs_activity_trigger AFTER UPDATE OF paused, active ON jobs
+  FOR EACH ROW WHEN (OLD.paused IS DISTINCT FROM NEW.paused OR OLD.active IS DISTINCT FROM NEW.active) EXECUTE PROCEDURE notify_job_activity();
+
+CREATE TRIGGER pipelines_insert_or_delete_activity_trigger AFTER INSERT OR DELETE ON pipelines
+  FOR EACH ROW EXECUTE PROCEDURE notify_pipeline_activity();
+
+CREATE TRIGGER pipelines_update_activity_trigger AFTER UPDATE OF name, version, paused, archived, public ON pipelines
+  FOR EACH ROW WHEN (
+    OLD.name IS DISTINCT FROM NEW.name OR
+    OLD.version IS DISTINCT FROM NEW.version OR
+    OLD.paused IS DISTINCT FROM NEW.paused OR
+    OLD.archived IS DISTINCT FROM NEW.archived OR
+    OLD.public IS DISTINCT FROM NEW.public
+  ) EXECUTE PROCEDURE notify_pipeline_activity();
@@ -0,0 +1,7 @@
+CREATE TABLE build_annotations (
+    build_id INTEGER PRIMARY KEY,
+    annotations jsonb NOT NULL DEFAULT '[]'
+);
+
+ALTER TABLE build_annotations
+  ADD CONSTRAINT build_annotations_build_id_fkey FOREIGN KEY (build_id) REFERENCES builds(id) ON DELETE CASCADE;
@@ -0,0 +1,22 @@
+CREATE TABLE build_test_results (
+    id bigserial PRIMARY KEY,
+    build_id integer NOT NULL,
+    job_id integer,
+    step text NOT NULL,
+    suite text NOT NULL,
+    class_name text NOT NULL DEFAULT '',
+    name text NOT NULL,
+    status text NOT NULL,
+    duration double precision NOT NULL DEFAULT 0,
+    message text
+);
+
+ALTER TABLE build_test_results
+  ADD CONSTRAINT build_test_results_build_id_fkey FOREIGN KEY (build_id) REFERENCES builds(id) ON DELETE CASCADE;
+
+ALTER TABLE build_test_results
+  ADD CONSTRAINT build_test_results_job_id_fkey FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE;
+
+CREATE INDEX build_test_results_build_id_idx ON build_test_results (build_id);
+
+CREATE INDEX build_test_results_job_id_build_id_idx ON build_test_results (job_id, build_id DESC);
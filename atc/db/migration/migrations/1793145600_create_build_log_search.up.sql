@@ -0,0 +1,14 @@
+CREATE TABLE build_log_search (
+    build_id integer NOT NULL,
+    event_id integer NOT NULL,
+    origin text NOT NULL DEFAULT '',
+    time timestamp with time zone NOT NULL,
+    payload text NOT NULL,
+    tsv tsvector NOT NULL,
+    PRIMARY KEY (build_id, event_id)
+);
+
+ALTER TABLE build_log_search
+  ADD CONSTRAINT build_log_search_build_id_fkey FOREIGN KEY (build_id) REFERENCES builds(id) ON DELETE CASCADE;
+
+CREATE INDEX build_log_search_tsv_idx ON build_log_search USING gin (tsv);
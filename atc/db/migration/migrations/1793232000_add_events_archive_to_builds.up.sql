@@ -0,0 +1,3 @@
+
+ALTER TABLE builds
+  ADD COLUMN events_archive text;
@@ -0,0 +1,7 @@
+CREATE TABLE build_provenance (
+    build_id INTEGER PRIMARY KEY,
+    envelope jsonb NOT NULL
+);
+
+ALTER TABLE build_provenance
+  ADD CONSTRAINT build_provenance_build_id_fkey FOREIGN KEY (build_id) REFERENCES builds(id) ON DELETE CASCADE;
@@ -0,0 +1,13 @@
+CREATE TABLE job_slo_breaches (
+    build_id INTEGER PRIMARY KEY,
+    job_id INTEGER NOT NULL,
+    breach jsonb NOT NULL
+);
+
+ALTER TABLE job_slo_breaches
+  ADD CONSTRAINT job_slo_breaches_build_id_fkey FOREIGN KEY (build_id) REFERENCES builds(id) ON DELETE CASCADE;
+
+ALTER TABLE job_slo_breaches
+  ADD CONSTRAINT job_slo_breaches_job_id_fkey FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE;
+
+CREATE INDEX job_slo_breaches_job_id ON job_slo_breaches (job_id, build_id DESC);
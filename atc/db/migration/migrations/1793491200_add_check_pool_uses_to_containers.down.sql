@@ -0,0 +1,2 @@
+ALTER TABLE containers
+    DROP COLUMN check_pool_uses;
@@ -0,0 +1,2 @@
+ALTER TABLE containers
+    ADD COLUMN check_pool_uses integer DEFAULT 0 NOT NULL;
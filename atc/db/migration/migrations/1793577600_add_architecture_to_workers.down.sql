@@ -0,0 +1,3 @@
+ALTER TABLE workers
+    DROP COLUMN architecture,
+    DROP COLUMN variant;
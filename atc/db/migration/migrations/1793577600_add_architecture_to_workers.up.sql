@@ -0,0 +1,3 @@
+ALTER TABLE workers
+    ADD COLUMN architecture text,
+    ADD COLUMN variant text;
@@ -0,0 +1,2 @@
+ALTER TABLE build_provenance
+    DROP COLUMN subjects;
@@ -0,0 +1,2 @@
+ALTER TABLE build_provenance
+    ADD COLUMN subjects integer NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE containers
+    ADD COLUMN check_pool_leased_until timestamp with time zone;
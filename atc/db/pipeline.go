@@ -63,8 +63,12 @@ type Pipeline interface {
 	Groups() atc.GroupConfigs
 	VarSources() atc.VarSourceConfigs
 	Display() *atc.DisplayConfig
+	Egress() atc.EgressRules
+	Lint() *atc.LintConfig
 	ConfigVersion() ConfigVersion
 	Config() (atc.Config, error)
+	ConfigHistory() ([]PipelineConfig, error)
+	ConfigAtVersion(version int) (PipelineConfig, bool, error)
 	Public() bool
 	Archived() bool
 	LastUpdated() time.Time
@@ -139,6 +143,8 @@ type pipeline struct {
 	groups        atc.GroupConfigs
 	varSources    atc.VarSourceConfigs
 	display       *atc.DisplayConfig
+	egress        atc.EgressRules
+	lint          *atc.LintConfig
 	configVersion ConfigVersion
 	paused        bool
 	pausedBy      string
@@ -172,7 +178,9 @@ var pipelinesQuery = psql.Select(`
 		p.parent_build_id,
 		p.instance_vars,
 		p.paused_by,
-		p.paused_at`).
+		p.paused_at,
+		p.egress,
+		p.lint`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
 
@@ -193,6 +201,8 @@ func (p *pipeline) InstanceVars() atc.InstanceVars   { return p.instanceVars }
 func (p *pipeline) Groups() atc.GroupConfigs         { return p.groups }
 func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
 func (p *pipeline) Display() *atc.DisplayConfig      { return p.display }
+func (p *pipeline) Egress() atc.EgressRules          { return p.egress }
+func (p *pipeline) Lint() *atc.LintConfig            { return p.lint }
 func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
 func (p *pipeline) Public() bool                     { return p.public }
 func (p *pipeline) Paused() bool                     { return p.paused }
@@ -267,6 +277,8 @@ func (p *pipeline) Config() (atc.Config, error) {
 		Prototypes:    prototypes.Configs(),
 		Jobs:          jobConfigs,
 		Display:       p.Display(),
+		Egress:        p.Egress(),
+		Lint:          p.Lint(),
 	}
 
 	return config, nil
@@ -659,19 +671,42 @@ func (p *pipeline) Dashboard() ([]atc.JobSummary, error) {
 }
 
 func (p *pipeline) Pause(pausedBy string) error {
-	_, err := psql.Update("pipelines").
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("pipelines").
 		Set("paused", true).
 		Set("paused_at", time.Now()).
 		Set("paused_by", pausedBy).
 		Where(sq.Eq{"id": p.id, "paused": false}).
-		RunWith(p.conn).
+		RunWith(tx).
 		Exec()
 
 	if err != nil {
 		return err
 	}
 
-	return nil
+	paused, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if paused > 0 {
+		err = enqueueWebhookEvent(tx, p.teamID, atc.WebhookEventPipelinePaused, atc.WebhookPipeline{
+			Name:         p.name,
+			InstanceVars: p.instanceVars,
+			By:           pausedBy,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
 func (p *pipeline) Unpause() error {
@@ -682,7 +717,7 @@ func (p *pipeline) Unpause() error {
 
 	defer Rollback(tx)
 
-	_, err = psql.Update("pipelines").
+	result, err := psql.Update("pipelines").
 		Set("paused", false).
 		Set("paused_by", nil).
 		Set("paused_at", nil).
@@ -694,6 +729,21 @@ func (p *pipeline) Unpause() error {
 		return err
 	}
 
+	unpaused, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if unpaused > 0 {
+		err = enqueueWebhookEvent(tx, p.teamID, atc.WebhookEventPipelineUnpaused, atc.WebhookPipeline{
+			Name:         p.name,
+			InstanceVars: p.instanceVars,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	err = requestScheduleForJobsInPipeline(tx, p.id)
 	if err != nil {
 		return err
@@ -1009,6 +1059,11 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 		return err
 	}
 
+	err = deleteBuildLogSearch(tx, buildIDs)
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(`
 		UPDATE builds
 		SET reap_time = now()
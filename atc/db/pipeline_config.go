@@ -0,0 +1,190 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// PipelineConfig is a config saved for a pipeline, kept in its history.
+// Versions are numbered from 1 for each pipeline, in the order they were
+// saved.
+type PipelineConfig struct {
+	Version   int
+	CreatedBy string
+	CreatedAt time.Time
+
+	// The set_pipeline step that saved the config, if any.
+	BuildID   int
+	BuildName string
+	JobName   string
+
+	// Config is only loaded for a single version; see ConfigAtVersion.
+	Config atc.Config
+}
+
+// MaxPipelineConfigVersions is how many versions are kept in a pipeline's
+// history. Older versions are removed as new ones are saved.
+const MaxPipelineConfigVersions = 100
+
+var pipelineConfigsQuery = psql.Select(
+	"c.version",
+	"c.created_by",
+	"c.created_at",
+	"c.build_id",
+	"b.name",
+	"j.name",
+).
+	From("pipeline_configs c").
+	LeftJoin("builds b ON b.id = c.build_id").
+	LeftJoin("jobs j ON j.id = b.job_id")
+
+// saveConfigVersion records the config in the pipeline's history, as the
+// version after the latest one, and removes the versions that no longer fit.
+func saveConfigVersion(
+	tx Tx,
+	pipelineID int,
+	config atc.Config,
+	createdBy sql.NullString,
+	buildID sql.NullInt64,
+) error {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("pipeline_configs").
+		SetMap(map[string]interface{}{
+			"pipeline_id": pipelineID,
+			"version": sq.Expr(
+				"(SELECT COALESCE(MAX(version), 0) + 1 FROM pipeline_configs WHERE pipeline_id = ?)",
+				pipelineID,
+			),
+			"config":     encryptedPayload,
+			"nonce":      nonce,
+			"created_by": createdBy,
+			"build_id":   buildID,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("pipeline_configs").
+		Where(sq.Eq{"pipeline_id": pipelineID}).
+		Where(sq.Expr(
+			"version <= (SELECT MAX(version) FROM pipeline_configs WHERE pipeline_id = ?) - ?",
+			pipelineID,
+			MaxPipelineConfigVersions,
+		)).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func (p *pipeline) ConfigHistory() ([]PipelineConfig, error) {
+	rows, err := pipelineConfigsQuery.
+		Where(sq.Eq{"c.pipeline_id": p.id}).
+		OrderBy("c.version DESC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var configs []PipelineConfig
+	for rows.Next() {
+		var config PipelineConfig
+		err = scanPipelineConfig(&config, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs, rows.Err()
+}
+
+func (p *pipeline) ConfigAtVersion(version int) (PipelineConfig, bool, error) {
+	var rawConfig string
+	var nonce sql.NullString
+
+	var config PipelineConfig
+	err := scanPipelineConfig(
+		&config,
+		pipelineConfigsQuery.
+			Columns("c.config", "c.nonce").
+			Where(sq.Eq{
+				"c.pipeline_id": p.id,
+				"c.version":     version,
+			}).
+			RunWith(p.conn).
+			QueryRow(),
+		&rawConfig,
+		&nonce,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PipelineConfig{}, false, nil
+		}
+
+		return PipelineConfig{}, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := p.conn.EncryptionStrategy().Decrypt(rawConfig, noncense)
+	if err != nil {
+		return PipelineConfig{}, false, err
+	}
+
+	err = json.Unmarshal(decryptedConfig, &config.Config)
+	if err != nil {
+		return PipelineConfig{}, false, err
+	}
+
+	return config, true, nil
+}
+
+func scanPipelineConfig(config *PipelineConfig, row scannable, extra ...interface{}) error {
+	var (
+		createdBy, buildName, jobName sql.NullString
+		buildID                       sql.NullInt64
+	)
+
+	dest := append([]interface{}{
+		&config.Version,
+		&createdBy,
+		&config.CreatedAt,
+		&buildID,
+		&buildName,
+		&jobName,
+	}, extra...)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return err
+	}
+
+	config.CreatedBy = createdBy.String
+	config.BuildID = int(buildID.Int64)
+	config.BuildName = buildName.String
+	config.JobName = jobName.String
+
+	return nil
+}
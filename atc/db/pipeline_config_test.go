@@ -0,0 +1,123 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipeline config history", func() {
+	var (
+		pipelineRef atc.PipelineRef
+		config      atc.Config
+		pipeline    db.Pipeline
+	)
+
+	BeforeEach(func() {
+		pipelineRef = atc.PipelineRef{Name: "history-pipeline"}
+		config = atc.Config{
+			Jobs: atc.JobConfigs{{Name: "some-job"}},
+		}
+
+		var err error
+		pipeline, _, err = defaultTeam.SavePipeline(pipelineRef, config, db.ConfigVersion(0), false, "some-user")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("records the first config as version 1", func() {
+		history, err := pipeline.ConfigHistory()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].Version).To(Equal(1))
+		Expect(history[0].CreatedBy).To(Equal("some-user"))
+		Expect(history[0].CreatedAt).ToNot(BeZero())
+		Expect(history[0].BuildID).To(BeZero())
+	})
+
+	Context("when the pipeline is saved again", func() {
+		var newConfig atc.Config
+
+		BeforeEach(func() {
+			newConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-other-job"}},
+			}
+
+			var err error
+			pipeline, _, err = defaultTeam.SavePipeline(pipelineRef, newConfig, pipeline.ConfigVersion(), false, "")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists the versions newest first", func() {
+			history, err := pipeline.ConfigHistory()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(2))
+			Expect(history[0].Version).To(Equal(2))
+			Expect(history[0].CreatedBy).To(BeEmpty())
+			Expect(history[1].Version).To(Equal(1))
+		})
+
+		It("keeps the config of each version", func() {
+			version, found, err := pipeline.ConfigAtVersion(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(version.Config).To(Equal(config))
+
+			version, found, err = pipeline.ConfigAtVersion(2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(version.Config).To(Equal(newConfig))
+		})
+	})
+
+	Context("when the pipeline is saved by a set_pipeline step", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild("some-other-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			pipeline, _, err = build.SavePipeline(pipelineRef, defaultTeam.ID(), config, pipeline.ConfigVersion(), false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("records the build", func() {
+			history, err := pipeline.ConfigHistory()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history[0].BuildID).To(Equal(build.ID()))
+			Expect(history[0].BuildName).To(Equal(build.Name()))
+			Expect(history[0].JobName).To(Equal(defaultJob.Name()))
+			Expect(history[0].CreatedBy).To(Equal("some-other-user"))
+		})
+	})
+
+	Context("when the pipeline has been saved more times than the history keeps", func() {
+		BeforeEach(func() {
+			for i := 0; i < db.MaxPipelineConfigVersions; i++ {
+				var err error
+				pipeline, _, err = defaultTeam.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("removes the oldest versions", func() {
+			history, err := pipeline.ConfigHistory()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(db.MaxPipelineConfigVersions))
+			Expect(history[0].Version).To(Equal(db.MaxPipelineConfigVersions + 1))
+			Expect(history[len(history)-1].Version).To(Equal(2))
+
+			_, found, err := pipeline.ConfigAtVersion(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	It("does not find versions that were never saved", func() {
+		_, found, err := pipeline.ConfigAtVersion(2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+})
@@ -106,7 +106,7 @@ var _ = Describe("PipelineLifecycle", func() {
 							Name: "a-different-job",
 						},
 					}
-					defaultTeam.SavePipeline(defaultPipelineRef, defaultPipelineConfig, defaultPipeline.ConfigVersion(), false)
+					defaultTeam.SavePipeline(defaultPipelineRef, defaultPipelineConfig, defaultPipeline.ConfigVersion(), false, "")
 				})
 
 				It("archives all child pipelines set by the deleted job", func() {
@@ -167,9 +167,9 @@ var _ = Describe("PipelineLifecycle", func() {
 		)
 
 		BeforeEach(func() {
-			pipeline1, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, defaultPipelineConfig, 0, false)
+			pipeline1, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, defaultPipelineConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, defaultPipelineConfig, 0, false)
+			pipeline2, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, defaultPipelineConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -37,7 +37,7 @@ var _ = Describe("PipelinePauser", func() {
 			Context("and one job has zero builds", func() {
 				It("should be paused", func() {
 					By("creating a pipeline with two jobs")
-					twoJobPipeline, _, err = defaultTeam.SavePipeline(pipelineRef, twoJobPipelineConfig, db.ConfigVersion(0), false)
+					twoJobPipeline, _, err = defaultTeam.SavePipeline(pipelineRef, twoJobPipelineConfig, db.ConfigVersion(0), false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(twoJobPipeline.Paused()).To(BeFalse(), "pipeline should start unpaused")
 					By("making it look like the pipeline was set 15 days ago as well")
@@ -70,7 +70,7 @@ var _ = Describe("PipelinePauser", func() {
 			Context("all jobs have builds", func() {
 				It("should be paused", func() {
 					By("creating a pipeline with two jobs")
-					twoJobPipeline, _, err = defaultTeam.SavePipeline(pipelineRef, twoJobPipelineConfig, db.ConfigVersion(0), false)
+					twoJobPipeline, _, err = defaultTeam.SavePipeline(pipelineRef, twoJobPipelineConfig, db.ConfigVersion(0), false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(twoJobPipeline.Paused()).To(BeFalse(), "pipeline should start unpaused")
 					By("making it look like the pipeline was set 15 days ago as well")
@@ -134,7 +134,7 @@ var _ = Describe("PipelinePauser", func() {
 		Context("last run was 1 day ago", func() {
 			It("should not be paused", func() {
 				By("creating a pipeline with two jobs")
-				twoJobPipeline, _, err = defaultTeam.SavePipeline(pipelineRef, twoJobPipelineConfig, db.ConfigVersion(0), false)
+				twoJobPipeline, _, err = defaultTeam.SavePipeline(pipelineRef, twoJobPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(twoJobPipeline.Paused()).To(BeFalse(), "pipeline should start unpaused")
 
@@ -171,7 +171,7 @@ var _ = Describe("PipelinePauser", func() {
 		Context("last run was 10 days ago", func() {
 			It("should not be paused", func() {
 				By("creating a pipeline with two jobs")
-				twoJobPipeline, _, err = defaultTeam.SavePipeline(pipelineRef, twoJobPipelineConfig, db.ConfigVersion(0), false)
+				twoJobPipeline, _, err = defaultTeam.SavePipeline(pipelineRef, twoJobPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(twoJobPipeline.Paused()).To(BeFalse(), "pipeline should start unpaused")
 
@@ -209,7 +209,7 @@ var _ = Describe("PipelinePauser", func() {
 	Describe("newly set pipeline whose jobs have no builds", func() {
 		It("should not be paused if all of its jobs have no builds", func() {
 			By("creating a new pipeline")
-			newPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "new-pipeline"}, defaultPipelineConfig, db.ConfigVersion(0), false)
+			newPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "new-pipeline"}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(newPipeline.Paused()).To(BeFalse(), "pipeline should start unpaused")
 
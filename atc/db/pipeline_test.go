@@ -55,6 +55,9 @@ var _ = Describe("Pipeline", func() {
 			Display: &atc.DisplayConfig{
 				BackgroundImage: "background.jpg",
 			},
+			Lint: &atc.LintConfig{
+				Disable: []string{"privileged-task"},
+			},
 			Jobs: atc.JobConfigs{
 				{
 					Name: "job-name",
@@ -174,7 +177,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false)
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -2006,7 +2009,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -2026,7 +2029,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(found).To(BeTrue())
 			}
 
-			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err := otherPipeline.Job("some-job")
@@ -2182,7 +2185,7 @@ var _ = Describe("Pipeline", func() {
 				})
 
 				var created bool
-				pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false)
+				pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
 			})
@@ -125,6 +125,11 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 		if err != nil {
 			return err
 		}
+
+		err = notifyResourceVersionsDiscovered(tx, rcsID)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = tx.Commit()
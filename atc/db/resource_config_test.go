@@ -61,6 +61,7 @@ var _ = Describe("ResourceConfig", func() {
 				},
 				0,
 				false,
+				"",
 			)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
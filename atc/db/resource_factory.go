@@ -12,6 +12,7 @@ type ResourceFactory interface {
 	Resource(int) (Resource, bool, error)
 	VisibleResources([]string) ([]Resource, error)
 	AllResources() ([]Resource, error)
+	ResourcesForPipelines([]int) ([]Resource, error)
 }
 
 type resourceFactory struct {
@@ -75,6 +76,21 @@ func (r *resourceFactory) AllResources() ([]Resource, error) {
 	return scanResources(rows, r.conn, r.lockFactory)
 }
 
+// ResourcesForPipelines returns the active resources of all of the given
+// pipelines at once, for loading them in batches.
+func (r *resourceFactory) ResourcesForPipelines(pipelineIDs []int) ([]Resource, error) {
+	rows, err := resourcesQuery.
+		Where(sq.Eq{"r.pipeline_id": pipelineIDs}).
+		OrderBy("r.id ASC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanResources(rows, r.conn, r.lockFactory)
+}
+
 func scanResources(resourceRows *sql.Rows, conn Conn, lockFactory lock.LockFactory) ([]Resource, error) {
 	var resources []Resource
 
@@ -25,7 +25,7 @@ var _ = Describe("Resource Factory", func() {
 				Resources: atc.ResourceConfigs{
 					{Name: "public-pipeline-resource"},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
@@ -33,7 +33,7 @@ var _ = Describe("Resource Factory", func() {
 				Resources: atc.ResourceConfigs{
 					{Name: "private-pipeline-resource"},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
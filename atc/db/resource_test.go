@@ -79,6 +79,7 @@ var _ = Describe("Resource", func() {
 			},
 			0,
 			false,
+			"",
 		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
@@ -424,6 +425,7 @@ var _ = Describe("Resource", func() {
 				config,
 				0,
 				false,
+				"",
 			)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
@@ -1465,7 +1467,7 @@ var _ = Describe("Resource", func() {
 		})
 
 		setupCheckPlan := func(pipelineName string, config atc.Config, resourceName string, sourceDefault atc.Source, resourceTypes atc.ResourceTypes) {
-			pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false)
+			pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -3,3 +3,14 @@ package db
 type scannable interface {
 	Scan(destinations ...interface{}) error
 }
+
+// extraColumnsScanner scans the columns selected after the ones a scan
+// function knows about into extra.
+type extraColumnsScanner struct {
+	row   scannable
+	extra []interface{}
+}
+
+func (s extraColumnsScanner) Scan(destinations ...interface{}) error {
+	return s.row.Scan(append(destinations, s.extra...)...)
+}
@@ -45,6 +45,7 @@ type Team interface {
 		config atc.Config,
 		from ConfigVersion,
 		initiallyPaused bool,
+		createdBy string,
 	) (Pipeline, bool, error)
 	RenamePipeline(oldName string, newName string) (bool, error)
 
@@ -60,6 +61,7 @@ type Team interface {
 	PrivateAndPublicBuilds(Page) ([]BuildForAPI, Pagination, error)
 	Builds(page Page) ([]BuildForAPI, Pagination, error)
 	BuildsWithTime(page Page) ([]BuildForAPI, Pagination, error)
+	SearchBuildLogs(BuildLogSearch) ([]atc.BuildLogMatch, error)
 
 	SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error)
 	Workers() ([]Worker, error)
@@ -413,6 +415,7 @@ func savePipeline(
 	teamID int,
 	jobID sql.NullInt64,
 	buildID sql.NullInt64,
+	createdBy sql.NullString,
 ) (int, bool, error) {
 
 	var instanceVars sql.NullString
@@ -462,6 +465,26 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	var egressPayload sql.NullString
+	if len(config.Egress) > 0 {
+		payload, err := json.Marshal(config.Egress)
+		if err != nil {
+			return 0, false, err
+		}
+
+		egressPayload = sql.NullString{String: string(payload), Valid: true}
+	}
+
+	var lintPayload sql.NullString
+	if config.Lint != nil {
+		payload, err := json.Marshal(config.Lint)
+		if err != nil {
+			return 0, false, err
+		}
+
+		lintPayload = sql.NullString{String: string(payload), Valid: true}
+	}
+
 	var pipelineID int
 	if !existingConfig {
 		values := map[string]interface{}{
@@ -469,6 +492,8 @@ func savePipeline(
 			"groups":          groupsPayload,
 			"var_sources":     encryptedVarSourcesPayload,
 			"display":         displayPayload,
+			"egress":          egressPayload,
+			"lint":            lintPayload,
 			"nonce":           nonce,
 			"version":         sq.Expr("nextval('config_version_seq')"),
 			"paused":          initiallyPaused,
@@ -514,6 +539,8 @@ func savePipeline(
 			Set("groups", groupsPayload).
 			Set("var_sources", encryptedVarSourcesPayload).
 			Set("display", displayPayload).
+			Set("egress", egressPayload).
+			Set("lint", lintPayload).
 			Set("nonce", nonce).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
 			Set("last_updated", sq.Expr("now()")).
@@ -611,14 +638,32 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	err = saveConfigVersion(tx, pipelineID, config, createdBy, buildID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = enqueueWebhookEvent(tx, teamID, atc.WebhookEventPipelineConfig, atc.WebhookPipeline{
+		Name:         pipelineRef.Name,
+		InstanceVars: pipelineRef.InstanceVars,
+		By:           createdBy.String,
+		Created:      !existingConfig,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
 	return pipelineID, !existingConfig, nil
 }
 
+// SavePipeline saves the pipeline's config, recording it in the pipeline's
+// config history as set by the given user, if known.
 func (t *team) SavePipeline(
 	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
+	createdBy string,
 ) (Pipeline, bool, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -628,7 +673,8 @@ func (t *team) SavePipeline(
 	defer Rollback(tx)
 
 	nullID := sql.NullInt64{Valid: false}
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, t.id, nullID, nullID)
+	createdByUser := sql.NullString{String: createdBy, Valid: createdBy != ""}
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, t.id, nullID, nullID, createdByUser)
 	if err != nil {
 		return nil, false, err
 	}
@@ -1340,8 +1386,10 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		instanceVars  sql.NullString
 		pausedBy      sql.NullString
 		pausedAt      sql.NullTime
+		egress        sql.NullString
+		lint          sql.NullString
 	)
-	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &display, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &instanceVars, &pausedBy, &pausedAt)
+	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &display, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &instanceVars, &pausedBy, &pausedAt, &egress, &lint)
 	if err != nil {
 		return err
 	}
@@ -1374,6 +1422,20 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		p.display = displayConfig
 	}
 
+	if egress.Valid {
+		err = json.Unmarshal([]byte(egress.String), &p.egress)
+		if err != nil {
+			return err
+		}
+	}
+
+	if lint.Valid {
+		err = json.Unmarshal([]byte(lint.String), &p.lint)
+		if err != nil {
+			return err
+		}
+	}
+
 	if varSources.Valid {
 		var pipelineVarSources atc.VarSourceConfigs
 		decryptedVarSource, err := p.conn.EncryptionStrategy().Decrypt(varSources.String, nonceStr)
@@ -1119,21 +1119,21 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline", InstanceVars: atc.InstanceVars{"branch": "feature/foo"}}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline3, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1157,7 +1157,7 @@ var _ = Describe("Team", func() {
 						Jobs: atc.JobConfigs{
 							{Name: "job-name"},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -1202,14 +1202,14 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = pipeline2.Expose()
@@ -1244,19 +1244,19 @@ var _ = Describe("Team", func() {
 
 		BeforeEach(func() {
 			var err error
-			instancePipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "master"}}, atc.Config{}, 0, false)
+			instancePipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "master"}}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			instancePipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "feature/foo"}}, atc.Config{}, 0, false)
+			instancePipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "feature/foo"}}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false)
+			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherTeamPipeline1, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false)
+			otherTeamPipeline1, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherTeamPipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false)
+			otherTeamPipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1433,7 +1433,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 				var err error
-				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1584,7 +1584,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1690,7 +1690,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1845,7 +1845,7 @@ var _ = Describe("Team", func() {
 					Name:         "fake-pipeline",
 					InstanceVars: atc.InstanceVars{"branch": "feature"},
 				}
-				instancedPipeline, _, err = team.SavePipeline(instancedPipelineRef, atc.Config{}, db.ConfigVersion(0), false)
+				instancedPipeline, _, err = team.SavePipeline(instancedPipelineRef, atc.Config{}, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1872,7 +1872,7 @@ var _ = Describe("Team", func() {
 				BeforeEach(func() {
 					var err error
 					namedPipelineRef = atc.PipelineRef{Name: "fake-pipeline"}
-					namedPipeline, _, err = team.SavePipeline(namedPipelineRef, atc.Config{}, db.ConfigVersion(0), false)
+					namedPipeline, _, err = team.SavePipeline(namedPipelineRef, atc.Config{}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -2021,13 +2021,13 @@ var _ = Describe("Team", func() {
 		})
 
 		It("returns true for created", func() {
-			_, created, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, created, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 		})
 
 		It("caches the team id", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -2037,7 +2037,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as paused", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -2048,7 +2048,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -2059,7 +2059,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("is not archived by default", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -2070,10 +2070,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("requests schedule on the pipeline", func() {
-			requestedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			requestedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			requestedJob, found, err := requestedPipeline.Job("some-job")
@@ -2091,7 +2091,7 @@ var _ = Describe("Team", func() {
 				"source-other-config": "some-other-value",
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, requestedPipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, requestedPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			found, err = requestedJob.Reload()
@@ -2107,7 +2107,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resources from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -2227,7 +2227,7 @@ var _ = Describe("Team", func() {
 				"version": "v1",
 			}
 
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -2238,7 +2238,7 @@ var _ = Describe("Team", func() {
 
 			config.Resources[0].Version = nil
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -2270,7 +2270,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources = []atc.ResourceConfig{}
@@ -2318,7 +2318,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Resource("some-other-resource")
@@ -2327,7 +2327,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resource types from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -2340,14 +2340,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource type config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -2360,12 +2360,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource type as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes = []atc.ResourceType{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -2374,7 +2374,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the prototypes from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			prototype, found, err := savedPipeline.Prototype("some-prototype")
@@ -2387,14 +2387,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates prototype config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Prototypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			prototype, found, err := savedPipeline.Prototype("some-prototype")
@@ -2407,12 +2407,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks prototype as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Prototypes = atc.Prototypes{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Prototype("some-resource-type")
@@ -2421,7 +2421,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the jobs from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-job")
@@ -2431,12 +2431,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates job config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs[0].Public = false
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2446,12 +2446,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks job inactive when it is no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs = []atc.JobConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Job("some-job")
@@ -2514,7 +2514,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when there are multiple name changes", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -2526,7 +2526,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[3].Name = "new-other-job"
 				config.Jobs[3].OldName = "new-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("new-job")
@@ -2537,7 +2537,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when old job has the same name as new job", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -2545,7 +2545,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[0].Name = "some-job"
 				config.Jobs[0].OldName = "some-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("some-job")
@@ -2553,7 +2553,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should return an error when there is a swap with job name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				config.Jobs[0].Name = "new-job"
@@ -2562,24 +2562,24 @@ var _ = Describe("Team", func() {
 				config.Jobs[1].Name = "some-job"
 				config.Jobs[1].OldName = "new-job"
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 			})
 
 			Context("when new job name is in database but is inactive", func() {
 				It("should successfully update job name", func() {
-					pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+					pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs = config.Jobs[:len(config.Jobs)-1]
 
-					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs[0].Name = "new-job"
 					config.Jobs[0].OldName = "some-job"
 
-					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion()+1, false)
+					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion()+1, false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
@@ -2599,7 +2599,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should successfully update resource name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, _ := pipeline.Resource("some-resource")
@@ -2621,7 +2621,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedResource, _, _ := updatedPipeline.Resource("renamed-resource")
@@ -2629,7 +2629,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when there are multiple name changes", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, _ := pipeline.Resource("some-resource")
@@ -2655,7 +2655,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedResource, _, _ := updatedPipeline.Resource("new-resource")
@@ -2666,7 +2666,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when old resource has the same name as new resource", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, _ := pipeline.Resource("some-resource")
@@ -2674,7 +2674,7 @@ var _ = Describe("Team", func() {
 				config.Resources[0].Name = "some-resource"
 				config.Resources[0].OldName = "some-resource"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedResource, _, _ := updatedPipeline.Resource("some-resource")
@@ -2682,7 +2682,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should return an error when there is a swap with resource name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				config.Resources[0].Name = "new-resource"
@@ -2691,7 +2691,7 @@ var _ = Describe("Team", func() {
 				config.Resources[1].Name = "some-resource"
 				config.Resources[1].OldName = "new-resource"
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 			})
 
@@ -2800,7 +2800,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes task caches for jobs that are no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2823,7 +2823,7 @@ var _ = Describe("Team", func() {
 
 			config.Jobs = []atc.JobConfig{}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2836,7 +2836,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes task caches for tasks that are no longer exist", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2871,7 +2871,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2884,10 +2884,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("should not remove task caches in other pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, config, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2926,7 +2926,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2939,7 +2939,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the serial groups from the jobs in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			serialGroups := []SerialGroup{}
@@ -2970,7 +2970,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("saves tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2982,7 +2982,7 @@ var _ = Describe("Team", func() {
 
 		It("saves tags in the jobs table based on globs", func() {
 			otherConfig.Groups[0].Jobs = []string{"*-other-job"}
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2993,7 +2993,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -3013,7 +3013,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err = team.SavePipeline(pipelineRef, otherConfig, savedPipeline.ConfigVersion(), false)
+			savedPipeline, _, err = team.SavePipeline(pipelineRef, otherConfig, savedPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = savedPipeline.Job("some-other-job")
@@ -3024,10 +3024,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("it returns created as false when updated", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			_, created, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, created, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeFalse())
 		})
@@ -3138,7 +3138,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err := psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -3294,7 +3294,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err = psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -3339,7 +3339,7 @@ var _ = Describe("Team", func() {
 
 		Context("updating an existing pipeline", func() {
 			It("maintains paused if the pipeline is paused", func() {
-				_, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+				_, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineRef)
@@ -3347,7 +3347,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineRef)
@@ -3357,7 +3357,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("maintains unpaused if the pipeline is unpaused", func() {
-				_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineRef)
@@ -3365,7 +3365,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), true, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineRef)
@@ -3375,11 +3375,11 @@ var _ = Describe("Team", func() {
 			})
 
 			It("resets to unarchived", func() {
-				team.SavePipeline(pipelineRef, config, 0, false)
+				team.SavePipeline(pipelineRef, config, 0, false, "")
 				pipeline, _, _ := team.Pipeline(pipelineRef)
 				pipeline.Archive()
 
-				team.SavePipeline(pipelineRef, config, db.ConfigVersion(0), true)
+				team.SavePipeline(pipelineRef, config, db.ConfigVersion(0), true, "")
 				pipeline.Reload()
 				Expect(pipeline.Archived()).To(BeFalse(), "the pipeline remained archived")
 			})
@@ -3388,9 +3388,9 @@ var _ = Describe("Team", func() {
 		It("can lookup a pipeline by name", func() {
 			otherPipelineFilter := atc.PipelineRef{Name: "an-other-pipeline-name"}
 
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			_, _, err = team.SavePipeline(otherPipelineFilter, otherConfig, 0, false)
+			_, _, err = team.SavePipeline(otherPipelineFilter, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -3439,10 +3439,10 @@ var _ = Describe("Team", func() {
 			otherPipelineFilter := atc.PipelineRef{Name: "an-other-pipeline-name"}
 
 			By("being able to save the config")
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(otherPipelineFilter, otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(otherPipelineFilter, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the saved config to later gets")
@@ -3520,22 +3520,22 @@ var _ = Describe("Team", func() {
 			})
 
 			By("not allowing non-sequential updates")
-			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()-1, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()+10, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()-1, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()+10, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
 			By("being able to update the config with a valid con")
-			pipeline, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion(), false)
+			pipeline, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion(), false)
+			otherPipeline, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the updated config")
@@ -3594,7 +3594,7 @@ var _ = Describe("Team", func() {
 				},
 			})
 
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceTypes, err := pipeline.ResourceTypes()
@@ -3614,27 +3614,27 @@ var _ = Describe("Team", func() {
 			It("can allow pipelines with the same name across teams", func() {
 				pipelineRef := atc.PipelineRef{Name: "steve"}
 
-				teamPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+				teamPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(teamPipeline.Paused()).To(BeTrue())
 
 				By("allowing you to save a pipeline with the same name in another team")
-				otherTeamPipeline, _, err := otherTeam.SavePipeline(pipelineRef, otherConfig, 0, true)
+				otherTeamPipeline, _, err := otherTeam.SavePipeline(pipelineRef, otherConfig, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(otherTeamPipeline.Paused()).To(BeTrue())
 
 				By("updating the pipeline config for the correct team's pipeline")
-				_, _, err = team.SavePipeline(pipelineRef, otherConfig, teamPipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, otherConfig, teamPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = otherTeam.SavePipeline(pipelineRef, config, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = otherTeam.SavePipeline(pipelineRef, config, otherTeamPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				By("cannot cross update configs")
-				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 
-				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), true, "")
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -3704,6 +3704,7 @@ var _ = Describe("Team", func() {
 					config,
 					pipeline.ConfigVersion(),
 					false,
+					"",
 				)
 				if err != nil {
 					panic(err)
@@ -3784,19 +3785,19 @@ var _ = Describe("Team", func() {
 				p1, _, err = defaultTeam.SavePipeline(atc.PipelineRef{
 					Name:         "release",
 					InstanceVars: atc.InstanceVars{"version": "6.7.x"},
-				}, defaultPipelineConfig, db.ConfigVersion(0), false)
+				}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				p2, _, err = defaultTeam.SavePipeline(atc.PipelineRef{
 					Name:         "release",
 					InstanceVars: atc.InstanceVars{"version": "7.0.x"},
-				}, defaultPipelineConfig, db.ConfigVersion(0), false)
+				}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				p3, _, err = defaultTeam.SavePipeline(atc.PipelineRef{
 					Name:         "release",
 					InstanceVars: nil,
-				}, defaultPipelineConfig, db.ConfigVersion(0), false)
+				}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -3905,7 +3906,7 @@ var _ = Describe("Team", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(0), false)
+							}, db.ConfigVersion(0), false, "")
 							Expect(err).NotTo(HaveOccurred())
 
 							otherResource, found, err = otherPipeline.Resource("some-resource")
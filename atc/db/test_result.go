@@ -0,0 +1,334 @@
+package db
+
+import (
+	"database/sql"
+	"sort"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// testResultsBatchSize bounds the number of rows inserted per statement, to
+// stay well within postgres' limit on query parameters.
+const testResultsBatchSize = 1000
+
+// DefaultTestHistoryBuilds is how many of a job's builds with test results
+// are considered when looking for flaky tests.
+const DefaultTestHistoryBuilds = 25
+
+// SaveTestResults records the results of the tests that the given step ran.
+func (b *build) SaveTestResults(step string, results []atc.TestResult) error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	var jobID sql.NullInt64
+	if b.jobID != 0 {
+		jobID = newNullInt64(b.jobID)
+	}
+
+	for start := 0; start < len(results); start += testResultsBatchSize {
+		end := start + testResultsBatchSize
+		if end > len(results) {
+			end = len(results)
+		}
+
+		insert := psql.Insert("build_test_results").
+			Columns("build_id", "job_id", "step", "suite", "class_name", "name", "status", "duration", "message")
+
+		for _, result := range results[start:end] {
+			insert = insert.Values(
+				b.id,
+				jobID,
+				step,
+				result.Suite,
+				result.ClassName,
+				result.Name,
+				string(result.Status),
+				result.Duration,
+				optionalString(result.Message),
+			)
+		}
+
+		_, err = insert.RunWith(tx).Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// TestResults returns the results of every test the build ran, in the order
+// they were recorded.
+func (b *build) TestResults() ([]atc.TestResult, error) {
+	rows, err := psql.Select("step", "suite", "class_name", "name", "status", "duration", "message").
+		From("build_test_results").
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("id").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var results []atc.TestResult
+	for rows.Next() {
+		var (
+			result  atc.TestResult
+			status  string
+			message sql.NullString
+		)
+
+		err = rows.Scan(&result.Step, &result.Suite, &result.ClassName, &result.Name, &status, &result.Duration, &message)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Status = atc.TestStatus(status)
+		result.Message = message.String
+
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
+
+// TestResults summarizes the tests run by the job's most recent builds that
+// recorded any, and finds the tests that were flaky across them.
+func (j *job) TestResults(builds int) (atc.JobTestResults, error) {
+	if builds <= 0 {
+		builds = DefaultTestHistoryBuilds
+	}
+
+	buildIDs, err := j.testedBuildIDs(builds)
+	if err != nil {
+		return atc.JobTestResults{}, err
+	}
+
+	history := atc.JobTestResults{
+		Builds: []atc.BuildTestSummary{},
+		Flaky:  []atc.FlakyTest{},
+	}
+
+	if len(buildIDs) == 0 {
+		return history, nil
+	}
+
+	rows, err := psql.Select("b.id", "b.name", "b.status", "r.status", "count(*)", "sum(r.duration)").
+		From("build_test_results r").
+		Join("builds b ON b.id = r.build_id").
+		Where(sq.Expr("r.build_id = ANY(?)", pq.Array(buildIDs))).
+		GroupBy("b.id", "b.name", "b.status", "r.status").
+		OrderBy("b.id DESC").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return atc.JobTestResults{}, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var (
+			summary     atc.BuildTestSummary
+			buildStatus string
+			testStatus  string
+			count       int
+			duration    float64
+		)
+
+		err = rows.Scan(&summary.BuildID, &summary.BuildName, &buildStatus, &testStatus, &count, &duration)
+		if err != nil {
+			return atc.JobTestResults{}, err
+		}
+
+		last := len(history.Builds) - 1
+		if last < 0 || history.Builds[last].BuildID != summary.BuildID {
+			summary.Status = atc.BuildStatus(buildStatus)
+			history.Builds = append(history.Builds, summary)
+			last++
+		}
+
+		counts := &history.Builds[last].Summary
+		counts.Total += count
+		counts.Duration += duration
+
+		switch atc.TestStatus(testStatus) {
+		case atc.TestPassed:
+			counts.Passed += count
+		case atc.TestFailed:
+			counts.Failed += count
+		case atc.TestErrored:
+			counts.Errored += count
+		case atc.TestSkipped:
+			counts.Skipped += count
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return atc.JobTestResults{}, err
+	}
+
+	history.Flaky, err = j.flakyTests(buildIDs)
+	if err != nil {
+		return atc.JobTestResults{}, err
+	}
+
+	return history, nil
+}
+
+func (j *job) testedBuildIDs(limit int) ([]int, error) {
+	rows, err := psql.Select("DISTINCT build_id").
+		From("build_test_results").
+		Where(sq.Eq{"job_id": j.id}).
+		OrderBy("build_id DESC").
+		Limit(uint64(limit)).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var buildIDs []int
+	for rows.Next() {
+		var buildID int
+		err = rows.Scan(&buildID)
+		if err != nil {
+			return nil, err
+		}
+
+		buildIDs = append(buildIDs, buildID)
+	}
+
+	return buildIDs, rows.Err()
+}
+
+// flakyTests finds the tests whose outcome doesn't follow from the code they
+// ran against: those that failed, passed and then failed again, and those
+// that both passed and failed in builds with the same input versions. A test
+// that failed until a fix landed, or that broke and stayed broken, isn't
+// flaky.
+func (j *job) flakyTests(buildIDs []int) ([]atc.FlakyTest, error) {
+	rows, err := j.conn.Query(`
+		WITH inputs AS (
+			SELECT build_id, string_agg(name || ':' || resource_id || ':' || version_md5, ',' ORDER BY name, resource_id, version_md5) AS versions
+			FROM build_resource_config_version_inputs
+			WHERE build_id = ANY($1)
+			GROUP BY build_id
+		)
+		SELECT
+			r.suite,
+			r.class_name,
+			r.name,
+			b.name,
+			COALESCE(i.versions, ''),
+			bool_or(r.status = 'passed'),
+			bool_or(r.status IN ('failed', 'errored'))
+		FROM build_test_results r
+		JOIN builds b ON b.id = r.build_id
+		LEFT JOIN inputs i ON i.build_id = r.build_id
+		WHERE r.build_id = ANY($1)
+		GROUP BY r.suite, r.class_name, r.name, r.build_id, b.name, i.versions
+		ORDER BY r.suite, r.class_name, r.name, r.build_id
+	`, pq.Array(buildIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	type outcomes struct {
+		test atc.FlakyTest
+
+		// whether the test has failed, and then passed after failing
+		failed, recovered bool
+
+		flaky    bool
+		versions map[string]atc.TestStatus
+	}
+
+	var tests []*outcomes
+	for rows.Next() {
+		var (
+			test           atc.FlakyTest
+			buildName      string
+			versions       string
+			passed, failed bool
+		)
+
+		err = rows.Scan(&test.Suite, &test.ClassName, &test.Name, &buildName, &versions, &passed, &failed)
+		if err != nil {
+			return nil, err
+		}
+
+		last := len(tests) - 1
+		if last < 0 || tests[last].test.Suite != test.Suite || tests[last].test.ClassName != test.ClassName || tests[last].test.Name != test.Name {
+			tests = append(tests, &outcomes{
+				test:     test,
+				versions: map[string]atc.TestStatus{},
+			})
+			last++
+		}
+
+		t := tests[last]
+
+		switch {
+		case passed && failed:
+			// passed and failed within the same build
+			t.flaky = true
+			t.test.Passed++
+			t.test.Failed++
+			t.test.LastFailedBuild = buildName
+		case failed:
+			if t.recovered {
+				t.flaky = true
+			}
+
+			if t.versions[versions] == atc.TestPassed {
+				t.flaky = true
+			}
+
+			t.failed = true
+			t.versions[versions] = atc.TestFailed
+			t.test.Failed++
+			t.test.LastFailedBuild = buildName
+		case passed:
+			if t.failed {
+				t.recovered = true
+			}
+
+			if t.versions[versions] == atc.TestFailed {
+				t.flaky = true
+			}
+
+			t.versions[versions] = atc.TestPassed
+			t.test.Passed++
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	flaky := []atc.FlakyTest{}
+	for _, t := range tests {
+		if t.flaky {
+			flaky = append(flaky, t.test)
+		}
+	}
+
+	sort.SliceStable(flaky, func(i, k int) bool {
+		return flaky[i].Failed > flaky[k].Failed
+	})
+
+	return flaky, nil
+}
@@ -0,0 +1,189 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test results", func() {
+	var build db.Build
+
+	BeforeEach(func() {
+		var err error
+		build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("saves and returns the build's results", func() {
+		err := build.SaveTestResults("unit", []atc.TestResult{
+			{Suite: "api", ClassName: "api.Users", Name: "creates", Status: atc.TestPassed, Duration: 0.5},
+			{Suite: "api", ClassName: "api.Users", Name: "deletes", Status: atc.TestFailed, Message: "expected 204"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		results, err := build.TestResults()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]atc.TestResult{
+			{Step: "unit", Suite: "api", ClassName: "api.Users", Name: "creates", Status: atc.TestPassed, Duration: 0.5},
+			{Step: "unit", Suite: "api", ClassName: "api.Users", Name: "deletes", Status: atc.TestFailed, Message: "expected 204"},
+		}))
+	})
+
+	Describe("a job's test history", func() {
+		var otherBuild db.Build
+
+		BeforeEach(func() {
+			err := build.SaveTestResults("unit", []atc.TestResult{
+				{Suite: "api", Name: "creates", Status: atc.TestPassed, Duration: 1},
+				{Suite: "api", Name: "deletes", Status: atc.TestFailed},
+				{Suite: "api", Name: "lists", Status: atc.TestSkipped},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusFailed)
+			Expect(err).ToNot(HaveOccurred())
+
+			otherBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = otherBuild.SaveTestResults("unit", []atc.TestResult{
+				{Suite: "api", Name: "creates", Status: atc.TestPassed, Duration: 2},
+				{Suite: "api", Name: "deletes", Status: atc.TestPassed},
+				{Suite: "api", Name: "lists", Status: atc.TestSkipped},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("summarizes the most recent builds", func() {
+			history, err := defaultJob.TestResults(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history.Builds).To(Equal([]atc.BuildTestSummary{
+				{
+					BuildID:   otherBuild.ID(),
+					BuildName: otherBuild.Name(),
+					Status:    atc.StatusPending,
+					Summary:   atc.TestSummary{Total: 3, Passed: 2, Skipped: 1, Duration: 2},
+				},
+				{
+					BuildID:   build.ID(),
+					BuildName: build.Name(),
+					Status:    atc.StatusFailed,
+					Summary:   atc.TestSummary{Total: 3, Passed: 1, Failed: 1, Skipped: 1, Duration: 1},
+				},
+			}))
+		})
+
+		It("finds the tests that both passed and failed", func() {
+			history, err := defaultJob.TestResults(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history.Flaky).To(Equal([]atc.FlakyTest{
+				{Suite: "api", Name: "deletes", Passed: 1, Failed: 1, LastFailedBuild: build.Name()},
+			}))
+		})
+
+		It("only considers the given number of builds", func() {
+			history, err := defaultJob.TestResults(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history.Builds).To(HaveLen(1))
+			Expect(history.Builds[0].BuildID).To(Equal(otherBuild.ID()))
+			Expect(history.Flaky).To(BeEmpty())
+		})
+	})
+
+	Describe("finding flaky tests", func() {
+		var scenario *dbtest.Scenario
+
+		BeforeEach(func() {
+			scenario = dbtest.Setup(
+				builder.WithPipeline(atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "some-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name: "some-resource",
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "some-resource",
+							Type:   dbtest.BaseResourceType,
+							Source: atc.Source{"some": "source"},
+						},
+					},
+				}),
+				builder.WithResourceVersions(
+					"some-resource",
+					atc.Version{"ver": "1"},
+					atc.Version{"ver": "2"},
+					atc.Version{"ver": "3"},
+				),
+			)
+		})
+
+		runTest := func(version string, status atc.TestStatus) {
+			var build db.Build
+			scenario.Run(
+				builder.WithJobBuild(&build, "some-job", dbtest.JobInputs{
+					{
+						Name:    "some-resource",
+						Version: atc.Version{"ver": version},
+					},
+				}, dbtest.JobOutputs{}),
+			)
+
+			err := build.SaveTestResults("unit", []atc.TestResult{
+				{Suite: "api", Name: "deletes", Status: status},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		flakyTests := func() []atc.FlakyTest {
+			history, err := scenario.Job("some-job").TestResults(0)
+			Expect(err).ToNot(HaveOccurred())
+			return history.Flaky
+		}
+
+		It("does not consider a test that was fixed flaky", func() {
+			runTest("1", atc.TestFailed)
+			runTest("2", atc.TestPassed)
+
+			Expect(flakyTests()).To(BeEmpty())
+		})
+
+		It("does not consider a test that broke flaky", func() {
+			runTest("1", atc.TestPassed)
+			runTest("2", atc.TestFailed)
+			runTest("3", atc.TestFailed)
+
+			Expect(flakyTests()).To(BeEmpty())
+		})
+
+		It("considers a test that fails again after passing flaky", func() {
+			runTest("1", atc.TestFailed)
+			runTest("2", atc.TestPassed)
+			runTest("3", atc.TestFailed)
+
+			Expect(flakyTests()).To(Equal([]atc.FlakyTest{
+				{Suite: "api", Name: "deletes", Passed: 1, Failed: 2, LastFailedBuild: "3"},
+			}))
+		})
+
+		It("considers a test that passes and fails on the same versions flaky", func() {
+			runTest("1", atc.TestPassed)
+			runTest("1", atc.TestFailed)
+
+			Expect(flakyTests()).To(Equal([]atc.FlakyTest{
+				{Suite: "api", Name: "deletes", Passed: 1, Failed: 1, LastFailedBuild: "2"},
+			}))
+		})
+	})
+})
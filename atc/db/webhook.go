@@ -0,0 +1,428 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// Webhook is a team's subscription to have events POSTed to a URL. A webhook
+// with no events is sent every event.
+type Webhook struct {
+	ID        int
+	TeamID    int
+	Name      string
+	URL       string
+	Secret    string
+	Events    []string
+	CreatedAt time.Time
+}
+
+// WebhookDelivery is an event queued for a webhook. The event is queued in
+// the same transaction as the change that caused it, so that it is sent even
+// if the web node goes away before it gets to it.
+type WebhookDelivery struct {
+	ID        int
+	WebhookID int
+
+	// URL and Secret are only loaded for pending deliveries, for sending.
+	URL    string
+	Secret string
+
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       int
+	ResponseStatus int
+	Error          string
+	CreatedAt      time.Time
+	LastAttemptAt  time.Time
+	NextAttemptAt  time.Time
+}
+
+// WebhookDeliveryAttempt is the outcome of trying to send a delivery. A
+// pending status with a NextAttemptAt schedules a retry.
+type WebhookDeliveryAttempt struct {
+	Status         string
+	ResponseStatus int
+	Error          string
+	NextAttemptAt  time.Time
+}
+
+//counterfeiter:generate . WebhookFactory
+type WebhookFactory interface {
+	SaveWebhook(teamID int, name string, url string, secret string, events []string) (Webhook, error)
+	Webhooks(teamID int) ([]Webhook, error)
+	DestroyWebhook(teamID int, name string) (bool, error)
+	WebhookDeliveries(teamID int, name string, limit int) ([]WebhookDelivery, bool, error)
+
+	PendingWebhookDeliveries(limit int) ([]WebhookDelivery, error)
+	RecordWebhookDeliveryAttempt(id int, attempt WebhookDeliveryAttempt) error
+	DeleteWebhookDeliveriesBefore(before time.Time) (int, error)
+}
+
+type webhookFactory struct {
+	conn Conn
+}
+
+func NewWebhookFactory(conn Conn) WebhookFactory {
+	return &webhookFactory{
+		conn: conn,
+	}
+}
+
+var webhookDeliveriesQuery = psql.Select(
+	"d.id",
+	"d.webhook_id",
+	"d.event",
+	"d.payload",
+	"d.status",
+	"d.attempts",
+	"d.response_status",
+	"d.error",
+	"d.created_at",
+	"d.last_attempt_at",
+	"d.next_attempt_at",
+).
+	From("webhook_deliveries d")
+
+func (f *webhookFactory) SaveWebhook(teamID int, name string, url string, secret string, events []string) (Webhook, error) {
+	encryptedSecret, nonce, err := f.conn.EncryptionStrategy().Encrypt([]byte(secret))
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	if events == nil {
+		events = []string{}
+	}
+
+	webhook := Webhook{
+		TeamID: teamID,
+		Name:   name,
+		URL:    url,
+		Secret: secret,
+		Events: events,
+	}
+
+	err = psql.Insert("webhooks").
+		Columns("team_id", "name", "url", "secret", "nonce", "events").
+		Values(teamID, name, url, encryptedSecret, nonce, pq.Array(events)).
+		Suffix(`
+			ON CONFLICT (team_id, name) DO UPDATE SET
+				url = EXCLUDED.url,
+				secret = EXCLUDED.secret,
+				nonce = EXCLUDED.nonce,
+				events = EXCLUDED.events
+			RETURNING id, created_at
+		`).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&webhook.ID, &webhook.CreatedAt)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+func (f *webhookFactory) Webhooks(teamID int) ([]Webhook, error) {
+	rows, err := psql.Select("id", "team_id", "name", "url", "events", "created_at").
+		From("webhooks").
+		Where(sq.Eq{"team_id": teamID}).
+		OrderBy("name").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var webhooks []Webhook
+	for rows.Next() {
+		var webhook Webhook
+		err = rows.Scan(
+			&webhook.ID,
+			&webhook.TeamID,
+			&webhook.Name,
+			&webhook.URL,
+			pq.Array(&webhook.Events),
+			&webhook.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+func (f *webhookFactory) DestroyWebhook(teamID int, name string) (bool, error) {
+	result, err := psql.Delete("webhooks").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"name":    name,
+		}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (f *webhookFactory) WebhookDeliveries(teamID int, name string, limit int) ([]WebhookDelivery, bool, error) {
+	var webhookID int
+	err := psql.Select("id").
+		From("webhooks").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"name":    name,
+		}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&webhookID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	query := webhookDeliveriesQuery.
+		Where(sq.Eq{"d.webhook_id": webhookID}).
+		OrderBy("d.id DESC")
+
+	if limit > 0 {
+		query = query.Limit(uint64(limit))
+	}
+
+	rows, err := query.RunWith(f.conn).Query()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Close(rows)
+
+	var deliveries []WebhookDelivery
+	for rows.Next() {
+		var delivery WebhookDelivery
+		err = scanWebhookDelivery(&delivery, rows)
+		if err != nil {
+			return nil, false, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	return deliveries, true, nil
+}
+
+// PendingWebhookDeliveries returns the deliveries that are due to be sent,
+// oldest first, along with their webhook's URL and decrypted secret.
+func (f *webhookFactory) PendingWebhookDeliveries(limit int) ([]WebhookDelivery, error) {
+	query := webhookDeliveriesQuery.
+		Columns("w.url", "w.secret", "w.nonce").
+		Join("webhooks w ON w.id = d.webhook_id").
+		Where(sq.Eq{"d.status": string(atc.WebhookDeliveryPending)}).
+		Where(sq.Expr("d.next_attempt_at <= now()")).
+		OrderBy("d.id")
+
+	if limit > 0 {
+		query = query.Limit(uint64(limit))
+	}
+
+	rows, err := query.RunWith(f.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	es := f.conn.EncryptionStrategy()
+
+	var deliveries []WebhookDelivery
+	for rows.Next() {
+		var (
+			delivery WebhookDelivery
+			secret   string
+			nonce    sql.NullString
+		)
+
+		err = scanWebhookDelivery(&delivery, rows, &delivery.URL, &secret, &nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decryptedSecret, err := es.Decrypt(secret, noncense)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.Secret = string(decryptedSecret)
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (f *webhookFactory) RecordWebhookDeliveryAttempt(id int, attempt WebhookDeliveryAttempt) error {
+	var responseStatus sql.NullInt64
+	if attempt.ResponseStatus != 0 {
+		responseStatus = sql.NullInt64{Int64: int64(attempt.ResponseStatus), Valid: true}
+	}
+
+	values := map[string]interface{}{
+		"status":          attempt.Status,
+		"attempts":        sq.Expr("attempts + 1"),
+		"response_status": responseStatus,
+		"error":           optionalString(attempt.Error),
+		"last_attempt_at": sq.Expr("now()"),
+	}
+
+	if !attempt.NextAttemptAt.IsZero() {
+		values["next_attempt_at"] = attempt.NextAttemptAt
+	}
+
+	_, err := psql.Update("webhook_deliveries").
+		SetMap(values).
+		Where(sq.Eq{"id": id}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+// DeleteWebhookDeliveriesBefore removes the log of deliveries that were
+// queued before the given time and are no longer pending.
+func (f *webhookFactory) DeleteWebhookDeliveriesBefore(before time.Time) (int, error) {
+	result, err := psql.Delete("webhook_deliveries").
+		Where(sq.NotEq{"status": string(atc.WebhookDeliveryPending)}).
+		Where(sq.Lt{"created_at": before}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+func scanWebhookDelivery(delivery *WebhookDelivery, row scannable, extra ...interface{}) error {
+	var (
+		responseStatus               sql.NullInt64
+		deliveryError                sql.NullString
+		lastAttemptAt, nextAttemptAt sql.NullTime
+	)
+
+	dest := []interface{}{
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.Event,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&responseStatus,
+		&deliveryError,
+		&delivery.CreatedAt,
+		&lastAttemptAt,
+		&nextAttemptAt,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return err
+	}
+
+	delivery.ResponseStatus = int(responseStatus.Int64)
+	delivery.Error = deliveryError.String
+	delivery.LastAttemptAt = lastAttemptAt.Time
+	delivery.NextAttemptAt = nextAttemptAt.Time
+
+	return nil
+}
+
+// webhookBuild is the build as it's sent in a build_status event, in the
+// same shape as the API's. The end time is zero for a build that has only
+// started.
+func (b *build) webhookBuild(status BuildStatus, startTime time.Time, endTime time.Time) atc.Build {
+	webhookBuild := atc.Build{
+		ID:                   b.id,
+		Name:                 b.name,
+		JobName:              b.jobName,
+		ResourceName:         b.resourceName,
+		PipelineID:           b.pipelineID,
+		PipelineName:         b.pipelineName,
+		PipelineInstanceVars: b.pipelineInstanceVars,
+		TeamName:             b.teamName,
+		Status:               atc.BuildStatus(status),
+		APIURL:               fmt.Sprintf("/api/v1/builds/%d", b.id),
+		CreatedBy:            b.createdBy,
+	}
+
+	if !startTime.IsZero() {
+		webhookBuild.StartTime = startTime.Unix()
+	}
+
+	if !endTime.IsZero() {
+		webhookBuild.EndTime = endTime.Unix()
+	}
+
+	return webhookBuild
+}
+
+// enqueueWebhookEvent queues the event for each of the team's webhooks that
+// are subscribed to it. It is called within the transaction making the
+// change, so that the event is only sent if the change is committed.
+func enqueueWebhookEvent(tx Tx, teamID int, event atc.WebhookEvent, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(atc.WebhookPayload{
+		Event:     event,
+		Timestamp: time.Now().Unix(),
+		Data:      payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO webhook_deliveries (webhook_id, event, payload)
+		SELECT id, $2, $3
+		FROM webhooks
+		WHERE team_id = $1
+		AND (cardinality(events) = 0 OR $2 = ANY(events))
+	`, teamID, string(event), string(body))
+	return err
+}
@@ -0,0 +1,247 @@
+package db_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhooks", func() {
+	var (
+		factory db.WebhookFactory
+		webhook db.Webhook
+	)
+
+	BeforeEach(func() {
+		factory = db.NewWebhookFactory(dbConn)
+
+		var err error
+		webhook, err = factory.SaveWebhook(
+			defaultTeam.ID(),
+			"some-webhook",
+			"https://example.com/hook",
+			"some-secret",
+			[]string{string(atc.WebhookEventBuildStatus), string(atc.WebhookEventPipelinePaused)},
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	pending := func() []db.WebhookDelivery {
+		deliveries, err := factory.PendingWebhookDeliveries(0)
+		Expect(err).ToNot(HaveOccurred())
+		return deliveries
+	}
+
+	It("lists the team's webhooks without their secrets", func() {
+		webhooks, err := factory.Webhooks(defaultTeam.ID())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(webhooks).To(HaveLen(1))
+		Expect(webhooks[0].ID).To(Equal(webhook.ID))
+		Expect(webhooks[0].Name).To(Equal("some-webhook"))
+		Expect(webhooks[0].URL).To(Equal("https://example.com/hook"))
+		Expect(webhooks[0].Secret).To(BeEmpty())
+		Expect(webhooks[0].Events).To(ConsistOf("build_status", "pipeline_paused"))
+	})
+
+	It("updates a webhook that is saved again", func() {
+		updated, err := factory.SaveWebhook(defaultTeam.ID(), "some-webhook", "https://example.com/other", "other-secret", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.ID).To(Equal(webhook.ID))
+
+		webhooks, err := factory.Webhooks(defaultTeam.ID())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(webhooks).To(HaveLen(1))
+		Expect(webhooks[0].URL).To(Equal("https://example.com/other"))
+		Expect(webhooks[0].Events).To(BeEmpty())
+	})
+
+	It("queues events for a finished build", func() {
+		build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.Finish(db.BuildStatusSucceeded)
+		Expect(err).ToNot(HaveOccurred())
+
+		deliveries := pending()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].Event).To(Equal("build_status"))
+		Expect(deliveries[0].URL).To(Equal("https://example.com/hook"))
+		Expect(deliveries[0].Secret).To(Equal("some-secret"))
+
+		var payload atc.WebhookPayload
+		err = json.Unmarshal([]byte(deliveries[0].Payload), &payload)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload.Event).To(Equal(atc.WebhookEventBuildStatus))
+
+		var webhookBuild atc.Build
+		err = json.Unmarshal(payload.Data, &webhookBuild)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(webhookBuild.ID).To(Equal(build.ID()))
+		Expect(webhookBuild.JobName).To(Equal("some-job"))
+		Expect(webhookBuild.Status).To(Equal(atc.StatusSucceeded))
+	})
+
+	It("queues events for a started build", func() {
+		build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		started, err := build.Start(atc.Plan{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(started).To(BeTrue())
+
+		deliveries := pending()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].Event).To(Equal("build_status"))
+
+		var payload struct {
+			Data atc.Build `json:"data"`
+		}
+		err = json.Unmarshal([]byte(deliveries[0].Payload), &payload)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload.Data.ID).To(Equal(build.ID()))
+		Expect(payload.Data.Status).To(Equal(atc.StatusStarted))
+		Expect(payload.Data.StartTime).ToNot(BeZero())
+		Expect(payload.Data.EndTime).To(BeZero())
+	})
+
+	It("queues events for an aborted build", func() {
+		build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.MarkAsAborted()
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.Finish(db.BuildStatusAborted)
+		Expect(err).ToNot(HaveOccurred())
+
+		deliveries := pending()
+		Expect(deliveries).To(HaveLen(1))
+
+		var payload struct {
+			Data atc.Build `json:"data"`
+		}
+		err = json.Unmarshal([]byte(deliveries[0].Payload), &payload)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload.Data.Status).To(Equal(atc.StatusAborted))
+	})
+
+	It("does not queue events for check builds", func() {
+		build, created, err := defaultResource.CreateBuild(context.TODO(), true, atc.Plan{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeTrue())
+
+		err = build.Finish(db.BuildStatusSucceeded)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(pending()).To(BeEmpty())
+	})
+
+	It("only queues events the webhook is subscribed to", func() {
+		err := defaultPipeline.Pause("some-user")
+		Expect(err).ToNot(HaveOccurred())
+
+		err = defaultPipeline.Unpause()
+		Expect(err).ToNot(HaveOccurred())
+
+		deliveries := pending()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].Event).To(Equal("pipeline_paused"))
+	})
+
+	It("queues worker state changes for webhooks subscribed to every event", func() {
+		_, err := factory.SaveWebhook(defaultTeam.ID(), "some-webhook", "https://example.com/hook", "some-secret", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		worker, found, err := workerFactory.GetWorker(defaultWorker.Name())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		err = worker.Land()
+		Expect(err).ToNot(HaveOccurred())
+
+		deliveries := pending()
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].Event).To(Equal("worker_state"))
+
+		var payload struct {
+			Data atc.WebhookWorker `json:"data"`
+		}
+		err = json.Unmarshal([]byte(deliveries[0].Payload), &payload)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload.Data).To(Equal(atc.WebhookWorker{
+			Name:          defaultWorker.Name(),
+			State:         "landing",
+			PreviousState: "running",
+		}))
+	})
+
+	Describe("recording attempts", func() {
+		var delivery db.WebhookDelivery
+
+		BeforeEach(func() {
+			err := defaultPipeline.Pause("some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			deliveries := pending()
+			Expect(deliveries).To(HaveLen(1))
+			delivery = deliveries[0]
+		})
+
+		It("stops returning deliveries until their next attempt", func() {
+			err := factory.RecordWebhookDeliveryAttempt(delivery.ID, db.WebhookDeliveryAttempt{
+				Status:         string(atc.WebhookDeliveryPending),
+				ResponseStatus: 500,
+				Error:          "unexpected response",
+				NextAttemptAt:  time.Now().Add(time.Hour),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pending()).To(BeEmpty())
+
+			deliveries, found, err := factory.WebhookDeliveries(defaultTeam.ID(), "some-webhook", 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].Attempts).To(Equal(1))
+			Expect(deliveries[0].ResponseStatus).To(Equal(500))
+			Expect(deliveries[0].Error).To(Equal("unexpected response"))
+			Expect(deliveries[0].LastAttemptAt).ToNot(BeZero())
+		})
+
+		It("prunes old deliveries that are no longer pending", func() {
+			deleted, err := factory.DeleteWebhookDeliveriesBefore(time.Now().Add(time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeZero())
+
+			err = factory.RecordWebhookDeliveryAttempt(delivery.ID, db.WebhookDeliveryAttempt{
+				Status:         string(atc.WebhookDeliveryDelivered),
+				ResponseStatus: 200,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			deleted, err = factory.DeleteWebhookDeliveriesBefore(time.Now().Add(time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(Equal(1))
+		})
+	})
+
+	It("can be destroyed", func() {
+		destroyed, err := factory.DestroyWebhook(defaultTeam.ID(), "some-webhook")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(destroyed).To(BeTrue())
+
+		_, found, err := factory.WebhookDeliveries(defaultTeam.ID(), "some-webhook", 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+
+		destroyed, err = factory.DestroyWebhook(defaultTeam.ID(), "some-webhook")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(destroyed).To(BeFalse())
+	})
+})
@@ -63,7 +63,10 @@ type Worker interface {
 	ActiveVolumes() int
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
+	Architecture() string
+	Variant() string
 	Tags() []string
+	RuntimeClasses() []string
 	TeamID() int
 	TeamName() string
 	StartTime() time.Time
@@ -101,7 +104,10 @@ type worker struct {
 	activeTasks      int
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
+	architecture     string
+	variant          string
 	tags             []string
+	runtimeClasses   []string
 	teamID           int
 	teamName         string
 	startTime        time.Time
@@ -124,7 +130,10 @@ func (worker *worker) ActiveContainers() int                   { return worker.a
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
+func (worker *worker) Architecture() string                    { return worker.architecture }
+func (worker *worker) Variant() string                         { return worker.variant }
 func (worker *worker) Tags() []string                          { return worker.tags }
+func (worker *worker) RuntimeClasses() []string                { return worker.runtimeClasses }
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
@@ -50,7 +50,10 @@ var workersQuery = psql.Select(`
 		w.active_volumes,
 		w.resource_types,
 		w.platform,
+		w.architecture,
+		w.variant,
 		w.tags,
+		w.runtime_classes,
 		t.name,
 		w.team_id,
 		w.start_time,
@@ -151,22 +154,25 @@ func getWorkers(conn Conn, query sq.SelectBuilder) ([]Worker, error) {
 
 func scanWorker(worker *worker, row scannable) error {
 	var (
-		version       sql.NullString
-		addStr        sql.NullString
-		state         string
-		bcURLStr      sql.NullString
-		certsPathStr  sql.NullString
-		httpProxyURL  sql.NullString
-		httpsProxyURL sql.NullString
-		noProxy       sql.NullString
-		resourceTypes []byte
-		platform      sql.NullString
-		tags          []byte
-		teamName      sql.NullString
-		teamID        sql.NullInt64
-		startTime     pq.NullTime
-		expiresAt     pq.NullTime
-		ephemeral     sql.NullBool
+		version        sql.NullString
+		addStr         sql.NullString
+		state          string
+		bcURLStr       sql.NullString
+		certsPathStr   sql.NullString
+		httpProxyURL   sql.NullString
+		httpsProxyURL  sql.NullString
+		noProxy        sql.NullString
+		resourceTypes  []byte
+		platform       sql.NullString
+		architecture   sql.NullString
+		variant        sql.NullString
+		tags           []byte
+		runtimeClasses []byte
+		teamName       sql.NullString
+		teamID         sql.NullInt64
+		startTime      pq.NullTime
+		expiresAt      pq.NullTime
+		ephemeral      sql.NullBool
 	)
 
 	err := row.Scan(
@@ -183,7 +189,10 @@ func scanWorker(worker *worker, row scannable) error {
 		&worker.activeVolumes,
 		&resourceTypes,
 		&platform,
+		&architecture,
+		&variant,
 		&tags,
+		&runtimeClasses,
 		&teamName,
 		&teamID,
 		&startTime,
@@ -238,6 +247,14 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.platform = platform.String
 	}
 
+	if architecture.Valid {
+		worker.architecture = architecture.String
+	}
+
+	if variant.Valid {
+		worker.variant = variant.String
+	}
+
 	if ephemeral.Valid {
 		worker.ephemeral = ephemeral.Bool
 	}
@@ -247,6 +264,13 @@ func scanWorker(worker *worker, row scannable) error {
 		return err
 	}
 
+	if runtimeClasses != nil {
+		err = json.Unmarshal(runtimeClasses, &worker.runtimeClasses)
+		if err != nil {
+			return err
+		}
+	}
+
 	return json.Unmarshal(tags, &worker.tags)
 }
 
@@ -376,6 +400,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	runtimeClasses, err := json.Marshal(atcWorker.RuntimeClasses)
+	if err != nil {
+		return nil, err
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -401,7 +430,10 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.ActiveVolumes,
 		resourceTypes,
 		tags,
+		runtimeClasses,
 		atcWorker.Platform,
+		atcWorker.Architecture,
+		atcWorker.Variant,
 		atcWorker.BaggageclaimURL,
 		atcWorker.CertsPath,
 		atcWorker.HTTPProxyURL,
@@ -432,7 +464,10 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"active_volumes",
 			"resource_types",
 			"tags",
+			"runtime_classes",
 			"platform",
+			"architecture",
+			"variant",
 			"baggageclaim_url",
 			"certs_path",
 			"http_proxy_url",
@@ -457,7 +492,10 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				active_volumes = ?,
 				resource_types = ?,
 				tags = ?,
+				runtime_classes = ?,
 				platform = ?,
+				architecture = ?,
+				variant = ?,
 				baggageclaim_url = ?,
 				certs_path = ?,
 				http_proxy_url = ?,
@@ -505,7 +543,10 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		activeVolumes:    atcWorker.ActiveVolumes,
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
+		architecture:     atcWorker.Architecture,
+		variant:          atcWorker.Variant,
 		tags:             atcWorker.Tags,
+		runtimeClasses:   atcWorker.RuntimeClasses,
 		teamName:         atcWorker.Team,
 		teamID:           workerTeamID,
 		startTime:        time.Unix(atcWorker.StartTime, 0),
@@ -43,10 +43,11 @@ var _ = Describe("WorkerFactory", func() {
 					Privileged: false,
 				},
 			},
-			Platform:  "some-platform",
-			Tags:      atc.Tags{"some", "tags"},
-			Name:      "some-name",
-			StartTime: 1565367209,
+			RuntimeClasses: []string{"runc", "runsc"},
+			Platform:       "some-platform",
+			Tags:           atc.Tags{"some", "tags"},
+			Name:           "some-name",
+			StartTime:      1565367209,
 		}
 	})
 
@@ -243,6 +244,7 @@ var _ = Describe("WorkerFactory", func() {
 				}))
 				Expect(foundWorker.Platform()).To(Equal("some-platform"))
 				Expect(foundWorker.Tags()).To(Equal([]string{"some", "tags"}))
+				Expect(foundWorker.RuntimeClasses()).To(Equal([]string{"runc", "runsc"}))
 				Expect(foundWorker.StartTime().Unix()).To(Equal(int64(1565367209)))
 				Expect(foundWorker.State()).To(Equal(db.WorkerStateRunning))
 			})
@@ -230,7 +230,7 @@ var _ = Describe("Worker Lifecycle", func() {
 								Interruptible: false,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -262,7 +262,7 @@ var _ = Describe("Worker Lifecycle", func() {
 								Interruptible: true,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -477,7 +477,7 @@ var _ = Describe("Worker Lifecycle", func() {
 								Interruptible: false,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -509,7 +509,7 @@ var _ = Describe("Worker Lifecycle", func() {
 								Interruptible: true,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -0,0 +1,93 @@
+package atc
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var egressHostnameRegex = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+
+// EgressRule is a destination a container is allowed to reach. It is one of
+// an IPv4 address, an IPv4 CIDR range or a hostname, optionally followed by
+// `:port`, e.g. `10.0.0.0/8`, `10.0.0.0/8:443` or `artifacts.example.com:443`.
+//
+// Hostnames are resolved by the worker once, when the container is created;
+// only their IPv4 addresses are allowed, and later DNS changes are not picked
+// up by a running container.
+type EgressRule string
+
+// Destination splits the rule into its host (IP, CIDR or hostname) and
+// port. A port of 0 means any port.
+func (rule EgressRule) Destination() (string, uint16, error) {
+	host := string(rule)
+	if host == "" {
+		return "", 0, fmt.Errorf("egress rule must not be empty")
+	}
+
+	if isEgressAddress(host) {
+		return host, 0, validateEgressAddress(rule, host)
+	}
+
+	var port uint16
+	if i := strings.LastIndex(host, ":"); i != -1 {
+		p, err := strconv.ParseUint(host[i+1:], 10, 16)
+		if err != nil || p == 0 {
+			return "", 0, fmt.Errorf("egress rule '%s' has invalid port '%s'", rule, host[i+1:])
+		}
+
+		host = host[:i]
+		port = uint16(p)
+	}
+
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+
+	if isEgressAddress(host) {
+		return host, port, validateEgressAddress(rule, host)
+	}
+
+	if !egressHostnameRegex.MatchString(host) {
+		return "", 0, fmt.Errorf("egress rule '%s' must be an IP, CIDR or hostname", rule)
+	}
+
+	return host, port, nil
+}
+
+func validateEgressAddress(rule EgressRule, host string) error {
+	ip, _, err := net.ParseCIDR(host)
+	if err != nil {
+		ip = net.ParseIP(host)
+	}
+
+	if ip.To4() == nil {
+		return fmt.Errorf("egress rule '%s' is an IPv6 destination, which is not supported", rule)
+	}
+
+	return nil
+}
+
+func isEgressAddress(host string) bool {
+	if _, _, err := net.ParseCIDR(host); err == nil {
+		return true
+	}
+
+	return net.ParseIP(host) != nil
+}
+
+func (rule EgressRule) Validate() error {
+	_, _, err := rule.Destination()
+	return err
+}
+
+type EgressRules []EgressRule
+
+func (rules EgressRules) Strings() []string {
+	strs := make([]string, len(rules))
+	for i, rule := range rules {
+		strs[i] = string(rule)
+	}
+
+	return strs
+}
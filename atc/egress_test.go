@@ -0,0 +1,42 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EgressRule", func() {
+	Describe("Destination", func() {
+		DescribeTable("valid rules",
+			func(rule atc.EgressRule, host string, port int) {
+				h, p, err := rule.Destination()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(h).To(Equal(host))
+				Expect(p).To(BeEquivalentTo(port))
+			},
+			Entry("an IP", atc.EgressRule("10.1.2.3"), "10.1.2.3", 0),
+			Entry("an IP and port", atc.EgressRule("10.1.2.3:8080"), "10.1.2.3", 8080),
+			Entry("a CIDR", atc.EgressRule("10.0.0.0/8"), "10.0.0.0/8", 0),
+			Entry("a CIDR and port", atc.EgressRule("10.0.0.0/8:443"), "10.0.0.0/8", 443),
+			Entry("a hostname", atc.EgressRule("artifacts.example.com"), "artifacts.example.com", 0),
+			Entry("a hostname and port", atc.EgressRule("artifacts.example.com:443"), "artifacts.example.com", 443),
+		)
+
+		DescribeTable("invalid rules",
+			func(rule atc.EgressRule, message string) {
+				_, _, err := rule.Destination()
+				Expect(err).To(MatchError(message))
+			},
+			Entry("empty", atc.EgressRule(""), "egress rule must not be empty"),
+			Entry("a named port", atc.EgressRule("example.com:https"), "egress rule 'example.com:https' has invalid port 'https'"),
+			Entry("port zero", atc.EgressRule("example.com:0"), "egress rule 'example.com:0' has invalid port '0'"),
+			Entry("a URL", atc.EgressRule("https://example.com"), "egress rule 'https://example.com' has invalid port '//example.com'"),
+			Entry("an IPv6 address", atc.EgressRule("fd00::1"), "egress rule 'fd00::1' is an IPv6 destination, which is not supported"),
+			Entry("a bracketed IPv6 address and port", atc.EgressRule("[fd00::1]:443"), "egress rule '[fd00::1]:443' is an IPv6 destination, which is not supported"),
+			Entry("an IPv6 CIDR", atc.EgressRule("fd00::/8"), "egress rule 'fd00::/8' is an IPv6 destination, which is not supported"),
+			Entry("a bad hostname", atc.EgressRule("exa mple.com"), "egress rule 'exa mple.com' must be an IP, CIDR or hostname"),
+		)
+	})
+})
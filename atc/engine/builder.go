@@ -40,24 +40,27 @@ func NewStepperFactory(
 	policyChecker policy.Checker,
 	dbWorkerFactory db.WorkerFactory,
 	lockFactory lock.LockFactory,
+	provenanceGenerator ProvenanceGenerator,
 ) StepperFactory {
 	return &stepperFactory{
-		coreFactory:     coreFactory,
-		externalURL:     externalURL,
-		rateLimiter:     rateLimiter,
-		policyChecker:   policyChecker,
-		dbWorkerFactory: dbWorkerFactory,
-		lockFactory:     lockFactory,
+		coreFactory:         coreFactory,
+		externalURL:         externalURL,
+		rateLimiter:         rateLimiter,
+		policyChecker:       policyChecker,
+		dbWorkerFactory:     dbWorkerFactory,
+		lockFactory:         lockFactory,
+		provenanceGenerator: provenanceGenerator,
 	}
 }
 
 type stepperFactory struct {
-	coreFactory     CoreStepFactory
-	externalURL     string
-	rateLimiter     RateLimiter
-	policyChecker   policy.Checker
-	dbWorkerFactory db.WorkerFactory
-	lockFactory     lock.LockFactory
+	coreFactory         CoreStepFactory
+	externalURL         string
+	rateLimiter         RateLimiter
+	policyChecker       policy.Checker
+	dbWorkerFactory     db.WorkerFactory
+	lockFactory         lock.LockFactory
+	provenanceGenerator ProvenanceGenerator
 }
 
 func (factory *stepperFactory) StepperForBuild(build db.Build) (exec.Stepper, error) {
@@ -78,6 +81,8 @@ func (factory *stepperFactory) buildDelegateFactory(build db.Build, plan atc.Pla
 		policyChecker:   factory.policyChecker,
 		dbWorkerFactory: factory.dbWorkerFactory,
 		lockFactory:     factory.lockFactory,
+
+		provenanceGenerator: factory.provenanceGenerator,
 	}
 }
 
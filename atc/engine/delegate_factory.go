@@ -17,6 +17,8 @@ type DelegateFactory struct {
 	policyChecker   policy.Checker
 	dbWorkerFactory db.WorkerFactory
 	lockFactory     lock.LockFactory
+
+	provenanceGenerator ProvenanceGenerator
 }
 
 func (delegate DelegateFactory) GetDelegate(state exec.RunState) exec.GetDelegate {
@@ -24,7 +26,7 @@ func (delegate DelegateFactory) GetDelegate(state exec.RunState) exec.GetDelegat
 }
 
 func (delegate DelegateFactory) PutDelegate(state exec.RunState) exec.PutDelegate {
-	return NewPutDelegate(delegate.build, delegate.plan.ID, state, clock.NewClock(), delegate.policyChecker)
+	return NewPutDelegate(delegate.build, delegate.plan.ID, state, clock.NewClock(), delegate.policyChecker, delegate.provenanceGenerator)
 }
 
 func (delegate DelegateFactory) TaskDelegate(state exec.RunState) exec.TaskDelegate {
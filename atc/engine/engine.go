@@ -284,6 +284,8 @@ func (b *engineBuild) saveStatus(logger lager.Logger, status atc.BuildStatus) {
 		return
 	}
 
+	b.checkSLO(logger, job)
+
 	id, err := job.LatestCompletedBuildId()
 	if err != nil {
 		logger.Error("failed-to-get-latest-completed-build-id", err)
@@ -299,6 +301,65 @@ func (b *engineBuild) saveStatus(logger lager.Logger, status atc.BuildStatus) {
 	}
 }
 
+// checkSLO compares the finished build against its job's SLO, recording and
+// emitting a metric for each way it missed it.
+func (b *engineBuild) checkSLO(logger lager.Logger, job db.Job) {
+	config, err := job.Config()
+	if err != nil {
+		logger.Error("failed-to-get-job-config", err)
+		return
+	}
+
+	if config.SLO == nil {
+		return
+	}
+
+	slo := *config.SLO
+
+	// the build along with a full window of the builds before it
+	history, err := job.SLOBuilds(b.build.ID(), slo.WindowSize()+1)
+	if err != nil {
+		logger.Error("failed-to-get-slo-builds", err)
+		return
+	}
+
+	// aborted builds aren't held to the SLO, and aren't returned
+	if len(history) == 0 || history[0].ID != b.build.ID() {
+		return
+	}
+
+	stats, violations := slo.Evaluate(history[0], history[1:])
+	if len(violations) == 0 {
+		return
+	}
+
+	for _, violation := range violations {
+		metric.JobSLOBreach{
+			Kind:         string(violation.Kind),
+			Value:        violation.Value,
+			Target:       violation.Target,
+			JobName:      job.Name(),
+			PipelineName: job.PipelineName(),
+			TeamName:     job.TeamName(),
+		}.Emit(logger)
+	}
+
+	// reload so that the breach is recorded with the build's final status
+	_, err = b.build.Reload()
+	if err != nil {
+		logger.Error("failed-to-reload-build", err)
+		return
+	}
+
+	err = b.build.SaveSLOBreach(stats, violations)
+	if err != nil {
+		logger.Error("failed-to-save-slo-breach", err)
+		return
+	}
+
+	logger.Info("slo-breached", lager.Data{"violations": violations})
+}
+
 func (b *engineBuild) trackStarted(logger lager.Logger) {
 	if b.build.Name() != db.CheckBuildName {
 		metric.BuildStarted{
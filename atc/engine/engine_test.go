@@ -260,6 +260,72 @@ var _ = Describe("Engine", func() {
 									})
 								})
 
+								Context("when the build's job has an SLO", func() {
+									var fakeJob *dbfakes.FakeJob
+
+									BeforeEach(func() {
+										fakeStep.RunReturns(false, nil)
+
+										fakeJob = new(dbfakes.FakeJob)
+										fakeJob.ConfigReturns(atc.JobConfig{
+											SLO: &atc.JobSLO{SuccessRate: 0.9, Window: 5},
+										}, nil)
+										fakeJob.SLOBuildsReturns([]atc.JobSLOBuild{
+											{ID: 128, Status: atc.StatusFailed, Duration: time.Minute},
+											{ID: 127, Status: atc.StatusSucceeded, Duration: time.Minute},
+										}, nil)
+
+										fakeBuild.JobIDReturns(1)
+										fakeBuild.JobReturns(fakeJob, true, nil)
+									})
+
+									It("measures the build against the builds before it", func() {
+										waitGroup.Wait()
+										Expect(fakeJob.SLOBuildsCallCount()).To(Equal(1))
+										buildID, limit := fakeJob.SLOBuildsArgsForCall(0)
+										Expect(buildID).To(Equal(128))
+										Expect(limit).To(Equal(6))
+									})
+
+									It("records the breach", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.SaveSLOBreachCallCount()).To(Equal(1))
+										stats, violations := fakeBuild.SaveSLOBreachArgsForCall(0)
+										Expect(stats.Builds).To(Equal(2))
+										Expect(stats.SuccessRate).To(Equal(0.5))
+										Expect(violations).To(Equal([]atc.JobSLOViolation{
+											{Kind: atc.JobSLOViolationSuccessRate, Value: 0.5, Target: 0.9},
+										}))
+									})
+
+									Context("when the build meets the SLO", func() {
+										BeforeEach(func() {
+											fakeStep.RunReturns(true, nil)
+											fakeJob.SLOBuildsReturns([]atc.JobSLOBuild{
+												{ID: 128, Status: atc.StatusSucceeded, Duration: time.Minute},
+											}, nil)
+										})
+
+										It("does not record a breach", func() {
+											waitGroup.Wait()
+											Expect(fakeBuild.SaveSLOBreachCallCount()).To(Equal(0))
+										})
+									})
+
+									Context("when the build is not returned because it was aborted", func() {
+										BeforeEach(func() {
+											fakeJob.SLOBuildsReturns([]atc.JobSLOBuild{
+												{ID: 127, Status: atc.StatusFailed, Duration: time.Minute},
+											}, nil)
+										})
+
+										It("does not record a breach", func() {
+											waitGroup.Wait()
+											Expect(fakeBuild.SaveSLOBreachCallCount()).To(Equal(0))
+										})
+									})
+								})
+
 								Context("when the build finishes with error", func() {
 									Context("when the error is not retryable", func() {
 										BeforeEach(func() {
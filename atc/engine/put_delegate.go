@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"fmt"
 	"io"
 	"time"
 
@@ -14,28 +15,36 @@ import (
 	"github.com/concourse/concourse/atc/resource"
 )
 
+//counterfeiter:generate . ProvenanceGenerator
+type ProvenanceGenerator interface {
+	Generate(db.Build) (atc.ProvenanceEnvelope, bool, error)
+}
+
 func NewPutDelegate(
 	build db.Build,
 	planID atc.PlanID,
 	state exec.RunState,
 	clock clock.Clock,
 	policyChecker policy.Checker,
+	provenanceGenerator ProvenanceGenerator,
 ) exec.PutDelegate {
 	return &putDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(build, planID, state, clock, policyChecker),
 
-		eventOrigin: event.Origin{ID: event.OriginID(planID)},
-		build:       build,
-		clock:       clock,
+		eventOrigin:         event.Origin{ID: event.OriginID(planID)},
+		build:               build,
+		clock:               clock,
+		provenanceGenerator: provenanceGenerator,
 	}
 }
 
 type putDelegate struct {
 	exec.BuildStepDelegate
 
-	build       db.Build
-	eventOrigin event.Origin
-	clock       clock.Clock
+	build               db.Build
+	eventOrigin         event.Origin
+	clock               clock.Clock
+	provenanceGenerator ProvenanceGenerator
 }
 
 func (d *putDelegate) Initializing(logger lager.Logger) {
@@ -106,3 +115,31 @@ func (d *putDelegate) SaveOutput(log lager.Logger, plan atc.PutPlan, source atc.
 		return
 	}
 }
+
+// SaveProvenance records provenance covering every output the build has
+// produced so far, in place of any recorded by an earlier put covering fewer
+// of them. It returns false if provenance is not configured or the build has
+// no outputs.
+func (d *putDelegate) SaveProvenance(logger lager.Logger) (atc.ProvenanceEnvelope, bool, error) {
+	if d.provenanceGenerator == nil {
+		return atc.ProvenanceEnvelope{}, false, nil
+	}
+
+	envelope, found, err := d.provenanceGenerator.Generate(d.build)
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, false, fmt.Errorf("generate provenance: %w", err)
+	}
+
+	if !found {
+		return atc.ProvenanceEnvelope{}, false, nil
+	}
+
+	err = d.build.SaveProvenance(envelope)
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, false, fmt.Errorf("save provenance: %w", err)
+	}
+
+	logger.Debug("saved-provenance")
+
+	return envelope, true, nil
+}
@@ -1,6 +1,7 @@
 package engine_test
 
 import (
+	"errors"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -12,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/engine/enginefakes"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/policy/policyfakes"
@@ -26,6 +28,8 @@ var _ = Describe("PutDelegate", func() {
 		fakeClock         *fakeclock.FakeClock
 		fakePolicyChecker *policyfakes.FakeChecker
 
+		fakeProvenanceGenerator *enginefakes.FakeProvenanceGenerator
+
 		state exec.RunState
 
 		now = time.Date(1991, 6, 3, 5, 30, 0, 0, time.UTC)
@@ -52,8 +56,9 @@ var _ = Describe("PutDelegate", func() {
 		}
 
 		fakePolicyChecker = new(policyfakes.FakeChecker)
+		fakeProvenanceGenerator = new(enginefakes.FakeProvenanceGenerator)
 
-		delegate = engine.NewPutDelegate(fakeBuild, "some-plan-id", state, fakeClock, fakePolicyChecker)
+		delegate = engine.NewPutDelegate(fakeBuild, "some-plan-id", state, fakeClock, fakePolicyChecker, fakeProvenanceGenerator)
 	})
 
 	Describe("Finished", func() {
@@ -103,4 +108,87 @@ var _ = Describe("PutDelegate", func() {
 			Expect(resource).To(Equal(plan.Resource))
 		})
 	})
+
+	Describe("SaveProvenance", func() {
+		var (
+			envelope atc.ProvenanceEnvelope
+			found    bool
+			err      error
+
+			generated atc.ProvenanceEnvelope
+		)
+
+		BeforeEach(func() {
+			generated = atc.ProvenanceEnvelope{
+				PayloadType: atc.ProvenancePayloadType,
+				Payload:     []byte(`{"some":"statement"}`),
+				Signatures:  []atc.ProvenanceSignature{{KeyID: "some-key", Sig: []byte("some-sig")}},
+			}
+
+			fakeProvenanceGenerator.GenerateReturns(generated, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			envelope, found, err = delegate.SaveProvenance(logger)
+		})
+
+		It("generates provenance for the build", func() {
+			Expect(fakeProvenanceGenerator.GenerateCallCount()).To(Equal(1))
+			Expect(fakeProvenanceGenerator.GenerateArgsForCall(0)).To(Equal(fakeBuild))
+		})
+
+		It("saves the provenance against the build", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(envelope).To(Equal(generated))
+
+			Expect(fakeBuild.SaveProvenanceCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveProvenanceArgsForCall(0)).To(Equal(generated))
+		})
+
+		Context("when the build has no outputs", func() {
+			BeforeEach(func() {
+				fakeProvenanceGenerator.GenerateReturns(atc.ProvenanceEnvelope{}, false, nil)
+			})
+
+			It("does not save any provenance", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+				Expect(fakeBuild.SaveProvenanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when generating provenance fails", func() {
+			BeforeEach(func() {
+				fakeProvenanceGenerator.GenerateReturns(atc.ProvenanceEnvelope{}, false, errors.New("nope"))
+			})
+
+			It("errors", func() {
+				Expect(err).To(MatchError(ContainSubstring("nope")))
+				Expect(fakeBuild.SaveProvenanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when saving the provenance fails", func() {
+			BeforeEach(func() {
+				fakeBuild.SaveProvenanceReturns(errors.New("nope"))
+			})
+
+			It("errors", func() {
+				Expect(err).To(MatchError(ContainSubstring("nope")))
+			})
+		})
+
+		Context("when provenance is not configured", func() {
+			BeforeEach(func() {
+				delegate = engine.NewPutDelegate(fakeBuild, "some-plan-id", state, fakeClock, fakePolicyChecker, nil)
+			})
+
+			It("does not save any provenance", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+				Expect(fakeBuild.SaveProvenanceCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
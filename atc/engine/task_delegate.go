@@ -103,6 +103,38 @@ func (d *taskDelegate) Finished(
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
 
+func (d *taskDelegate) Annotated(logger lager.Logger, annotations atc.BuildAnnotations) {
+	err := d.build.AddAnnotations(annotations)
+	if err != nil {
+		logger.Error("failed-to-save-annotations", err)
+		return
+	}
+
+	err = d.build.SaveEvent(event.Annotations{
+		Origin:      d.eventOrigin,
+		Time:        d.clock.Now().Unix(),
+		Annotations: annotations,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-annotations-event", err)
+		return
+	}
+
+	logger.Debug("annotated")
+}
+
+// ReportedTests records the test results. Their counts are annotated by the
+// step, along with any annotations the task wrote.
+func (d *taskDelegate) ReportedTests(logger lager.Logger, step string, results []atc.TestResult) {
+	err := d.build.SaveTestResults(step, results)
+	if err != nil {
+		logger.Error("failed-to-save-test-results", err)
+		return
+	}
+
+	logger.Debug("reported-tests", lager.Data{"tests": len(results)})
+}
+
 func (d *taskDelegate) FetchImage(
 	ctx context.Context,
 	image atc.ImageResource,
@@ -3,6 +3,7 @@ package engine
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -146,6 +147,73 @@ var _ = Describe("TaskDelegate", func() {
 		})
 	})
 
+	Describe("Annotated", func() {
+		var annotations atc.BuildAnnotations
+
+		BeforeEach(func() {
+			annotations = atc.BuildAnnotations{
+				Step:  "some-task",
+				Tests: &atc.BuildAnnotationTests{Passed: 1234},
+			}
+		})
+
+		JustBeforeEach(func() {
+			delegate.Annotated(logger, annotations)
+		})
+
+		It("adds them to the build", func() {
+			Expect(fakeBuild.AddAnnotationsCallCount()).To(Equal(1))
+			Expect(fakeBuild.AddAnnotationsArgsForCall(0)).To(Equal(annotations))
+		})
+
+		It("saves an event", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Annotations{
+				Origin:      event.Origin{ID: event.OriginID(planID)},
+				Time:        now.Unix(),
+				Annotations: annotations,
+			}))
+		})
+
+		Context("when adding them to the build fails", func() {
+			BeforeEach(func() {
+				fakeBuild.AddAnnotationsReturns(errors.New("nope"))
+			})
+
+			It("does not save an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("ReportedTests", func() {
+		var results []atc.TestResult
+
+		BeforeEach(func() {
+			results = []atc.TestResult{
+				{Step: "some-task", Suite: "unit", Name: "adds", Status: atc.TestPassed},
+				{Step: "some-task", Suite: "unit", Name: "subtracts", Status: atc.TestFailed},
+				{Step: "some-task", Suite: "unit", Name: "divides", Status: atc.TestErrored},
+				{Step: "some-task", Suite: "unit", Name: "multiplies", Status: atc.TestSkipped},
+			}
+		})
+
+		JustBeforeEach(func() {
+			delegate.ReportedTests(logger, "some-task", results)
+		})
+
+		It("saves them to the build", func() {
+			Expect(fakeBuild.SaveTestResultsCallCount()).To(Equal(1))
+			step, saved := fakeBuild.SaveTestResultsArgsForCall(0)
+			Expect(step).To(Equal("some-task"))
+			Expect(saved).To(Equal(results))
+		})
+
+		It("leaves annotating the build to the step", func() {
+			Expect(fakeBuild.AddAnnotationsCallCount()).To(BeZero())
+		})
+	})
+
 	Describe("FetchImage", func() {
 		var delegate exec.TaskDelegate
 
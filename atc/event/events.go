@@ -228,6 +228,15 @@ type SetPipelineChanged struct {
 func (SetPipelineChanged) EventType() atc.EventType  { return EventTypeSetPipelineChanged }
 func (SetPipelineChanged) Version() atc.EventVersion { return "1.0" }
 
+type Annotations struct {
+	Origin      Origin               `json:"origin"`
+	Time        int64                `json:"time"`
+	Annotations atc.BuildAnnotations `json:"annotations"`
+}
+
+func (Annotations) EventType() atc.EventType  { return EventTypeAnnotations }
+func (Annotations) Version() atc.EventVersion { return "1.0" }
+
 type Initialize struct {
 	Origin Origin `json:"origin"`
 	Time   int64  `json:"time,omitempty"`
@@ -45,6 +45,7 @@ func init() {
 	RegisterEvent(StartPut{})
 	RegisterEvent(FinishPut{})
 	RegisterEvent(SetPipelineChanged{})
+	RegisterEvent(Annotations{})
 	RegisterEvent(Status{})
 	RegisterEvent(WaitingForWorker{})
 	RegisterEvent(SelectedWorker{})
@@ -66,6 +66,7 @@ var _ = Describe("ParseEvent", func() {
 		Entry("StartPut", event.StartPut{}),
 		Entry("FinishPut", event.FinishPut{}),
 		Entry("SetPipelineChanged", event.SetPipelineChanged{}),
+		Entry("Annotations", event.Annotations{}),
 		Entry("Status", event.Status{}),
 		Entry("WaitingForWorker", event.WaitingForWorker{}),
 		Entry("SelectedWorker", event.SelectedWorker{}),
@@ -53,6 +53,9 @@ const (
 
 	EventTypeSetPipelineChanged atc.EventType = "set-pipeline-changed"
 
+	// a task annotated its build
+	EventTypeAnnotations atc.EventType = "annotations"
+
 	// initialize step
 	EventTypeInitialize atc.EventType = "initialize"
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"code.cloudfoundry.org/lager/v3"
@@ -19,6 +20,10 @@ import (
 	"github.com/concourse/concourse/tracing"
 )
 
+// checkPoolLease is how long a pooled check container is kept from being
+// garbage collected for a check that runs without a timeout.
+const checkPoolLease = 1 * time.Hour
+
 type CheckStep struct {
 	planID                atc.PlanID
 	plan                  atc.CheckPlan
@@ -186,7 +191,11 @@ func (step *CheckStep) run(ctx context.Context, state RunState, delegate CheckDe
 			ctx = lagerctx.NewContext(ctx, logger)
 		}
 
-		versions, processResult, runErr := step.runCheck(ctx, logger, delegate, imageSpec, resourceConfig, source, fromVersion)
+		if buildId == 0 {
+			buildId = step.metadata.BuildID
+		}
+
+		versions, processResult, runErr := step.runCheck(ctx, logger, delegate, imageSpec, resourceConfig, source, fromVersion, buildId)
 		if runErr != nil || processResult.ExitStatus != 0 {
 			metric.Metrics.ChecksFinishedWithError.Inc()
 
@@ -246,6 +255,7 @@ func (step *CheckStep) runCheck(
 	resourceConfig db.ResourceConfig,
 	source atc.Source,
 	fromVersion atc.Version,
+	buildID int,
 ) ([]atc.Version, runtime.ProcessResult, error) {
 	workerSpec := worker.Spec{
 		Tags:   step.plan.Tags,
@@ -269,7 +279,12 @@ func (step *CheckStep) runCheck(
 	}
 	tracing.Inject(ctx, &containerSpec)
 
-	containerOwner := step.containerOwner(delegate, resourceConfig)
+	containerOwner, pooled := step.containerOwner(delegate, resourceConfig, imageSpec)
+	if pooled {
+		// the container is shared with checks of other resources, so the
+		// step's metadata is given to the check process instead
+		containerSpec.Env = nil
+	}
 
 	err := delegate.BeforeSelectWorker(logger)
 	if err != nil {
@@ -308,16 +323,82 @@ func (step *CheckStep) runCheck(
 		return nil, runtime.ProcessResult{}, err
 	}
 
-	delegate.Starting(logger)
-	return resource.Resource{
+	res := resource.Resource{
 		Source:  source,
 		Version: fromVersion,
-	}.Check(ctx, container, delegate.Stderr())
+	}
+
+	if !pooled {
+		delegate.Starting(logger)
+		return res.Check(ctx, container, delegate.Stderr())
+	}
+
+	leaseUntil, ok := ctx.Deadline()
+	if !ok {
+		leaseUntil = time.Now().Add(checkPoolLease)
+	}
+
+	warm, err := container.DBContainer().RecordCheckPoolUse(leaseUntil)
+	if err != nil {
+		return nil, runtime.ProcessResult{}, err
+	}
+
+	defer func() {
+		err := container.DBContainer().ReleaseCheckPoolLease()
+		if err != nil {
+			logger.Error("failed-to-release-check-pool-lease", err)
+		}
+	}()
+
+	if warm {
+		metric.Metrics.CheckContainerPoolHits.Inc()
+	} else {
+		metric.Metrics.CheckContainerPoolMisses.Inc()
+	}
+
+	delegate.Starting(logger)
+	return step.runPooledCheck(ctx, logger, container, res, buildID, delegate.Stderr())
+}
+
+// runPooledCheck runs the check in a scratch directory of its own, which is
+// removed afterwards, so that checks of other resources sharing the
+// container don't come across anything it left behind, e.g. its source's
+// credentials. Failing to remove it, the container is destroyed rather than
+// run another check in.
+func (step *CheckStep) runPooledCheck(
+	ctx context.Context,
+	logger lager.Logger,
+	container runtime.Container,
+	res resource.Resource,
+	buildID int,
+	stderr io.Writer,
+) ([]atc.Version, runtime.ProcessResult, error) {
+	dir := resource.ResourcesDir(fmt.Sprintf("check-%d", buildID))
+
+	versions, processResult, checkErr := res.CheckInScratchDir(ctx, container, dir, step.metadata.Env(), stderr)
+
+	err := resource.RemoveScratchDir(context.WithoutCancel(ctx), container, dir)
+	if err != nil {
+		logger.Error("failed-to-remove-scratch-dir", err)
+
+		_, err := container.DBContainer().Destroying()
+		if err != nil {
+			logger.Error("failed-to-destroy-container", err)
+		}
+	}
+
+	return versions, processResult, checkErr
 }
 
-func (step *CheckStep) containerOwner(delegate CheckDelegate, resourceConfig db.ResourceConfig) db.ContainerOwner {
+func (step *CheckStep) containerOwner(delegate CheckDelegate, resourceConfig db.ResourceConfig, imageSpec runtime.ImageSpec) (db.ContainerOwner, bool) {
 	if !step.plan.IsResourceCheck() {
-		return delegate.ContainerOwner(step.planID)
+		return delegate.ContainerOwner(step.planID), false
+	}
+
+	if atc.CheckContainerPoolSize > 0 {
+		if key, ok := checkPoolKey(step.metadata.TeamID, imageSpec); ok {
+			return db.NewCheckPoolContainerOwner(key, atc.CheckContainerPoolSize), true
+		}
 	}
 
 	expires := db.ContainerOwnerExpiries{
@@ -332,5 +413,25 @@ func (step *CheckStep) containerOwner(delegate CheckDelegate, resourceConfig db.
 		resourceConfig.ID(),
 		resourceConfig.OriginBaseResourceType().ID,
 		expires,
-	)
+	), false
+}
+
+// checkPoolKey identifies the warm check containers that a check can run in:
+// those of the same team, running the same resource type image. For custom
+// resource types, the image artifact is the fetched version of the type, so
+// a new version of the type gets containers of its own.
+func checkPoolKey(teamID int, imageSpec runtime.ImageSpec) (string, bool) {
+	var image string
+	switch {
+	case imageSpec.ResourceType != "":
+		image = "base:" + imageSpec.ResourceType
+	case imageSpec.ImageArtifact != nil:
+		image = "artifact:" + imageSpec.ImageArtifact.Handle()
+	case imageSpec.ImageURL != "":
+		image = "url:" + imageSpec.ImageURL
+	default:
+		return "", false
+	}
+
+	return fmt.Sprintf("team:%d:privileged:%t:%s", teamID, imageSpec.Privileged, image), true
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimetest"
@@ -475,6 +476,115 @@ var _ = Describe("CheckStep", func() {
 					})
 				})
 
+				Context("when the check container pool is enabled", func() {
+					var scratchDir string
+
+					BeforeEach(func() {
+						atc.CheckContainerPoolSize = 5
+						checkPlan.Resource = "some-resource"
+
+						defaultTimeout = 30 * time.Minute
+
+						expectedOwner = db.NewCheckPoolContainerOwner("team:345:privileged:false:base:some-base-type", 5)
+						scratchDir = "/tmp/build/check-678"
+
+						chosenWorker = runtimetest.NewWorker("worker").
+							WithContainer(
+								expectedOwner,
+								runtimetest.NewContainer().
+									WithProcess(
+										runtime.ProcessSpec{
+											Path: "/opt/resource/check",
+											Env:  append([]string{"HOME=" + scratchDir, "TMPDIR=" + scratchDir}, stepMetadata.Env()...),
+											Dir:  scratchDir,
+										},
+										runtimetest.ProcessStub{},
+									).
+									WithProcess(
+										runtime.ProcessSpec{
+											Path: "/bin/rm",
+											Args: []string{"-rf", scratchDir},
+										},
+										runtimetest.ProcessStub{},
+									),
+								nil,
+							)
+						chosenContainer = chosenWorker.Containers[0]
+						fakePool.FindOrSelectWorkerReturns(chosenWorker, nil)
+
+						metric.Metrics.CheckContainerPoolHits.Delta()
+						metric.Metrics.CheckContainerPoolMisses.Delta()
+					})
+
+					AfterEach(func() {
+						atc.CheckContainerPoolSize = 0
+						defaultTimeout = 0
+					})
+
+					It("runs the check in a scratch directory of a pooled container", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(chosenContainer.RunningProcesses()).To(HaveLen(2))
+						Expect(chosenContainer.RunningProcesses()[0].Spec.Dir).To(Equal(scratchDir))
+
+						_, owner, _, _, _, _ := fakePool.FindOrSelectWorkerArgsForCall(0)
+						Expect(owner).To(Equal(expectedOwner))
+					})
+
+					It("gives the step's metadata to the check rather than the container", func() {
+						_, _, containerSpec, _, _, _ := fakePool.FindOrSelectWorkerArgsForCall(0)
+						Expect(containerSpec.Env).To(BeEmpty())
+					})
+
+					It("removes the scratch directory afterwards", func() {
+						Expect(chosenContainer.RunningProcesses()[1].Spec.Path).To(Equal("/bin/rm"))
+						Expect(chosenContainer.DBContainer_.DestroyingCallCount()).To(Equal(0))
+					})
+
+					Context("when the scratch directory can't be removed", func() {
+						BeforeEach(func() {
+							chosenContainer.ProcessDefs[1].Stub = runtimetest.ProcessStub{ExitStatus: 1}
+						})
+
+						It("destroys the container rather than running another check in it", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(chosenContainer.DBContainer_.DestroyingCallCount()).To(Equal(1))
+						})
+					})
+
+					It("leases the container for the duration of the check", func() {
+						Expect(chosenContainer.DBContainer_.RecordCheckPoolUseCallCount()).To(Equal(1))
+						Expect(chosenContainer.DBContainer_.RecordCheckPoolUseArgsForCall(0)).To(BeTemporally("~", time.Now().Add(defaultTimeout), time.Minute))
+						Expect(chosenContainer.DBContainer_.ReleaseCheckPoolLeaseCallCount()).To(Equal(1))
+					})
+
+					Context("when checking another resource config of the same type", func() {
+						BeforeEach(func() {
+							fakeResourceConfig.IDReturns(502)
+						})
+
+						It("shares the pooled containers", func() {
+							_, owner, _, _, _, _ := fakePool.FindOrSelectWorkerArgsForCall(0)
+							Expect(owner).To(Equal(expectedOwner))
+						})
+					})
+
+					It("counts a pool miss", func() {
+						Expect(metric.Metrics.CheckContainerPoolMisses.Delta()).To(Equal(float64(1)))
+						Expect(metric.Metrics.CheckContainerPoolHits.Delta()).To(Equal(float64(0)))
+					})
+
+					Context("when the worker has a warm container", func() {
+						BeforeEach(func() {
+							chosenContainer.DBContainer_.RecordCheckPoolUseReturns(true, nil)
+						})
+
+						It("counts a pool hit", func() {
+							Expect(metric.Metrics.CheckContainerPoolHits.Delta()).To(Equal(float64(1)))
+							Expect(metric.Metrics.CheckContainerPoolMisses.Delta()).To(Equal(float64(0)))
+						})
+					})
+				})
+
 				Context("when the plan is nested", func() {
 					BeforeEach(func() {
 						checkPlan.Resource = ""
@@ -84,6 +84,21 @@ type FakePutDelegate struct {
 		arg4 db.ResourceCache
 		arg5 resource.VersionResult
 	}
+	SaveProvenanceStub        func(lager.Logger) (atc.ProvenanceEnvelope, bool, error)
+	saveProvenanceMutex       sync.RWMutex
+	saveProvenanceArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	saveProvenanceReturns struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
+	saveProvenanceReturnsOnCall map[int]struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
 	SelectedWorkerStub        func(lager.Logger, string)
 	selectedWorkerMutex       sync.RWMutex
 	selectedWorkerArgsForCall []struct {
@@ -473,6 +488,73 @@ func (fake *FakePutDelegate) SaveOutputArgsForCall(i int) (lager.Logger, atc.Put
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
+func (fake *FakePutDelegate) SaveProvenance(arg1 lager.Logger) (atc.ProvenanceEnvelope, bool, error) {
+	fake.saveProvenanceMutex.Lock()
+	ret, specificReturn := fake.saveProvenanceReturnsOnCall[len(fake.saveProvenanceArgsForCall)]
+	fake.saveProvenanceArgsForCall = append(fake.saveProvenanceArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.SaveProvenanceStub
+	fakeReturns := fake.saveProvenanceReturns
+	fake.recordInvocation("SaveProvenance", []interface{}{arg1})
+	fake.saveProvenanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePutDelegate) SaveProvenanceCallCount() int {
+	fake.saveProvenanceMutex.RLock()
+	defer fake.saveProvenanceMutex.RUnlock()
+	return len(fake.saveProvenanceArgsForCall)
+}
+
+func (fake *FakePutDelegate) SaveProvenanceCalls(stub func(lager.Logger) (atc.ProvenanceEnvelope, bool, error)) {
+	fake.saveProvenanceMutex.Lock()
+	defer fake.saveProvenanceMutex.Unlock()
+	fake.SaveProvenanceStub = stub
+}
+
+func (fake *FakePutDelegate) SaveProvenanceArgsForCall(i int) lager.Logger {
+	fake.saveProvenanceMutex.RLock()
+	defer fake.saveProvenanceMutex.RUnlock()
+	argsForCall := fake.saveProvenanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePutDelegate) SaveProvenanceReturns(result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.saveProvenanceMutex.Lock()
+	defer fake.saveProvenanceMutex.Unlock()
+	fake.SaveProvenanceStub = nil
+	fake.saveProvenanceReturns = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePutDelegate) SaveProvenanceReturnsOnCall(i int, result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.saveProvenanceMutex.Lock()
+	defer fake.saveProvenanceMutex.Unlock()
+	fake.SaveProvenanceStub = nil
+	if fake.saveProvenanceReturnsOnCall == nil {
+		fake.saveProvenanceReturnsOnCall = make(map[int]struct {
+			result1 atc.ProvenanceEnvelope
+			result2 bool
+			result3 error
+		})
+	}
+	fake.saveProvenanceReturnsOnCall[i] = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePutDelegate) SelectedWorker(arg1 lager.Logger, arg2 string) {
 	fake.selectedWorkerMutex.Lock()
 	fake.selectedWorkerArgsForCall = append(fake.selectedWorkerArgsForCall, struct {
@@ -828,6 +910,8 @@ func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
 	defer fake.initializingMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
+	fake.saveProvenanceMutex.RLock()
+	defer fake.saveProvenanceMutex.RUnlock()
 	fake.selectedWorkerMutex.RLock()
 	defer fake.selectedWorkerMutex.RUnlock()
 	fake.startSpanMutex.RLock()
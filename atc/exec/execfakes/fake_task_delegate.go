@@ -16,6 +16,12 @@ import (
 )
 
 type FakeTaskDelegate struct {
+	AnnotatedStub        func(lager.Logger, atc.BuildAnnotations)
+	annotatedMutex       sync.RWMutex
+	annotatedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.BuildAnnotations
+	}
 	BeforeSelectWorkerStub        func(lager.Logger) error
 	beforeSelectWorkerMutex       sync.RWMutex
 	beforeSelectWorkerArgsForCall []struct {
@@ -72,6 +78,13 @@ type FakeTaskDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	ReportedTestsStub        func(lager.Logger, string, []atc.TestResult)
+	reportedTestsMutex       sync.RWMutex
+	reportedTestsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 []atc.TestResult
+	}
 	SelectedWorkerStub        func(lager.Logger, string)
 	selectedWorkerMutex       sync.RWMutex
 	selectedWorkerArgsForCall []struct {
@@ -147,6 +160,39 @@ type FakeTaskDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTaskDelegate) Annotated(arg1 lager.Logger, arg2 atc.BuildAnnotations) {
+	fake.annotatedMutex.Lock()
+	fake.annotatedArgsForCall = append(fake.annotatedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.BuildAnnotations
+	}{arg1, arg2})
+	stub := fake.AnnotatedStub
+	fake.recordInvocation("Annotated", []interface{}{arg1, arg2})
+	fake.annotatedMutex.Unlock()
+	if stub != nil {
+		fake.AnnotatedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) AnnotatedCallCount() int {
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
+	return len(fake.annotatedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) AnnotatedCalls(stub func(lager.Logger, atc.BuildAnnotations)) {
+	fake.annotatedMutex.Lock()
+	defer fake.annotatedMutex.Unlock()
+	fake.AnnotatedStub = stub
+}
+
+func (fake *FakeTaskDelegate) AnnotatedArgsForCall(i int) (lager.Logger, atc.BuildAnnotations) {
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
+	argsForCall := fake.annotatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTaskDelegate) BeforeSelectWorker(arg1 lager.Logger) error {
 	fake.beforeSelectWorkerMutex.Lock()
 	ret, specificReturn := fake.beforeSelectWorkerReturnsOnCall[len(fake.beforeSelectWorkerArgsForCall)]
@@ -428,6 +474,45 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTaskDelegate) ReportedTests(arg1 lager.Logger, arg2 string, arg3 []atc.TestResult) {
+	var arg3Copy []atc.TestResult
+	if arg3 != nil {
+		arg3Copy = make([]atc.TestResult, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.reportedTestsMutex.Lock()
+	fake.reportedTestsArgsForCall = append(fake.reportedTestsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 []atc.TestResult
+	}{arg1, arg2, arg3Copy})
+	stub := fake.ReportedTestsStub
+	fake.recordInvocation("ReportedTests", []interface{}{arg1, arg2, arg3Copy})
+	fake.reportedTestsMutex.Unlock()
+	if stub != nil {
+		fake.ReportedTestsStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTaskDelegate) ReportedTestsCallCount() int {
+	fake.reportedTestsMutex.RLock()
+	defer fake.reportedTestsMutex.RUnlock()
+	return len(fake.reportedTestsArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ReportedTestsCalls(stub func(lager.Logger, string, []atc.TestResult)) {
+	fake.reportedTestsMutex.Lock()
+	defer fake.reportedTestsMutex.Unlock()
+	fake.ReportedTestsStub = stub
+}
+
+func (fake *FakeTaskDelegate) ReportedTestsArgsForCall(i int) (lager.Logger, string, []atc.TestResult) {
+	fake.reportedTestsMutex.RLock()
+	defer fake.reportedTestsMutex.RUnlock()
+	argsForCall := fake.reportedTestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeTaskDelegate) SelectedWorker(arg1 lager.Logger, arg2 string) {
 	fake.selectedWorkerMutex.Lock()
 	fake.selectedWorkerArgsForCall = append(fake.selectedWorkerArgsForCall, struct {
@@ -801,6 +886,8 @@ func (fake *FakeTaskDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
 	fake.beforeSelectWorkerMutex.RLock()
 	defer fake.beforeSelectWorkerMutex.RUnlock()
 	fake.buildStartTimeMutex.RLock()
@@ -813,6 +900,8 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.finishedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.reportedTestsMutex.RLock()
+	defer fake.reportedTestsMutex.RUnlock()
 	fake.selectedWorkerMutex.RLock()
 	defer fake.selectedWorkerMutex.RUnlock()
 	fake.setTaskConfigMutex.RLock()
@@ -1,16 +1,22 @@
 package exec
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"time"
 
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagerctx"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/compression"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
@@ -45,8 +51,13 @@ type PutDelegate interface {
 	BuildStartTime() time.Time
 
 	SaveOutput(lager.Logger, atc.PutPlan, atc.Source, db.ResourceCache, resource.VersionResult)
+	SaveProvenance(lager.Logger) (atc.ProvenanceEnvelope, bool, error)
 }
 
+// ErrProvenanceNotGenerated is returned when a put asks for its build's
+// provenance but none was generated, i.e. no signing key is configured.
+var ErrProvenanceNotGenerated = errors.New("no provenance was generated for the build; is a provenance signing key configured?")
+
 // PutStep produces a resource version using preconfigured params and any data
 // available in the worker.ArtifactRepository.
 type PutStep struct {
@@ -241,9 +252,88 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 		delegate.SaveOutput(logger, step.plan, source, imageResourceCache, versionResult)
 	}
 
+	envelope, found, err := delegate.SaveProvenance(logger)
+	if err != nil {
+		if step.plan.Provenance != "" {
+			return false, err
+		}
+
+		logger.Error("failed-to-save-provenance", err)
+	}
+
+	if step.plan.Provenance != "" {
+		if !found {
+			return false, ErrProvenanceNotGenerated
+		}
+
+		err = step.registerProvenance(ctx, state, worker, envelope)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	state.StoreResult(step.planID, versionResult.Version)
 
 	delegate.Finished(logger, 0, versionResult)
 
 	return true, nil
 }
+
+// registerProvenance writes the provenance envelope to a new volume on the
+// worker the put ran on, and registers it as an artifact for later steps.
+func (step *PutStep) registerProvenance(ctx context.Context, state RunState, worker runtime.Worker, envelope atc.ProvenanceEnvelope) error {
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	payload = append(payload, '\n')
+
+	volume, _, err := worker.CreateVolumeForArtifact(ctx, step.metadata.TeamID)
+	if err != nil {
+		return fmt.Errorf("create provenance volume: %w", err)
+	}
+
+	gzipCompression := compression.NewGzipCompression()
+
+	var archive bytes.Buffer
+	compressed, err := gzipCompression.NewWriter(&archive)
+	if err != nil {
+		return err
+	}
+
+	tarWriter := tar.NewWriter(compressed)
+	err = tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     atc.ProvenanceFileName,
+		Mode:     0644,
+		Size:     int64(len(payload)),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tarWriter.Write(payload)
+	if err != nil {
+		return err
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	err = compressed.Close()
+	if err != nil {
+		return err
+	}
+
+	err = volume.StreamIn(ctx, "/", gzipCompression, 0, &archive)
+	if err != nil {
+		return fmt.Errorf("stream in provenance: %w", err)
+	}
+
+	state.ArtifactRepository().RegisterArtifact(build.ArtifactName(step.plan.Provenance), volume, false)
+
+	return nil
+}
@@ -606,6 +606,90 @@ var _ = Describe("PutStep", func() {
 		})
 	})
 
+	Describe("provenance", func() {
+		var envelope atc.ProvenanceEnvelope
+
+		BeforeEach(func() {
+			envelope = atc.ProvenanceEnvelope{
+				PayloadType: atc.ProvenancePayloadType,
+				Payload:     []byte(`{"some":"statement"}`),
+				Signatures:  []atc.ProvenanceSignature{{KeyID: "some-key", Sig: []byte("some-sig")}},
+			}
+
+			fakeDelegate.SaveProvenanceReturns(envelope, true, nil)
+		})
+
+		It("saves the build's provenance after saving the output", func() {
+			Expect(fakeDelegate.SaveProvenanceCallCount()).To(Equal(1))
+			Expect(fakeDelegate.SaveOutputCallCount()).To(Equal(1))
+		})
+
+		It("does not register a provenance artifact", func() {
+			Expect(stepOk).To(BeTrue())
+			Expect(chosenWorker.Volumes).To(BeEmpty())
+		})
+
+		Context("when saving the provenance fails", func() {
+			BeforeEach(func() {
+				fakeDelegate.SaveProvenanceReturns(atc.ProvenanceEnvelope{}, false, errors.New("nope"))
+			})
+
+			It("still succeeds", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stepOk).To(BeTrue())
+			})
+		})
+
+		Context("when the plan asks for a provenance artifact", func() {
+			BeforeEach(func() {
+				putPlan.Provenance = "some-provenance"
+			})
+
+			It("is successful", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stepOk).To(BeTrue())
+			})
+
+			It("registers the provenance as an artifact on the put's worker", func() {
+				Expect(chosenWorker.Volumes).To(HaveLen(1))
+
+				artifact, _, found := repo.ArtifactFor("some-provenance")
+				Expect(found).To(BeTrue())
+				Expect(artifact).To(Equal(chosenWorker.Volumes[0]))
+
+				file, found := chosenWorker.Volumes[0].Content[atc.ProvenanceFileName]
+				Expect(found).To(BeTrue())
+				Expect(file.Data).To(HaveSuffix("\n"))
+
+				var written atc.ProvenanceEnvelope
+				Expect(json.Unmarshal(file.Data, &written)).To(Succeed())
+				Expect(written).To(Equal(envelope))
+			})
+
+			Context("when no provenance was generated", func() {
+				BeforeEach(func() {
+					fakeDelegate.SaveProvenanceReturns(atc.ProvenanceEnvelope{}, false, nil)
+				})
+
+				It("errors", func() {
+					Expect(stepErr).To(Equal(exec.ErrProvenanceNotGenerated))
+					Expect(stepOk).To(BeFalse())
+				})
+			})
+
+			Context("when saving the provenance fails", func() {
+				BeforeEach(func() {
+					fakeDelegate.SaveProvenanceReturns(atc.ProvenanceEnvelope{}, false, errors.New("nope"))
+				})
+
+				It("errors", func() {
+					Expect(stepErr).To(MatchError("nope"))
+					Expect(stepOk).To(BeFalse())
+				})
+			})
+		})
+	})
+
 	Context("when the step.Plan.Resource is blank", func() {
 		BeforeEach(func() {
 			putPlan.Resource = ""
@@ -18,11 +18,16 @@ import (
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
+	"github.com/concourse/concourse/worker/baggageclaim"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const taskProcessID = "task"
 
+// annotationsFile is where a task may write annotations for its build,
+// relative to its working directory. Tasks find it at $BUILD_ANNOTATIONS_FILE.
+const annotationsFile = ".build-annotations.json"
+
 // MissingInputsError is returned when any of the task's required inputs are
 // missing.
 type MissingInputsError struct {
@@ -67,6 +72,8 @@ type TaskDelegate interface {
 	Stderr() io.Writer
 
 	SetTaskConfig(config atc.TaskConfig)
+	Annotated(lager.Logger, atc.BuildAnnotations)
+	ReportedTests(lager.Logger, string, []atc.TestResult)
 
 	Initializing(lager.Logger)
 	Starting(lager.Logger)
@@ -283,35 +290,59 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		return false, err
 	}
 
-	delegate.Starting(logger)
-	process, err := attachOrRun(
-		ctx,
-		container,
-		runtime.ProcessSpec{
-			ID:   taskProcessID,
-			Path: config.Run.Path,
-			Args: config.Run.Args,
-			Dir:  resolvePath(step.containerMetadata.WorkingDirectory, config.Run.Dir),
-			User: config.Run.User,
-			// Guardian sets the default TTY window size to width: 80, height: 24,
-			// which creates ANSI control sequences that do not work with other window sizes
-			TTY: &runtime.TTYSpec{
-				WindowSize: runtime.WindowSize{
-					Columns: 500,
-					Rows:    500,
-				},
+	processSpec := runtime.ProcessSpec{
+		ID:   taskProcessID,
+		Path: config.Run.Path,
+		Args: config.Run.Args,
+		Dir:  resolvePath(step.containerMetadata.WorkingDirectory, config.Run.Dir),
+		User: config.Run.User,
+		// Guardian sets the default TTY window size to width: 80, height: 24,
+		// which creates ANSI control sequences that do not work with other window sizes
+		TTY: &runtime.TTYSpec{
+			WindowSize: runtime.WindowSize{
+				Columns: 500,
+				Rows:    500,
 			},
 		},
-		runtime.ProcessIO{
-			Stdout: delegate.Stdout(),
-			Stderr: delegate.Stderr(),
-		},
-	)
+	}
+
+	processIO := runtime.ProcessIO{
+		Stdout: delegate.Stdout(),
+		Stderr: delegate.Stderr(),
+	}
+
+	delegate.Starting(logger)
+	process, err := attachOrRun(ctx, container, processSpec, processIO)
 	if err != nil {
 		return false, err
 	}
 
-	result, runErr := process.Wait(ctx)
+	var result runtime.ProcessResult
+	var runErr error
+	if step.plan.Checkpointable {
+		var run taskRun
+		run, result, runErr = step.waitCheckpointable(
+			ctx,
+			logger,
+			delegate,
+			owner,
+			containerSpec,
+			config,
+			processSpec,
+			processIO,
+			taskRun{
+				worker:       worker,
+				container:    container,
+				volumeMounts: volumeMounts,
+				process:      process,
+			},
+		)
+
+		// the task may have moved to another worker
+		worker, volumeMounts = run.worker, run.volumeMounts
+	} else {
+		result, runErr = process.Wait(ctx)
+	}
 
 	step.registerOutputs(logger, repository, config, volumeMounts, step.containerMetadata)
 
@@ -322,6 +353,10 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		}
 	}
 
+	if runErr == nil {
+		step.annotate(ctx, logger, delegate, config, volumeMounts)
+	}
+
 	if runErr != nil {
 		if errors.Is(runErr, context.DeadlineExceeded) {
 			delegate.Errored(logger, TimeoutLogMessage)
@@ -411,6 +446,7 @@ func (step *TaskStep) containerInputs(logger lager.Logger, repository *build.Rep
 
 func (step *TaskStep) containerSpec(logger lager.Logger, state RunState, imageSpec runtime.ImageSpec, config atc.TaskConfig, metadata db.ContainerMetadata) (runtime.ContainerSpec, error) {
 	env := step.metadata.TaskEnv()
+	env = append(env, "BUILD_ANNOTATIONS_FILE="+filepath.Join(metadata.WorkingDirectory, annotationsFile))
 	env = append(env, config.Params.Env()...)
 
 	containerSpec := runtime.ContainerSpec{
@@ -423,8 +459,12 @@ func (step *TaskStep) containerSpec(logger lager.Logger, state RunState, imageSp
 		Env:       env,
 		Type:      metadata.Type,
 
-		Dir:      metadata.WorkingDirectory,
-		Hermetic: step.plan.Hermetic,
+		Dir:          metadata.WorkingDirectory,
+		Hermetic:     step.plan.Hermetic,
+		RuntimeClass: step.plan.RuntimeClass,
+		Egress:       step.plan.Egress.Strings(),
+
+		Checkpointable: step.plan.Checkpointable,
 	}
 
 	var err error
@@ -443,6 +483,12 @@ func (step *TaskStep) containerSpec(logger lager.Logger, state RunState, imageSp
 		containerSpec.Outputs[output.Name] = ensureTrailingSlash(artifactPath(metadata.WorkingDirectory, output.Name, output.Path))
 	}
 
+	// the process of a checkpointable task is dumped into a volume of its
+	// own, which then gets carried over to the container it's restored in.
+	if step.plan.Checkpointable {
+		containerSpec.Outputs[checkpointOutputName] = ensureTrailingSlash(checkpointPath)
+	}
+
 	if config.Limits != nil {
 		containerSpec.Limits.CPU = (*uint64)(config.Limits.CPU)
 		containerSpec.Limits.Memory = (*uint64)(config.Limits.Memory)
@@ -453,9 +499,10 @@ func (step *TaskStep) containerSpec(logger lager.Logger, state RunState, imageSp
 
 func (step *TaskStep) workerSpec(config atc.TaskConfig) worker.Spec {
 	return worker.Spec{
-		Platform: config.Platform,
-		Tags:     step.plan.Tags,
-		TeamID:   step.metadata.TeamID,
+		Platform:     config.Platform,
+		Tags:         step.plan.Tags,
+		TeamID:       step.metadata.TeamID,
+		RuntimeClass: step.plan.RuntimeClass,
 	}
 }
 
@@ -478,6 +525,165 @@ func (step *TaskStep) registerOutputs(logger lager.Logger, repository *build.Rep
 	}
 }
 
+// annotate attaches the annotations the task wrote, if any, to the build,
+// along with the counts of the tests in its reports. They form a single entry
+// for the step, with the reports' counts taking the place of any the task
+// wrote itself.
+func (step *TaskStep) annotate(ctx context.Context, logger lager.Logger, delegate TaskDelegate, config atc.TaskConfig, volumeMounts []runtime.VolumeMount) {
+	annotations, annotated := step.readAnnotations(ctx, logger, delegate, volumeMounts)
+
+	results := step.reportTests(ctx, logger, delegate, config, volumeMounts)
+	if len(results) > 0 {
+		summary := atc.SummarizeTestResults(results)
+
+		annotations.Tests = &atc.BuildAnnotationTests{
+			Passed:  summary.Passed,
+			Failed:  summary.Failed + summary.Errored,
+			Skipped: summary.Skipped,
+		}
+
+		annotated = true
+	}
+
+	if !annotated {
+		return
+	}
+
+	annotations.Step = step.plan.Name
+
+	delegate.Annotated(logger, annotations)
+}
+
+// readAnnotations reads the annotations the task wrote, if any. Invalid
+// annotations only produce a warning, so as not to fail the build.
+func (step *TaskStep) readAnnotations(ctx context.Context, logger lager.Logger, delegate TaskDelegate, volumeMounts []runtime.VolumeMount) (atc.BuildAnnotations, bool) {
+	var workingDir runtime.Volume
+	for _, mount := range volumeMounts {
+		if filepath.Clean(mount.MountPath) == filepath.Clean(step.containerMetadata.WorkingDirectory) {
+			workingDir = mount.Volume
+			break
+		}
+	}
+
+	if workingDir == nil {
+		return atc.BuildAnnotations{}, false
+	}
+
+	file, err := step.streamer.StreamFile(ctx, workingDir, annotationsFile)
+	if err != nil {
+		if !errors.Is(err, baggageclaim.ErrFileNotFound) {
+			logger.Error("failed-to-stream-annotations", err)
+		}
+
+		return atc.BuildAnnotations{}, false
+	}
+
+	defer file.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(file, atc.MaxBuildAnnotationsSize+1))
+	if err != nil {
+		logger.Error("failed-to-read-annotations", err)
+		return atc.BuildAnnotations{}, false
+	}
+
+	annotations, err := atc.ParseBuildAnnotations(payload)
+	if err != nil {
+		fmt.Fprintln(delegate.Stderr(), "[WARNING] ignoring build annotations:", err)
+		return atc.BuildAnnotations{}, false
+	}
+
+	return annotations, true
+}
+
+// reportTests records the results in the task's JUnit reports against the
+// build, returning them. As with annotations, reports that can't be read only
+// produce a warning.
+func (step *TaskStep) reportTests(ctx context.Context, logger lager.Logger, delegate TaskDelegate, config atc.TaskConfig, volumeMounts []runtime.VolumeMount) []atc.TestResult {
+	if len(step.plan.Reports) == 0 {
+		return nil
+	}
+
+	var results []atc.TestResult
+	for _, report := range step.plan.Reports {
+		reportResults, err := step.readTestReport(ctx, config, volumeMounts, report)
+		if err != nil {
+			fmt.Fprintf(delegate.Stderr(), "[WARNING] ignoring test report %s: %s\n", report, err)
+			continue
+		}
+
+		results = append(results, reportResults...)
+	}
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	for i := range results {
+		results[i].Step = step.plan.Name
+	}
+
+	delegate.ReportedTests(logger, step.plan.Name, results)
+
+	return results
+}
+
+func (step *TaskStep) readTestReport(ctx context.Context, config atc.TaskConfig, volumeMounts []runtime.VolumeMount, report string) ([]atc.TestResult, error) {
+	outputName, path, _ := strings.Cut(filepath.Clean(report), "/")
+
+	var volume runtime.Volume
+	for _, output := range config.Outputs {
+		if output.Name != outputName {
+			continue
+		}
+
+		outputPath := artifactPath(step.containerMetadata.WorkingDirectory, output.Name, output.Path)
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
+				volume = mount.Volume
+				break
+			}
+		}
+	}
+
+	if volume == nil {
+		return nil, fmt.Errorf("task has no output named '%s'", outputName)
+	}
+
+	file, err := step.streamer.StreamFile(ctx, volume, path)
+	if err != nil {
+		if errors.Is(err, baggageclaim.ErrFileNotFound) {
+			return nil, errors.New("file not found")
+		}
+
+		return nil, err
+	}
+
+	defer file.Close()
+
+	return atc.ParseJUnitReport(&limitedReader{
+		r:   io.LimitReader(file, atc.MaxTestReportSize+1),
+		max: atc.MaxTestReportSize,
+	})
+}
+
+// limitedReader fails reads past the given size, rather than silently
+// truncating like an io.LimitedReader.
+type limitedReader struct {
+	r    io.Reader
+	read int64
+	max  int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.max {
+		return 0, fmt.Errorf("report must be at most %d bytes", l.max)
+	}
+
+	return n, err
+}
+
 func (step *TaskStep) registerCaches(ctx context.Context, repository *build.Repository, config atc.TaskConfig, volumeMounts []runtime.VolumeMount, metadata db.ContainerMetadata) error {
 	logger := lagerctx.FromContext(ctx)
 	for _, cacheConfig := range config.Caches {
@@ -0,0 +1,221 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+const (
+	checkpointOutputName = "concourse-checkpoint"
+	checkpointPath       = "/concourse/checkpoint"
+
+	checkpointPropertyName   = "concourse:checkpoint"
+	checkpointedPropertyName = "concourse:checkpointed"
+	restorePropertyName      = "concourse:restore"
+)
+
+// CheckpointPollInterval is how often the worker running a checkpointable
+// task is checked for being retired or landed.
+var CheckpointPollInterval = 10 * time.Second
+
+// taskRun is a task process along with the container it runs in.
+type taskRun struct {
+	worker       runtime.Worker
+	container    runtime.Container
+	volumeMounts []runtime.VolumeMount
+	process      runtime.Process
+}
+
+type processWaitResult struct {
+	result runtime.ProcessResult
+	err    error
+}
+
+func waitAsync(ctx context.Context, process runtime.Process) <-chan processWaitResult {
+	waitC := make(chan processWaitResult, 1)
+	go func() {
+		result, err := process.Wait(ctx)
+		waitC <- processWaitResult{result, err}
+	}()
+	return waitC
+}
+
+// waitCheckpointable waits for the process of a checkpointable task. If the
+// worker it's running on starts retiring or landing in the meantime, the
+// process is checkpointed and restored in a new container on another worker,
+// and waiting carries on there.
+func (step *TaskStep) waitCheckpointable(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate TaskDelegate,
+	owner db.ContainerOwner,
+	containerSpec runtime.ContainerSpec,
+	config atc.TaskConfig,
+	processSpec runtime.ProcessSpec,
+	processIO runtime.ProcessIO,
+	run taskRun,
+) (taskRun, runtime.ProcessResult, error) {
+	ticker := time.NewTicker(CheckpointPollInterval)
+	defer ticker.Stop()
+
+	waitC := waitAsync(ctx, run.process)
+	for {
+		select {
+		case res := <-waitC:
+			return run, res.result, res.err
+
+		case <-ticker.C:
+			if !workerDraining(logger, run.worker) {
+				continue
+			}
+
+			logger.Info("checkpointing-task", lager.Data{"worker": run.worker.Name()})
+			fmt.Fprintf(delegate.Stderr(), "\x1b[1;33mworker %s is going away; checkpointing task\x1b[0m\n", run.worker.Name())
+
+			err := run.container.SetProperty(checkpointPropertyName, checkpointPath)
+			if err != nil {
+				logger.Error("failed-to-checkpoint-task", err)
+				fmt.Fprintf(delegate.Stderr(), "\x1b[1;33mfailed to checkpoint task, continuing on worker %s: %s\x1b[0m\n", run.worker.Name(), err)
+				ticker.Stop()
+				continue
+			}
+
+			// the process exits once it's been checkpointed. runtimes that
+			// can't checkpoint leave it running, and it may have exited on its
+			// own in the meantime, in which case its result is the task's.
+			res := <-waitC
+			if res.err != nil || !checkpointed(logger, run.container) {
+				return run, res.result, res.err
+			}
+
+			run, err = step.restore(ctx, logger, delegate, owner, containerSpec, config, processSpec, processIO, run)
+			if err != nil {
+				return run, runtime.ProcessResult{}, err
+			}
+
+			fmt.Fprintf(delegate.Stderr(), "\x1b[1;33mrestored task on worker %s\x1b[0m\n", run.worker.Name())
+
+			waitC = waitAsync(ctx, run.process)
+		}
+	}
+}
+
+// restore recreates the container of a checkpointed task on another worker,
+// with the volumes of the old container as its inputs, and restores the
+// process from the checkpoint. Should restoring fail, the task is run from
+// scratch in the new container.
+//
+// The old worker is no longer running, so it won't be selected again. The
+// old container is only marked as destroying once its volumes have been
+// streamed to the new one.
+func (step *TaskStep) restore(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate TaskDelegate,
+	owner db.ContainerOwner,
+	containerSpec runtime.ContainerSpec,
+	config atc.TaskConfig,
+	processSpec runtime.ProcessSpec,
+	processIO runtime.ProcessIO,
+	old taskRun,
+) (taskRun, error) {
+	restoreSpec := containerSpec
+	restoreSpec.Outputs = nil
+	restoreSpec.Caches = nil
+	restoreSpec.Inputs = nil
+	for _, mount := range old.volumeMounts {
+		if mount.MountPath == "/scratch" {
+			continue
+		}
+
+		restoreSpec.Inputs = append(restoreSpec.Inputs, runtime.Input{
+			Artifact:        mount.Volume,
+			DestinationPath: mount.MountPath,
+		})
+	}
+
+	worker, err := step.workerPool.FindOrSelectWorker(
+		ctx,
+		owner,
+		restoreSpec,
+		step.workerSpec(config),
+		step.strategy,
+		delegate,
+	)
+	if err != nil {
+		return old, err
+	}
+
+	step.workerPool.ReleaseWorker(logger, containerSpec, old.worker, step.strategy)
+
+	delegate.SelectedWorker(logger, worker.Name())
+
+	container, volumeMounts, err := worker.FindOrCreateContainer(ctx, owner, step.containerMetadata, restoreSpec, delegate)
+	if err != nil {
+		return taskRun{worker: worker}, err
+	}
+
+	run := taskRun{
+		worker:       worker,
+		container:    container,
+		volumeMounts: volumeMounts,
+	}
+
+	err = container.SetProperty(restorePropertyName, checkpointPath)
+	if err != nil {
+		return run, err
+	}
+
+	run.process, err = container.Attach(ctx, processSpec.ID, processIO)
+	if err != nil {
+		logger.Error("failed-to-restore-task", err)
+		fmt.Fprintf(delegate.Stderr(), "\x1b[1;33mfailed to restore task, running it again: %s\x1b[0m\n", err)
+
+		run.process, err = container.Run(ctx, processSpec, processIO)
+		if err != nil {
+			return run, err
+		}
+	}
+
+	_, err = old.container.DBContainer().Destroying()
+	if err != nil {
+		return run, fmt.Errorf("destroy checkpointed container: %w", err)
+	}
+
+	return run, nil
+}
+
+// checkpointed determines whether the process of the container exited
+// because it was checkpointed.
+func checkpointed(logger lager.Logger, container runtime.Container) bool {
+	properties, err := container.Properties()
+	if err != nil {
+		logger.Error("failed-to-get-container-properties", err)
+		return false
+	}
+
+	return properties[checkpointedPropertyName] == "true"
+}
+
+func workerDraining(logger lager.Logger, worker runtime.Worker) bool {
+	dbWorker := worker.DBWorker()
+
+	found, err := dbWorker.Reload()
+	if err != nil {
+		logger.Error("failed-to-reload-worker", err)
+		return false
+	}
+
+	if !found {
+		return false
+	}
+
+	state := dbWorker.State()
+	return state == db.WorkerStateRetiring || state == db.WorkerStateLanding
+}
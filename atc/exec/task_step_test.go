@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -16,6 +18,7 @@ import (
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
+	"github.com/concourse/concourse/worker/baggageclaim"
 	"github.com/onsi/gomega/gbytes"
 	"go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
@@ -179,7 +182,11 @@ var _ = Describe("TaskStep", func() {
 		})
 
 		It("Task env includes atc external url", func() {
-			Expect(chosenContainer.Spec.Env).To(ConsistOf("ATC_EXTERNAL_URL=http://foo.bar", "SECURE=secret-task-param"))
+			Expect(chosenContainer.Spec.Env).To(ConsistOf(
+				"ATC_EXTERNAL_URL=http://foo.bar",
+				"BUILD_ANNOTATIONS_FILE=some-artifact-root/.build-annotations.json",
+				"SECURE=secret-task-param",
+			))
 		})
 
 		Context("before running the task", func() {
@@ -290,6 +297,179 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when a runtime class is configured", func() {
+			BeforeEach(func() {
+				taskPlan.RuntimeClass = "runsc"
+			})
+
+			It("requests a worker with the runtime class", func() {
+				_, _, _, workerSpec, _, _ := fakePool.FindOrSelectWorkerArgsForCall(0)
+				Expect(workerSpec.RuntimeClass).To(Equal("runsc"))
+			})
+
+			It("sets the runtime class on the container spec", func() {
+				Expect(chosenContainer.Spec.RuntimeClass).To(Equal("runsc"))
+			})
+		})
+
+		Context("when checkpointable", func() {
+			BeforeEach(func() {
+				taskPlan.Checkpointable = true
+			})
+
+			It("marks the container spec as checkpointable", func() {
+				Expect(chosenContainer.Spec.Checkpointable).To(BeTrue())
+			})
+
+			It("adds a volume to checkpoint the process into", func() {
+				Expect(chosenContainer.Spec.Outputs).To(HaveKeyWithValue("concourse-checkpoint", "/concourse/checkpoint/"))
+			})
+
+			It("finishes on the same worker", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stepOk).To(BeTrue())
+				Expect(fakePool.FindOrSelectWorkerCallCount()).To(Equal(1))
+			})
+
+			Context("when the worker starts retiring", func() {
+				var restoreWorker *runtimetest.Worker
+				var restoreContainer *runtimetest.WorkerContainer
+				var pollInterval time.Duration
+
+				BeforeEach(func() {
+					pollInterval = exec.CheckpointPollInterval
+					exec.CheckpointPollInterval = 10 * time.Millisecond
+
+					chosenWorker.DBWorker_.ReloadReturns(true, nil)
+					chosenWorker.DBWorker_.StateReturns(db.WorkerStateRetiring)
+
+					chosenContainer.Mounts = []runtime.VolumeMount{
+						{Volume: runtimetest.NewVolume("scratch"), MountPath: "/scratch"},
+						{Volume: runtimetest.NewVolume("checkpoint"), MountPath: "/concourse/checkpoint/"},
+					}
+
+					checkpointed := make(chan struct{})
+					chosenContainer.ProcessDefs[0].Stub.Do = func(context.Context, *runtimetest.Process) error {
+						Eventually(func() map[string]string {
+							props, _ := chosenContainer.Properties()
+							return props
+						}).Should(HaveKeyWithValue("concourse:checkpoint", "/concourse/checkpoint"))
+						chosenContainer.SetProperty("concourse:checkpointed", "true")
+						close(checkpointed)
+						return nil
+					}
+					chosenContainer.ProcessDefs[0].Stub.ExitStatus = 137
+
+					restoreWorker = runtimetest.NewWorker("restore-worker").
+						WithContainer(
+							expectedOwner,
+							runtimetest.NewContainer().WithProcess(
+								chosenContainer.ProcessDefs[0].Spec,
+								runtimetest.ProcessStub{
+									Do: func(context.Context, *runtimetest.Process) error {
+										<-checkpointed
+										return nil
+									},
+								},
+							),
+							nil,
+						)
+					restoreContainer = restoreWorker.Containers[0]
+
+					fakePool.FindOrSelectWorkerReturnsOnCall(1, restoreWorker, nil)
+				})
+
+				AfterEach(func() {
+					exec.CheckpointPollInterval = pollInterval
+				})
+
+				It("checkpoints the task and restores it on another worker", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeTrue())
+
+					Expect(fakePool.FindOrSelectWorkerCallCount()).To(Equal(2))
+					Expect(chosenContainer.DBContainer_.DestroyingCallCount()).To(Equal(1))
+				})
+
+				Context("when the old container is marked as destroying", func() {
+					var restoredBeforeDestroying bool
+
+					BeforeEach(func() {
+						chosenContainer.DBContainer_.DestroyingStub = func() (db.DestroyingContainer, error) {
+							_, restoredBeforeDestroying = restoreContainer.Props["concourse:restore"]
+							return nil, nil
+						}
+					})
+
+					It("has already restored the task from its volumes", func() {
+						Expect(restoredBeforeDestroying).To(BeTrue())
+					})
+				})
+
+				It("restores the task from the old container's volumes", func() {
+					Expect(restoreContainer.Props).To(HaveKeyWithValue("concourse:restore", "/concourse/checkpoint"))
+					Expect(restoreContainer.Spec.Inputs).To(ConsistOf(runtime.Input{
+						Artifact:        chosenContainer.Mounts[1].Volume,
+						DestinationPath: "/concourse/checkpoint/",
+					}))
+				})
+
+				It("releases both workers", func() {
+					Expect(fakePool.ReleaseWorkerCallCount()).To(Equal(2))
+					_, _, released, _ := fakePool.ReleaseWorkerArgsForCall(0)
+					Expect(released.Name()).To(Equal("worker"))
+					_, _, released, _ = fakePool.ReleaseWorkerArgsForCall(1)
+					Expect(released.Name()).To(Equal("restore-worker"))
+				})
+			})
+
+			Context("when the runtime does not checkpoint the task", func() {
+				var pollInterval time.Duration
+
+				BeforeEach(func() {
+					pollInterval = exec.CheckpointPollInterval
+					exec.CheckpointPollInterval = 10 * time.Millisecond
+
+					chosenWorker.DBWorker_.ReloadReturns(true, nil)
+					chosenWorker.DBWorker_.StateReturns(db.WorkerStateRetiring)
+
+					chosenContainer.ProcessDefs[0].Stub.Do = func(context.Context, *runtimetest.Process) error {
+						Eventually(func() map[string]string {
+							props, _ := chosenContainer.Properties()
+							return props
+						}).Should(HaveKeyWithValue("concourse:checkpoint", "/concourse/checkpoint"))
+						return nil
+					}
+					chosenContainer.ProcessDefs[0].Stub.ExitStatus = 3
+				})
+
+				AfterEach(func() {
+					exec.CheckpointPollInterval = pollInterval
+				})
+
+				It("finishes with the process's own exit status on the same worker", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeFalse())
+
+					Expect(fakePool.FindOrSelectWorkerCallCount()).To(Equal(1))
+					Expect(chosenContainer.DBContainer_.DestroyingCallCount()).To(Equal(0))
+
+					_, status := fakeDelegate.FinishedArgsForCall(0)
+					Expect(status).To(Equal(exec.ExitStatus(3)))
+				})
+			})
+		})
+
+		Context("when egress is configured", func() {
+			BeforeEach(func() {
+				taskPlan.Egress = atc.EgressRules{"10.0.0.0/8", "artifacts.example.com:443"}
+			})
+
+			It("sets the egress rules on the container spec", func() {
+				Expect(chosenContainer.Spec.Egress).To(Equal([]string{"10.0.0.0/8", "artifacts.example.com:443"}))
+			})
+		})
+
 		Context("when a timeout is configured", func() {
 			BeforeEach(func() {
 				taskPlan.Timeout = "1ms"
@@ -679,6 +859,194 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the task has a working directory", func() {
+			var workingDirVolume *runtimetest.Volume
+
+			BeforeEach(func() {
+				workingDirVolume = runtimetest.NewVolume("working-dir")
+
+				chosenContainer.Mounts = []runtime.VolumeMount{
+					{
+						Volume:    workingDirVolume,
+						MountPath: "some-artifact-root",
+					},
+				}
+			})
+
+			Context("when the task writes annotations", func() {
+				BeforeEach(func() {
+					fakeStreamer.StreamFileReturns(io.NopCloser(strings.NewReader(`{
+						"summary": "all good",
+						"tests": {"passed": 1234, "failed": 0}
+					}`)), nil)
+				})
+
+				It("reads them from the working directory", func() {
+					Expect(fakeStreamer.StreamFileCallCount()).To(Equal(1))
+					_, artifact, path := fakeStreamer.StreamFileArgsForCall(0)
+					Expect(artifact).To(Equal(workingDirVolume))
+					Expect(path).To(Equal(".build-annotations.json"))
+				})
+
+				It("attaches them to the build under the task's name", func() {
+					Expect(fakeDelegate.AnnotatedCallCount()).To(Equal(1))
+					_, annotations := fakeDelegate.AnnotatedArgsForCall(0)
+					Expect(annotations).To(Equal(atc.BuildAnnotations{
+						Step:    "some-task",
+						Summary: "all good",
+						Tests:   &atc.BuildAnnotationTests{Passed: 1234},
+					}))
+				})
+			})
+
+			Context("when the annotations are invalid", func() {
+				BeforeEach(func() {
+					fakeStreamer.StreamFileReturns(io.NopCloser(strings.NewReader(`{"links": [{"name": "bad", "url": "javascript:alert(1)"}]}`)), nil)
+				})
+
+				It("warns without failing the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeTrue())
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] ignoring build annotations: link 'bad' must be an http or https URL`))
+					Expect(fakeDelegate.AnnotatedCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the task does not write annotations", func() {
+				BeforeEach(func() {
+					fakeStreamer.StreamFileReturns(nil, baggageclaim.ErrFileNotFound)
+				})
+
+				It("does not annotate the build", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeDelegate.AnnotatedCallCount()).To(BeZero())
+					Expect(stderrBuf.Contents()).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the task has test reports", func() {
+			var reportsVolume *runtimetest.Volume
+
+			BeforeEach(func() {
+				taskPlan.Config.Outputs = []atc.TaskOutputConfig{
+					{Name: "reports"},
+				}
+				taskPlan.Reports = []string{"reports/junit.xml"}
+
+				reportsVolume = runtimetest.NewVolume("reports")
+
+				chosenContainer.Mounts = []runtime.VolumeMount{
+					{
+						Volume:    reportsVolume,
+						MountPath: "some-artifact-root/reports/",
+					},
+				}
+
+				fakeStreamer.StreamFileReturns(io.NopCloser(strings.NewReader(`<testsuite name="unit">
+					<testcase name="adds" time="0.5"/>
+					<testcase name="subtracts"><failure message="expected 1, got 2"/></testcase>
+				</testsuite>`)), nil)
+			})
+
+			It("reads them from the task's outputs", func() {
+				Expect(fakeStreamer.StreamFileCallCount()).To(Equal(1))
+				_, artifact, path := fakeStreamer.StreamFileArgsForCall(0)
+				Expect(artifact).To(Equal(reportsVolume))
+				Expect(path).To(Equal("junit.xml"))
+			})
+
+			It("records the results under the task's name", func() {
+				Expect(fakeDelegate.ReportedTestsCallCount()).To(Equal(1))
+				_, stepName, results := fakeDelegate.ReportedTestsArgsForCall(0)
+				Expect(stepName).To(Equal("some-task"))
+				Expect(results).To(Equal([]atc.TestResult{
+					{Step: "some-task", Suite: "unit", Name: "adds", Status: atc.TestPassed, Duration: 0.5},
+					{Step: "some-task", Suite: "unit", Name: "subtracts", Status: atc.TestFailed, Message: "expected 1, got 2"},
+				}))
+			})
+
+			It("annotates the build with their counts", func() {
+				Expect(fakeDelegate.AnnotatedCallCount()).To(Equal(1))
+				_, annotations := fakeDelegate.AnnotatedArgsForCall(0)
+				Expect(annotations).To(Equal(atc.BuildAnnotations{
+					Step:  "some-task",
+					Tests: &atc.BuildAnnotationTests{Passed: 1, Failed: 1},
+				}))
+			})
+
+			Context("when the task also writes annotations", func() {
+				BeforeEach(func() {
+					chosenContainer.Mounts = append(chosenContainer.Mounts, runtime.VolumeMount{
+						Volume:    runtimetest.NewVolume("working-dir"),
+						MountPath: "some-artifact-root",
+					})
+
+					fakeStreamer.StreamFileStub = func(_ context.Context, _ runtime.Artifact, path string) (io.ReadCloser, error) {
+						if path == ".build-annotations.json" {
+							return io.NopCloser(strings.NewReader(`{
+								"summary": "all good",
+								"tests": {"passed": 1234, "failed": 0}
+							}`)), nil
+						}
+
+						return io.NopCloser(strings.NewReader(`<testsuite name="unit">
+							<testcase name="adds" time="0.5"/>
+							<testcase name="subtracts"><failure message="expected 1, got 2"/></testcase>
+						</testsuite>`)), nil
+					}
+				})
+
+				It("adds the counts to the task's annotations", func() {
+					Expect(fakeDelegate.AnnotatedCallCount()).To(Equal(1))
+					_, annotations := fakeDelegate.AnnotatedArgsForCall(0)
+					Expect(annotations).To(Equal(atc.BuildAnnotations{
+						Step:    "some-task",
+						Summary: "all good",
+						Tests:   &atc.BuildAnnotationTests{Passed: 1, Failed: 1},
+					}))
+				})
+			})
+
+			Context("when a report is in an unknown output", func() {
+				BeforeEach(func() {
+					taskPlan.Reports = []string{"bogus/junit.xml", "reports/junit.xml"}
+				})
+
+				It("warns and records the other reports", func() {
+					Expect(stepOk).To(BeTrue())
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] ignoring test report bogus/junit.xml: task has no output named 'bogus'`))
+					Expect(fakeDelegate.ReportedTestsCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the report does not exist", func() {
+				BeforeEach(func() {
+					fakeStreamer.StreamFileReturns(nil, baggageclaim.ErrFileNotFound)
+				})
+
+				It("warns without failing the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeTrue())
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] ignoring test report reports/junit.xml: file not found`))
+					Expect(fakeDelegate.ReportedTestsCallCount()).To(BeZero())
+					Expect(fakeDelegate.AnnotatedCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the report is malformed", func() {
+				BeforeEach(func() {
+					fakeStreamer.StreamFileReturns(io.NopCloser(strings.NewReader(`<html></html>`)), nil)
+				})
+
+				It("warns without failing the task", func() {
+					Expect(stepOk).To(BeTrue())
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] ignoring test report reports/junit.xml: malformed report: unexpected root element <html>`))
+					Expect(fakeDelegate.ReportedTestsCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when missing the platform", func() {
 			BeforeEach(func() {
 				taskPlan.Config.Platform = ""
@@ -9,12 +9,21 @@ import (
 	"github.com/concourse/concourse/atc/db"
 )
 
+// BuildLogArchiver keeps a copy of a build's events elsewhere before they are
+// reaped from the database.
+//
+//counterfeiter:generate . BuildLogArchiver
+type BuildLogArchiver interface {
+	Archive(context.Context, db.BuildForAPI) error
+}
+
 type buildLogCollector struct {
 	pipelineFactory             db.PipelineFactory
 	pipelineLifecycle           db.PipelineLifecycle
 	batchSize                   int
 	drainerConfigured           bool
 	buildLogRetentionCalculator BuildLogRetentionCalculator
+	archiver                    BuildLogArchiver
 }
 
 func NewBuildLogCollector(
@@ -23,6 +32,7 @@ func NewBuildLogCollector(
 	batchSize int,
 	buildLogRetentionCalculator BuildLogRetentionCalculator,
 	drainerConfigured bool,
+	archiver BuildLogArchiver,
 ) *buildLogCollector {
 	return &buildLogCollector{
 		pipelineFactory:             pipelineFactory,
@@ -30,6 +40,7 @@ func NewBuildLogCollector(
 		batchSize:                   batchSize,
 		drainerConfigured:           drainerConfigured,
 		buildLogRetentionCalculator: buildLogRetentionCalculator,
+		archiver:                    archiver,
 	}
 }
 
@@ -67,7 +78,7 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 				continue
 			}
 
-			err = br.reapLogsOfJob(pipeline, job, logger)
+			err = br.reapLogsOfJob(ctx, pipeline, job, logger)
 			if err != nil {
 				continue
 			}
@@ -77,7 +88,8 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 	return nil
 }
 
-func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
+func (br *buildLogCollector) reapLogsOfJob(ctx context.Context,
+	pipeline db.Pipeline,
 	job db.Job,
 	logger lager.Logger) error {
 
@@ -203,6 +215,25 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 		}
 	}
 
+	if br.archiver != nil {
+		unarchivedBuildIDs := br.archiveBuilds(ctx, buildsToConsiderDeleting, buildIDsToDelete, logger)
+
+		archivedBuildIDs := []int{}
+		for _, id := range buildIDsToDelete {
+			if !unarchivedBuildIDs[id] {
+				archivedBuildIDs = append(archivedBuildIDs, id)
+				continue
+			}
+
+			// keep the build logged so that it's archived on the next run
+			if firstLoggedBuildID == 0 || id < firstLoggedBuildID {
+				firstLoggedBuildID = id
+			}
+		}
+
+		buildIDsToDelete = archivedBuildIDs
+	}
+
 	logger.Debug("reaping-builds", lager.Data{
 		"build_ids": buildIDsToDelete,
 	})
@@ -223,3 +254,28 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 
 	return nil
 }
+
+// archiveBuilds archives the builds that are about to be reaped, returning
+// the ones that failed to be archived. Those aren't reaped, so that they're
+// retried on the next run rather than lost, but they don't hold up the rest.
+func (br *buildLogCollector) archiveBuilds(ctx context.Context, builds []db.BuildForAPI, buildIDs []int, logger lager.Logger) map[int]bool {
+	toArchive := map[int]bool{}
+	for _, id := range buildIDs {
+		toArchive[id] = true
+	}
+
+	failed := map[int]bool{}
+	for _, build := range builds {
+		if !toArchive[build.ID()] || build.EventsArchive() != "" {
+			continue
+		}
+
+		err := br.archiver.Archive(ctx, build)
+		if err != nil {
+			logger.Error("failed-to-archive-build-events", err, lager.Data{"build_id": build.ID()})
+			failed[build.ID()] = true
+		}
+	}
+
+	return failed
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/gc/gcfakes"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -45,6 +46,7 @@ var _ = Describe("BuildLogCollector", func() {
 			batchSize,
 			buildLogRetainCalc,
 			false,
+			nil,
 		)
 	})
 
@@ -111,6 +113,7 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						true,
+						nil,
 					)
 				})
 				BeforeEach(func() {
@@ -164,6 +167,7 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						false,
+						nil,
 					)
 					fakeJob.ChronoBuildsStub = func(page db.Page) ([]db.BuildForAPI, db.Pagination, error) {
 						if *page.From == 5 {
@@ -191,6 +195,92 @@ var _ = Describe("BuildLogCollector", func() {
 				})
 			})
 
+			Context("when an archiver is configured", func() {
+				var (
+					fakeArchiver  *gcfakes.FakeBuildLogArchiver
+					archivedBuild *dbfakes.FakeBuild
+				)
+
+				BeforeEach(func() {
+					fakeArchiver = new(gcfakes.FakeBuildLogArchiver)
+
+					archivedBuild = new(dbfakes.FakeBuild)
+					archivedBuild.IDReturns(6)
+					archivedBuild.EventsArchiveReturns("builds/6/events.ndjson.gz")
+
+					fakeJob.ChronoBuildsStub = func(page db.Page) ([]db.BuildForAPI, db.Pagination, error) {
+						if *page.From == 5 {
+							return []db.BuildForAPI{sb(9), sb(8), sb(7), archivedBuild, sb(5)}, db.Pagination{}, nil
+						}
+						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
+						return []db.BuildForAPI{}, db.Pagination{}, nil
+					}
+				})
+
+				JustBeforeEach(func() {
+					buildLogCollector = NewBuildLogCollector(
+						fakePipelineFactory,
+						fakePipelineLifecycle,
+						batchSize,
+						buildLogRetainCalc,
+						false,
+						fakeArchiver,
+					)
+				})
+
+				It("archives the builds before reaping them", func() {
+					err := buildLogCollector.Run(ctx)
+					Expect(err).NotTo(HaveOccurred())
+
+					archived := []int{}
+					for i := 0; i < fakeArchiver.ArchiveCallCount(); i++ {
+						_, build := fakeArchiver.ArchiveArgsForCall(i)
+						archived = append(archived, build.ID())
+					}
+
+					Expect(archived).To(ConsistOf(7, 5))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(7, 6, 5))
+				})
+
+				Context("when archiving a build fails", func() {
+					BeforeEach(func() {
+						fakeArchiver.ArchiveStub = func(_ context.Context, build db.BuildForAPI) error {
+							if build.ID() == 5 {
+								return errors.New("bucket is gone")
+							}
+
+							return nil
+						}
+					})
+
+					It("reaps the other builds and keeps it logged to retry it", func() {
+						err := buildLogCollector.Run(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(logger.Buffer()).Should(gbytes.Say("bucket is gone"))
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(7, 6))
+						Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when archiving fails for every build", func() {
+					BeforeEach(func() {
+						fakeArchiver.ArchiveReturns(errors.New("bucket is gone"))
+					})
+
+					It("reaps only the builds that were already archived", func() {
+						err := buildLogCollector.Run(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6))
+						Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(BeZero())
+					})
+				})
+			})
+
 			Context("when deleting build events fails", func() {
 				var disaster error
 
@@ -11,6 +11,10 @@ import (
 	"github.com/hashicorp/go-multierror"
 )
 
+// CheckPoolIdleTimeout is how long a pooled check container may go without
+// running a check before it's evicted from the pool.
+var CheckPoolIdleTimeout = 1 * time.Hour
+
 type containerCollector struct {
 	containerRepository         db.ContainerRepository
 	missingContainerGracePeriod time.Duration
@@ -93,6 +97,12 @@ func (c *containerCollector) cleanupOrphanedContainers(logger lager.Logger) erro
 		return err
 	}
 
+	_, err = c.containerRepository.EvictIdleCheckPoolContainers(CheckPoolIdleTimeout)
+	if err != nil {
+		logger.Error("failed-to-evict-idle-check-pool-containers", err)
+		return err
+	}
+
 	creatingContainers, createdContainers, destroyingContainers, err := c.containerRepository.FindOrphanedContainers()
 	if err != nil {
 		logger.Error("failed-to-get-orphaned-containers-for-deletion", err)
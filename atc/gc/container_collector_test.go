@@ -10,6 +10,7 @@ import (
 
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/db/dbtest"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -83,6 +84,22 @@ var _ = Describe("ContainerCollector", func() {
 			})
 		})
 
+		It("evicts idle containers from the check container pool", func() {
+			Expect(fakeContainerRepository.EvictIdleCheckPoolContainersCallCount()).To(Equal(1))
+			Expect(fakeContainerRepository.EvictIdleCheckPoolContainersArgsForCall(0)).To(Equal(gc.CheckPoolIdleTimeout))
+		})
+
+		Context("when evicting idle check pool containers fails", func() {
+			BeforeEach(func() {
+				fakeContainerRepository.EvictIdleCheckPoolContainersReturns(0, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(fakeContainerRepository.FindOrphanedContainersCallCount()).To(Equal(0))
+			})
+		})
+
 		Describe("Orphaned Containers", func() {
 
 			var (
@@ -182,3 +199,61 @@ var _ = Describe("ContainerCollector", func() {
 		})
 	})
 })
+
+var _ = Describe("ContainerCollector with the check container pool", func() {
+	var (
+		containerRepository db.ContainerRepository
+		collector           GcCollector
+
+		worker  db.Worker
+		evicted db.CreatedContainer
+	)
+
+	BeforeEach(func() {
+		containerRepository = db.NewContainerRepository(dbConn)
+		collector = gc.NewContainerCollector(containerRepository, time.Minute, time.Minute)
+
+		scenario := dbtest.Setup(builder.WithBaseWorker())
+		worker = scenario.Workers[0]
+
+		creating, err := worker.CreateContainer(
+			db.NewCheckPoolContainerOwner("some-key", 1),
+			db.ContainerMetadata{Type: db.ContainerTypeCheck},
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		evicted, err = creating.Created()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = evicted.RecordCheckPoolUse(time.Now().Add(time.Hour))
+		Expect(err).ToNot(HaveOccurred())
+
+		// pooling another container evicts the one the check is running in
+		_, err = worker.CreateContainer(
+			db.NewCheckPoolContainerOwner("some-other-key", 1),
+			db.ContainerMetadata{Type: db.ContainerTypeCheck},
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	destroying := func() []string {
+		handles, err := containerRepository.FindDestroyingContainers(worker.Name())
+		Expect(err).ToNot(HaveOccurred())
+		return handles
+	}
+
+	It("does not destroy an evicted container while a check is running in it", func() {
+		err := collector.Run(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(destroying()).To(BeEmpty())
+	})
+
+	It("destroys the evicted container once the check is done", func() {
+		err := evicted.ReleaseCheckPoolLease()
+		Expect(err).ToNot(HaveOccurred())
+
+		err = collector.Run(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(destroying()).To(ConsistOf(evicted.Handle()))
+	})
+})
@@ -111,7 +111,7 @@ var _ = BeforeEach(func() {
 	}
 
 	defaultPipelineRef = atc.PipelineRef{Name: "default-pipeline"}
-	defaultPipeline, _, err = defaultTeam.SavePipeline(defaultPipelineRef, atcConfig, db.ConfigVersion(0), false)
+	defaultPipeline, _, err = defaultTeam.SavePipeline(defaultPipelineRef, atcConfig, db.ConfigVersion(0), false, "")
 	Expect(err).NotTo(HaveOccurred())
 
 	var found bool
@@ -0,0 +1,48 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type interceptSessionCollector struct {
+	interceptSessionFactory db.InterceptSessionFactory
+	retention               time.Duration
+}
+
+// NewInterceptSessionCollector returns a collector deleting the intercept
+// sessions, and their recordings, started longer ago than the retention. A
+// retention of 0 keeps them forever.
+func NewInterceptSessionCollector(interceptSessionFactory db.InterceptSessionFactory, retention time.Duration) *interceptSessionCollector {
+	return &interceptSessionCollector{
+		interceptSessionFactory: interceptSessionFactory,
+		retention:               retention,
+	}
+}
+
+func (c *interceptSessionCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("intercept-session-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	if c.retention == 0 {
+		return nil
+	}
+
+	deleted, err := c.interceptSessionFactory.DeleteInterceptSessionsBefore(time.Now().Add(-c.retention))
+	if err != nil {
+		logger.Error("failed-to-delete-intercept-sessions", err)
+		return err
+	}
+
+	if deleted > 0 {
+		logger.Debug("deleted-intercept-sessions", lager.Data{"deleted": deleted})
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package gc_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InterceptSessionCollector", func() {
+	var fakeInterceptSessionFactory *dbfakes.FakeInterceptSessionFactory
+
+	BeforeEach(func() {
+		fakeInterceptSessionFactory = new(dbfakes.FakeInterceptSessionFactory)
+	})
+
+	It("deletes the sessions started before the retention", func() {
+		collector := gc.NewInterceptSessionCollector(fakeInterceptSessionFactory, 24*time.Hour)
+
+		err := collector.Run(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeInterceptSessionFactory.DeleteInterceptSessionsBeforeCallCount()).To(Equal(1))
+		Expect(fakeInterceptSessionFactory.DeleteInterceptSessionsBeforeArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+	})
+
+	It("keeps the sessions forever when there's no retention", func() {
+		collector := gc.NewInterceptSessionCollector(fakeInterceptSessionFactory, 0)
+
+		err := collector.Run(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeInterceptSessionFactory.DeleteInterceptSessionsBeforeCallCount()).To(BeZero())
+	})
+})
@@ -0,0 +1,24 @@
+package atc
+
+// InterceptSession is a shell opened in a container with `fly intercept`,
+// as recorded for auditing.
+type InterceptSession struct {
+	ID              int      `json:"id"`
+	ContainerHandle string   `json:"container_handle"`
+	CreatedBy       string   `json:"created_by,omitempty"`
+	Command         []string `json:"command"`
+
+	BuildID      int    `json:"build_id,omitempty"`
+	BuildName    string `json:"build_name,omitempty"`
+	PipelineName string `json:"pipeline_name,omitempty"`
+	JobName      string `json:"job_name,omitempty"`
+	StepName     string `json:"step_name,omitempty"`
+
+	StartedAt  int64 `json:"started_at"`
+	EndedAt    int64 `json:"ended_at,omitempty"`
+	ExitStatus *int  `json:"exit_status,omitempty"`
+
+	// Recorded is whether the session's recording can be replayed. It is
+	// saved once the session ends.
+	Recorded bool `json:"recorded"`
+}
@@ -14,6 +14,8 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	SLO *JobSLO `json:"slo,omitempty"`
+
 	OnSuccess *Step `json:"on_success,omitempty"`
 	OnFailure *Step `json:"on_failure,omitempty"`
 	OnAbort   *Step `json:"on_abort,omitempty"`
@@ -0,0 +1,212 @@
+package atc
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// DefaultJobSLOWindow is the number of a job's most recent builds that
+	// its SLO is measured over when it doesn't configure a window.
+	DefaultJobSLOWindow = 20
+
+	// DefaultJobSLOAnomalyThreshold is how many standard deviations above
+	// the job's mean duration a build can take before it's considered an
+	// anomaly.
+	DefaultJobSLOAnomalyThreshold = 3.0
+
+	// MinimumJobSLOAnomalyBuilds is the number of earlier succeeded builds
+	// a job needs before its builds are checked for duration anomalies.
+	MinimumJobSLOAnomalyBuilds = 5
+)
+
+// JobSLO is the duration and success rate a job is expected to meet. Builds
+// are also checked against the job's history: one that takes much longer than
+// the job's recent builds is flagged even if it's within the duration target.
+type JobSLO struct {
+	Duration         string  `json:"duration,omitempty"`
+	SuccessRate      float64 `json:"success_rate,omitempty"`
+	Window           int     `json:"window,omitempty"`
+	AnomalyThreshold float64 `json:"anomaly_threshold,omitempty"`
+}
+
+func (slo JobSLO) WindowSize() int {
+	if slo.Window > 0 {
+		return slo.Window
+	}
+
+	return DefaultJobSLOWindow
+}
+
+func (slo JobSLO) Threshold() float64 {
+	if slo.AnomalyThreshold > 0 {
+		return slo.AnomalyThreshold
+	}
+
+	return DefaultJobSLOAnomalyThreshold
+}
+
+// TargetDuration returns zero if the SLO has no duration target. The duration
+// is parsed when the pipeline is validated, so an invalid one is ignored.
+func (slo JobSLO) TargetDuration() time.Duration {
+	if slo.Duration == "" {
+		return 0
+	}
+
+	duration, err := time.ParseDuration(slo.Duration)
+	if err != nil {
+		return 0
+	}
+
+	return duration
+}
+
+// JobSLOBuild is a completed build as its job's SLO sees it.
+type JobSLOBuild struct {
+	ID       int
+	Name     string
+	Status   BuildStatus
+	Duration time.Duration
+}
+
+// JobSLOStats are rolling stats over a job's most recent builds. Durations
+// are in seconds, and only cover the builds that succeeded.
+type JobSLOStats struct {
+	Builds         int     `json:"builds"`
+	Succeeded      int     `json:"succeeded"`
+	SuccessRate    float64 `json:"success_rate"`
+	MeanDuration   float64 `json:"mean_duration"`
+	StdDevDuration float64 `json:"stddev_duration"`
+}
+
+func NewJobSLOStats(builds []JobSLOBuild) JobSLOStats {
+	stats := JobSLOStats{
+		Builds: len(builds),
+	}
+
+	var durations []float64
+	for _, build := range builds {
+		if build.Status == StatusSucceeded {
+			durations = append(durations, build.Duration.Seconds())
+		}
+	}
+
+	stats.Succeeded = len(durations)
+	if stats.Builds == 0 || stats.Succeeded == 0 {
+		return stats
+	}
+
+	stats.SuccessRate = float64(stats.Succeeded) / float64(stats.Builds)
+
+	var sum float64
+	for _, duration := range durations {
+		sum += duration
+	}
+
+	stats.MeanDuration = sum / float64(len(durations))
+
+	var squares float64
+	for _, duration := range durations {
+		squares += (duration - stats.MeanDuration) * (duration - stats.MeanDuration)
+	}
+
+	stats.StdDevDuration = math.Sqrt(squares / float64(len(durations)))
+
+	return stats
+}
+
+type JobSLOViolationKind string
+
+const (
+	// JobSLOViolationDuration is a build that took longer than the SLO's
+	// duration target.
+	JobSLOViolationDuration JobSLOViolationKind = "duration"
+
+	// JobSLOViolationSuccessRate is a build that didn't succeed while the
+	// job's success rate is below the SLO's target.
+	JobSLOViolationSuccessRate JobSLOViolationKind = "success_rate"
+
+	// JobSLOViolationDurationAnomaly is a build that took more than the
+	// anomaly threshold's standard deviations longer than the job's mean
+	// duration.
+	JobSLOViolationDurationAnomaly JobSLOViolationKind = "duration_anomaly"
+)
+
+// JobSLOViolation is a way a build missed its job's SLO. Durations are in
+// seconds; for an anomaly, the target is the longest duration that would not
+// have been one.
+type JobSLOViolation struct {
+	Kind   JobSLOViolationKind `json:"kind"`
+	Value  float64             `json:"value"`
+	Target float64             `json:"target"`
+}
+
+// Evaluate checks a build against the SLO, given the job's builds that
+// completed before it, most recent first. It returns the stats over the
+// SLO's window, including the build, along with how the build missed the SLO.
+func (slo JobSLO) Evaluate(build JobSLOBuild, history []JobSLOBuild) (JobSLOStats, []JobSLOViolation) {
+	window := slo.WindowSize()
+
+	if len(history) > window {
+		history = history[:window]
+	}
+
+	recent := append([]JobSLOBuild{build}, history...)
+	if len(recent) > window {
+		recent = recent[:window]
+	}
+
+	stats := NewJobSLOStats(recent)
+
+	var violations []JobSLOViolation
+
+	duration := build.Duration.Seconds()
+
+	if target := slo.TargetDuration(); target > 0 && build.Duration > target {
+		violations = append(violations, JobSLOViolation{
+			Kind:   JobSLOViolationDuration,
+			Value:  duration,
+			Target: target.Seconds(),
+		})
+	}
+
+	if slo.SuccessRate > 0 && build.Status != StatusSucceeded && stats.SuccessRate < slo.SuccessRate {
+		violations = append(violations, JobSLOViolation{
+			Kind:   JobSLOViolationSuccessRate,
+			Value:  stats.SuccessRate,
+			Target: slo.SuccessRate,
+		})
+	}
+
+	// a build is compared against the builds before it, so that a slow one
+	// doesn't raise the bar it's measured against
+	baseline := NewJobSLOStats(history)
+	if build.Status == StatusSucceeded && baseline.Succeeded >= MinimumJobSLOAnomalyBuilds && baseline.StdDevDuration > 0 {
+		limit := baseline.MeanDuration + slo.Threshold()*baseline.StdDevDuration
+		if duration > limit {
+			violations = append(violations, JobSLOViolation{
+				Kind:   JobSLOViolationDurationAnomaly,
+				Value:  duration,
+				Target: limit,
+			})
+		}
+	}
+
+	return stats, violations
+}
+
+// JobSLOBreach is a build that missed its job's SLO. It's the data of a
+// job_slo_breach webhook event.
+type JobSLOBreach struct {
+	Build      Build             `json:"build"`
+	Stats      JobSLOStats       `json:"stats"`
+	Violations []JobSLOViolation `json:"violations"`
+}
+
+// JobSLOReport is a job's SLO, its current stats and the builds that most
+// recently breached it.
+type JobSLOReport struct {
+	SLO      *JobSLO        `json:"slo,omitempty"`
+	Stats    JobSLOStats    `json:"stats"`
+	Breaches []JobSLOBreach `json:"breaches"`
+}
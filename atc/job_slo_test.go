@@ -0,0 +1,132 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobSLO", func() {
+	succeeded := func(id int, duration time.Duration) atc.JobSLOBuild {
+		return atc.JobSLOBuild{ID: id, Status: atc.StatusSucceeded, Duration: duration}
+	}
+
+	failed := func(id int, duration time.Duration) atc.JobSLOBuild {
+		return atc.JobSLOBuild{ID: id, Status: atc.StatusFailed, Duration: duration}
+	}
+
+	Describe("NewJobSLOStats", func() {
+		It("measures durations over the succeeded builds", func() {
+			stats := atc.NewJobSLOStats([]atc.JobSLOBuild{
+				succeeded(4, 10*time.Second),
+				failed(3, time.Second),
+				succeeded(2, 20*time.Second),
+				succeeded(1, 30*time.Second),
+			})
+
+			Expect(stats.Builds).To(Equal(4))
+			Expect(stats.Succeeded).To(Equal(3))
+			Expect(stats.SuccessRate).To(Equal(0.75))
+			Expect(stats.MeanDuration).To(Equal(20.0))
+			Expect(stats.StdDevDuration).To(BeNumerically("~", 8.165, 0.001))
+		})
+
+		It("has no durations if nothing succeeded", func() {
+			stats := atc.NewJobSLOStats([]atc.JobSLOBuild{failed(1, time.Minute)})
+			Expect(stats).To(Equal(atc.JobSLOStats{Builds: 1}))
+		})
+	})
+
+	Describe("Evaluate", func() {
+		var (
+			slo     atc.JobSLO
+			history []atc.JobSLOBuild
+		)
+
+		BeforeEach(func() {
+			slo = atc.JobSLO{}
+
+			history = nil
+			for i := 10; i > 0; i-- {
+				history = append(history, succeeded(i, time.Duration(600+i)*time.Second))
+			}
+		})
+
+		It("flags a build that takes longer than the duration target", func() {
+			slo.Duration = "10m"
+
+			_, violations := slo.Evaluate(succeeded(11, 11*time.Minute), nil)
+			Expect(violations).To(Equal([]atc.JobSLOViolation{
+				{Kind: atc.JobSLOViolationDuration, Value: 660, Target: 600},
+			}))
+
+			_, violations = slo.Evaluate(succeeded(11, 9*time.Minute), nil)
+			Expect(violations).To(BeEmpty())
+		})
+
+		It("flags a failed build that takes the success rate below the target", func() {
+			slo.SuccessRate = 0.9
+			slo.Window = 5
+
+			stats, violations := slo.Evaluate(failed(11, time.Minute), history)
+			Expect(stats.Builds).To(Equal(5))
+			Expect(stats.SuccessRate).To(Equal(0.8))
+			Expect(violations).To(Equal([]atc.JobSLOViolation{
+				{Kind: atc.JobSLOViolationSuccessRate, Value: 0.8, Target: 0.9},
+			}))
+		})
+
+		It("does not flag a succeeded build while the success rate is below the target", func() {
+			slo.SuccessRate = 0.9
+			slo.Window = 5
+
+			history[0] = failed(10, time.Minute)
+			history[1] = failed(9, time.Minute)
+
+			stats, violations := slo.Evaluate(succeeded(11, 600*time.Second), history)
+			Expect(stats.SuccessRate).To(Equal(0.6))
+			Expect(violations).To(BeEmpty())
+		})
+
+		It("flags a build that takes much longer than the builds before it", func() {
+			_, violations := slo.Evaluate(succeeded(11, 45*time.Minute), history)
+			Expect(violations).To(HaveLen(1))
+			Expect(violations[0].Kind).To(Equal(atc.JobSLOViolationDurationAnomaly))
+			Expect(violations[0].Value).To(Equal(2700.0))
+			Expect(violations[0].Target).To(BeNumerically("~", 605.5+3*2.872, 0.001))
+
+			_, violations = slo.Evaluate(succeeded(11, 610*time.Second), history)
+			Expect(violations).To(BeEmpty())
+		})
+
+		It("uses the configured anomaly threshold", func() {
+			slo.AnomalyThreshold = 10
+
+			_, violations := slo.Evaluate(succeeded(11, 630*time.Second), history)
+			Expect(violations).To(BeEmpty())
+
+			slo.AnomalyThreshold = 1
+
+			_, violations = slo.Evaluate(succeeded(11, 630*time.Second), history)
+			Expect(violations).To(HaveLen(1))
+		})
+
+		It("does not look for anomalies without enough history", func() {
+			_, violations := slo.Evaluate(succeeded(11, 45*time.Minute), history[:atc.MinimumJobSLOAnomalyBuilds-1])
+			Expect(violations).To(BeEmpty())
+		})
+
+		It("only compares against the builds within the window", func() {
+			slo.Window = 5
+
+			history = append(history[:5], succeeded(5, 45*time.Minute), succeeded(4, 45*time.Minute))
+
+			_, violations := slo.Evaluate(succeeded(11, 30*time.Minute), history)
+			Expect(violations).To(HaveLen(1))
+			Expect(violations[0].Kind).To(Equal(atc.JobSLOViolationDurationAnomaly))
+		})
+	})
+})
@@ -0,0 +1,177 @@
+package logarchive
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// Archive writes the events of completed builds to a Store as NDJSON, one
+// event envelope per line, so that they outlive the build log retention
+// policy without being kept in the database.
+type Archive struct {
+	store       Store
+	compression compression.Compression
+}
+
+func NewArchive(store Store, compression compression.Compression) *Archive {
+	return &Archive{
+		store:       store,
+		compression: compression,
+	}
+}
+
+// Archive writes all of the build's events to the store and records on the
+// build where they were written to.
+func (a *Archive) Archive(ctx context.Context, build db.BuildForAPI) error {
+	events, err := build.Events(0)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close(events)
+
+	key := fmt.Sprintf("builds/%d/events.ndjson%s", build.ID(), extensions[a.compression.Encoding()])
+
+	reader, writer := io.Pipe()
+
+	written := make(chan error, 1)
+	go func() {
+		err := a.write(writer, events)
+		writer.CloseWithError(err)
+		written <- err
+	}()
+
+	err = a.store.Put(ctx, key, reader)
+	reader.CloseWithError(err)
+
+	writeErr := <-written
+	if err != nil {
+		return err
+	}
+
+	if writeErr != nil {
+		return writeErr
+	}
+
+	return build.SetEventsArchive(key)
+}
+
+func (a *Archive) write(w io.Writer, events db.EventSource) error {
+	compressed, err := a.compression.NewWriter(w)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(compressed)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			return err
+		}
+
+		err = encoder.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return compressed.Close()
+}
+
+// Build returns a build whose events are read back from the archive if they
+// have been reaped from the database. The archive is read for as long as ctx,
+// which is usually that of the request for the events.
+func (a *Archive) Build(ctx context.Context, build db.BuildForAPI) db.BuildForAPI {
+	if build.ReapTime().IsZero() || build.EventsArchive() == "" {
+		return build
+	}
+
+	return &archivedBuild{
+		BuildForAPI: build,
+		ctx:         ctx,
+		store:       a.store,
+	}
+}
+
+type archivedBuild struct {
+	db.BuildForAPI
+
+	ctx   context.Context
+	store Store
+}
+
+func (b *archivedBuild) Events(from uint) (db.EventSource, error) {
+	key := b.EventsArchive()
+
+	content, err := b.store.Get(b.ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := compressionOf(key).NewReader(content)
+	if err != nil {
+		content.Close()
+		return nil, err
+	}
+
+	source := &archivedEventSource{
+		content: content,
+		reader:  reader,
+		decoder: json.NewDecoder(bufio.NewReader(reader)),
+	}
+
+	for i := uint(0); i < from; i++ {
+		_, err := source.Next()
+		if err == db.ErrEndOfBuildEventStream {
+			break
+		}
+
+		if err != nil {
+			source.Close()
+			return nil, err
+		}
+	}
+
+	return source, nil
+}
+
+type archivedEventSource struct {
+	// content is the archive as read from the store. Closing the
+	// decompressing reader doesn't close it.
+	content io.ReadCloser
+	reader  io.ReadCloser
+	decoder *json.Decoder
+}
+
+func (s *archivedEventSource) Next() (event.Envelope, error) {
+	var envelope event.Envelope
+	err := s.decoder.Decode(&envelope)
+	if err == io.EOF {
+		return event.Envelope{}, db.ErrEndOfBuildEventStream
+	}
+
+	return envelope, err
+}
+
+func (s *archivedEventSource) Close() error {
+	err := s.reader.Close()
+
+	contentErr := s.content.Close()
+	if err == nil {
+		err = contentErr
+	}
+
+	return err
+}
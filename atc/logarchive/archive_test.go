@@ -0,0 +1,212 @@
+package logarchive_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logarchive"
+	"github.com/concourse/concourse/atc/logarchive/logarchivefakes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Archive", func() {
+	var (
+		store     logarchive.Store
+		archive   *logarchive.Archive
+		fakeBuild *dbfakes.FakeBuildForAPI
+		envelopes []event.Envelope
+	)
+
+	envelope := func(ev atc.Event) event.Envelope {
+		payload, err := json.Marshal(ev)
+		Expect(err).ToNot(HaveOccurred())
+
+		data := json.RawMessage(payload)
+		return event.Envelope{
+			Data:    &data,
+			Event:   ev.EventType(),
+			Version: ev.Version(),
+		}
+	}
+
+	eventSource := func(envelopes []event.Envelope) db.EventSource {
+		fakeEventSource := new(dbfakes.FakeEventSource)
+		for i, envelope := range envelopes {
+			fakeEventSource.NextReturnsOnCall(i, envelope, nil)
+		}
+		fakeEventSource.NextReturnsOnCall(len(envelopes), event.Envelope{}, db.ErrEndOfBuildEventStream)
+		return fakeEventSource
+	}
+
+	BeforeEach(func() {
+		store = logarchive.NewLocalStore(GinkgoT().TempDir())
+		archive = logarchive.NewArchive(store, compression.NewGzipCompression())
+
+		envelopes = []event.Envelope{
+			envelope(event.Log{Time: 1, Payload: "hello\n"}),
+			envelope(event.Log{Time: 2, Payload: "world\n"}),
+			envelope(event.Status{Time: 3, Status: atc.StatusSucceeded}),
+		}
+
+		fakeBuild = new(dbfakes.FakeBuildForAPI)
+		fakeBuild.IDReturns(42)
+		fakeBuild.EventsReturns(eventSource(envelopes), nil)
+	})
+
+	It("writes the build's events to the store and records where", func() {
+		err := archive.Archive(context.Background(), fakeBuild)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeBuild.EventsArgsForCall(0)).To(BeZero())
+		Expect(fakeBuild.SetEventsArchiveCallCount()).To(Equal(1))
+		Expect(fakeBuild.SetEventsArchiveArgsForCall(0)).To(Equal("builds/42/events.ndjson.gz"))
+	})
+
+	Context("when the build's events have been reaped", func() {
+		var reapedBuild *dbfakes.FakeBuildForAPI
+
+		BeforeEach(func() {
+			err := archive.Archive(context.Background(), fakeBuild)
+			Expect(err).ToNot(HaveOccurred())
+
+			reapedBuild = new(dbfakes.FakeBuildForAPI)
+			reapedBuild.ReapTimeReturns(time.Now())
+			reapedBuild.EventsArchiveReturns("builds/42/events.ndjson.gz")
+		})
+
+		readAll := func(source db.EventSource) []event.Envelope {
+			read := []event.Envelope{}
+			for {
+				ev, err := source.Next()
+				if err == db.ErrEndOfBuildEventStream {
+					return read
+				}
+
+				Expect(err).ToNot(HaveOccurred())
+				read = append(read, ev)
+			}
+		}
+
+		It("serves the events from the archive", func() {
+			events, err := archive.Build(context.Background(), reapedBuild).Events(0)
+			Expect(err).ToNot(HaveOccurred())
+			defer events.Close()
+
+			Expect(readAll(events)).To(Equal(envelopes))
+			Expect(reapedBuild.EventsCallCount()).To(BeZero())
+		})
+
+		It("resumes from the given event", func() {
+			events, err := archive.Build(context.Background(), reapedBuild).Events(2)
+			Expect(err).ToNot(HaveOccurred())
+			defer events.Close()
+
+			Expect(readAll(events)).To(Equal(envelopes[2:]))
+		})
+
+		It("reads archives regardless of the configured compression", func() {
+			archive = logarchive.NewArchive(store, compression.NewZstdCompression())
+
+			events, err := archive.Build(context.Background(), reapedBuild).Events(0)
+			Expect(err).ToNot(HaveOccurred())
+			defer events.Close()
+
+			Expect(readAll(events)).To(Equal(envelopes))
+		})
+	})
+
+	Context("when the events are read from the store", func() {
+		var (
+			content   *closeTracker
+			fakeStore *logarchivefakes.FakeStore
+		)
+
+		BeforeEach(func() {
+			err := archive.Archive(context.Background(), fakeBuild)
+			Expect(err).ToNot(HaveOccurred())
+
+			archived, err := store.Get(context.Background(), "builds/42/events.ndjson.gz")
+			Expect(err).ToNot(HaveOccurred())
+
+			content = &closeTracker{ReadCloser: archived}
+
+			fakeStore = new(logarchivefakes.FakeStore)
+			fakeStore.GetReturns(content, nil)
+			archive = logarchive.NewArchive(fakeStore, compression.NewGzipCompression())
+
+			fakeBuild.ReapTimeReturns(time.Now())
+			fakeBuild.EventsArchiveReturns("builds/42/events.ndjson.gz")
+		})
+
+		It("closes what was read when the events are closed", func() {
+			events, err := archive.Build(context.Background(), fakeBuild).Events(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(content.closed).To(BeFalse())
+
+			Expect(events.Close()).To(Succeed())
+			Expect(content.closed).To(BeTrue())
+		})
+
+		It("reads them for as long as the given context", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			_, err := archive.Build(ctx, fakeBuild).Events(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeStore.GetCallCount()).To(Equal(1))
+			getCtx, key := fakeStore.GetArgsForCall(0)
+			Expect(getCtx).To(Equal(ctx))
+			Expect(key).To(Equal("builds/42/events.ndjson.gz"))
+		})
+
+		It("closes what was read when the events can't be resumed from", func() {
+			fakeBuild.EventsArchiveReturns("builds/42/events.ndjson.zst")
+
+			_, err := archive.Build(context.Background(), fakeBuild).Events(1)
+			Expect(err).To(HaveOccurred())
+			Expect(content.closed).To(BeTrue())
+		})
+	})
+
+	Context("when the build has not been reaped", func() {
+		It("serves the events from the database", func() {
+			fakeBuild.EventsArchiveReturns("builds/42/events.ndjson.gz")
+			Expect(archive.Build(context.Background(), fakeBuild)).To(BeIdenticalTo(fakeBuild))
+		})
+	})
+
+	Context("when the store fails", func() {
+		BeforeEach(func() {
+			fakeStore := new(logarchivefakes.FakeStore)
+			fakeStore.PutReturns(errors.New("nope"))
+			archive = logarchive.NewArchive(fakeStore, compression.NewGzipCompression())
+		})
+
+		It("does not record the archive", func() {
+			err := archive.Archive(context.Background(), fakeBuild)
+			Expect(err).To(MatchError("nope"))
+			Expect(fakeBuild.SetEventsArchiveCallCount()).To(BeZero())
+		})
+	})
+})
+
+type closeTracker struct {
+	io.ReadCloser
+	closed bool
+}
+
+func (tracker *closeTracker) Close() error {
+	tracker.closed = true
+	return tracker.ReadCloser.Close()
+}
@@ -0,0 +1,28 @@
+package logarchive
+
+import (
+	"strings"
+
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/worker/baggageclaim"
+)
+
+var extensions = map[baggageclaim.Encoding]string{
+	baggageclaim.GzipEncoding: ".gz",
+	baggageclaim.ZstdEncoding: ".zst",
+	baggageclaim.RawEncoding:  "",
+}
+
+// compressionOf returns the compression an archive was written with, going by
+// its extension, so that changing the configured compression doesn't affect
+// reading existing archives.
+func compressionOf(key string) compression.Compression {
+	switch {
+	case strings.HasSuffix(key, extensions[baggageclaim.GzipEncoding]):
+		return compression.NewGzipCompression()
+	case strings.HasSuffix(key, extensions[baggageclaim.ZstdEncoding]):
+		return compression.NewZstdCompression()
+	default:
+		return compression.NewNoCompression()
+	}
+}
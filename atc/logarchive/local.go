@@ -0,0 +1,68 @@
+package logarchive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+type localStore struct {
+	dir string
+}
+
+// NewLocalStore returns a Store which keeps archives as files under the given
+// directory, e.g. a mounted network volume.
+func NewLocalStore(dir string) Store {
+	return &localStore{dir: dir}
+}
+
+func (s *localStore) Put(ctx context.Context, key string, content io.Reader) error {
+	path := s.path(key)
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a partially written archive is
+	// never mistaken for a complete one
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".archive-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, content)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *localStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(s.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *localStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
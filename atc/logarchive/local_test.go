@@ -0,0 +1,36 @@
+package logarchive_test
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/concourse/concourse/atc/logarchive"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LocalStore", func() {
+	var store logarchive.Store
+
+	BeforeEach(func() {
+		store = logarchive.NewLocalStore(GinkgoT().TempDir())
+	})
+
+	It("reads back what was put", func() {
+		err := store.Put(context.Background(), "builds/1/events.ndjson", strings.NewReader("some-events"))
+		Expect(err).ToNot(HaveOccurred())
+
+		content, err := store.Get(context.Background(), "builds/1/events.ndjson")
+		Expect(err).ToNot(HaveOccurred())
+		defer content.Close()
+
+		Expect(io.ReadAll(content)).To(Equal([]byte("some-events")))
+	})
+
+	It("returns ErrNotFound for missing keys", func() {
+		_, err := store.Get(context.Background(), "builds/2/events.ndjson")
+		Expect(err).To(Equal(logarchive.ErrNotFound))
+	})
+})
@@ -0,0 +1,92 @@
+package logarchive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type S3Config struct {
+	Bucket          string
+	Prefix          string
+	Region          string
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	ForcePathStyle  bool
+}
+
+type s3Store struct {
+	bucket   string
+	prefix   string
+	client   *s3.S3
+	uploader *s3manager.Uploader
+}
+
+// NewS3Store returns a Store which keeps archives in an S3 bucket. Setting an
+// endpoint allows any S3-compatible object storage to be used.
+func NewS3Store(config S3Config) (Store, error) {
+	awsConfig := &aws.Config{
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.ForcePathStyle),
+	}
+
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+	}
+
+	if config.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, config.SessionToken)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Store{
+		bucket:   config.Bucket,
+		prefix:   config.Prefix,
+		client:   s3.New(sess),
+		uploader: s3manager.NewUploader(sess),
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, content io.Reader) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+		Body:   content,
+	})
+	return err
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
+func (s *s3Store) key(key string) string {
+	return path.Join(s.prefix, key)
+}
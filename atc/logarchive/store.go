@@ -0,0 +1,19 @@
+package logarchive
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+var ErrNotFound = errors.New("archived object not found")
+
+// Store is a blob store which archived build events are written to.
+//
+//counterfeiter:generate . Store
+type Store interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
@@ -79,6 +79,11 @@ type Monitor struct {
 
 	ChecksEnqueued Counter
 
+	// CheckContainerPoolHits+CheckContainerPoolMisses counts the checks run
+	// while the check container pool is enabled; a hit reused a warm container.
+	CheckContainerPoolHits   Counter
+	CheckContainerPoolMisses Counter
+
 	ConcurrentRequests         map[string]*Gauge
 	ConcurrentRequestsLimitHit map[string]*Counter
 
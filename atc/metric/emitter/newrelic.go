@@ -103,6 +103,7 @@ func (emitter *NewRelicEmitter) Emit(logger lager.Logger, event metric.Event) {
 		"worker unknown volumes",
 		"volumes streamed",
 		"get step cache hits",
+		"check container pool",
 		"streamed resource caches":
 		emitter.NewRelicBatch = append(emitter.NewRelicBatch, emitter.transformToNewRelicEvent(event, ""))
 
@@ -46,6 +46,7 @@ type PrometheusEmitter struct {
 	buildsSucceeded   prometheus.Counter
 
 	latestCompletedBuildStatus *prometheus.GaugeVec
+	jobSLOBreaches             *prometheus.CounterVec
 
 	gcBuildCollectorDuration                      prometheus.Histogram
 	gcWorkerCollectorDuration                     prometheus.Histogram
@@ -73,8 +74,9 @@ type PrometheusEmitter struct {
 
 	locksHeld *prometheus.GaugeVec
 
-	checksFinished *prometheus.CounterVec
-	checksStarted  prometheus.Counter
+	checksFinished     *prometheus.CounterVec
+	checksStarted      prometheus.Counter
+	checkContainerPool *prometheus.CounterVec
 
 	checksEnqueued prometheus.Counter
 
@@ -290,6 +292,15 @@ func (config *PrometheusConfig) NewEmitter(attributes map[string]string) (metric
 	}, []string{"jobName", "pipelineName", "teamName"})
 	prometheus.MustRegister(latestCompletedBuildStatus)
 
+	jobSLOBreaches := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   "concourse",
+		Subsystem:   "jobs",
+		Name:        "slo_breaches_total",
+		Help:        "Total number of builds that missed their job's SLO, by how they missed it.",
+		ConstLabels: attributes,
+	}, []string{"kind", "jobName", "pipelineName", "teamName"})
+	prometheus.MustRegister(jobSLOBreaches)
+
 	stepsWaiting := prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace:   "concourse",
 		Subsystem:   "steps",
@@ -543,6 +554,18 @@ func (config *PrometheusConfig) NewEmitter(attributes map[string]string) (metric
 	)
 	prometheus.MustRegister(checksFinished)
 
+	checkContainerPool := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace:   "concourse",
+			Subsystem:   "lidar",
+			Name:        "check_container_pool_total",
+			Help:        "Total number of checks run while the check container pool is enabled, by whether they reused a warm container.",
+			ConstLabels: attributes,
+		},
+		[]string{"result"},
+	)
+	prometheus.MustRegister(checkContainerPool)
+
 	checksStarted := prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Namespace:   "concourse",
@@ -828,6 +851,7 @@ func (config *PrometheusConfig) NewEmitter(attributes map[string]string) (metric
 		concurrentRequests:         concurrentRequests,
 
 		latestCompletedBuildStatus:            latestCompletedBuildStatus,
+		jobSLOBreaches:                        jobSLOBreaches,
 		stepsWaiting:         stepsWaiting,
 		stepsWaitingDuration: stepsWaitingDuration,
 
@@ -873,8 +897,9 @@ func (config *PrometheusConfig) NewEmitter(attributes map[string]string) (metric
 
 		locksHeld: locksHeld,
 
-		checksFinished: checksFinished,
-		checksStarted:  checksStarted,
+		checksFinished:     checksFinished,
+		checksStarted:      checksStarted,
+		checkContainerPool: checkContainerPool,
 
 		checksEnqueued: checksEnqueued,
 
@@ -943,6 +968,14 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 				event.Attributes["pipelineName"],
 				event.Attributes["teamName"],
 			).Set(event.Value)
+	case "job slo breach":
+		emitter.jobSLOBreaches.
+			WithLabelValues(
+				event.Attributes["kind"],
+				event.Attributes["jobName"],
+				event.Attributes["pipelineName"],
+				event.Attributes["teamName"],
+			).Inc()
 	case "steps waiting":
 		emitter.stepsWaiting.
 			WithLabelValues(
@@ -1027,6 +1060,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checksFinished.WithLabelValues(event.Attributes["status"]).Add(event.Value)
 	case "checks started":
 		emitter.checksStarted.Add(event.Value)
+	case "check container pool":
+		emitter.checkContainerPool.WithLabelValues(event.Attributes["result"]).Add(event.Value)
 	case "checks enqueued":
 		emitter.checksEnqueued.Add(event.Value)
 	case "volumes streamed":
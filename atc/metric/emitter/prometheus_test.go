@@ -220,6 +220,18 @@ var _ = Describe("PrometheusEmitter", func() {
 			},
 		})
 
+		prometheusEmitter.Emit(logger, metric.Event{
+			Name:  "job slo breach",
+			Value: 2700,
+			Attributes: map[string]string{
+				"kind":         "duration",
+				"target":       "600",
+				"jobName":      "job1",
+				"pipelineName": "pipeline1",
+				"teamName":     "team1",
+			},
+		})
+
 		getPrometheusMetrics := func() string {
 			res, _ := http.Get(fmt.Sprintf("http://%s:%s/metrics", prometheusConfig.BindIP, prometheusConfig.BindPort))
 			body, _ := io.ReadAll(res.Body)
@@ -231,5 +243,6 @@ var _ = Describe("PrometheusEmitter", func() {
 
 		Eventually(getPrometheusMetrics()).Should(ContainSubstring("concourse_steps_waiting{invalid_label=\"foo\",platform=\"darwin\",prefix_test=\"bar\",prefix_testtwo=\"baz\",teamId=\"42\",teamName=\"teamdev\",type=\"get\",workerTags=\"tester\"} 4"))
 		Eventually(getPrometheusMetrics()).Should(ContainSubstring("concourse_builds_latest_completed_build_status{invalid_label=\"foo\",jobName=\"job1\",pipelineName=\"pipeline1\",prefix_test=\"bar\",prefix_testtwo=\"baz\",teamName=\"team1\"} 0"))
+		Eventually(getPrometheusMetrics()).Should(ContainSubstring("concourse_jobs_slo_breaches_total{invalid_label=\"foo\",jobName=\"job1\",kind=\"duration\",pipelineName=\"pipeline1\",prefix_test=\"bar\",prefix_testtwo=\"baz\",teamName=\"team1\"} 1"))
 	})
 })
@@ -469,6 +469,33 @@ func (event JobStatus) Emit(logger lager.Logger) {
 	)
 }
 
+// JobSLOBreach is emitted for each way a build missed its job's SLO.
+type JobSLOBreach struct {
+	Kind         string
+	Value        float64
+	Target       float64
+	JobName      string
+	PipelineName string
+	TeamName     string
+}
+
+func (event JobSLOBreach) Emit(logger lager.Logger) {
+	Metrics.emit(
+		logger.Session("job-slo-breach"),
+		Event{
+			Name:  "job slo breach",
+			Value: event.Value,
+			Attributes: map[string]string{
+				"kind":         event.Kind,
+				"target":       strconv.FormatFloat(event.Target, 'f', -1, 64),
+				"jobName":      event.JobName,
+				"pipelineName": event.PipelineName,
+				"teamName":     event.TeamName,
+			},
+		},
+	)
+}
+
 type BuildStarted struct {
 	Build db.Build
 }
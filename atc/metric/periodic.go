@@ -245,6 +245,28 @@ func tick(logger lager.Logger, m *Monitor) {
 		},
 	)
 
+	m.emit(
+		logger.Session("check-container-pool-hits"),
+		Event{
+			Name:  "check container pool",
+			Value: m.CheckContainerPoolHits.Delta(),
+			Attributes: map[string]string{
+				"result": "hit",
+			},
+		},
+	)
+
+	m.emit(
+		logger.Session("check-container-pool-misses"),
+		Event{
+			Name:  "check container pool",
+			Value: m.CheckContainerPoolMisses.Delta(),
+			Attributes: map[string]string{
+				"result": "miss",
+			},
+		},
+	)
+
 	m.emit(
 
 		logger.Session("checks-enqueued"),
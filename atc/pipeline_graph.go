@@ -0,0 +1,139 @@
+package atc
+
+const (
+	GraphNodeResource = "resource"
+	GraphNodeJob      = "job"
+
+	// GraphEdgeInput is a resource fetched by a job with no passed
+	// constraints.
+	GraphEdgeInput = "input"
+
+	// GraphEdgePassed is a resource fetched by a job only once it has passed
+	// through another job.
+	GraphEdgePassed = "passed"
+
+	// GraphEdgeOutput is a resource put to by a job.
+	GraphEdgeOutput = "output"
+)
+
+// PipelineGraph is how the resources and jobs of a pipeline are connected,
+// as drawn by the web UI.
+type PipelineGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+type GraphNode struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+
+	// Type is the type of a resource.
+	Type string `json:"type,omitempty"`
+}
+
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+
+	// Resource is the resource that passes between the jobs of a passed
+	// edge.
+	Resource string `json:"resource,omitempty"`
+
+	// Trigger is whether new versions coming along an input or passed edge
+	// trigger the job.
+	Trigger bool `json:"trigger,omitempty"`
+}
+
+func ResourceNodeID(name string) string {
+	return GraphNodeResource + ":" + name
+}
+
+func JobNodeID(name string) string {
+	return GraphNodeJob + ":" + name
+}
+
+// Graph works out the pipeline graph from the get and put steps of each job.
+// A get with passed constraints is an edge from each of the jobs it must
+// have passed, rather than from the resource itself.
+func (config Config) Graph() PipelineGraph {
+	graph := PipelineGraph{
+		Nodes: []GraphNode{},
+		Edges: []GraphEdge{},
+	}
+
+	for _, resource := range config.Resources {
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			ID:   ResourceNodeID(resource.Name),
+			Kind: GraphNodeResource,
+			Name: resource.Name,
+			Type: resource.Type,
+		})
+	}
+
+	for _, job := range config.Jobs {
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			ID:   JobNodeID(job.Name),
+			Kind: GraphNodeJob,
+			Name: job.Name,
+		})
+	}
+
+	// the same resource may be fetched or put more than once by a job, but
+	// only makes for one edge, which triggers if any of them do
+	edges := map[GraphEdge]int{}
+	addEdge := func(edge GraphEdge) {
+		trigger := edge.Trigger
+		edge.Trigger = false
+
+		i, found := edges[edge]
+		if !found {
+			i = len(graph.Edges)
+			edges[edge] = i
+			graph.Edges = append(graph.Edges, edge)
+		}
+
+		graph.Edges[i].Trigger = graph.Edges[i].Trigger || trigger
+	}
+
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(StepRecursor{
+			OnGet: func(step *GetStep) error {
+				if len(step.Passed) == 0 {
+					addEdge(GraphEdge{
+						From:    ResourceNodeID(step.ResourceName()),
+						To:      JobNodeID(job.Name),
+						Kind:    GraphEdgeInput,
+						Trigger: step.Trigger,
+					})
+
+					return nil
+				}
+
+				for _, passed := range step.Passed {
+					addEdge(GraphEdge{
+						From:     JobNodeID(passed),
+						To:       JobNodeID(job.Name),
+						Kind:     GraphEdgePassed,
+						Resource: step.ResourceName(),
+						Trigger:  step.Trigger,
+					})
+				}
+
+				return nil
+			},
+			OnPut: func(step *PutStep) error {
+				addEdge(GraphEdge{
+					From: JobNodeID(job.Name),
+					To:   ResourceNodeID(step.ResourceName()),
+					Kind: GraphEdgeOutput,
+				})
+
+				return nil
+			},
+		})
+	}
+
+	return graph
+}
@@ -0,0 +1,87 @@
+package atc_test
+
+import (
+	. "github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipeline graph", func() {
+	var config Config
+
+	BeforeEach(func() {
+		config = Config{
+			Resources: ResourceConfigs{
+				{Name: "repo", Type: "git"},
+				{Name: "image", Type: "registry-image"},
+			},
+			Jobs: JobConfigs{
+				{
+					Name: "unit",
+					PlanSequence: []Step{
+						{Config: &GetStep{Name: "repo", Trigger: true}},
+						{Config: &TaskStep{Name: "test"}},
+					},
+				},
+				{
+					Name: "build",
+					PlanSequence: []Step{
+						{
+							Config: &InParallelStep{
+								Config: InParallelConfig{
+									Steps: []Step{
+										{Config: &GetStep{Name: "repo", Passed: []string{"unit"}, Trigger: true}},
+										{Config: &GetStep{Name: "source", Resource: "repo", Passed: []string{"unit"}}},
+									},
+								},
+							},
+						},
+						{Config: &PutStep{Name: "image"}},
+						{Config: &PutStep{Name: "image"}},
+					},
+				},
+			},
+		}
+	})
+
+	It("has a node for each resource and job", func() {
+		Expect(config.Graph().Nodes).To(Equal([]GraphNode{
+			{ID: "resource:repo", Kind: GraphNodeResource, Name: "repo", Type: "git"},
+			{ID: "resource:image", Kind: GraphNodeResource, Name: "image", Type: "registry-image"},
+			{ID: "job:unit", Kind: GraphNodeJob, Name: "unit"},
+			{ID: "job:build", Kind: GraphNodeJob, Name: "build"},
+		}))
+	})
+
+	It("connects resources and jobs through their get and put steps", func() {
+		Expect(config.Graph().Edges).To(Equal([]GraphEdge{
+			{From: "resource:repo", To: "job:unit", Kind: GraphEdgeInput, Trigger: true},
+			{From: "job:unit", To: "job:build", Kind: GraphEdgePassed, Resource: "repo", Trigger: true},
+			{From: "job:build", To: "resource:image", Kind: GraphEdgeOutput},
+		}))
+	})
+
+	Context("when a passed constraint names several jobs", func() {
+		BeforeEach(func() {
+			config.Jobs = append(config.Jobs, JobConfig{
+				Name: "deploy",
+				PlanSequence: []Step{
+					{Config: &GetStep{Name: "repo", Passed: []string{"unit", "build"}}},
+				},
+			})
+		})
+
+		It("has an edge from each of them", func() {
+			Expect(config.Graph().Edges).To(ContainElements(
+				GraphEdge{From: "job:unit", To: "job:deploy", Kind: GraphEdgePassed, Resource: "repo"},
+				GraphEdge{From: "job:build", To: "job:deploy", Kind: GraphEdgePassed, Resource: "repo"},
+			))
+		})
+	})
+
+	Context("when the config is empty", func() {
+		It("has no nodes or edges", func() {
+			Expect(Config{}.Graph()).To(Equal(PipelineGraph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}))
+		})
+	})
+})
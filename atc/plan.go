@@ -260,6 +260,10 @@ type PutPlan struct {
 
 	// If or not expose BUILD_CREATED_BY to build metadata
 	ExposeBuildCreatedBy bool `json:"expose_build_created_by,omitempty"`
+
+	// The name of an artifact to hold the build's signed provenance once the
+	// put has completed, for later steps to publish.
+	Provenance string `json:"provenance,omitempty"`
 }
 
 type CheckPlan struct {
@@ -317,6 +321,18 @@ type TaskPlan struct {
 	// the container to external will be dropped.
 	Hermetic bool `json:"hermetic"`
 
+	// The OCI runtime handler (e.g. runsc, kata) to run the task's container
+	// with. Only workers advertising the runtime class will be selected.
+	RuntimeClass string `json:"runtime_class,omitempty"`
+
+	// Whether the task's process can be checkpointed and restored on another
+	// worker when the worker it runs on is retired or landed.
+	Checkpointable bool `json:"checkpointable,omitempty"`
+
+	// Destinations the task is allowed to reach. If set, all other network
+	// traffic from the container to external will be rejected and logged.
+	Egress EgressRules `json:"egress,omitempty"`
+
 	// Worker tags to influence placement of the container.
 	Tags Tags `json:"tags,omitempty"`
 
@@ -347,6 +363,10 @@ type TaskPlan struct {
 	// image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
 
+	// Paths to JUnit XML reports within the task's outputs, whose test results
+	// are recorded against the build once the task finishes.
+	Reports []string `json:"reports,omitempty"`
+
 	// Resource types to have available for use when fetching the task's image.
 	ResourceTypes ResourceTypes `json:"resource_types,omitempty"`
 
@@ -0,0 +1,158 @@
+package atc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+)
+
+const (
+	// ProvenancePayloadType is the DSSE payload type of a provenance
+	// envelope, whose payload is an in-toto statement.
+	ProvenancePayloadType = "application/vnd.in-toto+json"
+
+	ProvenanceStatementType = "https://in-toto.io/Statement/v1"
+	ProvenancePredicateType = "https://slsa.dev/provenance/v1"
+
+	// ProvenanceBuildType identifies the shape of the build definition within
+	// provenance generated by Concourse.
+	ProvenanceBuildType = "https://concourse-ci.org/provenance/build/v1"
+
+	// ProvenanceFileName is the name of the file holding the provenance
+	// envelope within a put step's provenance artifact.
+	ProvenanceFileName = "provenance.intoto.jsonl"
+)
+
+// ProvenanceEnvelope is a DSSE envelope carrying a signed in-toto statement.
+// The payload and signatures are base64 encoded when marshalled, as the DSSE
+// spec requires.
+type ProvenanceEnvelope struct {
+	PayloadType string                `json:"payloadType"`
+	Payload     []byte                `json:"payload"`
+	Signatures  []ProvenanceSignature `json:"signatures"`
+}
+
+type ProvenanceSignature struct {
+	KeyID string `json:"keyid"`
+	Sig   []byte `json:"sig"`
+}
+
+// Statement decodes the envelope's payload. It does not verify the envelope's
+// signatures.
+func (envelope ProvenanceEnvelope) Statement() (ProvenanceStatement, error) {
+	if envelope.PayloadType != ProvenancePayloadType {
+		return ProvenanceStatement{}, fmt.Errorf("unknown payload type: %s", envelope.PayloadType)
+	}
+
+	var statement ProvenanceStatement
+	err := json.Unmarshal(envelope.Payload, &statement)
+	if err != nil {
+		return ProvenanceStatement{}, err
+	}
+
+	return statement, nil
+}
+
+// ProvenanceStatement is an in-toto statement whose subjects are the resource
+// versions produced by a build's puts.
+type ProvenanceStatement struct {
+	Type          string                         `json:"_type"`
+	Subject       []ProvenanceResourceDescriptor `json:"subject"`
+	PredicateType string                         `json:"predicateType"`
+	Predicate     Provenance                     `json:"predicate"`
+}
+
+// Provenance is a SLSA v1 provenance predicate describing how a build
+// produced its outputs.
+type Provenance struct {
+	BuildDefinition ProvenanceBuildDefinition `json:"buildDefinition"`
+	RunDetails      ProvenanceRunDetails      `json:"runDetails"`
+}
+
+type ProvenanceBuildDefinition struct {
+	BuildType            string                         `json:"buildType"`
+	ExternalParameters   ProvenanceExternalParameters   `json:"externalParameters"`
+	InternalParameters   ProvenanceInternalParameters   `json:"internalParameters"`
+	ResolvedDependencies []ProvenanceResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+type ProvenanceExternalParameters struct {
+	Team         string           `json:"team"`
+	Pipeline     string           `json:"pipeline,omitempty"`
+	InstanceVars InstanceVars     `json:"instance_vars,omitempty"`
+	Job          string           `json:"job,omitempty"`
+	Build        string           `json:"build"`
+	Plan         *json.RawMessage `json:"plan,omitempty"`
+}
+
+type ProvenanceInternalParameters struct {
+	Workers []string `json:"workers,omitempty"`
+}
+
+type ProvenanceRunDetails struct {
+	Builder  ProvenanceBuilder  `json:"builder"`
+	Metadata ProvenanceMetadata `json:"metadata"`
+}
+
+type ProvenanceBuilder struct {
+	ID string `json:"id"`
+}
+
+type ProvenanceMetadata struct {
+	InvocationID string     `json:"invocationId"`
+	StartedOn    *time.Time `json:"startedOn,omitempty"`
+	FinishedOn   *time.Time `json:"finishedOn,omitempty"`
+}
+
+// ProvenanceResourceDescriptor identifies a resource version either consumed
+// or produced by a build.
+type ProvenanceResourceDescriptor struct {
+	Name        string                      `json:"name"`
+	Digest      map[string]string           `json:"digest"`
+	Annotations ProvenanceVersionAnnotation `json:"annotations"`
+}
+
+type ProvenanceVersionAnnotation struct {
+	Type    string  `json:"type,omitempty"`
+	Version Version `json:"version"`
+}
+
+var digestValueRegexp = regexp.MustCompile(`^(sha1|sha256|sha384|sha512):([0-9a-f]+)$`)
+var gitCommitRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// ProvenanceDigest returns the digests identifying a resource version. Fields
+// holding a digest, such as a registry image's "sha256:..." digest, and git
+// commit refs are used as they are. Versions without any are identified by
+// the SHA-256 of their JSON encoding.
+func ProvenanceDigest(version Version) map[string]string {
+	keys := make([]string, 0, len(version))
+	for key := range version {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	digest := map[string]string{}
+	for _, key := range keys {
+		value := version[key]
+
+		if match := digestValueRegexp.FindStringSubmatch(value); match != nil {
+			digest[match[1]] = match[2]
+		} else if key == "ref" && gitCommitRegexp.MatchString(value) {
+			digest["gitCommit"] = value
+		}
+	}
+
+	if len(digest) == 0 {
+		// json.Marshal sorts map keys, so this is stable
+		payload, _ := json.Marshal(version)
+		sum := sha256.Sum256(payload)
+		digest["sha256"] = hex.EncodeToString(sum[:])
+	}
+
+	return digest
+}
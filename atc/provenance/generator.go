@@ -0,0 +1,140 @@
+package provenance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Generator produces signed SLSA provenance for builds, describing the
+// resource versions they consumed and produced.
+type Generator struct {
+	signer      *Signer
+	externalURL string
+}
+
+func NewGenerator(signer *Signer, externalURL string) *Generator {
+	return &Generator{
+		signer:      signer,
+		externalURL: strings.TrimSuffix(externalURL, "/"),
+	}
+}
+
+// Generate signs a provenance statement covering the outputs the build has
+// produced so far. It returns false if the build has not produced any.
+func (generator *Generator) Generate(build db.Build) (atc.ProvenanceEnvelope, bool, error) {
+	statement, found, err := generator.Statement(build)
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, false, err
+	}
+
+	if !found {
+		return atc.ProvenanceEnvelope{}, false, nil
+	}
+
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, false, err
+	}
+
+	envelope, err := generator.signer.Sign(atc.ProvenancePayloadType, payload)
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, false, fmt.Errorf("sign provenance: %w", err)
+	}
+
+	return envelope, true, nil
+}
+
+// Statement returns the unsigned provenance statement for the build. It
+// returns false if the build has not produced any outputs.
+func (generator *Generator) Statement(build db.Build) (atc.ProvenanceStatement, bool, error) {
+	inputs, outputs, err := build.Resources()
+	if err != nil {
+		return atc.ProvenanceStatement{}, false, fmt.Errorf("get build resources: %w", err)
+	}
+
+	if len(outputs) == 0 {
+		return atc.ProvenanceStatement{}, false, nil
+	}
+
+	imageVersions, err := build.ImageVersions()
+	if err != nil {
+		return atc.ProvenanceStatement{}, false, fmt.Errorf("get image versions: %w", err)
+	}
+
+	workers, err := build.WorkerNames()
+	if err != nil {
+		return atc.ProvenanceStatement{}, false, fmt.Errorf("get workers: %w", err)
+	}
+
+	subjects := make([]atc.ProvenanceResourceDescriptor, len(outputs))
+	for i, output := range outputs {
+		subjects[i] = descriptor(output.Name, "", output.Version)
+	}
+
+	var dependencies []atc.ProvenanceResourceDescriptor
+	for _, input := range inputs {
+		dependencies = append(dependencies, descriptor(input.Name, "", input.Version))
+	}
+
+	for _, image := range imageVersions {
+		dependencies = append(dependencies, descriptor("image", image.Type, image.Version))
+	}
+
+	return atc.ProvenanceStatement{
+		Type:          atc.ProvenanceStatementType,
+		Subject:       subjects,
+		PredicateType: atc.ProvenancePredicateType,
+		Predicate: atc.Provenance{
+			BuildDefinition: atc.ProvenanceBuildDefinition{
+				BuildType: atc.ProvenanceBuildType,
+				ExternalParameters: atc.ProvenanceExternalParameters{
+					Team:         build.TeamName(),
+					Pipeline:     build.PipelineName(),
+					InstanceVars: build.PipelineInstanceVars(),
+					Job:          build.JobName(),
+					Build:        build.Name(),
+					Plan:         build.PublicPlan(),
+				},
+				InternalParameters: atc.ProvenanceInternalParameters{
+					Workers: workers,
+				},
+				ResolvedDependencies: dependencies,
+			},
+			RunDetails: atc.ProvenanceRunDetails{
+				Builder: atc.ProvenanceBuilder{
+					ID: generator.externalURL,
+				},
+				Metadata: atc.ProvenanceMetadata{
+					InvocationID: fmt.Sprintf("%s/builds/%d", generator.externalURL, build.ID()),
+					StartedOn:    optionalTime(build.StartTime()),
+					FinishedOn:   optionalTime(build.EndTime()),
+				},
+			},
+		},
+	}, true, nil
+}
+
+func descriptor(name string, resourceType string, version atc.Version) atc.ProvenanceResourceDescriptor {
+	return atc.ProvenanceResourceDescriptor{
+		Name:   name,
+		Digest: atc.ProvenanceDigest(version),
+		Annotations: atc.ProvenanceVersionAnnotation{
+			Type:    resourceType,
+			Version: version,
+		},
+	}
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	utc := t.UTC()
+	return &utc
+}
@@ -0,0 +1,156 @@
+package provenance_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/provenance"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generator", func() {
+	var (
+		key       *ecdsa.PrivateKey
+		generator *provenance.Generator
+		fakeBuild *dbfakes.FakeBuild
+
+		envelope atc.ProvenanceEnvelope
+		found    bool
+		err      error
+	)
+
+	BeforeEach(func() {
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		signer, err := provenance.NewSigner(key)
+		Expect(err).ToNot(HaveOccurred())
+
+		generator = provenance.NewGenerator(signer, "https://ci.example.com/")
+
+		plan := json.RawMessage(`{"id":"some-plan"}`)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.NameReturns("7")
+		fakeBuild.TeamNameReturns("some-team")
+		fakeBuild.PipelineNameReturns("some-pipeline")
+		fakeBuild.PipelineInstanceVarsReturns(atc.InstanceVars{"branch": "main"})
+		fakeBuild.JobNameReturns("some-job")
+		fakeBuild.PublicPlanReturns(&plan)
+		fakeBuild.StartTimeReturns(time.Unix(1700000000, 0))
+		fakeBuild.ResourcesReturns(
+			[]db.BuildInput{
+				{Name: "some-repo", Version: atc.Version{"ref": "0123456789abcdef0123456789abcdef01234567"}},
+			},
+			[]db.BuildOutput{
+				{Name: "some-image", Version: atc.Version{"digest": "sha256:abcdef"}},
+			},
+			nil,
+		)
+		fakeBuild.ImageVersionsReturns([]db.BuildImageVersion{
+			{Type: "registry-image", Version: atc.Version{"digest": "sha256:123456"}},
+		}, nil)
+		fakeBuild.WorkerNamesReturns([]string{"some-worker"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		envelope, found, err = generator.Generate(fakeBuild)
+	})
+
+	It("signs the provenance", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		Expect(envelope.PayloadType).To(Equal(atc.ProvenancePayloadType))
+		Expect(provenance.Verify(envelope, key.Public())).To(Succeed())
+	})
+
+	It("describes the build's outputs, inputs, images and workers", func() {
+		statement, err := envelope.Statement()
+		Expect(err).ToNot(HaveOccurred())
+
+		startedOn := time.Unix(1700000000, 0).UTC()
+		plan := json.RawMessage(`{"id":"some-plan"}`)
+
+		Expect(statement).To(Equal(atc.ProvenanceStatement{
+			Type: atc.ProvenanceStatementType,
+			Subject: []atc.ProvenanceResourceDescriptor{
+				{
+					Name:        "some-image",
+					Digest:      map[string]string{"sha256": "abcdef"},
+					Annotations: atc.ProvenanceVersionAnnotation{Version: atc.Version{"digest": "sha256:abcdef"}},
+				},
+			},
+			PredicateType: atc.ProvenancePredicateType,
+			Predicate: atc.Provenance{
+				BuildDefinition: atc.ProvenanceBuildDefinition{
+					BuildType: atc.ProvenanceBuildType,
+					ExternalParameters: atc.ProvenanceExternalParameters{
+						Team:         "some-team",
+						Pipeline:     "some-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "main"},
+						Job:          "some-job",
+						Build:        "7",
+						Plan:         &plan,
+					},
+					InternalParameters: atc.ProvenanceInternalParameters{
+						Workers: []string{"some-worker"},
+					},
+					ResolvedDependencies: []atc.ProvenanceResourceDescriptor{
+						{
+							Name:        "some-repo",
+							Digest:      map[string]string{"gitCommit": "0123456789abcdef0123456789abcdef01234567"},
+							Annotations: atc.ProvenanceVersionAnnotation{Version: atc.Version{"ref": "0123456789abcdef0123456789abcdef01234567"}},
+						},
+						{
+							Name:   "image",
+							Digest: map[string]string{"sha256": "123456"},
+							Annotations: atc.ProvenanceVersionAnnotation{
+								Type:    "registry-image",
+								Version: atc.Version{"digest": "sha256:123456"},
+							},
+						},
+					},
+				},
+				RunDetails: atc.ProvenanceRunDetails{
+					Builder: atc.ProvenanceBuilder{ID: "https://ci.example.com"},
+					Metadata: atc.ProvenanceMetadata{
+						InvocationID: "https://ci.example.com/builds/42",
+						StartedOn:    &startedOn,
+					},
+				},
+			},
+		}))
+	})
+
+	Context("when the build has not produced any outputs", func() {
+		BeforeEach(func() {
+			fakeBuild.ResourcesReturns([]db.BuildInput{}, []db.BuildOutput{}, nil)
+		})
+
+		It("does not generate provenance", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when the build's resources cannot be found", func() {
+		BeforeEach(func() {
+			fakeBuild.ResourcesReturns(nil, nil, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(err).To(MatchError(ContainSubstring("nope")))
+		})
+	})
+})
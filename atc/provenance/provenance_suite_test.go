@@ -0,0 +1,13 @@
+package provenance_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestProvenance(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Provenance Suite")
+}
@@ -0,0 +1,149 @@
+package provenance
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var ErrNoValidSignature = errors.New("no valid signature for key")
+
+// Signer signs payloads as DSSE envelopes.
+type Signer struct {
+	key   crypto.Signer
+	keyID string
+}
+
+// NewSigner returns a Signer for an RSA, ECDSA or Ed25519 private key. The
+// key ID recorded in each signature is the SHA-256 of the DER encoded public
+// key.
+func NewSigner(key crypto.Signer) (*Signer, error) {
+	keyID, err := KeyID(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		key:   key,
+		keyID: keyID,
+	}, nil
+}
+
+func (signer *Signer) KeyID() string {
+	return signer.keyID
+}
+
+func (signer *Signer) Sign(payloadType string, payload []byte) (atc.ProvenanceEnvelope, error) {
+	message := PAE(payloadType, payload)
+
+	var (
+		sig []byte
+		err error
+	)
+	if _, ok := signer.key.(ed25519.PrivateKey); ok {
+		sig, err = signer.key.Sign(rand.Reader, message, crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256(message)
+		sig, err = signer.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return atc.ProvenanceEnvelope{}, err
+	}
+
+	return atc.ProvenanceEnvelope{
+		PayloadType: payloadType,
+		Payload:     payload,
+		Signatures: []atc.ProvenanceSignature{
+			{KeyID: signer.keyID, Sig: sig},
+		},
+	}, nil
+}
+
+// Verify checks that the envelope carries a valid signature from the given
+// public key.
+func Verify(envelope atc.ProvenanceEnvelope, publicKey crypto.PublicKey) error {
+	keyID, err := KeyID(publicKey)
+	if err != nil {
+		return err
+	}
+
+	message := PAE(envelope.PayloadType, envelope.Payload)
+	digest := sha256.Sum256(message)
+
+	for _, signature := range envelope.Signatures {
+		if signature.KeyID != "" && signature.KeyID != keyID {
+			continue
+		}
+
+		var valid bool
+		switch key := publicKey.(type) {
+		case *rsa.PublicKey:
+			valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature.Sig) == nil
+		case *ecdsa.PublicKey:
+			valid = ecdsa.VerifyASN1(key, digest[:], signature.Sig)
+		case ed25519.PublicKey:
+			valid = ed25519.Verify(key, message, signature.Sig)
+		}
+
+		if valid {
+			return nil
+		}
+	}
+
+	return ErrNoValidSignature
+}
+
+// KeyID returns the ID identifying signatures made by the public key's
+// private key.
+func KeyID(publicKey crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("marshal public key: %w", err)
+	}
+
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// PAE is the DSSE pre-authentication encoding of a payload, which is what
+// actually gets signed.
+func PAE(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// ParsePrivateKey parses a PEM encoded PKCS #8, PKCS #1 or SEC 1 private key.
+func ParsePrivateKey(pemBytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type: %T", key)
+		}
+
+		return signer, nil
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	return nil, errors.New("unsupported private key format")
+}
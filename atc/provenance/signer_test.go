@@ -0,0 +1,122 @@
+package provenance_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/provenance"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Signer", func() {
+	Describe("PAE", func() {
+		It("matches the DSSE pre-authentication encoding", func() {
+			Expect(string(provenance.PAE("http://example.com/HelloWorld", []byte("hello world")))).To(Equal(
+				"DSSEv1 29 http://example.com/HelloWorld 11 hello world",
+			))
+		})
+	})
+
+	DescribeTable("signing and verifying",
+		func(generate func() (crypto.Signer, error)) {
+			key, err := generate()
+			Expect(err).ToNot(HaveOccurred())
+
+			signer, err := provenance.NewSigner(key)
+			Expect(err).ToNot(HaveOccurred())
+
+			envelope, err := signer.Sign(atc.ProvenancePayloadType, []byte(`{"some":"statement"}`))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(envelope.PayloadType).To(Equal(atc.ProvenancePayloadType))
+			Expect(envelope.Payload).To(MatchJSON(`{"some":"statement"}`))
+			Expect(envelope.Signatures).To(HaveLen(1))
+			Expect(envelope.Signatures[0].KeyID).To(Equal(signer.KeyID()))
+
+			Expect(provenance.Verify(envelope, key.Public())).To(Succeed())
+
+			By("rejecting a tampered payload")
+			envelope.Payload = []byte(`{"some":"other-statement"}`)
+			Expect(provenance.Verify(envelope, key.Public())).To(MatchError(provenance.ErrNoValidSignature))
+		},
+		Entry("with an RSA key", func() (crypto.Signer, error) {
+			return rsa.GenerateKey(rand.Reader, 2048)
+		}),
+		Entry("with an ECDSA key", func() (crypto.Signer, error) {
+			return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		}),
+		Entry("with an Ed25519 key", func() (crypto.Signer, error) {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			return key, err
+		}),
+	)
+
+	It("does not verify against a different key", func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		signer, err := provenance.NewSigner(key)
+		Expect(err).ToNot(HaveOccurred())
+
+		envelope, err := signer.Sign(atc.ProvenancePayloadType, []byte(`{}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(provenance.Verify(envelope, otherKey.Public())).To(MatchError(provenance.ErrNoValidSignature))
+	})
+
+	Describe("ParsePrivateKey", func() {
+		encode := func(blockType string, der []byte) []byte {
+			return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+		}
+
+		It("parses PKCS #8 keys", func() {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+
+			der, err := x509.MarshalPKCS8PrivateKey(key)
+			Expect(err).ToNot(HaveOccurred())
+
+			parsed, err := provenance.ParsePrivateKey(encode("PRIVATE KEY", der))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed).To(Equal(key))
+		})
+
+		It("parses PKCS #1 RSA keys", func() {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+
+			parsed, err := provenance.ParsePrivateKey(encode("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed.Public()).To(Equal(key.Public()))
+		})
+
+		It("parses SEC 1 EC keys", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+
+			der, err := x509.MarshalECPrivateKey(key)
+			Expect(err).ToNot(HaveOccurred())
+
+			parsed, err := provenance.ParsePrivateKey(encode("EC PRIVATE KEY", der))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed.Public()).To(Equal(key.Public()))
+		})
+
+		It("errors when there is no key", func() {
+			_, err := provenance.ParsePrivateKey([]byte("not a key"))
+			Expect(err).To(MatchError("no PEM data found"))
+		})
+	})
+})
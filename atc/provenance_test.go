@@ -0,0 +1,49 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProvenanceDigest", func() {
+	It("uses digests found in the version", func() {
+		Expect(atc.ProvenanceDigest(atc.Version{
+			"digest": "sha256:abcdef",
+			"tag":    "latest",
+		})).To(Equal(map[string]string{"sha256": "abcdef"}))
+	})
+
+	It("uses git commit refs", func() {
+		Expect(atc.ProvenanceDigest(atc.Version{
+			"ref": "0123456789abcdef0123456789abcdef01234567",
+		})).To(Equal(map[string]string{"gitCommit": "0123456789abcdef0123456789abcdef01234567"}))
+	})
+
+	It("hashes versions without any digest", func() {
+		digest := atc.ProvenanceDigest(atc.Version{"number": "1.2.3", "path": "some-path"})
+		Expect(digest).To(HaveKey("sha256"))
+		Expect(digest["sha256"]).To(HaveLen(64))
+
+		Expect(atc.ProvenanceDigest(atc.Version{"path": "some-path", "number": "1.2.3"})).To(Equal(digest))
+		Expect(atc.ProvenanceDigest(atc.Version{"number": "1.2.4", "path": "some-path"})).ToNot(Equal(digest))
+	})
+})
+
+var _ = Describe("ProvenanceEnvelope", func() {
+	It("decodes its statement", func() {
+		statement, err := atc.ProvenanceEnvelope{
+			PayloadType: atc.ProvenancePayloadType,
+			Payload:     []byte(`{"_type":"https://in-toto.io/Statement/v1","subject":[{"name":"some-image","digest":{"sha256":"abcdef"}}]}`),
+		}.Statement()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statement.Type).To(Equal(atc.ProvenanceStatementType))
+		Expect(statement.Subject[0].Name).To(Equal("some-image"))
+	})
+
+	It("rejects unknown payload types", func() {
+		_, err := atc.ProvenanceEnvelope{PayloadType: "text/plain"}.Statement()
+		Expect(err).To(HaveOccurred())
+	})
+})
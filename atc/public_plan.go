@@ -283,13 +283,19 @@ func (plan CheckPlan) Public() *json.RawMessage {
 
 func (plan TaskPlan) Public() *json.RawMessage {
 	return enc(struct {
-		Name       string `json:"name"`
-		Privileged bool   `json:"privileged"`
-		Hermetic   bool   `json:"hermetic"`
+		Name           string      `json:"name"`
+		Privileged     bool        `json:"privileged"`
+		Hermetic       bool        `json:"hermetic"`
+		RuntimeClass   string      `json:"runtime_class,omitempty"`
+		Egress         EgressRules `json:"egress,omitempty"`
+		Checkpointable bool        `json:"checkpointable,omitempty"`
 	}{
-		Name:       plan.Name,
-		Privileged: plan.Privileged,
-		Hermetic:   plan.Hermetic,
+		Name:           plan.Name,
+		Privileged:     plan.Privileged,
+		Hermetic:       plan.Hermetic,
+		RuntimeClass:   plan.RuntimeClass,
+		Egress:         plan.Egress,
+		Checkpointable: plan.Checkpointable,
 	})
 }
 
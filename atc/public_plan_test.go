@@ -224,11 +224,14 @@ var _ = Describe("Plan", func() {
 						{
 							ID: "5",
 							Task: &atc.TaskPlan{
-								Name:       "name",
-								Privileged: true,
-								Hermetic:   true,
-								Tags:       atc.Tags{"tags"},
-								ConfigPath: "some/config/path.yml",
+								Name:           "name",
+								Privileged:     true,
+								Hermetic:       true,
+								RuntimeClass:   "runsc",
+								Egress:         atc.EgressRules{"10.0.0.0/8:443"},
+								Checkpointable: true,
+								Tags:           atc.Tags{"tags"},
+								ConfigPath:     "some/config/path.yml",
 								Config: &atc.TaskConfig{
 									Params: atc.TaskEnv{"some": "secret"},
 								},
@@ -700,7 +703,10 @@ var _ = Describe("Plan", func() {
 				"task": {
 					"name": "name",
 					"privileged": true,
-					"hermetic": true
+					"hermetic": true,
+					"runtime_class": "runsc",
+					"egress": ["10.0.0.0/8:443"],
+					"checkpointable": true
 				}
 			},
 			{
@@ -36,6 +36,47 @@ func (resource Resource) Check(ctx context.Context, container runtime.Container,
 		Path: "/opt/resource/check",
 	}
 
+	return resource.check(ctx, container, spec, stderr)
+}
+
+// CheckInScratchDir runs the check in dir, which is also its $HOME and
+// $TMPDIR, so that what it leaves behind in the container ends up in there.
+// The directory is created by the runtime; env is added to the check's
+// environment.
+func (resource Resource) CheckInScratchDir(ctx context.Context, container runtime.Container, dir string, env []string, stderr io.Writer) ([]atc.Version, runtime.ProcessResult, error) {
+	spec := runtime.ProcessSpec{
+		Path: "/opt/resource/check",
+		Env:  append([]string{"HOME=" + dir, "TMPDIR=" + dir}, env...),
+		Dir:  dir,
+	}
+
+	return resource.check(ctx, container, spec, stderr)
+}
+
+// RemoveScratchDir removes the directory a check was run in by
+// CheckInScratchDir.
+func RemoveScratchDir(ctx context.Context, container runtime.Container, dir string) error {
+	process, err := container.Run(ctx, runtime.ProcessSpec{
+		Path: "/bin/rm",
+		Args: []string{"-rf", dir},
+	}, runtime.ProcessIO{})
+	if err != nil {
+		return err
+	}
+
+	result, err := process.Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	if result.ExitStatus != 0 {
+		return fmt.Errorf("remove %s: exit status %d", dir, result.ExitStatus)
+	}
+
+	return nil
+}
+
+func (resource Resource) check(ctx context.Context, container runtime.Container, spec runtime.ProcessSpec, stderr io.Writer) ([]atc.Version, runtime.ProcessResult, error) {
 	var versions []atc.Version
 	processResult, err := resource.run(ctx, container, spec, stderr, false, &versions)
 	if err != nil {
@@ -70,6 +70,55 @@ func TestResourceCheck(t *testing.T) {
 	})
 }
 
+func TestResourceCheckInScratchDir(t *testing.T) {
+	resource := Resource{
+		Source: atc.Source{"some": "source"},
+	}
+	ctx := context.Background()
+
+	expectedVersions := []atc.Version{
+		{"version": "v1"},
+	}
+	container := runtimetest.NewContainer().
+		WithProcess(
+			runtime.ProcessSpec{
+				Path: "/opt/resource/check",
+				Env:  []string{"HOME=/tmp/build/check-1", "TMPDIR=/tmp/build/check-1", "SOME=env"},
+				Dir:  "/tmp/build/check-1",
+			},
+			runtimetest.ProcessStub{
+				Output: expectedVersions,
+			},
+		)
+	versions, processResult, err := resource.CheckInScratchDir(ctx, container, "/tmp/build/check-1", []string{"SOME=env"}, new(bytes.Buffer))
+	require.NoError(t, err)
+	require.Equal(t, expectedVersions, versions)
+	require.Equal(t, 0, processResult.ExitStatus)
+}
+
+func TestRemoveScratchDir(t *testing.T) {
+	ctx := context.Background()
+	expectedSpec := runtime.ProcessSpec{
+		Path: "/bin/rm",
+		Args: []string{"-rf", "/tmp/build/check-1"},
+	}
+
+	t.Run("successful run", func(t *testing.T) {
+		container := runtimetest.NewContainer().
+			WithProcess(expectedSpec, runtimetest.ProcessStub{})
+		err := RemoveScratchDir(ctx, container, "/tmp/build/check-1")
+		require.NoError(t, err)
+		require.Len(t, container.RunningProcesses(), 1)
+	})
+
+	t.Run("non-zero exit status", func(t *testing.T) {
+		container := runtimetest.NewContainer().
+			WithProcess(expectedSpec, runtimetest.ProcessStub{ExitStatus: 1})
+		err := RemoveScratchDir(ctx, container, "/tmp/build/check-1")
+		require.EqualError(t, err, "remove /tmp/build/check-1: exit status 1")
+	})
+}
+
 func TestResourceGet(t *testing.T) {
 	resource := Resource{
 		Source:  atc.Source{"some": "source"},
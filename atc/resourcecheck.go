@@ -6,6 +6,10 @@ var (
 	DefaultCheckInterval   time.Duration
 	DefaultWebhookInterval time.Duration
 	DefaultResourceTypeInterval time.Duration
+
+	// CheckContainerPoolSize is the number of warm check containers kept per
+	// worker. Zero disables the pool.
+	CheckContainerPoolSize int
 )
 
 type CheckRequestBody struct {
@@ -13,6 +13,11 @@ type ConfigResponse struct {
 	Config Config `json:"config"`
 }
 
+type ConfigVersionResponse struct {
+	ConfigVersion
+	Config Config `json:"config"`
+}
+
 type ClearResourceCacheResponse struct {
 	CachesRemoved int64 `json:"caches_removed"`
 }
@@ -3,8 +3,10 @@ package atc
 import "github.com/tedsuo/rata"
 
 const (
-	SaveConfig = "SaveConfig"
-	GetConfig  = "GetConfig"
+	SaveConfig         = "SaveConfig"
+	GetConfig          = "GetConfig"
+	ListConfigVersions = "ListConfigVersions"
+	GetConfigVersion   = "GetConfigVersion"
 
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
@@ -15,6 +17,8 @@ const (
 	AbortBuild          = "AbortBuild"
 	GetBuildPreparation = "GetBuildPreparation"
 	SetBuildComment     = "SetBuildComment"
+	GetBuildTestResults = "GetBuildTestResults"
+	GetBuildProvenance  = "GetBuildProvenance"
 
 	GetJob         = "GetJob"
 	CreateJobBuild = "CreateJobBuild"
@@ -23,6 +27,8 @@ const (
 	ListJobs       = "ListJobs"
 	ListJobBuilds  = "ListJobBuilds"
 	ListJobInputs  = "ListJobInputs"
+	ListJobTests   = "ListJobTests"
+	GetJobSLO      = "GetJobSLO"
 	GetJobBuild    = "GetJobBuild"
 	PauseJob       = "PauseJob"
 	UnpauseJob     = "UnpauseJob"
@@ -95,6 +101,8 @@ const (
 	ListContainers           = "ListContainers"
 	GetContainer             = "GetContainer"
 	HijackContainer          = "HijackContainer"
+	ListInterceptSessions    = "ListInterceptSessions"
+	GetInterceptRecording    = "GetInterceptRecording"
 	ListDestroyingContainers = "ListDestroyingContainers"
 	ReportWorkerContainers   = "ReportWorkerContainers"
 
@@ -109,6 +117,18 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	SearchBuildLogs = "SearchBuildLogs"
+
+	ListWebhooks          = "ListWebhooks"
+	SetWebhook            = "SetWebhook"
+	DestroyWebhook        = "DestroyWebhook"
+	ListWebhookDeliveries = "ListWebhookDeliveries"
+
+	ClusterActivity = "ClusterActivity"
+	TeamActivity    = "TeamActivity"
+
+	GraphQL = "GraphQL"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
@@ -129,6 +149,8 @@ const (
 var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "PUT", Name: SaveConfig},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "GET", Name: GetConfig},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", Method: "GET", Name: ListConfigVersions},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version", Method: "GET", Name: GetConfigVersion},
 
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
 
@@ -141,6 +163,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
 	{Path: "/api/v1/builds/:build_id/comment", Method: "PUT", Name: SetBuildComment},
+	{Path: "/api/v1/builds/:build_id/test-results", Method: "GET", Name: GetBuildTestResults},
+	{Path: "/api/v1/builds/:build_id/provenance", Method: "GET", Name: GetBuildProvenance},
 
 	{Path: "/api/v1/jobs", Method: "GET", Name: ListAllJobs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs", Method: "GET", Name: ListJobs},
@@ -149,6 +173,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "POST", Name: RerunJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", Method: "GET", Name: ListJobInputs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/test-results", Method: "GET", Name: ListJobTests},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/slo", Method: "GET", Name: GetJobSLO},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "GET", Name: GetJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
@@ -227,6 +253,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
 	{Path: "/api/v1/teams/:team_name/containers/:id", Method: "GET", Name: GetContainer},
 	{Path: "/api/v1/teams/:team_name/containers/:id/hijack", Method: "GET", Name: HijackContainer},
+	{Path: "/api/v1/teams/:team_name/intercept-sessions", Method: "GET", Name: ListInterceptSessions},
+	{Path: "/api/v1/teams/:team_name/intercept-sessions/:session_id/recording", Method: "GET", Name: GetInterceptRecording},
 
 	{Path: "/api/v1/teams/:team_name/volumes", Method: "GET", Name: ListVolumes},
 	{Path: "/api/v1/volumes/destroying", Method: "GET", Name: ListDestroyingVolumes},
@@ -238,6 +266,17 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/builds/search", Method: "GET", Name: SearchBuildLogs},
+
+	{Path: "/api/v1/teams/:team_name/webhooks", Method: "GET", Name: ListWebhooks},
+	{Path: "/api/v1/teams/:team_name/webhooks/:webhook_name", Method: "PUT", Name: SetWebhook},
+	{Path: "/api/v1/teams/:team_name/webhooks/:webhook_name", Method: "DELETE", Name: DestroyWebhook},
+	{Path: "/api/v1/teams/:team_name/webhooks/:webhook_name/deliveries", Method: "GET", Name: ListWebhookDeliveries},
+
+	{Path: "/api/v1/activity", Method: "GET", Name: ClusterActivity},
+	{Path: "/api/v1/teams/:team_name/activity", Method: "GET", Name: TeamActivity},
+
+	{Path: "/api/v1/graphql", Method: "POST", Name: GraphQL},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
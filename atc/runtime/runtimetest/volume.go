@@ -160,6 +160,9 @@ func (vc VolumeContent) StreamOut(ctx context.Context, path string, encoding bag
 		}
 		return nil
 	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, baggageclaim.ErrFileNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
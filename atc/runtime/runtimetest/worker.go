@@ -68,8 +68,10 @@ func (w Worker) Name() string {
 	return w.WorkerName
 }
 
-func (w Worker) CreateVolumeForArtifact(ctx context.Context, teamID int) (runtime.Volume, db.WorkerArtifact, error) {
-	panic("unimplemented")
+func (w *Worker) CreateVolumeForArtifact(ctx context.Context, teamID int) (runtime.Volume, db.WorkerArtifact, error) {
+	volume := NewVolume(fmt.Sprintf("%s-artifact-%d", w.WorkerName, len(w.Volumes)))
+	w.Volumes = append(w.Volumes, volume)
+	return volume, new(dbfakes.FakeWorkerArtifact), nil
 }
 
 func (w *Worker) FindOrCreateContainer(ctx context.Context, owner db.ContainerOwner, metadata db.ContainerMetadata, spec runtime.ContainerSpec, delegate runtime.BuildStepDelegate) (runtime.Container, []runtime.VolumeMount, error) {
@@ -126,6 +126,18 @@ type ContainerSpec struct {
 
 	// Hermetic indicates whether or not the container has external network access.
 	Hermetic bool
+
+	// RuntimeClass is the OCI runtime handler to create the container with.
+	// If empty, the worker's default runtime is used.
+	RuntimeClass string
+
+	// Checkpointable indicates whether the process run in the container can
+	// be checkpointed and restored in another container.
+	Checkpointable bool
+
+	// Egress lists the destinations the container is allowed to reach. If
+	// set, all other external network traffic is rejected.
+	Egress []string
 }
 
 type BuildStepDelegate interface {
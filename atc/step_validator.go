@@ -94,6 +94,43 @@ func (validator *StepValidator) VisitTask(plan *TaskStep) error {
 		})
 	}
 
+	if plan.Hermetic && len(plan.Egress) > 0 {
+		validator.recordError("cannot specify both `hermetic:` and `egress:`")
+	}
+
+	if len(plan.Egress) > 0 {
+		for _, rule := range plan.Egress {
+			if err := rule.Validate(); err != nil {
+				validator.recordError(err.Error())
+			}
+		}
+
+		validator.recordWarning(ConfigWarning{
+			Type:    "pipeline",
+			Message: validator.annotate("specifies `egress:` only works against worker containerd runtime"),
+		})
+	}
+
+	if plan.RuntimeClass != "" {
+		validator.recordWarning(ConfigWarning{
+			Type:    "pipeline",
+			Message: validator.annotate(fmt.Sprintf("specifies `runtime_class: %s`, so it will only run on workers using the containerd runtime with that runtime class configured", plan.RuntimeClass)),
+		})
+	}
+
+	if plan.Checkpointable {
+		validator.recordWarning(ConfigWarning{
+			Type:    "pipeline",
+			Message: validator.annotate("specifies `checkpointable:` only works against worker containerd runtime"),
+		})
+	}
+
+	for _, report := range plan.Reports {
+		if err := ValidateTestReportPath(report); err != nil {
+			validator.recordError(err.Error())
+		}
+	}
+
 	if plan.Config != nil {
 		validator.pushContext(".config")
 
@@ -193,6 +230,20 @@ func (validator *StepValidator) VisitPut(step *PutStep) error {
 		validator.recordError("unknown resource '%s'", resourceName)
 	}
 
+	if step.Provenance != "" {
+		warning, err := ValidateIdentifier(step.Provenance, append(validator.context, ".provenance")...)
+		if err != nil {
+			validator.recordError(err.Error())
+		}
+		if warning != nil {
+			validator.recordWarning(*warning)
+		}
+
+		if step.Provenance == step.Name && !step.NoGet {
+			validator.recordError("provenance artifact '%s' conflicts with the artifact fetched after the put", step.Provenance)
+		}
+	}
+
 	return nil
 }
 
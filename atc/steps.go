@@ -316,14 +316,15 @@ func (step *GetStep) Visit(v StepVisitor) error {
 }
 
 type PutStep struct {
-	Name      string        `json:"put"`
-	Resource  string        `json:"resource,omitempty"`
-	Params    Params        `json:"params,omitempty"`
-	Inputs    *InputsConfig `json:"inputs,omitempty"`
-	Tags      Tags          `json:"tags,omitempty"`
-	GetParams Params        `json:"get_params,omitempty"`
-	Timeout   string        `json:"timeout,omitempty"`
-	NoGet     bool          `json:"no_get,omitempty"`
+	Name       string        `json:"put"`
+	Resource   string        `json:"resource,omitempty"`
+	Params     Params        `json:"params,omitempty"`
+	Inputs     *InputsConfig `json:"inputs,omitempty"`
+	Tags       Tags          `json:"tags,omitempty"`
+	GetParams  Params        `json:"get_params,omitempty"`
+	Timeout    string        `json:"timeout,omitempty"`
+	NoGet      bool          `json:"no_get,omitempty"`
+	Provenance string        `json:"provenance,omitempty"`
 }
 
 func (step *PutStep) ResourceName() string {
@@ -342,6 +343,9 @@ type TaskStep struct {
 	Name              string            `json:"task"`
 	Privileged        bool              `json:"privileged,omitempty"`
 	Hermetic          bool              `json:"hermetic,omitempty"`
+	RuntimeClass      string            `json:"runtime_class,omitempty"`
+	Checkpointable    bool              `json:"checkpointable,omitempty"`
+	Egress            EgressRules       `json:"egress,omitempty"`
 	ConfigPath        string            `json:"file,omitempty"`
 	Limits            *ContainerLimits  `json:"container_limits,omitempty"`
 	Config            *TaskConfig       `json:"config,omitempty"`
@@ -352,6 +356,7 @@ type TaskStep struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 	Timeout           string            `json:"timeout,omitempty"`
+	Reports           []string          `json:"reports,omitempty"`
 }
 
 func (step *TaskStep) Visit(v StepVisitor) error {
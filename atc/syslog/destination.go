@@ -0,0 +1,85 @@
+package syslog
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/concourse/concourse/atc/event"
+)
+
+// DefaultHostname is the hostname which build logs are sent with unless one
+// is configured.
+const DefaultHostname = "atc-syslog-drainer"
+
+const (
+	// EventsAll sends every build event, e.g. steps being initialized and
+	// finishing, along with their output.
+	EventsAll = "all"
+
+	// EventsLogs only sends the output of builds.
+	EventsLogs = "logs"
+)
+
+// Destination is a syslog server which build logs are drained to.
+type Destination struct {
+	Transport string   `yaml:"transport"`
+	Address   string   `yaml:"address"`
+	Hostname  string   `yaml:"hostname,omitempty"`
+	CACerts   []string `yaml:"ca_certs,omitempty"`
+
+	Format Format `yaml:"format,omitempty"`
+	Events string `yaml:"events,omitempty"`
+
+	// Teams limits the destination to the builds of the given teams. The
+	// builds of all teams are sent when empty.
+	Teams []string `yaml:"teams,omitempty"`
+}
+
+func (d Destination) Validate() error {
+	if d.Address == "" {
+		return errors.New("missing address")
+	}
+
+	switch d.Transport {
+	case "tcp", "udp", "tls":
+	case "":
+		return fmt.Errorf("%s: cannot configure a drainer without a transport", d.Address)
+	default:
+		return fmt.Errorf("%s: unknown transport '%s'", d.Address, d.Transport)
+	}
+
+	switch d.Format {
+	case "", FormatText, FormatRFC5424, FormatJSON:
+	default:
+		return fmt.Errorf("%s: unknown format '%s'", d.Address, d.Format)
+	}
+
+	switch d.Events {
+	case "", EventsAll, EventsLogs:
+	default:
+		return fmt.Errorf("%s: unknown events '%s'", d.Address, d.Events)
+	}
+
+	return nil
+}
+
+func (d Destination) drains(teamName string) bool {
+	return len(d.Teams) == 0 || slices.Contains(d.Teams, teamName)
+}
+
+func (d Destination) sends(msg Message) bool {
+	if msg.Text == "" {
+		return false
+	}
+
+	return d.Events != EventsLogs || msg.Event == event.EventTypeLog
+}
+
+func (d Destination) hostname() string {
+	if d.Hostname == "" {
+		return DefaultHostname
+	}
+
+	return d.Hostname
+}
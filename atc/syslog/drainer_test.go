@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/event"
@@ -13,10 +16,12 @@ import (
 	. "github.com/onsi/gomega"
 )
 
+var eventTime = time.Unix(1533744538, 0).Format("2006-01-02T15:04:05.999999Z07:00")
+
 func newFakeBuild(id int) db.Build {
 	fakeEventSource := new(dbfakes.FakeEventSource)
 
-	msg1 := json.RawMessage(`{"time":1533744538,"payload":"build ` + strconv.Itoa(id) + ` log"}`)
+	msg1 := json.RawMessage(`{"time":1533744538,"origin":{"id":"task-plan","source":"stdout"},"payload":"build ` + strconv.Itoa(id) + ` log"}`)
 	fakeEventSource.NextReturnsOnCall(0, event.Envelope{
 		Data:    &msg1,
 		Event:   "log",
@@ -58,6 +63,21 @@ func newFakeBuild(id int) db.Build {
 	fakeBuild := new(dbfakes.FakeBuild)
 	fakeBuild.EventsReturns(fakeEventSource, nil)
 	fakeBuild.IDReturns(id)
+	fakeBuild.NameReturns("7")
+	fakeBuild.TeamNameReturns("main")
+	fakeBuild.PipelineNameReturns("some-pipeline")
+	fakeBuild.JobNameReturns("some-job")
+	fakeBuild.SyslogTagReturns("main/some-pipeline/some-job/7")
+
+	// finished builds only have a public plan
+	plan := atc.Plan{
+		ID: "some-plan",
+		Do: &atc.DoPlan{
+			{ID: "get-plan", Get: &atc.GetPlan{Name: "some-input"}},
+			{ID: "task-plan", Task: &atc.TaskPlan{Name: "unit"}},
+		},
+	}
+	fakeBuild.PublicPlanReturns(plan.Public())
 
 	return fakeBuild
 }
@@ -82,7 +102,9 @@ var _ = Describe("Drainer", func() {
 			})
 
 			It("drains all build events by tcp", func() {
-				testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", []string{}, fakeBuildFactory)
+				testDrainer := syslog.NewDrainer([]syslog.Destination{
+					{Transport: "tcp", Address: server.Addr, Hostname: "test"},
+				}, fakeBuildFactory)
 				err := testDrainer.Run(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
@@ -95,7 +117,210 @@ var _ = Describe("Drainer", func() {
 				Expect(got).To(ContainSubstring("selected worker: example-worker"))
 				Expect(got).To(ContainSubstring("task initializing"))
 			}, 0.2)
+
+			It("sends the build's events in the legacy format by default", func() {
+				testDrainer := syslog.NewDrainer([]syslog.Destination{
+					{Transport: "tcp", Address: server.Addr, Hostname: "test"},
+				}, fakeBuildFactory)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				got := <-server.Messages
+				Expect(got).To(ContainSubstring(`<14>1 ` + eventTime + ` test main/some-pipeline/some-job/7 - - [concourse@0 eventId="1"] build 123 log`))
+			}, 0.2)
+
+			It("marks the builds as drained", func() {
+				testDrainer := syslog.NewDrainer([]syslog.Destination{
+					{Transport: "tcp", Address: server.Addr},
+				}, fakeBuildFactory)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				builds, _ := fakeBuildFactory.GetDrainableBuilds()
+				for _, build := range builds {
+					fakeBuild := build.(*dbfakes.FakeBuild)
+					Expect(fakeBuild.SetDrainedCallCount()).To(Equal(1))
+					Expect(fakeBuild.SetDrainedArgsForCall(0)).To(BeTrue())
+				}
+			}, 0.2)
+
+			Context("when the format is rfc5424", func() {
+				BeforeEach(func() {
+					fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakeBuild(123)}, nil)
+				})
+
+				It("describes the build and step with structured data", func() {
+					testDrainer := syslog.NewDrainer([]syslog.Destination{
+						{Transport: "tcp", Address: server.Addr, Hostname: "test", Format: syslog.FormatRFC5424},
+					}, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					got := <-server.Messages
+					Expect(got).To(ContainSubstring(`<14>1 ` + eventTime + ` test concourse - log [concourse@0 eventId="1" eventType="log" team="main" pipeline="some-pipeline" job="some-job" build="7" buildId="123" step="unit" origin="task-plan" source="stdout"] build 123 log` + "\n"))
+					Expect(got).To(ContainSubstring(`<14>1 ` + eventTime + ` test concourse - status [concourse@0 eventId="2" eventType="status" team="main" pipeline="some-pipeline" job="some-job" build="7" buildId="123"] build 123 status` + "\n"))
+				}, 0.2)
+			})
+
+			Context("when the format is json", func() {
+				BeforeEach(func() {
+					fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakeBuild(123)}, nil)
+				})
+
+				It("sends a line of JSON per event", func() {
+					testDrainer := syslog.NewDrainer([]syslog.Destination{
+						{Transport: "tcp", Address: server.Addr, Hostname: "test", Format: syslog.FormatJSON},
+					}, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					got := <-server.Messages
+					lines := strings.Split(strings.TrimSpace(got), "\n")
+					Expect(lines).To(HaveLen(5))
+					Expect(lines[0]).To(MatchJSON(`{
+						"time": "` + eventTime + `",
+						"hostname": "test",
+						"event_id": "1",
+						"event": "log",
+						"team": "main",
+						"pipeline": "some-pipeline",
+						"job": "some-job",
+						"build": "7",
+						"build_id": 123,
+						"step": "unit",
+						"origin": "task-plan",
+						"source": "stdout",
+						"message": "build 123 log"
+					}`))
+				}, 0.2)
+			})
+
+			Context("when only logs are sent", func() {
+				BeforeEach(func() {
+					fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakeBuild(123)}, nil)
+				})
+
+				It("leaves out the rest of the build's events", func() {
+					testDrainer := syslog.NewDrainer([]syslog.Destination{
+						{Transport: "tcp", Address: server.Addr, Format: syslog.FormatJSON, Events: syslog.EventsLogs},
+					}, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					got := <-server.Messages
+					Expect(strings.Split(strings.TrimSpace(got), "\n")).To(HaveLen(1))
+					Expect(got).To(ContainSubstring("build 123 log"))
+				}, 0.2)
+			})
+
+			Context("when there are multiple destinations", func() {
+				var otherServer *testServer
+
+				BeforeEach(func() {
+					otherServer = newTestServer(nil)
+
+					otherTeamBuild := newFakeBuild(345).(*dbfakes.FakeBuild)
+					otherTeamBuild.TeamNameReturns("other-team")
+
+					fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakeBuild(123), otherTeamBuild}, nil)
+				})
+
+				AfterEach(func() {
+					otherServer.Close()
+				})
+
+				It("only sends each destination the builds of the teams it is for", func() {
+					testDrainer := syslog.NewDrainer([]syslog.Destination{
+						{Transport: "tcp", Address: server.Addr},
+						{Transport: "tcp", Address: otherServer.Addr, Teams: []string{"other-team"}},
+					}, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					got := <-server.Messages
+					Expect(got).To(ContainSubstring("build 123 log"))
+					Expect(got).To(ContainSubstring("build 345 log"))
+
+					gotOther := <-otherServer.Messages
+					Expect(gotOther).ToNot(ContainSubstring("build 123 log"))
+					Expect(gotOther).To(ContainSubstring("build 345 log"))
+				}, 0.2)
+			})
 		})
 
+		Context("when a destination fails", func() {
+			BeforeEach(func() {
+				server = newTestServer(nil)
+
+				otherTeamBuild := newFakeBuild(345).(*dbfakes.FakeBuild)
+				otherTeamBuild.TeamNameReturns("other-team")
+
+				fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakeBuild(123), otherTeamBuild}, nil)
+			})
+
+			It("still drains the builds to the other destinations", func() {
+				testDrainer := syslog.NewDrainer([]syslog.Destination{
+					{Transport: "tcp", Address: "127.0.0.1:1", Teams: []string{"main"}},
+					{Transport: "tcp", Address: server.Addr},
+				}, fakeBuildFactory)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).To(MatchError(ContainSubstring("127.0.0.1:1")))
+
+				got := <-server.Messages
+				Expect(got).To(ContainSubstring("build 123 log"))
+				Expect(got).To(ContainSubstring("build 345 log"))
+			}, 0.2)
+
+			It("only marks the builds that every destination for them got as drained", func() {
+				testDrainer := syslog.NewDrainer([]syslog.Destination{
+					{Transport: "tcp", Address: "127.0.0.1:1", Teams: []string{"main"}},
+					{Transport: "tcp", Address: server.Addr},
+				}, fakeBuildFactory)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).To(HaveOccurred())
+
+				builds, _ := fakeBuildFactory.GetDrainableBuilds()
+				Expect(builds[0].(*dbfakes.FakeBuild).SetDrainedCallCount()).To(Equal(0))
+				Expect(builds[1].(*dbfakes.FakeBuild).SetDrainedCallCount()).To(Equal(1))
+			}, 0.2)
+		})
+
+		Context("when no destination is for the build's team", func() {
+			BeforeEach(func() {
+				server = newTestServer(nil)
+			})
+
+			It("marks the builds as drained without connecting", func() {
+				testDrainer := syslog.NewDrainer([]syslog.Destination{
+					{Transport: "tcp", Address: "127.0.0.1:1", Teams: []string{"other-team"}},
+				}, fakeBuildFactory)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				builds, _ := fakeBuildFactory.GetDrainableBuilds()
+				for _, build := range builds {
+					Expect(build.(*dbfakes.FakeBuild).SetDrainedCallCount()).To(Equal(1))
+				}
+			})
+		})
 	})
 })
+
+var _ = Describe("Destination", func() {
+	DescribeTable("Validate",
+		func(destination syslog.Destination, expectedErr string) {
+			err := destination.Validate()
+			if expectedErr == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+		Entry("valid", syslog.Destination{Transport: "tls", Address: "logs:6514", Format: syslog.FormatRFC5424, Events: syslog.EventsLogs}, ""),
+		Entry("without an address", syslog.Destination{Transport: "tcp"}, "missing address"),
+		Entry("without a transport", syslog.Destination{Address: "logs:514"}, "logs:514: cannot configure a drainer without a transport"),
+		Entry("with an unknown transport", syslog.Destination{Transport: "http", Address: "logs:514"}, "logs:514: unknown transport 'http'"),
+		Entry("with an unknown format", syslog.Destination{Transport: "tcp", Address: "logs:514", Format: "xml"}, "logs:514: unknown format 'xml'"),
+		Entry("with unknown events", syslog.Destination{Transport: "tcp", Address: "logs:514", Events: "some"}, "logs:514: unknown events 'some'"),
+	)
+})
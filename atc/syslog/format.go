@@ -0,0 +1,128 @@
+package syslog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type Format string
+
+const (
+	// FormatText sends each event as an RFC 5424 message whose app name is
+	// the build's syslog tag.
+	FormatText Format = "text"
+
+	// FormatRFC5424 sends each event as an RFC 5424 message which describes
+	// the build and step it came from with structured data.
+	FormatRFC5424 Format = "rfc5424"
+
+	// FormatJSON sends each event as a line of JSON.
+	FormatJSON Format = "json"
+)
+
+const appName = "concourse"
+
+func (format Format) render(hostname string, msg Message) (string, error) {
+	switch format {
+	case FormatRFC5424:
+		return renderRFC5424(hostname, msg), nil
+	case FormatJSON:
+		return renderJSON(hostname, msg)
+	default:
+		return getSyslogFormatter(hostname, msg.Time, msg.Tag, msg.EventID)(priority, "", "", msg.Text), nil
+	}
+}
+
+func renderRFC5424(hostname string, msg Message) string {
+	params := []string{sdParam("eventId", msg.EventID)}
+
+	add := func(name, value string) {
+		if value != "" {
+			params = append(params, sdParam(name, value))
+		}
+	}
+
+	add("eventType", string(msg.Event))
+	add("team", msg.Team)
+	add("pipeline", msg.Pipeline)
+	if len(msg.InstanceVars) > 0 {
+		vars, _ := json.Marshal(msg.InstanceVars)
+		add("instanceVars", string(vars))
+	}
+	add("job", msg.Job)
+	add("build", msg.Build)
+	add("buildId", strconv.Itoa(msg.BuildID))
+	add("step", msg.Step)
+	add("origin", string(msg.Origin.ID))
+	add("source", string(msg.Origin.Source))
+
+	return fmt.Sprintf("<%d>1 %s %s %s - %s [concourse@0 %s] %s\n",
+		priority, msg.Time.Format(rfc5424time), hostname, appName, msgID(msg), strings.Join(params, " "), singleLine(msg.Text))
+}
+
+// msgID identifies the type of the message, which RFC 5424 restricts to 32
+// printable characters.
+func msgID(msg Message) string {
+	if msg.Event == "" {
+		return "-"
+	}
+
+	id := string(msg.Event)
+	if len(id) > 32 {
+		id = id[:32]
+	}
+
+	return id
+}
+
+// sdParam renders a structured data parameter, escaping the characters RFC
+// 5424 requires to be escaped in its value.
+func sdParam(name, value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+	return fmt.Sprintf(`%s="%s"`, name, value)
+}
+
+type jsonMessage struct {
+	Time         string           `json:"time"`
+	Hostname     string           `json:"hostname"`
+	EventID      string           `json:"event_id"`
+	Event        string           `json:"event"`
+	Team         string           `json:"team"`
+	Pipeline     string           `json:"pipeline,omitempty"`
+	InstanceVars atc.InstanceVars `json:"instance_vars,omitempty"`
+	Job          string           `json:"job,omitempty"`
+	Build        string           `json:"build"`
+	BuildID      int              `json:"build_id"`
+	Step         string           `json:"step,omitempty"`
+	Origin       string           `json:"origin,omitempty"`
+	Source       string           `json:"source,omitempty"`
+	Message      string           `json:"message"`
+}
+
+func renderJSON(hostname string, msg Message) (string, error) {
+	payload, err := json.Marshal(jsonMessage{
+		Time:         msg.Time.Format(rfc5424time),
+		Hostname:     hostname,
+		EventID:      msg.EventID,
+		Event:        string(msg.Event),
+		Team:         msg.Team,
+		Pipeline:     msg.Pipeline,
+		InstanceVars: msg.InstanceVars,
+		Job:          msg.Job,
+		Build:        msg.Build,
+		BuildID:      msg.BuildID,
+		Step:         msg.Step,
+		Origin:       string(msg.Origin.ID),
+		Source:       string(msg.Origin.Source),
+		Message:      msg.Text,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(payload) + "\n", nil
+}
@@ -0,0 +1,248 @@
+package syslog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// Message is a build event to be sent to a syslog server, along with the
+// build and step it came from.
+type Message struct {
+	Time    time.Time
+	EventID string
+	Event   atc.EventType
+	Origin  event.Origin
+	Step    string
+
+	// Tag is the build's legacy syslog tag, e.g. team/pipeline/job/build/origin.
+	Tag string
+
+	Team         string
+	Pipeline     string
+	InstanceVars atc.InstanceVars
+	Job          string
+	Build        string
+	BuildID      int
+
+	Text string
+}
+
+// stepNames maps the IDs of a build's plans, which events refer to as their
+// origin, to the names of the steps they were planned for. They're read from
+// the build's public plan, in which every step is an object with an "id" and
+// a key for its kind of step.
+func stepNames(publicPlan *json.RawMessage) (map[event.OriginID]string, error) {
+	steps := map[event.OriginID]string{}
+	if publicPlan == nil {
+		return steps, nil
+	}
+
+	var plan interface{}
+	err := json.Unmarshal(*publicPlan, &plan)
+	if err != nil {
+		return steps, err
+	}
+
+	collectStepNames(plan, steps)
+
+	return steps, nil
+}
+
+// namedSteps are the kinds of steps which have a name.
+var namedSteps = []string{"get", "put", "task", "check", "set_pipeline", "load_var"}
+
+func collectStepNames(node interface{}, steps map[event.OriginID]string) {
+	switch node := node.(type) {
+	case []interface{}:
+		for _, child := range node {
+			collectStepNames(child, steps)
+		}
+
+	case map[string]interface{}:
+		if id, ok := node["id"].(string); ok {
+			for _, kind := range namedSteps {
+				step, ok := node[kind].(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if name, ok := step["name"].(string); ok && name != "" {
+					steps[event.OriginID(id)] = name
+				}
+			}
+		}
+
+		for _, child := range node {
+			collectStepNames(child, steps)
+		}
+	}
+}
+
+// newMessage describes a build event in a way which any of the formats can
+// render. Events which aren't worth sending result in an empty message.
+func newMessage(build db.Build, steps map[event.OriginID]string, ev event.Envelope) (Message, error) {
+	var (
+		ts      time.Time
+		origin  event.Origin
+		message string
+	)
+
+	switch ev.Event {
+	case event.EventTypeInitialize:
+		var initEvent event.Initialize
+		err := json.Unmarshal(*ev.Data, &initEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(initEvent.Time, 0)
+		origin = initEvent.Origin
+		message = "initializing"
+	case event.EventTypeInitializeGet:
+		var initGetEvent event.InitializeGet
+		err := json.Unmarshal(*ev.Data, &initGetEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(initGetEvent.Time, 0)
+		origin = initGetEvent.Origin
+		message = "get initializing"
+	case event.EventTypeInitializePut:
+		var initPutEvent event.InitializePut
+		err := json.Unmarshal(*ev.Data, &initPutEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(initPutEvent.Time, 0)
+		origin = initPutEvent.Origin
+		message = "put initializing"
+	case event.EventTypeInitializeCheck:
+		var initCheckEvent event.InitializeCheck
+		err := json.Unmarshal(*ev.Data, &initCheckEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(initCheckEvent.Time, 0)
+		origin = initCheckEvent.Origin
+		message = fmt.Sprintf("check initializing %s", initCheckEvent.Name)
+	case event.EventTypeInitializeTask:
+		var initTaskEvent event.InitializeTask
+		err := json.Unmarshal(*ev.Data, &initTaskEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(initTaskEvent.Time, 0)
+		origin = initTaskEvent.Origin
+		message = "task initializing"
+	case event.EventTypeSelectedWorker:
+		var selectedWorkerEvent event.SelectedWorker
+		err := json.Unmarshal(*ev.Data, &selectedWorkerEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(selectedWorkerEvent.Time, 0)
+		origin = selectedWorkerEvent.Origin
+		message = fmt.Sprintf("selected worker: %s", selectedWorkerEvent.WorkerName)
+	case event.EventTypeStreamingVolume:
+		var streamingVolumeEvent event.StreamingVolume
+		err := json.Unmarshal(*ev.Data, &streamingVolumeEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(streamingVolumeEvent.Time, 0)
+		origin = streamingVolumeEvent.Origin
+		message = fmt.Sprintf("streaming volume %s from worker %s", streamingVolumeEvent.Volume, streamingVolumeEvent.SourceWorker)
+	case event.EventTypeWaitingForStreamedVolume:
+		var waitingForStreamedVolumeEvent event.WaitingForStreamedVolume
+		err := json.Unmarshal(*ev.Data, &waitingForStreamedVolumeEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(waitingForStreamedVolumeEvent.Time, 0)
+		origin = waitingForStreamedVolumeEvent.Origin
+		message = fmt.Sprintf("waiting for volume %s to be streamed by another step", waitingForStreamedVolumeEvent.Volume)
+	case event.EventTypeStartTask:
+		var startTaskEvent event.StartTask
+		err := json.Unmarshal(*ev.Data, &startTaskEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(startTaskEvent.Time, 0)
+		origin = startTaskEvent.Origin
+
+		buildConfig := startTaskEvent.TaskConfig
+		argv := strings.Join(append([]string{buildConfig.Run.Path}, buildConfig.Run.Args...), " ")
+		message = fmt.Sprintf("running %s", argv)
+	case event.EventTypeLog:
+		var logEvent event.Log
+		err := json.Unmarshal(*ev.Data, &logEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(logEvent.Time, 0)
+		origin = logEvent.Origin
+		message = logEvent.Payload
+	case event.EventTypeFinishGet:
+		var finishGetEvent event.FinishGet
+		err := json.Unmarshal(*ev.Data, &finishGetEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(finishGetEvent.Time, 0)
+		origin = finishGetEvent.Origin
+
+		version, _ := json.Marshal(finishGetEvent.FetchedVersion)
+		metadata, _ := json.Marshal(finishGetEvent.FetchedMetadata)
+		message = fmt.Sprintf("get {\"version\": %s, \"metadata\": %s", string(version), string(metadata))
+	case event.EventTypeFinishPut:
+		var finishPutEvent event.FinishPut
+		err := json.Unmarshal(*ev.Data, &finishPutEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(finishPutEvent.Time, 0)
+		origin = finishPutEvent.Origin
+
+		version, _ := json.Marshal(finishPutEvent.CreatedVersion)
+		metadata, _ := json.Marshal(finishPutEvent.CreatedMetadata)
+		message = fmt.Sprintf("put {\"version\": %s, \"metadata\": %s", string(version), string(metadata))
+	case event.EventTypeError:
+		var errorEvent event.Error
+		err := json.Unmarshal(*ev.Data, &errorEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(errorEvent.Time, 0)
+		origin = errorEvent.Origin
+		message = errorEvent.Message
+	case event.EventTypeStatus:
+		var statusEvent event.Status
+		err := json.Unmarshal(*ev.Data, &statusEvent)
+		if err != nil {
+			return Message{}, err
+		}
+		ts = time.Unix(statusEvent.Time, 0)
+		message = statusEvent.Status.String()
+	}
+
+	return Message{
+		Time:         ts,
+		EventID:      ev.EventID,
+		Event:        ev.Event,
+		Origin:       origin,
+		Step:         steps[origin.ID],
+		Tag:          build.SyslogTag(origin.ID),
+		Team:         build.TeamName(),
+		Pipeline:     build.PipelineName(),
+		InstanceVars: build.PipelineInstanceVars(),
+		Job:          build.JobName(),
+		Build:        build.Name(),
+		BuildID:      build.ID(),
+		Text:         message,
+	}, nil
+}
@@ -77,6 +77,21 @@ func (s *Syslog) Write(hostname, tag string, ts time.Time, msg string, eventID s
 	return err
 }
 
+// WriteLine writes a message which has already been formatted.
+func (s *Syslog) WriteLine(line string) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.writer == nil {
+		return errors.New("connection already closed")
+	}
+
+	s.writer.SetFormatter(func(_ sl.Priority, _, _, content string) string {
+		return content
+	})
+	_, err := s.writer.Write([]byte(line))
+	return err
+}
+
 func (s *Syslog) Close() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -96,13 +111,17 @@ func (s *Syslog) Close() error {
 // generate custom formatter based on hostname and tag
 func getSyslogFormatter(hostname string, ts time.Time, tag string, eventID string) sl.Formatter {
 	return func(priority sl.Priority, _, _, content string) string {
-		// strip whitespaces
-		s := strings.Replace(content, "\n", " ", -1)
-		s = strings.Replace(s, "\r", " ", -1)
-		s = strings.Replace(s, "\x00", " ", -1)
-
 		msg := fmt.Sprintf("<%d>1 %s %s %s - - [concourse@0 eventId=\"%s\"] %s\n",
-			priority, ts.Format(rfc5424time), hostname, tag, eventID, s)
+			priority, ts.Format(rfc5424time), hostname, tag, eventID, singleLine(content))
 		return msg
 	}
 }
+
+// singleLine strips the whitespace which would split a message over multiple
+// lines.
+func singleLine(content string) string {
+	s := strings.Replace(content, "\n", " ", -1)
+	s = strings.Replace(s, "\r", " ", -1)
+	s = strings.Replace(s, "\x00", " ", -1)
+	return s
+}
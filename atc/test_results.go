@@ -0,0 +1,243 @@
+package atc
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// MaxTestReportSize is the largest JUnit report that will be read from a
+// task's output.
+const MaxTestReportSize = 10 * 1024 * 1024
+
+// maxTestMessageLength bounds the failure message kept for each test, as
+// reports often include entire stack traces.
+const maxTestMessageLength = 4096
+
+type TestStatus string
+
+const (
+	TestPassed  TestStatus = "passed"
+	TestFailed  TestStatus = "failed"
+	TestErrored TestStatus = "errored"
+	TestSkipped TestStatus = "skipped"
+)
+
+// TestResult is the outcome of a single test case from a JUnit report.
+type TestResult struct {
+	Step      string     `json:"step,omitempty"`
+	Suite     string     `json:"suite"`
+	ClassName string     `json:"class_name,omitempty"`
+	Name      string     `json:"name"`
+	Status    TestStatus `json:"status"`
+	Duration  float64    `json:"duration"`
+	Message   string     `json:"message,omitempty"`
+}
+
+type TestSummary struct {
+	Total    int     `json:"total"`
+	Passed   int     `json:"passed"`
+	Failed   int     `json:"failed"`
+	Errored  int     `json:"errored"`
+	Skipped  int     `json:"skipped"`
+	Duration float64 `json:"duration"`
+}
+
+// SummarizeTestResults counts the results by status.
+func SummarizeTestResults(results []TestResult) TestSummary {
+	var summary TestSummary
+	for _, result := range results {
+		summary.Total++
+		summary.Duration += result.Duration
+
+		switch result.Status {
+		case TestPassed:
+			summary.Passed++
+		case TestFailed:
+			summary.Failed++
+		case TestErrored:
+			summary.Errored++
+		case TestSkipped:
+			summary.Skipped++
+		}
+	}
+
+	return summary
+}
+
+type BuildTestResults struct {
+	Summary TestSummary  `json:"summary"`
+	Results []TestResult `json:"results"`
+}
+
+// BuildTestSummary is the test summary of one of a job's builds.
+type BuildTestSummary struct {
+	BuildID   int         `json:"build_id"`
+	BuildName string      `json:"build_name"`
+	Status    BuildStatus `json:"status"`
+	Summary   TestSummary `json:"summary"`
+}
+
+// FlakyTest is a test that, across a job's recent builds, failed again after
+// passing, or both passed and failed on the same input versions. Passed and
+// Failed count the builds with each outcome.
+type FlakyTest struct {
+	Suite           string `json:"suite"`
+	ClassName       string `json:"class_name,omitempty"`
+	Name            string `json:"name"`
+	Passed          int    `json:"passed"`
+	Failed          int    `json:"failed"`
+	LastFailedBuild string `json:"last_failed_build"`
+}
+
+type JobTestResults struct {
+	Builds []BuildTestSummary `json:"builds"`
+	Flaky  []FlakyTest        `json:"flaky"`
+}
+
+// ValidateTestReportPath checks a `reports:` path, which must point to a file
+// within one of the task's outputs.
+func ValidateTestReportPath(path string) error {
+	if filepath.IsAbs(path) {
+		return fmt.Errorf("report '%s' must be a relative path", path)
+	}
+
+	clean := filepath.Clean(path)
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return fmt.Errorf("report '%s' must be within one of the task's outputs", path)
+	}
+
+	if !strings.Contains(clean, "/") {
+		return fmt.Errorf("report '%s' must be a file within an output, e.g. %s/report.xml", path, clean)
+	}
+
+	return nil
+}
+
+type junitSuites struct {
+	Suites []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitOutcome `xml:"failure"`
+	Error     *junitOutcome `xml:"error"`
+	Skipped   *junitOutcome `xml:"skipped"`
+}
+
+type junitOutcome struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// ParseJUnitReport reads the test cases out of a JUnit XML report, whose root
+// element may be either <testsuites> or a single <testsuite>.
+func ParseJUnitReport(r io.Reader) ([]TestResult, error) {
+	decoder := xml.NewDecoder(r)
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("malformed report: no <testsuites> or <testsuite> element")
+			}
+
+			return nil, fmt.Errorf("malformed report: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		var suites []junitSuite
+		switch start.Name.Local {
+		case "testsuites":
+			var root junitSuites
+			err = decoder.DecodeElement(&root, &start)
+			suites = root.Suites
+		case "testsuite":
+			var root junitSuite
+			err = decoder.DecodeElement(&root, &start)
+			suites = []junitSuite{root}
+		default:
+			return nil, fmt.Errorf("malformed report: unexpected root element <%s>", start.Name.Local)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("malformed report: %w", err)
+		}
+
+		var results []TestResult
+		for _, suite := range suites {
+			results = suite.appendResults(results, "")
+		}
+
+		return results, nil
+	}
+}
+
+func (suite junitSuite) appendResults(results []TestResult, parent string) []TestResult {
+	name := suite.Name
+	if parent != "" && name != "" {
+		name = parent + "/" + name
+	} else if name == "" {
+		name = parent
+	}
+
+	for _, testCase := range suite.Cases {
+		duration, _ := strconv.ParseFloat(testCase.Time, 64)
+
+		result := TestResult{
+			Suite:     name,
+			ClassName: testCase.ClassName,
+			Name:      testCase.Name,
+			Status:    TestPassed,
+			Duration:  duration,
+		}
+
+		switch {
+		case testCase.Failure != nil:
+			result.Status = TestFailed
+			result.Message = testCase.Failure.message()
+		case testCase.Error != nil:
+			result.Status = TestErrored
+			result.Message = testCase.Error.message()
+		case testCase.Skipped != nil:
+			result.Status = TestSkipped
+			result.Message = testCase.Skipped.message()
+		}
+
+		results = append(results, result)
+	}
+
+	for _, child := range suite.Suites {
+		results = child.appendResults(results, name)
+	}
+
+	return results
+}
+
+func (outcome junitOutcome) message() string {
+	message := outcome.Message
+	if message == "" {
+		message = strings.TrimSpace(outcome.Text)
+	}
+
+	if len(message) > maxTestMessageLength {
+		message = message[:maxTestMessageLength]
+	}
+
+	return message
+}
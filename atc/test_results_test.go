@@ -0,0 +1,91 @@
+package atc_test
+
+import (
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseJUnitReport", func() {
+	It("parses a report with many suites", func() {
+		results, err := atc.ParseJUnitReport(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="api" tests="4">
+    <testcase classname="api.Users" name="creates" time="0.25"/>
+    <testcase classname="api.Users" name="deletes" time="1.5">
+      <failure message="expected 204, got 500">stack trace</failure>
+    </testcase>
+    <testcase classname="api.Users" name="lists" time="0">
+      <error>connection refused</error>
+    </testcase>
+    <testcase classname="api.Users" name="updates">
+      <skipped/>
+    </testcase>
+    <testsuite name="nested">
+      <testcase name="works" time="0.5"/>
+    </testsuite>
+  </testsuite>
+  <testsuite name="db">
+    <testcase classname="db.Conn" name="connects" time="2"/>
+  </testsuite>
+</testsuites>`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]atc.TestResult{
+			{Suite: "api", ClassName: "api.Users", Name: "creates", Status: atc.TestPassed, Duration: 0.25},
+			{Suite: "api", ClassName: "api.Users", Name: "deletes", Status: atc.TestFailed, Duration: 1.5, Message: "expected 204, got 500"},
+			{Suite: "api", ClassName: "api.Users", Name: "lists", Status: atc.TestErrored, Message: "connection refused"},
+			{Suite: "api", ClassName: "api.Users", Name: "updates", Status: atc.TestSkipped},
+			{Suite: "api/nested", Name: "works", Status: atc.TestPassed, Duration: 0.5},
+			{Suite: "db", ClassName: "db.Conn", Name: "connects", Status: atc.TestPassed, Duration: 2},
+		}))
+
+		Expect(atc.SummarizeTestResults(results)).To(Equal(atc.TestSummary{
+			Total:    6,
+			Passed:   3,
+			Failed:   1,
+			Errored:  1,
+			Skipped:  1,
+			Duration: 4.25,
+		}))
+	})
+
+	It("parses a report with a single suite", func() {
+		results, err := atc.ParseJUnitReport(strings.NewReader(`<testsuite name="unit"><testcase name="adds"/></testsuite>`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(Equal([]atc.TestResult{
+			{Suite: "unit", Name: "adds", Status: atc.TestPassed},
+		}))
+	})
+
+	DescribeTable("invalid reports",
+		func(report string, message string) {
+			_, err := atc.ParseJUnitReport(strings.NewReader(report))
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("an empty file", ``, "no <testsuites> or <testsuite> element"),
+		Entry("a different document", `<html></html>`, "unexpected root element <html>"),
+		Entry("malformed XML", `<testsuite><testcase></testsuite>`, "malformed report"),
+	)
+})
+
+var _ = Describe("ValidateTestReportPath", func() {
+	DescribeTable("paths",
+		func(path string, message string) {
+			err := atc.ValidateTestReportPath(path)
+			if message == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			}
+		},
+		Entry("a file in an output", "reports/junit.xml", ""),
+		Entry("a nested file in an output", "reports/unit/junit.xml", ""),
+		Entry("an absolute path", "/tmp/junit.xml", "must be a relative path"),
+		Entry("a path outside the outputs", "../junit.xml", "must be within one of the task's outputs"),
+		Entry("the working directory", ".", "must be within one of the task's outputs"),
+		Entry("an output itself", "reports", "must be a file within an output, e.g. reports/report.xml"),
+	)
+})
@@ -0,0 +1,150 @@
+package atc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// WebhookEvent is something that happened on a team that webhooks can be
+// subscribed to.
+type WebhookEvent string
+
+const (
+	// WebhookEventBuildStatus is sent when a build starts and when it
+	// finishes, including when it's aborted, with the build as its data.
+	WebhookEventBuildStatus WebhookEvent = "build_status"
+
+	// WebhookEventPipelineConfig is sent when a pipeline's config is set,
+	// with a WebhookPipeline as its data.
+	WebhookEventPipelineConfig WebhookEvent = "pipeline_config"
+
+	// WebhookEventPipelinePaused and WebhookEventPipelineUnpaused are sent
+	// when a pipeline is paused or unpaused, with a WebhookPipeline as their
+	// data.
+	WebhookEventPipelinePaused   WebhookEvent = "pipeline_paused"
+	WebhookEventPipelineUnpaused WebhookEvent = "pipeline_unpaused"
+
+	// WebhookEventWorkerState is sent when a worker's state changes, with a
+	// WebhookWorker as its data. Changes to workers that aren't owned by a
+	// team are sent to every team.
+	WebhookEventWorkerState WebhookEvent = "worker_state"
+
+	// WebhookEventJobSLOBreach is sent when a build misses its job's SLO,
+	// with a JobSLOBreach as its data.
+	WebhookEventJobSLOBreach WebhookEvent = "job_slo_breach"
+)
+
+// WebhookEvents are the events webhooks can be subscribed to.
+var WebhookEvents = []WebhookEvent{
+	WebhookEventBuildStatus,
+	WebhookEventPipelineConfig,
+	WebhookEventPipelinePaused,
+	WebhookEventPipelineUnpaused,
+	WebhookEventWorkerState,
+	WebhookEventJobSLOBreach,
+}
+
+const (
+	// WebhookSignatureHeader carries "sha256=" followed by the hex encoded
+	// HMAC-SHA256 of the request body, keyed with the webhook's secret.
+	WebhookSignatureHeader = "X-Concourse-Signature"
+
+	WebhookEventHeader    = "X-Concourse-Event"
+	WebhookDeliveryHeader = "X-Concourse-Delivery"
+)
+
+// Webhook is a team's subscription to have events POSTed to a URL. The
+// secret is write-only: it is never returned by the API. Deliveries to
+// addresses that aren't publicly routable are refused unless the operator
+// allows the network with --webhook-allowed-network.
+type Webhook struct {
+	Name      string         `json:"name"`
+	URL       string         `json:"url"`
+	Secret    string         `json:"secret,omitempty"`
+	Events    []WebhookEvent `json:"events,omitempty"`
+	CreatedAt int64          `json:"created_at,omitempty"`
+}
+
+// Validate checks the webhook before it is saved. Webhooks with no events
+// are sent every event.
+func (webhook Webhook) Validate() error {
+	var errorMessages []string
+
+	if webhook.Secret == "" {
+		errorMessages = append(errorMessages, "secret is required")
+	}
+
+	parsed, err := url.Parse(webhook.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		errorMessages = append(errorMessages, fmt.Sprintf("url '%s' must be an absolute http or https URL", webhook.URL))
+	}
+
+	for _, event := range webhook.Events {
+		known := false
+		for _, webhookEvent := range WebhookEvents {
+			if event == webhookEvent {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			errorMessages = append(errorMessages, fmt.Sprintf("unknown event '%s'", event))
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("invalid webhook:\n%s", strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+// WebhookPayload is the body POSTed to a webhook.
+type WebhookPayload struct {
+	Event     WebhookEvent    `json:"event"`
+	Timestamp int64           `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type WebhookPipeline struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+
+	// By is who set, paused or unpaused the pipeline, when known.
+	By string `json:"by,omitempty"`
+
+	// Created is set on the first config of a pipeline.
+	Created bool `json:"created,omitempty"`
+}
+
+type WebhookWorker struct {
+	Name          string `json:"name"`
+	Team          string `json:"team,omitempty"`
+	State         string `json:"state"`
+	PreviousState string `json:"previous_state,omitempty"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is an event sent, or to be sent, to a webhook.
+type WebhookDelivery struct {
+	ID             int                   `json:"id"`
+	Event          WebhookEvent          `json:"event"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	ResponseStatus int                   `json:"response_status,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	CreatedAt      int64                 `json:"created_at"`
+	LastAttemptAt  int64                 `json:"last_attempt_at,omitempty"`
+	NextAttemptAt  int64                 `json:"next_attempt_at,omitempty"`
+}
@@ -0,0 +1,161 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"time"
+)
+
+// ErrDestinationNotAllowed is returned when a webhook's URL resolves to an
+// address that deliveries may not be sent to.
+var ErrDestinationNotAllowed = errors.New("destination address is not allowed")
+
+// nonPublicNetworks are the special-purpose networks that aren't publicly
+// routable, as listed in the IANA IPv4 and IPv6 special-purpose address
+// registries, along with multicast and the networks that embed IPv4
+// addresses, which could be used to reach any of the others. IPv4-mapped
+// addresses are matched as the IPv4 address they map to.
+var nonPublicNetworks = parseNetworks(
+	"0.0.0.0/8",       // "this" network
+	"10.0.0.0/8",      // private
+	"100.64.0.0/10",   // shared address space (carrier-grade NAT)
+	"127.0.0.0/8",     // loopback
+	"169.254.0.0/16",  // link-local
+	"172.16.0.0/12",   // private
+	"192.0.0.0/24",    // IETF protocol assignments
+	"192.0.2.0/24",    // documentation
+	"192.88.99.0/24",  // 6to4 relay anycast
+	"192.168.0.0/16",  // private
+	"198.18.0.0/15",   // benchmarking
+	"198.51.100.0/24", // documentation
+	"203.0.113.0/24",  // documentation
+	"224.0.0.0/4",     // multicast
+	"240.0.0.0/4",     // reserved, including broadcast
+	"::/128",          // unspecified
+	"::1/128",         // loopback
+	"64:ff9b::/96",    // IPv4/IPv6 translation
+	"64:ff9b:1::/48",  // local IPv4/IPv6 translation
+	"100::/64",        // discard-only
+	"2001::/23",       // IETF protocol assignments, including Teredo
+	"2001:db8::/32",   // documentation
+	"2002::/16",       // 6to4
+	"fc00::/7",        // unique local
+	"fe80::/10",       // link-local
+	"fec0::/10",       // site-local
+	"ff00::/8",        // multicast
+)
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+
+		networks[i] = network
+	}
+
+	return networks
+}
+
+// AllowedNetwork is a CIDR that deliveries may be sent to even though it
+// isn't publicly routable, e.g. for webhooks on an internal network.
+type AllowedNetwork struct {
+	*net.IPNet
+}
+
+func (network *AllowedNetwork) UnmarshalFlag(value string) error {
+	_, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return fmt.Errorf("invalid network '%s': %w", value, err)
+	}
+
+	network.IPNet = ipNet
+
+	return nil
+}
+
+// NewHTTPClient returns the client deliveries are sent with. Webhook URLs are
+// set by team members, so to keep them from reaching the web node's own
+// network the client refuses to connect to addresses that aren't publicly
+// routable, unless they are in one of the allowed networks. The
+// check is made on the address being dialed, after name resolution, so it
+// can't be sidestepped with DNS. Redirects are not followed.
+func NewHTTPClient(timeout time.Duration, allowed []AllowedNetwork) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(host)
+			if ip == nil || !destinationAllowed(ip, allowed) {
+				return ErrDestinationNotAllowed
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func destinationAllowed(ip net.IP, allowed []AllowedNetwork) bool {
+	for _, network := range allowed {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// deliveryError describes why a delivery failed without echoing the
+// underlying error, which can reveal details of the web node's network, e.g.
+// which addresses are listening. It is recorded in the delivery log that
+// team members can see.
+func deliveryError(err error) string {
+	var dnsErr *net.DNSError
+	var netErr net.Error
+
+	switch {
+	case errors.Is(err, ErrDestinationNotAllowed):
+		return ErrDestinationNotAllowed.Error()
+	case errors.As(err, &dnsErr):
+		return "could not resolve host"
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, os.ErrDeadlineExceeded),
+		errors.As(err, &netErr) && netErr.Timeout():
+		return "request timed out"
+	default:
+		return "request failed"
+	}
+}
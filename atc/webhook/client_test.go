@@ -0,0 +1,53 @@
+package webhook_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/webhook"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTPClient", func() {
+	var httpClient *http.Client
+
+	BeforeEach(func() {
+		httpClient = webhook.NewHTTPClient(time.Second, nil)
+	})
+
+	DescribeTable("refusing addresses that aren't publicly routable",
+		func(host string) {
+			_, err := httpClient.Post("http://"+host+"/hook", "application/json", nil)
+			Expect(err).To(MatchError(webhook.ErrDestinationNotAllowed))
+		},
+		Entry("private", "10.1.2.3"),
+		Entry("loopback", "127.0.0.1"),
+		Entry("link-local", "169.254.169.254"),
+		Entry("shared address space", "100.64.0.1"),
+		Entry("IETF protocol assignments", "192.0.0.170"),
+		Entry("benchmarking", "198.18.0.1"),
+		Entry("documentation", "203.0.113.1"),
+		Entry("reserved", "240.0.0.1"),
+		Entry("broadcast", "255.255.255.255"),
+		Entry("unspecified", "0.0.0.0"),
+		Entry("IPv6 loopback", "[::1]"),
+		Entry("IPv4-mapped", "[::ffff:10.1.2.3]"),
+		Entry("IPv4/IPv6 translation", "[64:ff9b::a01:203]"),
+		Entry("6to4", "[2002:a01:203::1]"),
+		Entry("unique local", "[fd00::1]"),
+		Entry("IPv6 link-local", "[fe80::1]"),
+	)
+
+	It("connects to addresses in the allowed networks", func() {
+		var network webhook.AllowedNetwork
+		Expect(network.UnmarshalFlag("100.64.0.0/10")).To(Succeed())
+
+		httpClient = webhook.NewHTTPClient(10*time.Millisecond, []webhook.AllowedNetwork{network})
+
+		_, err := httpClient.Post("http://100.64.0.1/hook", "application/json", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err).ToNot(MatchError(webhook.ErrDestinationNotAllowed))
+	})
+})
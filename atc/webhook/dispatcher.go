@@ -0,0 +1,200 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// deliveriesPerRun bounds how many deliveries are sent each interval, so
+	// that a backlog is worked through over several runs.
+	deliveriesPerRun = 100
+
+	// concurrentDeliveries bounds how many deliveries are sent at once, so
+	// that a few slow endpoints don't hold up the rest of a run.
+	concurrentDeliveries = 10
+
+	minBackoff = 30 * time.Second
+	maxBackoff = time.Hour
+)
+
+// Dispatcher sends queued webhook deliveries, retrying the ones that fail
+// with an exponential backoff.
+type Dispatcher interface {
+	Run(context.Context) error
+}
+
+type dispatcher struct {
+	webhookFactory db.WebhookFactory
+	httpClient     *http.Client
+	maxAttempts    int
+	retention      time.Duration
+}
+
+func NewDispatcher(
+	webhookFactory db.WebhookFactory,
+	httpClient *http.Client,
+	maxAttempts int,
+	retention time.Duration,
+) Dispatcher {
+	return &dispatcher{
+		webhookFactory: webhookFactory,
+		httpClient:     httpClient,
+		maxAttempts:    maxAttempts,
+		retention:      retention,
+	}
+}
+
+func (d *dispatcher) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("webhook-dispatcher")
+
+	deliveries, err := d.webhookFactory.PendingWebhookDeliveries(deliveriesPerRun)
+	if err != nil {
+		logger.Error("failed-to-get-pending-deliveries", err)
+		return err
+	}
+
+	var deliveryGroup errgroup.Group
+	deliveryGroup.SetLimit(concurrentDeliveries)
+
+	for _, delivery := range deliveries {
+		delivery := delivery
+
+		deliveryGroup.Go(func() error {
+			attempt := d.send(ctx, logger, delivery)
+
+			err := d.webhookFactory.RecordWebhookDeliveryAttempt(delivery.ID, attempt)
+			if err != nil {
+				logger.Error("failed-to-record-attempt", err, lager.Data{"delivery": delivery.ID})
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	err = deliveryGroup.Wait()
+	if err != nil {
+		return err
+	}
+
+	if d.retention > 0 {
+		deleted, err := d.webhookFactory.DeleteWebhookDeliveriesBefore(time.Now().Add(-d.retention))
+		if err != nil {
+			logger.Error("failed-to-prune-deliveries", err)
+			return err
+		}
+
+		if deleted > 0 {
+			logger.Debug("pruned-deliveries", lager.Data{"deleted": deleted})
+		}
+	}
+
+	return nil
+}
+
+func (d *dispatcher) send(ctx context.Context, logger lager.Logger, delivery db.WebhookDelivery) db.WebhookDeliveryAttempt {
+	logger = logger.Session("send", lager.Data{
+		"delivery": delivery.ID,
+		"webhook":  delivery.WebhookID,
+		"event":    delivery.Event,
+	})
+
+	status, err := d.post(ctx, logger, delivery)
+	if err == nil {
+		return db.WebhookDeliveryAttempt{
+			Status:         string(atc.WebhookDeliveryDelivered),
+			ResponseStatus: status,
+		}
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= d.maxAttempts {
+		logger.Info("giving-up", lager.Data{"attempts": attempts, "error": err.Error()})
+
+		return db.WebhookDeliveryAttempt{
+			Status:         string(atc.WebhookDeliveryFailed),
+			ResponseStatus: status,
+			Error:          err.Error(),
+		}
+	}
+
+	logger.Debug("will-retry", lager.Data{"attempts": attempts, "error": err.Error()})
+
+	return db.WebhookDeliveryAttempt{
+		Status:         string(atc.WebhookDeliveryPending),
+		ResponseStatus: status,
+		Error:          err.Error(),
+		NextAttemptAt:  time.Now().Add(Backoff(attempts)),
+	}
+}
+
+func (d *dispatcher) post(ctx context.Context, logger lager.Logger, delivery db.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(atc.WebhookEventHeader, delivery.Event)
+	req.Header.Set(atc.WebhookDeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(atc.WebhookSignatureHeader, Sign(delivery.Secret, body))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		// the error is recorded for the team to see, so only the gist of it
+		// is kept
+		logger.Info("failed-to-post", lager.Data{"error": err.Error()})
+		return 0, errors.New(deliveryError(err))
+	}
+
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the value of the signature header for a payload: "sha256="
+// followed by the hex encoded HMAC-SHA256 of the payload, keyed with the
+// webhook's secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff is how long to wait before retrying a delivery that has failed the
+// given number of times.
+func Backoff(attempts int) time.Duration {
+	backoff := minBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+
+	return backoff
+}
@@ -0,0 +1,275 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/webhook"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dispatcher", func() {
+	var (
+		fakeWebhookFactory *dbfakes.FakeWebhookFactory
+		server             *httptest.Server
+		responseStatus     int
+		requests           chan *http.Request
+		bodies             chan string
+
+		httpClient *http.Client
+		dispatcher webhook.Dispatcher
+		runErr     error
+	)
+
+	BeforeEach(func() {
+		fakeWebhookFactory = new(dbfakes.FakeWebhookFactory)
+
+		responseStatus = http.StatusOK
+		requests = make(chan *http.Request, 10)
+		bodies = make(chan string, 10)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests <- r
+			bodies <- string(body)
+			w.WriteHeader(responseStatus)
+		}))
+
+		httpClient = server.Client()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		dispatcher = webhook.NewDispatcher(fakeWebhookFactory, httpClient, 3, 24*time.Hour)
+		runErr = dispatcher.Run(context.TODO())
+	})
+
+	Context("when there are pending deliveries", func() {
+		var attempts int
+
+		BeforeEach(func() {
+			attempts = 0
+
+			fakeWebhookFactory.PendingWebhookDeliveriesStub = func(int) ([]db.WebhookDelivery, error) {
+				return []db.WebhookDelivery{
+					{
+						ID:       42,
+						URL:      server.URL + "/hook",
+						Secret:   "some-secret",
+						Event:    "build_status",
+						Payload:  `{"event":"build_status"}`,
+						Attempts: attempts,
+					},
+				}, nil
+			}
+		})
+
+		It("posts them with a signature", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+
+			var req *http.Request
+			Eventually(requests).Should(Receive(&req))
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.URL.Path).To(Equal("/hook"))
+			Expect(req.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(req.Header.Get(atc.WebhookEventHeader)).To(Equal("build_status"))
+			Expect(req.Header.Get(atc.WebhookDeliveryHeader)).To(Equal("42"))
+			Expect(req.Header.Get(atc.WebhookSignatureHeader)).To(Equal(webhook.Sign("some-secret", []byte(`{"event":"build_status"}`))))
+			Expect(<-bodies).To(Equal(`{"event":"build_status"}`))
+		})
+
+		It("records them as delivered", func() {
+			Expect(fakeWebhookFactory.RecordWebhookDeliveryAttemptCallCount()).To(Equal(1))
+			id, attempt := fakeWebhookFactory.RecordWebhookDeliveryAttemptArgsForCall(0)
+			Expect(id).To(Equal(42))
+			Expect(attempt).To(Equal(db.WebhookDeliveryAttempt{
+				Status:         "delivered",
+				ResponseStatus: http.StatusOK,
+			}))
+		})
+
+		Context("when the webhook responds with an error", func() {
+			BeforeEach(func() {
+				responseStatus = http.StatusInternalServerError
+			})
+
+			It("schedules a retry", func() {
+				Expect(fakeWebhookFactory.RecordWebhookDeliveryAttemptCallCount()).To(Equal(1))
+				_, attempt := fakeWebhookFactory.RecordWebhookDeliveryAttemptArgsForCall(0)
+				Expect(attempt.Status).To(Equal("pending"))
+				Expect(attempt.ResponseStatus).To(Equal(http.StatusInternalServerError))
+				Expect(attempt.Error).To(ContainSubstring("500"))
+				Expect(attempt.NextAttemptAt).To(BeTemporally("~", time.Now().Add(30*time.Second), 5*time.Second))
+			})
+
+			Context("on the last attempt", func() {
+				BeforeEach(func() {
+					attempts = 2
+				})
+
+				It("gives up", func() {
+					_, attempt := fakeWebhookFactory.RecordWebhookDeliveryAttemptArgsForCall(0)
+					Expect(attempt.Status).To(Equal("failed"))
+					Expect(attempt.NextAttemptAt).To(BeZero())
+				})
+			})
+		})
+
+		Context("when sent with the webhook client", func() {
+			BeforeEach(func() {
+				httpClient = webhook.NewHTTPClient(time.Second, nil)
+			})
+
+			It("refuses to connect to the loopback address", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(requests).To(BeEmpty())
+
+				_, attempt := fakeWebhookFactory.RecordWebhookDeliveryAttemptArgsForCall(0)
+				Expect(attempt.Status).To(Equal("pending"))
+				Expect(attempt.Error).To(Equal("destination address is not allowed"))
+			})
+
+			Context("when the loopback network is allowed", func() {
+				BeforeEach(func() {
+					var network webhook.AllowedNetwork
+					Expect(network.UnmarshalFlag("127.0.0.0/8")).To(Succeed())
+					httpClient = webhook.NewHTTPClient(time.Second, []webhook.AllowedNetwork{network})
+				})
+
+				It("delivers them", func() {
+					Expect(runErr).ToNot(HaveOccurred())
+
+					_, attempt := fakeWebhookFactory.RecordWebhookDeliveryAttemptArgsForCall(0)
+					Expect(attempt.Status).To(Equal("delivered"))
+				})
+
+				Context("when the webhook redirects", func() {
+					BeforeEach(func() {
+						server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							requests <- r
+							http.Redirect(w, r, "/elsewhere", http.StatusFound)
+						})
+					})
+
+					It("does not follow the redirect", func() {
+						Expect(requests).To(HaveLen(1))
+
+						_, attempt := fakeWebhookFactory.RecordWebhookDeliveryAttemptArgsForCall(0)
+						Expect(attempt.Status).To(Equal("pending"))
+						Expect(attempt.ResponseStatus).To(Equal(http.StatusFound))
+					})
+				})
+
+				Context("when nothing is listening", func() {
+					BeforeEach(func() {
+						server.Close()
+					})
+
+					It("records the failure without the underlying error", func() {
+						_, attempt := fakeWebhookFactory.RecordWebhookDeliveryAttemptArgsForCall(0)
+						Expect(attempt.Status).To(Equal("pending"))
+						Expect(attempt.Error).To(Equal("request failed"))
+					})
+				})
+			})
+		})
+
+		Context("when there are several of them", func() {
+			BeforeEach(func() {
+				fakeWebhookFactory.PendingWebhookDeliveriesStub = func(int) ([]db.WebhookDelivery, error) {
+					var deliveries []db.WebhookDelivery
+					for id := 1; id <= 3; id++ {
+						deliveries = append(deliveries, db.WebhookDelivery{
+							ID:      id,
+							URL:     server.URL + "/hook",
+							Event:   "build_status",
+							Payload: `{"event":"build_status"}`,
+						})
+					}
+
+					return deliveries, nil
+				}
+
+				// each request is held until every delivery has been sent,
+				// which only happens if they're sent at once
+				var received sync.WaitGroup
+				received.Add(3)
+
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					received.Done()
+
+					allReceived := make(chan struct{})
+					go func() {
+						received.Wait()
+						close(allReceived)
+					}()
+
+					select {
+					case <-allReceived:
+						w.WriteHeader(http.StatusOK)
+					case <-time.After(5 * time.Second):
+						w.WriteHeader(http.StatusGatewayTimeout)
+					}
+				})
+			})
+
+			It("sends them concurrently", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeWebhookFactory.RecordWebhookDeliveryAttemptCallCount()).To(Equal(3))
+
+				for i := 0; i < 3; i++ {
+					_, attempt := fakeWebhookFactory.RecordWebhookDeliveryAttemptArgsForCall(i)
+					Expect(attempt.Status).To(Equal("delivered"))
+				}
+			})
+		})
+
+		Context("when recording the attempt fails", func() {
+			BeforeEach(func() {
+				fakeWebhookFactory.RecordWebhookDeliveryAttemptReturns(errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("nope"))
+			})
+		})
+	})
+
+	Context("when getting pending deliveries fails", func() {
+		BeforeEach(func() {
+			fakeWebhookFactory.PendingWebhookDeliveriesReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+
+	It("prunes deliveries older than the retention", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeWebhookFactory.DeleteWebhookDeliveriesBeforeCallCount()).To(Equal(1))
+		Expect(fakeWebhookFactory.DeleteWebhookDeliveriesBeforeArgsForCall(0)).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Minute))
+	})
+})
+
+var _ = Describe("Backoff", func() {
+	It("doubles with each attempt up to an hour", func() {
+		Expect(webhook.Backoff(1)).To(Equal(30 * time.Second))
+		Expect(webhook.Backoff(2)).To(Equal(time.Minute))
+		Expect(webhook.Backoff(4)).To(Equal(4 * time.Minute))
+		Expect(webhook.Backoff(20)).To(Equal(time.Hour))
+	})
+})
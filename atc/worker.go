@@ -23,6 +23,15 @@ type Worker struct {
 
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
+	// RuntimeClasses lists the OCI runtime handlers (e.g. runc, runsc, kata)
+	// that containers can be run with on the worker.
+	RuntimeClasses []string `json:"runtime_classes,omitempty"`
+
+	// Architecture and Variant are the CPU architecture of the worker, as
+	// used to select the image for the worker out of a multi-platform image.
+	Architecture string `json:"architecture,omitempty"`
+	Variant      string `json:"variant,omitempty"`
+
 	Platform  string `json:"platform"`
 	Tags      Tags   `json:"tags"`
 	Team      string `json:"team"`
@@ -33,6 +42,10 @@ type Worker struct {
 	State     string `json:"state"`
 }
 
+// RuntimeClassProperty is the container property through which the runtime
+// class requested by a task is passed on to the worker's runtime.
+const RuntimeClassProperty = "concourse:runtime-class"
+
 type Tags []string
 
 // UnmarshalJSON unmarshals as a []string, removing any empty elements. Empty
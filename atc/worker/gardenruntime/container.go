@@ -18,6 +18,10 @@ const userPropertyName = "user"
 
 const exitStatusPropertyName = "concourse:exit-status"
 
+const egressPropertyName = "concourse:egress"
+
+const checkpointablePropertyName = "concourse:checkpointable"
+
 type Container struct {
 	DBContainer_    db.CreatedContainer
 	GardenContainer gclient.Container
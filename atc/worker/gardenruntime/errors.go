@@ -8,6 +8,9 @@ import (
 var ErrMissingVolume = errors.New("volume mounted to container is missing")
 var ErrBaseResourceTypeNotFound = errors.New("base resource type not found")
 var ErrUnsupportedResourceType = errors.New("unsupported resource type")
+var ErrNoImageManifest = errors.New("OCI image layout has no image manifest for the worker's platform")
+var ErrImagePlatformMismatch = errors.New("image is not for the worker's platform")
+var ErrMalformedImageArchive = errors.New("malformed image archive")
 
 type CreatedVolumeNotFoundError struct {
 	Handle     string
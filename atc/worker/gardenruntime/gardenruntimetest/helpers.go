@@ -1,7 +1,10 @@
 package gardenruntimetest
 
 import (
+	"archive/tar"
+	"bytes"
 	"encoding/json"
+	"path"
 	"reflect"
 	"testing/fstest"
 
@@ -9,6 +12,8 @@ import (
 	"github.com/concourse/concourse/atc/worker/gardenruntime"
 	"github.com/concourse/concourse/worker/baggageclaim"
 	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
 func ImageMetadataFile(metadata gardenruntime.ImageMetadata) *fstest.MapFile {
@@ -17,6 +22,108 @@ func ImageMetadataFile(metadata gardenruntime.ImageMetadata) *fstest.MapFile {
 	return &fstest.MapFile{Data: data}
 }
 
+// OCIImageLayout lays out an image with the given config as an OCI image
+// with a manifest for each of the platforms, returning the digests of the
+// manifests.
+func OCIImageLayout(config specs.ImageConfig, platforms ...specs.Platform) (runtimetest.VolumeContent, []digest.Digest) {
+	content := runtimetest.VolumeContent{}
+
+	addBlob := func(mediaType string, blob any) specs.Descriptor {
+		data, err := json.Marshal(blob)
+		Expect(err).ToNot(HaveOccurred())
+
+		dgst := digest.FromBytes(data)
+		content[path.Join(gardenruntime.OCIImageLayoutDir, "blobs", dgst.Algorithm().String(), dgst.Encoded())] = &fstest.MapFile{Data: data}
+
+		return specs.Descriptor{
+			MediaType: mediaType,
+			Digest:    dgst,
+			Size:      int64(len(data)),
+		}
+	}
+
+	var manifests []specs.Descriptor
+	var digests []digest.Digest
+	for _, platform := range platforms {
+		configDescriptor := addBlob(specs.MediaTypeImageConfig, specs.Image{
+			Platform: platform,
+			Config:   config,
+		})
+
+		manifestDescriptor := addBlob(specs.MediaTypeImageManifest, specs.Manifest{
+			MediaType: specs.MediaTypeImageManifest,
+			Config:    configDescriptor,
+		})
+		manifestDescriptor.Platform = &specs.Platform{
+			OS:           platform.OS,
+			Architecture: platform.Architecture,
+			Variant:      platform.Variant,
+		}
+
+		manifests = append(manifests, manifestDescriptor)
+		digests = append(digests, manifestDescriptor.Digest)
+	}
+
+	index, err := json.Marshal(specs.Index{
+		MediaType: specs.MediaTypeImageIndex,
+		Manifests: manifests,
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	content[path.Join(gardenruntime.OCIImageLayoutDir, specs.ImageIndexFile)] = &fstest.MapFile{Data: index}
+
+	return content, digests
+}
+
+// ImageArchive saves an image with the given config as an image archive,
+// laid out the way the registry-image resource saves images: the config
+// first, then the layers, then the manifest.
+func ImageArchive(config specs.ImageConfig, platform specs.Platform) runtimetest.VolumeContent {
+	configData, err := json.Marshal(specs.Image{
+		Platform: platform,
+		Config:   config,
+		RootFS: specs.RootFS{
+			Type:    "layers",
+			DiffIDs: []digest.Digest{digest.FromString("some-layer")},
+		},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	configName := digest.FromBytes(configData).String()
+
+	manifest, err := json.Marshal([]map[string]any{{
+		"Config":   configName,
+		"RepoTags": []string{"some-image:latest"},
+		"Layers":   []string{"some-layer.tar.gz"},
+	}})
+	Expect(err).ToNot(HaveOccurred())
+
+	buf := new(bytes.Buffer)
+	archive := tar.NewWriter(buf)
+	for _, file := range []struct {
+		name string
+		data []byte
+	}{
+		{configName, configData},
+		{"some-layer.tar.gz", []byte("some-layer")},
+		{"manifest.json", manifest},
+	} {
+		Expect(archive.WriteHeader(&tar.Header{
+			Name: file.name,
+			Mode: 0644,
+			Size: int64(len(file.data)),
+		})).To(Succeed())
+
+		_, err := archive.Write(file.data)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(archive.Close()).To(Succeed())
+
+	return runtimetest.VolumeContent{
+		gardenruntime.ImageArchiveFile: &fstest.MapFile{Data: buf.Bytes()},
+	}
+}
+
 func StrategyEq(strategy baggageclaim.Strategy) func(*Volume) bool {
 	return func(v *Volume) bool {
 		return reflect.DeepEqual(v.Spec.Strategy.Encode(), strategy.Encode())
@@ -207,6 +207,19 @@ func (w Worker) WithTags(tags ...string) *Worker {
 	})
 }
 
+func (w Worker) WithRuntimeClasses(runtimeClasses ...string) *Worker {
+	return w.WithWorkerSetup(func(w *atc.Worker) {
+		w.RuntimeClasses = append(w.RuntimeClasses, runtimeClasses...)
+	})
+}
+
+func (w Worker) WithArchitecture(architecture, variant string) *Worker {
+	return w.WithWorkerSetup(func(w *atc.Worker) {
+		w.Architecture = architecture
+		w.Variant = variant
+	})
+}
+
 func (w Worker) WithPlatform(platform string) *Worker {
 	return w.WithWorkerSetup(func(w *atc.Worker) {
 		w.Platform = platform
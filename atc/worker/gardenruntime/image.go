@@ -1,24 +1,52 @@
 package gardenruntime
 
 import (
+	"archive/tar"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
 	"path"
+	"sync"
 
 	"code.cloudfoundry.org/lager/v3/lagerctx"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/worker/baggageclaim"
+	"github.com/containerd/containerd/platforms"
+	"github.com/golang/groupcache/lru"
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
 const RawRootFSScheme = "raw"
 
 const ImageMetadataFile = "metadata.json"
 
+// OCIImageLayoutDir is where an image is laid out as an OCI image, for
+// images that don't come with a rootfs.
+const OCIImageLayoutDir = "oci"
+
+// ImageArchiveFile is where an image is saved as an archive, for images that
+// come neither with a rootfs nor as an OCI image layout. This is what the
+// registry-image resource writes with `format: oci`, and what `docker save`
+// writes.
+const ImageArchiveFile = "image.tar"
+
+// imageArchiveManifestFile lists the images saved in an image archive.
+const imageArchiveManifestFile = "manifest.json"
+
+// maxImageArchiveMetadataSize bounds the files kept in memory while looking
+// for an image archive's manifest and config.
+const maxImageArchiveMetadataSize = 1024 * 1024
+
+var errNoOCIImageLayout = errors.New("no OCI image layout")
+
+const dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
 type FetchedImage struct {
 	Metadata   ImageMetadata
 	Version    atc.Version
@@ -47,6 +75,20 @@ func (worker *Worker) fetchImageForContainer(
 			return FetchedImage{}, fmt.Errorf("find or stream volume: %w", err)
 		}
 
+		imageMetadataReader, err := worker.streamer.StreamFile(ctx, imageSpec.ImageArtifact, ImageMetadataFile)
+		if errors.Is(err, baggageclaim.ErrFileNotFound) {
+			return worker.fetchOCIImageForContainer(ctx, imageSpec, teamID, container, volume)
+		}
+		if err != nil {
+			logger.Error("failed-to-stream-metadata-file", err)
+			return FetchedImage{}, fmt.Errorf("stream image metadata file: %w", err)
+		}
+
+		metadata, err := loadMetadata(imageMetadataReader)
+		if err != nil {
+			return FetchedImage{}, fmt.Errorf("load image metadata: %w", err)
+		}
+
 		imageVolume, err := worker.findOrCreateCOWVolumeForContainer(
 			ctx,
 			imageSpec.Privileged,
@@ -60,17 +102,6 @@ func (worker *Worker) fetchImageForContainer(
 			return FetchedImage{}, fmt.Errorf("create COW volume: %w", err)
 		}
 
-		imageMetadataReader, err := worker.streamer.StreamFile(ctx, imageSpec.ImageArtifact, ImageMetadataFile)
-		if err != nil {
-			logger.Error("failed-to-stream-metadata-file", err)
-			return FetchedImage{}, fmt.Errorf("stream image metadata file: %w", err)
-		}
-
-		metadata, err := loadMetadata(imageMetadataReader)
-		if err != nil {
-			return FetchedImage{}, fmt.Errorf("load image metadata: %w", err)
-		}
-
 		imageURL := url.URL{
 			Scheme: RawRootFSScheme,
 			Path:   path.Join(imageVolume.Path(), "rootfs"),
@@ -95,6 +126,345 @@ func (worker *Worker) fetchImageForContainer(
 	return FetchedImage{URL: imageSpec.ImageURL}, nil
 }
 
+// fetchOCIImageForContainer creates the root filesystem of an image laid
+// out as an OCI image, or saved as an image archive, rather than as a
+// rootfs. Its layers are unpacked into the worker's layer store, where
+// they're shared with other images. Images that come as a rootfs aren't
+// split into layers, so they get volumes of their own.
+func (worker *Worker) fetchOCIImageForContainer(
+	ctx context.Context,
+	imageSpec runtime.ImageSpec,
+	teamID int,
+	container db.CreatingContainer,
+	volume Volume,
+) (FetchedImage, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	imagePath := OCIImageLayoutDir
+
+	var manifest string
+	manifestDigest, config, err := worker.loadOCIImage(ctx, imageSpec.ImageArtifact)
+	if errors.Is(err, errNoOCIImageLayout) {
+		// an archive holds a single image, so there's no manifest to select
+		imagePath = ImageArchiveFile
+		config, err = worker.loadImageArchive(ctx, imageSpec.ImageArtifact)
+	} else {
+		manifest = manifestDigest.String()
+	}
+	if err != nil {
+		logger.Error("failed-to-load-oci-image", err)
+		return FetchedImage{}, fmt.Errorf("load OCI image: %w", err)
+	}
+
+	imageVolume, err := worker.findOrCreateOCIImageVolumeForContainer(
+		ctx,
+		imageSpec.Privileged,
+		container,
+		volume,
+		teamID,
+		"/",
+		imagePath,
+		manifest,
+	)
+	if err != nil {
+		logger.Error("failed-to-create-oci-image-volume", err)
+		return FetchedImage{}, fmt.Errorf("create OCI image volume: %w", err)
+	}
+
+	imageURL := url.URL{
+		Scheme: RawRootFSScheme,
+		Path:   imageVolume.Path(),
+	}
+
+	return FetchedImage{
+		Metadata: ImageMetadata{
+			Env:  config.Config.Env,
+			User: config.Config.User,
+		},
+		URL:        imageURL.String(),
+		Privileged: imageSpec.Privileged,
+	}, nil
+}
+
+// loadOCIImage finds the manifest of the image for the worker's platform in
+// an OCI image layout, and loads the image's config.
+func (worker *Worker) loadOCIImage(ctx context.Context, artifact runtime.Artifact) (digest.Digest, specs.Image, error) {
+	var index specs.Index
+	err := worker.loadOCIBlob(ctx, artifact, specs.ImageIndexFile, &index)
+	if errors.Is(err, baggageclaim.ErrFileNotFound) {
+		return "", specs.Image{}, errNoOCIImageLayout
+	}
+	if err != nil {
+		return "", specs.Image{}, err
+	}
+
+	matcher := worker.platformMatcher()
+
+	var manifestDescriptor *specs.Descriptor
+	for i, descriptor := range index.Manifests {
+		if descriptor.MediaType != specs.MediaTypeImageManifest && descriptor.MediaType != dockerManifestMediaType {
+			continue
+		}
+
+		if descriptor.Platform == nil {
+			if manifestDescriptor == nil {
+				manifestDescriptor = &index.Manifests[i]
+			}
+
+			continue
+		}
+
+		if !matcher.Match(*descriptor.Platform) {
+			continue
+		}
+
+		// prefer the closest match, e.g. arm/v7 over arm/v6 on an arm/v7 worker
+		if manifestDescriptor == nil || manifestDescriptor.Platform == nil || matcher.Less(*descriptor.Platform, *manifestDescriptor.Platform) {
+			manifestDescriptor = &index.Manifests[i]
+		}
+	}
+
+	if manifestDescriptor == nil {
+		return "", specs.Image{}, ErrNoImageManifest
+	}
+
+	var manifest specs.Manifest
+	err = worker.loadOCIBlob(ctx, artifact, ociBlobPath(manifestDescriptor.Digest), &manifest)
+	if err != nil {
+		return "", specs.Image{}, err
+	}
+
+	var config specs.Image
+	err = worker.loadOCIBlob(ctx, artifact, ociBlobPath(manifest.Config.Digest), &config)
+	if err != nil {
+		return "", specs.Image{}, err
+	}
+
+	err = worker.checkImagePlatform(config)
+	if err != nil {
+		return "", specs.Image{}, err
+	}
+
+	return manifestDescriptor.Digest, config, nil
+}
+
+type imageArchiveManifest struct {
+	Config string
+	Layers []string
+}
+
+// imageArchiveConfigs caches the configs of the images saved in image
+// archives by the handle of the artifact holding the archive, as reading one
+// means streaming the archive through the ATC up to its manifest, which
+// tends to come after the layers. An artifact's content doesn't change, so
+// entries are only ever evicted to make room.
+var imageArchiveConfigs = newImageConfigCache(1000)
+
+type imageConfigCache struct {
+	mu    sync.Mutex // lru.Cache is not safe for concurrent access
+	cache *lru.Cache
+}
+
+func newImageConfigCache(size int) *imageConfigCache {
+	return &imageConfigCache{
+		cache: lru.New(size),
+	}
+}
+
+func (c *imageConfigCache) Get(handle string) (specs.Image, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	config, found := c.cache.Get(handle)
+	if !found {
+		return specs.Image{}, false
+	}
+
+	return config.(specs.Image), true
+}
+
+// Add caches the parts of the config containers are created with.
+func (c *imageConfigCache) Add(handle string, config specs.Image) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache.Add(handle, specs.Image{
+		Platform: config.Platform,
+		Config: specs.ImageConfig{
+			Env:  config.Config.Env,
+			User: config.Config.User,
+		},
+	})
+}
+
+// loadImageArchive loads the config of the image saved in an image archive,
+// reading it from the archive the first time the artifact is used.
+func (worker *Worker) loadImageArchive(ctx context.Context, artifact runtime.Artifact) (specs.Image, error) {
+	config, found := imageArchiveConfigs.Get(artifact.Handle())
+	if !found {
+		var err error
+		config, err = worker.readImageArchiveConfig(ctx, artifact)
+		if err != nil {
+			return specs.Image{}, err
+		}
+
+		imageArchiveConfigs.Add(artifact.Handle(), config)
+	}
+
+	err := worker.checkImagePlatform(config)
+	if err != nil {
+		return specs.Image{}, err
+	}
+
+	return config, nil
+}
+
+// readImageArchiveConfig reads the config of the image saved in an image
+// archive. The archive is streamed up to its manifest, which tends to come
+// last, so an OCI image layout is the quicker of the two to create the first
+// container from.
+func (worker *Worker) readImageArchiveConfig(ctx context.Context, artifact runtime.Artifact) (specs.Image, error) {
+	reader, err := worker.streamer.StreamFile(ctx, artifact, ImageArchiveFile)
+	if err != nil {
+		return specs.Image{}, fmt.Errorf("stream %s: %w", ImageArchiveFile, err)
+	}
+
+	defer reader.Close()
+
+	files := map[string][]byte{}
+
+	var manifests []imageArchiveManifest
+
+	archive := tar.NewReader(reader)
+	for {
+		if manifests != nil {
+			if len(manifests) != 1 {
+				return specs.Image{}, fmt.Errorf("%w: holds %d images rather than one", ErrMalformedImageArchive, len(manifests))
+			}
+
+			content, found := files[path.Clean(manifests[0].Config)]
+			if found {
+				var config specs.Image
+				err := json.Unmarshal(content, &config)
+				if err != nil {
+					return specs.Image{}, MalformedMetadataError{
+						UnmarshalError: err,
+					}
+				}
+
+				return config, nil
+			}
+		}
+
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return specs.Image{}, fmt.Errorf("read %s: %w", ImageArchiveFile, err)
+		}
+
+		if header.Typeflag != tar.TypeReg || header.Size > maxImageArchiveMetadataSize {
+			continue
+		}
+
+		content, err := io.ReadAll(archive)
+		if err != nil {
+			return specs.Image{}, fmt.Errorf("read %s: %w", ImageArchiveFile, err)
+		}
+
+		name := path.Clean(header.Name)
+		if name == imageArchiveManifestFile {
+			err := json.Unmarshal(content, &manifests)
+			if err != nil {
+				return specs.Image{}, MalformedMetadataError{
+					UnmarshalError: err,
+				}
+			}
+
+			if manifests == nil {
+				manifests = []imageArchiveManifest{}
+			}
+
+			continue
+		}
+
+		files[name] = content
+	}
+
+	if manifests == nil {
+		return specs.Image{}, fmt.Errorf("%w: no %s", ErrMalformedImageArchive, imageArchiveManifestFile)
+	}
+
+	return specs.Image{}, fmt.Errorf("%w: config %s not found", ErrMalformedImageArchive, manifests[0].Config)
+}
+
+// platformMatcher matches the platforms of images that can run on the
+// worker. Workers that don't report their architecture are only matched on
+// their OS.
+func (worker *Worker) platformMatcher() platforms.MatchComparer {
+	platform := specs.Platform{
+		OS:           worker.dbWorker.Platform(),
+		Architecture: worker.dbWorker.Architecture(),
+		Variant:      worker.dbWorker.Variant(),
+	}
+
+	if platform.Architecture == "" {
+		return osMatcher{os: platform.OS}
+	}
+
+	return platforms.Only(platform)
+}
+
+// checkImagePlatform checks that the platform recorded in an image's config,
+// if any, can run on the worker.
+func (worker *Worker) checkImagePlatform(config specs.Image) error {
+	if config.OS == "" || config.Architecture == "" {
+		return nil
+	}
+
+	if !worker.platformMatcher().Match(config.Platform) {
+		return fmt.Errorf("%w: %s", ErrImagePlatformMismatch, platforms.Format(config.Platform))
+	}
+
+	return nil
+}
+
+type osMatcher struct {
+	os string
+}
+
+func (matcher osMatcher) Match(platform specs.Platform) bool {
+	return platform.OS == matcher.os
+}
+
+func (matcher osMatcher) Less(specs.Platform, specs.Platform) bool {
+	return false
+}
+
+func (worker *Worker) loadOCIBlob(ctx context.Context, artifact runtime.Artifact, blobPath string, dest interface{}) error {
+	reader, err := worker.streamer.StreamFile(ctx, artifact, path.Join(OCIImageLayoutDir, blobPath))
+	if err != nil {
+		return fmt.Errorf("stream %s: %w", blobPath, err)
+	}
+
+	defer reader.Close()
+
+	err = json.NewDecoder(reader).Decode(dest)
+	if err != nil {
+		return MalformedMetadataError{
+			UnmarshalError: err,
+		}
+	}
+
+	return nil
+}
+
+func ociBlobPath(dgst digest.Digest) string {
+	return path.Join(specs.ImageBlobsDir, dgst.Algorithm().String(), dgst.Encoded())
+}
+
 func (worker *Worker) imageFromBaseResourceType(
 	ctx context.Context,
 	resourceType atc.WorkerResourceType,
@@ -109,6 +109,14 @@ func (v Volume) COWStrategy() baggageclaim.COWStrategy {
 	}
 }
 
+func (v Volume) OCIImageStrategy(layoutPath string, manifest string) baggageclaim.OCIImageStrategy {
+	return baggageclaim.OCIImageStrategy{
+		Parent:   v.bcVolume,
+		Path:     layoutPath,
+		Manifest: manifest,
+	}
+}
+
 func (v Volume) StreamOut(ctx context.Context, path string, compression compression.Compression) (io.ReadCloser, error) {
 	return v.bcVolume.StreamOut(ctx, path, compression.Encoding())
 }
@@ -252,6 +260,32 @@ func (worker *Worker) findOrCreateCOWVolumeForContainer(
 	)
 }
 
+func (worker *Worker) findOrCreateOCIImageVolumeForContainer(
+	ctx context.Context,
+	privileged bool,
+	container db.CreatingContainer,
+	parent Volume,
+	teamID int,
+	mountPath string,
+	layoutPath string,
+	manifest string,
+) (Volume, error) {
+	ctx = lagerctx.NewContext(ctx, lagerctx.FromContext(ctx).Session("find-or-create-oci-image-volume-for-container"))
+	return worker.findOrCreateVolume(
+		ctx,
+		baggageclaim.VolumeSpec{
+			Strategy:   parent.OCIImageStrategy(layoutPath, manifest),
+			Privileged: privileged,
+		},
+		func() (db.CreatingVolume, db.CreatedVolume, error) {
+			return worker.db.VolumeRepo.FindContainerVolume(teamID, worker.Name(), container, mountPath)
+		},
+		func() (db.CreatingVolume, error) {
+			return parent.dbVolume.CreateChildForContainer(container, mountPath)
+		},
+	)
+}
+
 func (worker *Worker) findOrCreateVolumeForBaseResourceType(
 	ctx context.Context,
 	volumeSpec baggageclaim.VolumeSpec,
@@ -2,6 +2,7 @@ package gardenruntime
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -219,10 +220,29 @@ func (worker *Worker) createGardenContainer(
 		},
 	}
 
+	if containerSpec.RuntimeClass != "" {
+		gdnSpec.Properties[atc.RuntimeClassProperty] = containerSpec.RuntimeClass
+	}
+
+	if containerSpec.Checkpointable {
+		gdnSpec.Properties[checkpointablePropertyName] = "true"
+	}
+
+	if len(containerSpec.Egress) > 0 {
+		egress, err := json.Marshal(containerSpec.Egress)
+		if err != nil {
+			markContainerAsFailed(logger, creatingContainer)
+			return nil, err
+		}
+
+		gdnSpec.Properties[egressPropertyName] = string(egress)
+	}
+
 	// By default set NetOutRule to whitelist all range of IPs
 	// otherwise leave NetOutRule to nil so worker runtime knows nothing is allowed
-	// to reach outside
-	if !containerSpec.Hermetic {
+	// to reach outside. Containers with egress rules start out without
+	// NetOutRules too, so that workers unable to enforce the rules fail closed.
+	if !containerSpec.Hermetic && len(containerSpec.Egress) == 0 {
 		gdnSpec.NetOut = []garden.NetOutRule{{
 			Networks: []garden.IPRange{
 				{
@@ -25,6 +25,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
 var _ = Describe("Garden Worker", func() {
@@ -400,6 +401,280 @@ var _ = Describe("Garden Worker", func() {
 		})
 	})
 
+	Test("fetch image laid out as an OCI image from volume on same worker", func() {
+		imageContent, manifests := grt.OCIImageLayout(specs.ImageConfig{
+			Env:  []string{"FOO=bar"},
+			User: "somebody",
+		}, specs.Platform{OS: "linux"})
+		imageVolume := grt.NewVolume("local-image-volume").WithContent(imageContent)
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker").
+					WithPlatform("linux").
+					WithVolumesCreatedInDBAndBaggageclaim(
+						imageVolume,
+					),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		container, _, err := worker.FindOrCreateContainer(
+			ctx,
+			db.NewFixedHandleContainerOwner("my-handle"),
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				ImageSpec: runtime.ImageSpec{
+					ImageArtifact: scenario.WorkerVolume("worker", imageVolume.Handle()),
+				},
+				Env: []string{"A=b"},
+			},
+			delegate,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		var rootfsVolume *grt.Volume
+		By("validating the volume was created from the image's layers", func() {
+			var ok bool
+			rootfsVolume, ok = findVolumeBy(worker, grt.StrategyEq(baggageclaim.OCIImageStrategy{
+				Parent:   imageVolume,
+				Path:     "oci",
+				Manifest: manifests[0].String(),
+			}))
+			Expect(ok).To(BeTrue())
+		})
+
+		gardenContainer := gardenContainer(container)
+
+		By("validating the container was created with the proper rootfs + image config", func() {
+			Expect(gardenContainer.Spec.RootFSPath).To(Equal(fmt.Sprintf("raw://%s", rootfsVolume.Path())))
+			Expect(gardenContainer.Spec.Env).To(Equal([]string{"FOO=bar", "A=b"}))
+		})
+
+		By("running a process on the container and validating it uses the user from the image config", func() {
+			_, err := container.Run(ctx,
+				runtime.ProcessSpec{
+					Path: "noop",
+				},
+				runtime.ProcessIO{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(gardenContainer.Processes[0].Spec.User).To(Equal("somebody"))
+		})
+	})
+
+	Test("fetch image laid out as an OCI image for another platform", func() {
+		imageContent, _ := grt.OCIImageLayout(specs.ImageConfig{}, specs.Platform{OS: "windows"})
+		imageVolume := grt.NewVolume("local-image-volume").WithContent(imageContent)
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker").
+					WithPlatform("linux").
+					WithVolumesCreatedInDBAndBaggageclaim(
+						imageVolume,
+					),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		_, _, err := worker.FindOrCreateContainer(
+			ctx,
+			db.NewFixedHandleContainerOwner("my-handle"),
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				ImageSpec: runtime.ImageSpec{
+					ImageArtifact: scenario.WorkerVolume("worker", imageVolume.Handle()),
+				},
+			},
+			delegate,
+		)
+		Expect(err).To(MatchError(gardenruntime.ErrNoImageManifest))
+	})
+
+	Test("fetch image laid out as an OCI image for the worker's architecture", func() {
+		imageContent, manifests := grt.OCIImageLayout(
+			specs.ImageConfig{},
+			specs.Platform{OS: "linux", Architecture: "amd64"},
+			specs.Platform{OS: "linux", Architecture: "arm", Variant: "v6"},
+			specs.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+			specs.Platform{OS: "linux", Architecture: "arm64"},
+		)
+		imageVolume := grt.NewVolume("local-image-volume").WithContent(imageContent)
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker").
+					WithPlatform("linux").
+					WithArchitecture("arm", "v7").
+					WithVolumesCreatedInDBAndBaggageclaim(
+						imageVolume,
+					),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		_, _, err := worker.FindOrCreateContainer(
+			ctx,
+			db.NewFixedHandleContainerOwner("my-handle"),
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				ImageSpec: runtime.ImageSpec{
+					ImageArtifact: scenario.WorkerVolume("worker", imageVolume.Handle()),
+				},
+			},
+			delegate,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, ok := findVolumeBy(worker, grt.StrategyEq(baggageclaim.OCIImageStrategy{
+			Parent:   imageVolume,
+			Path:     "oci",
+			Manifest: manifests[2].String(),
+		}))
+		Expect(ok).To(BeTrue())
+	})
+
+	Test("fetch image laid out as an OCI image for another architecture", func() {
+		imageContent, _ := grt.OCIImageLayout(specs.ImageConfig{}, specs.Platform{OS: "linux", Architecture: "arm64"})
+		imageVolume := grt.NewVolume("local-image-volume").WithContent(imageContent)
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker").
+					WithPlatform("linux").
+					WithArchitecture("amd64", "").
+					WithVolumesCreatedInDBAndBaggageclaim(
+						imageVolume,
+					),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		_, _, err := worker.FindOrCreateContainer(
+			ctx,
+			db.NewFixedHandleContainerOwner("my-handle"),
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				ImageSpec: runtime.ImageSpec{
+					ImageArtifact: scenario.WorkerVolume("worker", imageVolume.Handle()),
+				},
+			},
+			delegate,
+		)
+		Expect(err).To(MatchError(gardenruntime.ErrNoImageManifest))
+	})
+
+	Test("fetch image saved as an image archive from volume on same worker", func() {
+		imageContent := grt.ImageArchive(specs.ImageConfig{
+			Env:  []string{"FOO=bar"},
+			User: "somebody",
+		}, specs.Platform{OS: "linux", Architecture: "amd64"})
+		imageVolume := grt.NewVolume("local-image-archive-volume").WithContent(imageContent)
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker").
+					WithPlatform("linux").
+					WithArchitecture("amd64", "").
+					WithVolumesCreatedInDBAndBaggageclaim(
+						imageVolume,
+					),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		container, _, err := worker.FindOrCreateContainer(
+			ctx,
+			db.NewFixedHandleContainerOwner("my-handle"),
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				ImageSpec: runtime.ImageSpec{
+					ImageArtifact: scenario.WorkerVolume("worker", imageVolume.Handle()),
+				},
+				Env: []string{"A=b"},
+			},
+			delegate,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		var rootfsVolume *grt.Volume
+		By("validating the volume was created from the archive", func() {
+			var ok bool
+			rootfsVolume, ok = findVolumeBy(worker, grt.StrategyEq(baggageclaim.OCIImageStrategy{
+				Parent: imageVolume,
+				Path:   "image.tar",
+			}))
+			Expect(ok).To(BeTrue())
+		})
+
+		gardenContainer := gardenContainer(container)
+
+		By("validating the container was created with the proper rootfs + image config", func() {
+			Expect(gardenContainer.Spec.RootFSPath).To(Equal(fmt.Sprintf("raw://%s", rootfsVolume.Path())))
+			Expect(gardenContainer.Spec.Env).To(Equal([]string{"FOO=bar", "A=b"}))
+		})
+	})
+
+	Test("fetch image saved as an image archive for another architecture", func() {
+		imageContent := grt.ImageArchive(specs.ImageConfig{}, specs.Platform{OS: "linux", Architecture: "arm64"})
+		imageVolume := grt.NewVolume("arm64-image-archive-volume").WithContent(imageContent)
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker").
+					WithPlatform("linux").
+					WithArchitecture("amd64", "").
+					WithVolumesCreatedInDBAndBaggageclaim(
+						imageVolume,
+					),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		_, _, err := worker.FindOrCreateContainer(
+			ctx,
+			db.NewFixedHandleContainerOwner("my-handle"),
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				ImageSpec: runtime.ImageSpec{
+					ImageArtifact: scenario.WorkerVolume("worker", imageVolume.Handle()),
+				},
+			},
+			delegate,
+		)
+		Expect(err).To(MatchError(gardenruntime.ErrImagePlatformMismatch))
+	})
+
+	Test("fetch image saved as an image archive only reads the archive once", func() {
+		imageContent := grt.ImageArchive(specs.ImageConfig{
+			Env: []string{"FOO=bar"},
+		}, specs.Platform{OS: "linux", Architecture: "amd64"})
+		imageVolume := grt.NewVolume("cached-image-archive-volume").WithContent(imageContent)
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker").
+					WithPlatform("linux").
+					WithArchitecture("amd64", "").
+					WithVolumesCreatedInDBAndBaggageclaim(
+						imageVolume,
+					),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		containerSpec := runtime.ContainerSpec{
+			ImageSpec: runtime.ImageSpec{
+				ImageArtifact: scenario.WorkerVolume("worker", imageVolume.Handle()),
+			},
+		}
+
+		_, _, err := worker.FindOrCreateContainer(ctx, db.NewFixedHandleContainerOwner("first-handle"), db.ContainerMetadata{}, containerSpec, delegate)
+		Expect(err).ToNot(HaveOccurred())
+
+		// the archive can't be read again, so the config must come from the
+		// first read
+		delete(imageVolume.Content, "image.tar")
+
+		container, _, err := worker.FindOrCreateContainer(ctx, db.NewFixedHandleContainerOwner("second-handle"), db.ContainerMetadata{}, containerSpec, delegate)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gardenContainer(container).Spec.Env).To(Equal([]string{"FOO=bar"}))
+	})
+
 	Test("fetch image from resource cache volume on same worker", func() {
 		imageContent := runtimetest.VolumeContent{
 			"metadata.json": grt.ImageMetadataFile(gardenruntime.ImageMetadata{
@@ -1341,6 +1616,79 @@ var _ = Describe("Garden Worker", func() {
 		})
 	})
 
+	Test("runtime class is passed to garden as a property", func() {
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker"),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		_, _, err := worker.FindOrCreateContainer(
+			ctx,
+			db.NewFixedHandleContainerOwner("my-sandboxed-handle"),
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				RuntimeClass: "runsc",
+			},
+			delegate,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		garden := gardenServer(worker)
+		Expect(garden.ContainerList).To(HaveLen(1))
+		Expect(garden.ContainerList[0].Spec.Properties).To(HaveKeyWithValue("concourse:runtime-class", "runsc"))
+	})
+
+	Test("checkpointable containers are marked with a property", func() {
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker"),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		_, _, err := worker.FindOrCreateContainer(
+			ctx,
+			db.NewFixedHandleContainerOwner("my-checkpointable-handle"),
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				Checkpointable: true,
+			},
+			delegate,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		garden := gardenServer(worker)
+		Expect(garden.ContainerList).To(HaveLen(1))
+		Expect(garden.ContainerList[0].Spec.Properties).To(HaveKeyWithValue("concourse:checkpointable", "true"))
+	})
+
+	Test("egress rules are passed to garden as a property", func() {
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker"),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		_, _, err := worker.FindOrCreateContainer(
+			ctx,
+			db.NewFixedHandleContainerOwner("my-egress-handle"),
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				Egress: []string{"10.0.0.0/8", "artifacts.example.com:443"},
+			},
+			delegate,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		garden := gardenServer(worker)
+		Expect(garden.ContainerList).To(HaveLen(1))
+		Expect(garden.ContainerList[0].Spec.Properties).To(HaveKeyWithValue("concourse:egress", `["10.0.0.0/8","artifacts.example.com:443"]`))
+		Expect(garden.ContainerList[0].Spec.NetOut).To(BeEmpty())
+	})
+
 	Test("container volume creating, but not in baggageclaim", func() {
 		scenario := Setup(
 			workertest.WithBasicJob(),
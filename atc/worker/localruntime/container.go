@@ -0,0 +1,126 @@
+package localruntime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/hashicorp/go-multierror"
+)
+
+const userPropertyName = "user"
+
+type Container struct {
+	GardenContainer garden.Container
+}
+
+func (c Container) Run(_ context.Context, spec runtime.ProcessSpec, io runtime.ProcessIO) (runtime.Process, error) {
+	properties, err := c.GardenContainer.Properties()
+	if err != nil {
+		return nil, fmt.Errorf("get properties: %w", err)
+	}
+
+	user := spec.User
+	if user == "" {
+		user = properties[userPropertyName]
+	}
+
+	var tty *garden.TTYSpec
+	if spec.TTY != nil {
+		tty = &garden.TTYSpec{
+			WindowSize: &garden.WindowSize{
+				Columns: spec.TTY.WindowSize.Columns,
+				Rows:    spec.TTY.WindowSize.Rows,
+			},
+		}
+	}
+
+	process, err := c.GardenContainer.Run(
+		garden.ProcessSpec{
+			ID:   spec.ID,
+			Path: spec.Path,
+			Args: spec.Args,
+			Env:  spec.Env,
+			Dir:  spec.Dir,
+			User: user,
+			TTY:  tty,
+		},
+		garden.ProcessIO{
+			Stdin:  io.Stdin,
+			Stdout: io.Stdout,
+			Stderr: io.Stderr,
+		},
+	)
+	if err != nil {
+		var exeNotFound garden.ExecutableNotFoundError
+		if errors.As(err, &exeNotFound) {
+			return nil, runtime.ExecutableNotFoundError{Message: exeNotFound.Message}
+		}
+		return nil, fmt.Errorf("start process: %w", err)
+	}
+
+	return Process{GardenContainer: c.GardenContainer, GardenProcess: process}, nil
+}
+
+// Attach always fails, as processes of local builds don't outlive them.
+func (c Container) Attach(_ context.Context, id string, io runtime.ProcessIO) (runtime.Process, error) {
+	return nil, fmt.Errorf("process %s not found", id)
+}
+
+func (c Container) Properties() (map[string]string, error) {
+	return c.GardenContainer.Properties()
+}
+
+func (c Container) SetProperty(name string, value string) error {
+	return c.GardenContainer.SetProperty(name, value)
+}
+
+func (c Container) DBContainer() db.CreatedContainer {
+	return nil
+}
+
+type Process struct {
+	GardenContainer garden.Container
+	GardenProcess   garden.Process
+}
+
+func (p Process) ID() string {
+	return p.GardenProcess.ID()
+}
+
+func (p Process) Wait(ctx context.Context) (runtime.ProcessResult, error) {
+	type result struct {
+		exitStatus int
+		err        error
+	}
+	waitResult := make(chan result, 1)
+
+	go func() {
+		exitStatus, err := p.GardenProcess.Wait()
+		waitResult <- result{exitStatus: exitStatus, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		err := p.GardenContainer.Stop(false)
+		<-waitResult
+		return runtime.ProcessResult{}, multierror.Append(ctx.Err(), err)
+	case r := <-waitResult:
+		if r.err != nil {
+			return runtime.ProcessResult{}, fmt.Errorf("wait for process completion: %w", r.err)
+		}
+		return runtime.ProcessResult{ExitStatus: r.exitStatus}, nil
+	}
+}
+
+func (p Process) SetTTY(tty runtime.TTYSpec) error {
+	return p.GardenProcess.SetTTY(garden.TTYSpec{
+		WindowSize: &garden.WindowSize{
+			Columns: tty.WindowSize.Columns,
+			Rows:    tty.WindowSize.Rows,
+		},
+	})
+}
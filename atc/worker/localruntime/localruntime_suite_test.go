@@ -0,0 +1,13 @@
+package localruntime_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLocalRuntime(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Local Runtime Suite")
+}
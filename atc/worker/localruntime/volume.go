@@ -0,0 +1,116 @@
+package localruntime
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/go-archive/tarfs"
+	"github.com/concourse/concourse/worker/baggageclaim"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Volume is a directory on the local machine.
+type Volume struct {
+	handle     string
+	path       string
+	workerName string
+}
+
+func (volume Volume) Handle() string {
+	return volume.handle
+}
+
+func (volume Volume) Source() string {
+	return volume.workerName
+}
+
+func (volume Volume) Path() string {
+	return volume.path
+}
+
+func (volume Volume) StreamOut(ctx context.Context, path string, compression compression.Compression) (io.ReadCloser, error) {
+	src := volume.subPath(path)
+
+	fileInfo, err := os.Stat(src)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, baggageclaim.ErrFileNotFound
+		}
+
+		return nil, err
+	}
+
+	tarDir, tarPath := src, "."
+	if !fileInfo.IsDir() {
+		tarDir, tarPath = filepath.Dir(src), filepath.Base(src)
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(compress(writer, compression.Encoding(), tarDir, tarPath))
+	}()
+
+	return reader, nil
+}
+
+func (volume Volume) StreamIn(ctx context.Context, path string, compression compression.Compression, limitInMB float64, reader io.Reader) error {
+	decompressed, err := compression.NewReader(io.NopCloser(reader))
+	if err != nil {
+		return err
+	}
+
+	defer decompressed.Close()
+
+	return tarfs.Extract(decompressed, volume.subPath(path))
+}
+
+func (volume Volume) InitializeResourceCache(context.Context, db.ResourceCache) (*db.UsedWorkerResourceCache, error) {
+	return nil, nil
+}
+
+func (volume Volume) InitializeStreamedResourceCache(context.Context, db.ResourceCache, int) (*db.UsedWorkerResourceCache, error) {
+	return nil, nil
+}
+
+func (volume Volume) InitializeTaskCache(context.Context, int, string, string, bool) error {
+	return nil
+}
+
+func (volume Volume) DBVolume() db.CreatedVolume {
+	return nil
+}
+
+// subPath confines the path to the volume.
+func (volume Volume) subPath(path string) string {
+	return filepath.Join(volume.path, filepath.Clean("/"+path))
+}
+
+func compress(dest io.Writer, encoding baggageclaim.Encoding, workDir string, path string) error {
+	var compressor io.WriteCloser
+	switch encoding {
+	case baggageclaim.GzipEncoding:
+		compressor = gzip.NewWriter(dest)
+	case baggageclaim.ZstdEncoding:
+		zstdWriter, err := zstd.NewWriter(dest)
+		if err != nil {
+			return err
+		}
+
+		compressor = zstdWriter
+	default:
+		return tarfs.Compress(dest, workDir, path)
+	}
+
+	err := tarfs.Compress(compressor, workDir, path)
+	if err != nil {
+		compressor.Close()
+		return err
+	}
+
+	return compressor.Close()
+}
@@ -0,0 +1,95 @@
+package localruntime_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/localruntime"
+	"github.com/concourse/concourse/worker/baggageclaim"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Volume", func() {
+	var (
+		dir         string
+		localWorker *localruntime.Worker
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "local-volume")
+		Expect(err).ToNot(HaveOccurred())
+
+		localWorker = localruntime.NewWorker("local", new(gardenfakes.FakeClient), dir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	for _, c := range []compression.Compression{
+		compression.NewGzipCompression(),
+		compression.NewZstdCompression(),
+		compression.NewNoCompression(),
+	} {
+		c := c
+
+		Context("with "+string(c.Encoding())+" compression", func() {
+			It("streams between volumes", func() {
+				src, err := localWorker.CreateVolume()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(os.MkdirAll(filepath.Join(src.Path(), "some-dir"), 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(src.Path(), "some-dir", "some-file"), []byte("some-content"), 0644)).To(Succeed())
+
+				dst, err := localWorker.CreateVolume()
+				Expect(err).ToNot(HaveOccurred())
+
+				out, err := src.StreamOut(context.Background(), ".", c)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(dst.StreamIn(context.Background(), ".", c, 0, out)).To(Succeed())
+				Expect(out.Close()).To(Succeed())
+
+				Expect(os.ReadFile(filepath.Join(dst.Path(), "some-dir", "some-file"))).To(Equal([]byte("some-content")))
+			})
+
+			It("streams a single file out", func() {
+				vol, err := localWorker.CreateVolume()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(os.WriteFile(filepath.Join(vol.Path(), "some-file"), []byte("some-content"), 0644)).To(Succeed())
+
+				streamer := worker.NewStreamer(nil, c, 0, worker.P2PConfig{})
+				file, err := streamer.StreamFile(context.Background(), vol, "some-file")
+				Expect(err).ToNot(HaveOccurred())
+				defer file.Close()
+
+				Expect(io.ReadAll(file)).To(Equal([]byte("some-content")))
+			})
+		})
+	}
+
+	It("returns ErrFileNotFound for missing paths", func() {
+		vol, err := localWorker.CreateVolume()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = vol.StreamOut(context.Background(), "missing", compression.NewGzipCompression())
+		Expect(err).To(Equal(baggageclaim.ErrFileNotFound))
+	})
+
+	It("is confined to its directory", func() {
+		vol, err := localWorker.CreateVolume()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = vol.StreamOut(context.Background(), "../../../etc/hostname", compression.NewGzipCompression())
+		Expect(err).To(Equal(baggageclaim.ErrFileNotFound))
+	})
+})
@@ -0,0 +1,411 @@
+// Package localruntime runs build steps on a Garden backend on the local
+// machine, for builds run outside of a cluster. Volumes are plain
+// directories and nothing is recorded in the database.
+package localruntime
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/worker/baggageclaim/volume/copy"
+)
+
+const imageMetadataFile = "metadata.json"
+
+var ErrArtifactNotLocal = errors.New("artifact is not a local volume")
+
+type imageMetadata struct {
+	Env  []string `json:"env"`
+	User string   `json:"user"`
+}
+
+// Worker is the only worker of a local build, and so doubles as its pool of
+// workers.
+type Worker struct {
+	name    string
+	backend garden.Client
+	dir     string
+
+	lock       sync.Mutex
+	containers map[string]Container
+	volumes    map[string]Volume
+}
+
+func NewWorker(name string, backend garden.Client, dir string) *Worker {
+	return &Worker{
+		name:    name,
+		backend: backend,
+		dir:     dir,
+
+		containers: map[string]Container{},
+		volumes:    map[string]Volume{},
+	}
+}
+
+func (worker *Worker) Name() string {
+	return worker.name
+}
+
+func (worker *Worker) FindOrCreateContainer(
+	ctx context.Context,
+	owner db.ContainerOwner,
+	metadata db.ContainerMetadata,
+	spec runtime.ContainerSpec,
+	delegate runtime.BuildStepDelegate,
+) (runtime.Container, []runtime.VolumeMount, error) {
+	rootFSPath, metadataFromImage, err := worker.image(spec.ImageSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	volumeMounts, err := worker.createVolumes(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bindMounts []garden.BindMount
+	for _, volumeMount := range volumeMounts {
+		bindMounts = append(bindMounts, garden.BindMount{
+			SrcPath: volumeMount.Volume.(Volume).Path(),
+			DstPath: volumeMount.MountPath,
+			Mode:    garden.BindMountModeRW,
+			Origin:  garden.BindMountOriginHost,
+		})
+	}
+
+	handle, err := newHandle()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gardenContainer, err := worker.backend.Create(garden.ContainerSpec{
+		Handle:     handle,
+		RootFSPath: rootFSPath,
+		Privileged: spec.ImageSpec.Privileged,
+		BindMounts: bindMounts,
+		Limits:     toGardenLimits(spec.Limits),
+		Env:        append(metadataFromImage.Env, spec.Env...),
+		Properties: garden.Properties{
+			userPropertyName: metadataFromImage.User,
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("create container: %w", err)
+	}
+
+	container := Container{GardenContainer: gardenContainer}
+
+	worker.lock.Lock()
+	worker.containers[handle] = container
+	worker.lock.Unlock()
+
+	return container, volumeMounts, nil
+}
+
+func (worker *Worker) CreateVolumeForArtifact(ctx context.Context, teamID int) (runtime.Volume, db.WorkerArtifact, error) {
+	return nil, nil, errors.New("artifacts cannot be uploaded to a local worker")
+}
+
+func (worker *Worker) LookupContainer(ctx context.Context, handle string) (runtime.Container, bool, error) {
+	worker.lock.Lock()
+	defer worker.lock.Unlock()
+
+	container, found := worker.containers[handle]
+	return container, found, nil
+}
+
+func (worker *Worker) LookupVolume(ctx context.Context, handle string) (runtime.Volume, bool, error) {
+	worker.lock.Lock()
+	defer worker.lock.Unlock()
+
+	volume, found := worker.volumes[handle]
+	return volume, found, nil
+}
+
+func (worker *Worker) DBWorker() db.Worker {
+	return nil
+}
+
+// CreateVolume creates an empty volume.
+func (worker *Worker) CreateVolume() (Volume, error) {
+	handle, err := newHandle()
+	if err != nil {
+		return Volume{}, err
+	}
+
+	path := filepath.Join(worker.dir, "volumes", handle)
+	err = os.MkdirAll(path, 0755)
+	if err != nil {
+		return Volume{}, err
+	}
+
+	return worker.registerVolume(handle, path), nil
+}
+
+// ImportDir makes a directory on the host available as a volume. The
+// directory is never written to, as volumes are copied into containers.
+func (worker *Worker) ImportDir(path string) (Volume, error) {
+	handle, err := newHandle()
+	if err != nil {
+		return Volume{}, err
+	}
+
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return Volume{}, err
+	}
+
+	return worker.registerVolume(handle, path), nil
+}
+
+// Cleanup destroys the containers and volumes created by the worker. Volumes
+// of imported directories are left alone.
+func (worker *Worker) Cleanup() error {
+	worker.lock.Lock()
+	defer worker.lock.Unlock()
+
+	var errs []error
+	for handle := range worker.containers {
+		err := worker.backend.Destroy(handle)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		delete(worker.containers, handle)
+	}
+
+	err := os.RemoveAll(filepath.Join(worker.dir, "volumes"))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	worker.volumes = map[string]Volume{}
+
+	return errors.Join(errs...)
+}
+
+func (worker *Worker) FindOrSelectWorker(
+	ctx context.Context,
+	owner db.ContainerOwner,
+	containerSpec runtime.ContainerSpec,
+	workerSpec worker.Spec,
+	strategy worker.PlacementStrategy,
+	callback worker.PoolCallback,
+) (runtime.Worker, error) {
+	return worker, nil
+}
+
+func (worker *Worker) FindResourceCacheVolume(context.Context, int, db.ResourceCache, worker.Spec, time.Time) (runtime.Volume, bool, error) {
+	return nil, false, nil
+}
+
+func (worker *Worker) FindResourceCacheVolumeOnWorker(context.Context, db.ResourceCache, worker.Spec, string, time.Time) (runtime.Volume, bool, error) {
+	return nil, false, nil
+}
+
+func (worker *Worker) ReleaseWorker(lager.Logger, runtime.ContainerSpec, runtime.Worker, worker.PlacementStrategy) {
+}
+
+func (worker *Worker) LocateVolume(ctx context.Context, teamID int, handle string) (runtime.Volume, runtime.Worker, bool, error) {
+	volume, found, err := worker.LookupVolume(ctx, handle)
+	if err != nil || !found {
+		return nil, nil, found, err
+	}
+
+	return volume, worker, true, nil
+}
+
+func (worker *Worker) registerVolume(handle string, path string) Volume {
+	volume := Volume{handle: handle, path: path, workerName: worker.name}
+
+	worker.lock.Lock()
+	worker.volumes[handle] = volume
+	worker.lock.Unlock()
+
+	return volume
+}
+
+// image determines the rootfs of the container, and the metadata of the
+// image it's from. Image artifacts are laid out as by the registry-image
+// resource: a rootfs directory next to a metadata.json file.
+func (worker *Worker) image(imageSpec runtime.ImageSpec) (string, imageMetadata, error) {
+	if imageSpec.ImageArtifact != nil {
+		volume, ok := imageSpec.ImageArtifact.(Volume)
+		if !ok {
+			return "", imageMetadata{}, ErrArtifactNotLocal
+		}
+
+		var metadata imageMetadata
+		content, err := os.ReadFile(filepath.Join(volume.Path(), imageMetadataFile))
+		if err != nil && !os.IsNotExist(err) {
+			return "", imageMetadata{}, err
+		}
+
+		if err == nil {
+			err := json.Unmarshal(content, &metadata)
+			if err != nil {
+				return "", imageMetadata{}, fmt.Errorf("malformed image metadata: %w", err)
+			}
+		}
+
+		return "raw://" + filepath.Join(volume.Path(), "rootfs"), metadata, nil
+	}
+
+	if imageSpec.ResourceType != "" {
+		return "", imageMetadata{}, fmt.Errorf("resource type '%s' cannot be run on a local worker", imageSpec.ResourceType)
+	}
+
+	return imageSpec.ImageURL, imageMetadata{}, nil
+}
+
+// createVolumes creates the volumes mounted to the container:
+// * scratch (empty volume)
+// * working dir (i.e. spec.Dir, empty volume)
+// * inputs (copies of their artifacts)
+// * outputs (empty volumes)
+// * caches (empty volumes, as nothing outlives a local build)
+func (worker *Worker) createVolumes(spec runtime.ContainerSpec) ([]runtime.VolumeMount, error) {
+	var volumeMounts []runtime.VolumeMount
+
+	scratchVolume, err := worker.CreateVolume()
+	if err != nil {
+		return nil, err
+	}
+
+	volumeMounts = append(volumeMounts, runtime.VolumeMount{
+		Volume:    scratchVolume,
+		MountPath: "/scratch",
+	})
+
+	var ioVolumeMounts []runtime.VolumeMount
+	inputDestinationPaths := map[string]bool{}
+
+	for _, input := range spec.Inputs {
+		source, ok := input.Artifact.(Volume)
+		if !ok {
+			return nil, ErrArtifactNotLocal
+		}
+
+		volume, err := worker.CreateVolume()
+		if err != nil {
+			return nil, err
+		}
+
+		err = copy.Cp(false, source.Path(), volume.Path())
+		if err != nil {
+			return nil, fmt.Errorf("copy input: %w", err)
+		}
+
+		mountPath := resolvePath(spec.Dir, input.DestinationPath)
+		inputDestinationPaths[mountPath] = true
+
+		ioVolumeMounts = append(ioVolumeMounts, runtime.VolumeMount{
+			Volume:    volume,
+			MountPath: mountPath,
+		})
+	}
+
+	for _, outputPath := range spec.Outputs {
+		mountPath := resolvePath(spec.Dir, outputPath)
+
+		// reuse volume if output path is the same as input
+		if inputDestinationPaths[mountPath] {
+			continue
+		}
+
+		volume, err := worker.CreateVolume()
+		if err != nil {
+			return nil, err
+		}
+
+		ioVolumeMounts = append(ioVolumeMounts, runtime.VolumeMount{
+			Volume:    volume,
+			MountPath: mountPath,
+		})
+	}
+
+	for _, cachePath := range spec.Caches {
+		volume, err := worker.CreateVolume()
+		if err != nil {
+			return nil, err
+		}
+
+		ioVolumeMounts = append(ioVolumeMounts, runtime.VolumeMount{
+			Volume:    volume,
+			MountPath: resolvePath(spec.Dir, cachePath),
+		})
+	}
+
+	if spec.Dir != "" && !anyMountTo(spec.Dir, ioVolumeMounts) {
+		workdirVolume, err := worker.CreateVolume()
+		if err != nil {
+			return nil, err
+		}
+
+		volumeMounts = append(volumeMounts, runtime.VolumeMount{
+			Volume:    workdirVolume,
+			MountPath: spec.Dir,
+		})
+	}
+
+	sort.Slice(ioVolumeMounts, func(i, j int) bool {
+		return ioVolumeMounts[i].MountPath < ioVolumeMounts[j].MountPath
+	})
+
+	return append(volumeMounts, ioVolumeMounts...), nil
+}
+
+func resolvePath(dir string, path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+
+	return filepath.Join(dir, path)
+}
+
+func anyMountTo(path string, volumeMounts []runtime.VolumeMount) bool {
+	for _, mnt := range volumeMounts {
+		if filepath.Clean(mnt.MountPath) == filepath.Clean(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toGardenLimits(limits runtime.ContainerLimits) garden.Limits {
+	var gardenLimits garden.Limits
+	if limits.CPU != nil {
+		gardenLimits.CPU = garden.CPULimits{LimitInShares: *limits.CPU}
+	}
+
+	if limits.Memory != nil {
+		gardenLimits.Memory = garden.MemoryLimits{LimitInBytes: *limits.Memory}
+	}
+
+	return gardenLimits
+}
+
+func newHandle() (string, error) {
+	handle := make([]byte, 8)
+	_, err := rand.Read(handle)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(handle), nil
+}
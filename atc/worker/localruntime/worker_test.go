@@ -0,0 +1,204 @@
+package localruntime_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/runtime/runtimetest"
+	"github.com/concourse/concourse/atc/worker/localruntime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker", func() {
+	var (
+		dir         string
+		fakeBackend *gardenfakes.FakeClient
+		worker      *localruntime.Worker
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "local-worker")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeBackend = new(gardenfakes.FakeClient)
+		fakeBackend.CreateReturns(new(gardenfakes.FakeContainer), nil)
+
+		worker = localruntime.NewWorker("local", fakeBackend, filepath.Join(dir, "worker"))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	writeDir := func(name string, files map[string]string) string {
+		path := filepath.Join(dir, name)
+		for file, content := range files {
+			Expect(os.MkdirAll(filepath.Dir(filepath.Join(path, file)), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(path, file), []byte(content), 0644)).To(Succeed())
+		}
+		return path
+	}
+
+	Describe("FindOrCreateContainer", func() {
+		var (
+			spec runtime.ContainerSpec
+
+			volumeMounts []runtime.VolumeMount
+			createErr    error
+		)
+
+		BeforeEach(func() {
+			image, err := worker.ImportDir(writeDir("image", map[string]string{
+				"metadata.json": `{"env":["IMAGE=env"],"user":"image-user"}`,
+				"rootfs/bin/sh": "",
+			}))
+			Expect(err).ToNot(HaveOccurred())
+
+			input, err := worker.ImportDir(writeDir("input", map[string]string{
+				"some-file": "some-content",
+			}))
+			Expect(err).ToNot(HaveOccurred())
+
+			spec = runtime.ContainerSpec{
+				ImageSpec: runtime.ImageSpec{
+					ImageArtifact: image,
+					Privileged:    true,
+				},
+				Env: []string{"SOME=env"},
+				Dir: "/tmp/build/workdir",
+				Inputs: []runtime.Input{
+					{Artifact: input, DestinationPath: "some-input"},
+				},
+				Outputs: runtime.OutputPaths{
+					"some-output": "/tmp/build/workdir/some-output/",
+					"some-input":  "/tmp/build/workdir/some-input/",
+				},
+				Caches: []string{"some-cache"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			_, volumeMounts, createErr = worker.FindOrCreateContainer(
+				context.Background(),
+				db.NewFixedHandleContainerOwner("owner"),
+				db.ContainerMetadata{},
+				spec,
+				nil,
+			)
+		})
+
+		It("creates the container from the image artifact", func() {
+			Expect(createErr).ToNot(HaveOccurred())
+
+			Expect(fakeBackend.CreateCallCount()).To(Equal(1))
+			gardenSpec := fakeBackend.CreateArgsForCall(0)
+			Expect(gardenSpec.RootFSPath).To(Equal("raw://" + filepath.Join(dir, "image", "rootfs")))
+			Expect(gardenSpec.Privileged).To(BeTrue())
+			Expect(gardenSpec.Env).To(Equal([]string{"IMAGE=env", "SOME=env"}))
+			Expect(gardenSpec.Properties).To(HaveKeyWithValue("user", "image-user"))
+		})
+
+		It("mounts copies of the inputs alongside the other volumes", func() {
+			Expect(createErr).ToNot(HaveOccurred())
+
+			var mountPaths []string
+			for _, mount := range volumeMounts {
+				mountPaths = append(mountPaths, mount.MountPath)
+			}
+
+			Expect(mountPaths).To(Equal([]string{
+				"/scratch",
+				"/tmp/build/workdir",
+				"/tmp/build/workdir/some-cache",
+				"/tmp/build/workdir/some-input",
+				"/tmp/build/workdir/some-output",
+			}))
+
+			inputPath := volumeMounts[3].Volume.(localruntime.Volume).Path()
+			Expect(inputPath).ToNot(Equal(filepath.Join(dir, "input")))
+			Expect(os.ReadFile(filepath.Join(inputPath, "some-file"))).To(Equal([]byte("some-content")))
+
+			gardenSpec := fakeBackend.CreateArgsForCall(0)
+			Expect(gardenSpec.BindMounts).To(HaveLen(5))
+			Expect(gardenSpec.BindMounts[3]).To(Equal(garden.BindMount{
+				SrcPath: inputPath,
+				DstPath: "/tmp/build/workdir/some-input",
+				Mode:    garden.BindMountModeRW,
+				Origin:  garden.BindMountOriginHost,
+			}))
+		})
+
+		Context("when an input is not a local volume", func() {
+			BeforeEach(func() {
+				spec.Inputs = []runtime.Input{
+					{Artifact: runtimetest.NewVolume("remote"), DestinationPath: "some-input"},
+				}
+			})
+
+			It("errors", func() {
+				Expect(createErr).To(Equal(localruntime.ErrArtifactNotLocal))
+				Expect(fakeBackend.CreateCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the image is a resource type", func() {
+			BeforeEach(func() {
+				spec.ImageSpec = runtime.ImageSpec{ResourceType: "git"}
+			})
+
+			It("errors", func() {
+				Expect(createErr).To(MatchError("resource type 'git' cannot be run on a local worker"))
+			})
+		})
+	})
+
+	Describe("Cleanup", func() {
+		It("destroys the containers and volumes it created", func() {
+			_, _, err := worker.FindOrCreateContainer(
+				context.Background(),
+				db.NewFixedHandleContainerOwner("owner"),
+				db.ContainerMetadata{},
+				runtime.ContainerSpec{ImageSpec: runtime.ImageSpec{ImageURL: "raw:///rootfs"}},
+				nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			imported := writeDir("imported", map[string]string{"some-file": ""})
+			_, err = worker.ImportDir(imported)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(worker.Cleanup()).To(Succeed())
+
+			Expect(fakeBackend.DestroyCallCount()).To(Equal(1))
+			Expect(fakeBackend.DestroyArgsForCall(0)).To(Equal(fakeBackend.CreateArgsForCall(0).Handle))
+
+			Expect(filepath.Join(dir, "worker", "volumes")).ToNot(BeADirectory())
+			Expect(imported).To(BeADirectory())
+		})
+
+		It("returns errors destroying containers", func() {
+			_, _, err := worker.FindOrCreateContainer(
+				context.Background(),
+				db.NewFixedHandleContainerOwner("owner"),
+				db.ContainerMetadata{},
+				runtime.ContainerSpec{},
+				nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			disaster := errors.New("nope")
+			fakeBackend.DestroyReturns(disaster)
+
+			Expect(worker.Cleanup()).To(MatchError(disaster))
+		})
+	})
+})
@@ -458,6 +458,12 @@ func (pool Pool) isWorkerCompatibleAndRunning(logger lager.Logger, worker db.Wor
 		}
 	}
 
+	if spec.RuntimeClass != "" {
+		if !runtimeClassMatches(worker, spec.RuntimeClass) {
+			return false
+		}
+	}
+
 	if !tagsMatch(worker, spec.Tags) {
 		return false
 	}
@@ -465,6 +471,15 @@ func (pool Pool) isWorkerCompatibleAndRunning(logger lager.Logger, worker db.Wor
 	return true
 }
 
+func runtimeClassMatches(worker db.Worker, runtimeClass string) bool {
+	for _, rc := range worker.RuntimeClasses() {
+		if rc == runtimeClass {
+			return true
+		}
+	}
+	return false
+}
+
 func tagsMatch(worker db.Worker, tags []string) bool {
 	if len(worker.Tags()) > 0 && len(tags) == 0 {
 		return false
@@ -216,6 +216,30 @@ var _ = Describe("Pool", func() {
 			Expect(err).To(MatchError(ContainSubstring("no workers satisfying")))
 		})
 
+		Test("filters out workers without the requested runtime class", func() {
+			concurrentId := GinkgoParallelProcess()
+			scenario := Setup(
+				workertest.WithWorkers(
+					grt.NewWorker(fmt.Sprintf("worker1-%d", concurrentId)).WithRuntimeClasses("runc"),
+					grt.NewWorker(fmt.Sprintf("worker2-%d", concurrentId)).WithRuntimeClasses("runc", "runsc"),
+					grt.NewWorker(fmt.Sprintf("worker3-%d", concurrentId)),
+				),
+			)
+
+			worker, err := scenario.Pool.FindOrSelectWorker(
+				ctx,
+				db.NewFixedHandleContainerOwner("my-container"),
+				runtime.ContainerSpec{},
+				worker.Spec{
+					RuntimeClass: "runsc",
+				},
+				nil,
+				nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(worker.Name()).To(Equal(fmt.Sprintf("worker2-%d", concurrentId)))
+		})
+
 		Test("only considers team workers when any team worker is compatible", func() {
 			concurrentId := GinkgoParallelProcess()
 			scenario := Setup(
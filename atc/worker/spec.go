@@ -10,6 +10,7 @@ type Spec struct {
 	ResourceType string
 	Tags         []string
 	TeamID       int
+	RuntimeClass string
 }
 
 func (spec Spec) Description() string {
@@ -23,6 +24,10 @@ func (spec Spec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("platform '%s'", spec.Platform))
 	}
 
+	if spec.RuntimeClass != "" {
+		attrs = append(attrs, fmt.Sprintf("runtime class '%s'", spec.RuntimeClass))
+	}
+
 	for _, tag := range spec.Tags {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
@@ -45,6 +45,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		// pipeline and job are public or authorized
 		case atc.GetBuildPreparation,
+			atc.GetBuildTestResults,
+			atc.GetBuildProvenance,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
 			atc.ListBuildArtifacts:
@@ -101,6 +103,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetCC,
 			atc.ListTeams,
 			atc.ListAllPipelines,
+			atc.GraphQL,
 			atc.ListPipelines,
 			atc.ListAllJobs,
 			atc.ListAllResources,
@@ -113,6 +116,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetLogLevel,
 			atc.DestroyTeam,
 			atc.ListActiveUsersSince,
+			atc.ClusterActivity,
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
@@ -130,7 +134,14 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListContainers,
 			atc.GetContainer,
 			atc.HijackContainer,
+			atc.ListInterceptSessions,
+			atc.GetInterceptRecording,
 			atc.ListVolumes,
+			atc.ListWebhooks,
+			atc.SetWebhook,
+			atc.DestroyWebhook,
+			atc.ListWebhookDeliveries,
+			atc.TeamActivity,
 			atc.CreateBuild,
 			atc.CheckResource,
 			atc.CheckResourceType,
@@ -145,8 +156,13 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
+			atc.ListConfigVersions,
+			atc.GetConfigVersion,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.ListJobTests,
+			atc.GetJobSLO,
+			atc.SearchBuildLogs,
 			atc.OrderPipelines,
 			atc.OrderPipelinesWithinGroup,
 			atc.PauseJob,
@@ -43,11 +43,15 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			// leave the handler as-is
 		case
 			atc.GetConfig,
+			atc.ListConfigVersions,
+			atc.GetConfigVersion,
 			atc.GetBuild,
 			atc.BuildResources,
 			atc.BuildEvents,
 			atc.ListBuildArtifacts,
 			atc.GetBuildPreparation,
+			atc.GetBuildTestResults,
+			atc.GetBuildProvenance,
 			atc.GetBuildPlan,
 			atc.AbortBuild,
 			atc.SetBuildComment,
@@ -78,9 +82,17 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.CreateBuild,
 			atc.GetContainer,
 			atc.HijackContainer,
+			atc.ListInterceptSessions,
+			atc.GetInterceptRecording,
 			atc.ListContainers,
 			atc.ListVolumes,
 			atc.ListTeamBuilds,
+			atc.SearchBuildLogs,
+			atc.ListWebhooks,
+			atc.SetWebhook,
+			atc.DestroyWebhook,
+			atc.ListWebhookDeliveries,
+			atc.TeamActivity,
 			atc.ListWorkers,
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
@@ -105,12 +117,16 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.ListActiveUsersSince,
+			atc.ClusterActivity,
+			atc.GraphQL,
 			atc.SetWall,
 			atc.ClearWall,
 			atc.DeletePipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.ListJobTests,
+			atc.GetJobSLO,
 			atc.OrderPipelines,
 			atc.OrderPipelinesWithinGroup,
 			atc.ArchivePipeline,
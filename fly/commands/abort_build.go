@@ -12,9 +12,9 @@ import (
 )
 
 type AbortBuildCommand struct {
-	Job   flaghelpers.JobFlag  `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to cancel"`
-	Build string               `short:"b" long:"build" required:"true" description:"If job is specified: build number to cancel. If job not specified: build id"`
-	Team  flaghelpers.TeamFlag `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+	Job   flaghelpers.JobFlag   `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to cancel"`
+	Build flaghelpers.BuildFlag `short:"b" long:"build" required:"true" description:"If job is specified: build number to cancel. If job not specified: build id"`
+	Team  flaghelpers.TeamFlag  `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
 }
 
 func (command *AbortBuildCommand) Execute([]string) error {
@@ -37,9 +37,9 @@ func (command *AbortBuildCommand) Execute([]string) error {
 	var build atc.Build
 	var exists bool
 	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
-		build, exists, err = target.Client().Build(command.Build)
+		build, exists, err = target.Client().Build(command.Build.String())
 	} else {
-		build, exists, err = team.JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+		build, exists, err = team.JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build.String())
 	}
 	if err != nil {
 		return err
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type ActivityCommand struct {
+	Events []string             `short:"e" long:"event" choice:"build_started" choice:"build_finished" choice:"job_changed" choice:"pipeline_changed" choice:"pipeline_destroyed" choice:"resource_versions_discovered" description:"Only print events of this type. Can be specified multiple times"`
+	All    bool                 `short:"a" long:"all" description:"Print the activity of every team (admin only)"`
+	Json   bool                 `long:"json" description:"Print each event as a line of JSON"`
+	Team   flaghelpers.TeamFlag `long:"team" description:"Name of the team whose activity to print, if different from the target default"`
+}
+
+func (command *ActivityCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var types []atc.ActivityEventType
+	for _, event := range command.Events {
+		types = append(types, atc.ActivityEventType(event))
+	}
+
+	var events concourse.ActivityEvents
+	if command.All {
+		events, err = target.Client().ClusterActivity(types)
+	} else {
+		var team concourse.Team
+		team, err = command.Team.LoadTeam(target)
+		if err != nil {
+			return err
+		}
+
+		events, err = team.TeamActivity(types)
+	}
+	if err != nil {
+		return err
+	}
+
+	defer events.Close()
+
+	dst, isTTY := ui.ForTTY(os.Stdout)
+	encoder := json.NewEncoder(dst)
+
+	for {
+		event, err := events.NextEvent()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		if command.Json {
+			err = encoder.Encode(event)
+		} else {
+			err = printActivityEvent(dst, event, isTTY)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func printActivityEvent(dst io.Writer, event atc.ActivityEvent, isTTY bool) error {
+	var description string
+	var descriptionColor *color.Color
+
+	switch event.Type {
+	case atc.ActivityBuildStarted, atc.ActivityBuildFinished:
+		description = fmt.Sprintf("build #%s %s", event.BuildName, event.BuildStatus)
+		descriptionColor = ui.BuildStatusCell(event.BuildStatus).Color
+	case atc.ActivityJobChanged:
+		description = strings.Join([]string{
+			activityFlag(event.Paused, "paused", "unpaused"),
+			activityFlag(event.Active, "active", "inactive"),
+		}, ", ")
+	case atc.ActivityPipelineChanged:
+		description = strings.Join([]string{
+			activityFlag(event.Paused, "paused", "unpaused"),
+			activityFlag(event.Public, "exposed", "hidden"),
+		}, ", ")
+		if event.Archived != nil && *event.Archived {
+			description += ", archived"
+		}
+	case atc.ActivityPipelineDestroyed:
+		description = "destroyed"
+		descriptionColor = color.New(color.FgRed)
+	case atc.ActivityResourceVersionsDiscovered:
+		description = "new versions"
+	case atc.ActivityResync:
+		description = "events may have been missed"
+		descriptionColor = color.New(color.Faint)
+	default:
+		description = string(event.Type)
+	}
+
+	if descriptionColor != nil {
+		if isTTY {
+			descriptionColor.EnableColor()
+		} else {
+			descriptionColor.DisableColor()
+		}
+
+		description = descriptionColor.Sprint(description)
+	}
+
+	_, err := fmt.Fprintf(dst, "%s  %s  %s\n", time.Unix(event.Time, 0).Format(timeDateLayout), activitySubject(event), description)
+	return err
+}
+
+// activitySubject is the path to the thing the event is about, e.g.
+// team/pipeline/job.
+func activitySubject(event atc.ActivityEvent) string {
+	if event.TeamName == "" {
+		return "-"
+	}
+
+	segments := []string{event.TeamName}
+
+	if event.PipelineName != "" {
+		segments = append(segments, atc.PipelineRef{
+			Name:         event.PipelineName,
+			InstanceVars: event.PipelineInstanceVars,
+		}.String())
+	}
+
+	if event.JobName != "" {
+		segments = append(segments, event.JobName)
+	} else if event.ResourceName != "" {
+		segments = append(segments, event.ResourceName)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func activityFlag(flag *bool, set string, unset string) string {
+	if flag != nil && *flag {
+		return set
+	}
+
+	return unset
+}
@@ -3,6 +3,8 @@ package commands
 import (
 	"fmt"
 	"reflect"
+
+	"github.com/jessevdk/go-flags"
 )
 
 type CompletionCommand struct {
@@ -21,6 +23,16 @@ const bashCompletionSnippet = `_fly_compl() {
 complete -F _fly_compl fly
 `
 
+// fish can't run fly to complete the command line as a whole like bash does,
+// so flags whose values come from the target ask fly for them with this.
+const fishCompleteFunction = `function __fly_complete
+	set -l args (commandline -opc)
+	env GO_FLAGS_COMPLETION=1 $args[1] $args[2..-1] (commandline -ct)
+end
+`
+
+var completerType = reflect.TypeOf((*flags.Completer)(nil)).Elem()
+
 func fishCompletionSnippetHelper(snippet string, prefix string, commandType reflect.Type) string {
 	for i := 0; i < commandType.NumField(); i++ {
 		var tags = commandType.Field(i).Tag
@@ -54,6 +66,10 @@ func fishCompletionSnippetHelper(snippet string, prefix string, commandType refl
 			template += fmt.Sprintf(" -s \"%s\"", short)
 		}
 
+		if (long != "" || short != "") && reflect.PointerTo(commandType.Field(i).Type).Implements(completerType) {
+			template += " -x -a \"(__fly_complete)\""
+		}
+
 		snippet += template + "\n"
 
 		// A subcommand is found, recursion begins.
@@ -65,7 +81,7 @@ func fishCompletionSnippetHelper(snippet string, prefix string, commandType refl
 	return snippet
 }
 
-var fishCompletionSnippet = fishCompletionSnippetHelper("", "", reflect.TypeOf(Fly))
+var fishCompletionSnippet = fishCompleteFunction + fishCompletionSnippetHelper("", "", reflect.TypeOf(Fly))
 
 // initial implemenation just using bashcompinit
 const zshCompletionSnippet = `autoload -Uz compinit && compinit
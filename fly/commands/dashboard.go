@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/dashboard"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/pty"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type DashboardCommand struct {
+	Interval time.Duration        `long:"interval" default:"5s" description:"How often to refresh the dashboard"`
+	Team     flaghelpers.TeamFlag `long:"team" description:"Name of the team to show, if different from the target default"`
+}
+
+func (command *DashboardCommand) Execute([]string) error {
+	if command.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+
+	if !pty.IsTerminal() {
+		return errors.New("dashboard must be run in a terminal")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	term, err := pty.OpenRawTerm()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = term.Restore()
+	}()
+
+	board := &dashboard.Dashboard{
+		Client:   target.Client(),
+		Team:     team,
+		Interval: command.Interval,
+		Size:     terminalSize,
+		Resized:  pty.ResizeNotifier(),
+	}
+
+	return board.Run(term)
+}
+
+func terminalSize() (int, int) {
+	rows, cols, err := pty.Getsize(os.Stdout)
+	if err != nil || rows == 0 || cols == 0 {
+		return 80, 24
+	}
+
+	return cols, rows
+}
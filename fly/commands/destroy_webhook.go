@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/vito/go-interact/interact"
+)
+
+type DestroyWebhookCommand struct {
+	Webhook         string               `short:"w" long:"webhook" required:"true" description:"Name of the webhook to destroy"`
+	SkipInteractive bool                 `short:"n" long:"non-interactive" description:"Destroy the webhook without confirmation"`
+	Team            flaghelpers.TeamFlag `long:"team" description:"Name of the team the webhook belongs to, if different from the target default"`
+}
+
+func (command *DestroyWebhookCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("!!! this will stop events being sent to webhook `%s` and remove its delivery log\n\n", command.Webhook)
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	found, err := team.DestroyWebhook(command.Webhook)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		fmt.Printf("`%s` does not exist\n", command.Webhook)
+	} else {
+		fmt.Printf("`%s` deleted\n", command.Webhook)
+	}
+
+	return nil
+}
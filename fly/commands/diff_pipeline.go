@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type DiffPipelineCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to compare the saved configs of"`
+	From     int                      `long:"from" required:"true" value-name:"VERSION" description:"Version of the config to compare from"`
+	To       int                      `long:"to"                   value-name:"VERSION" description:"Version of the config to compare to (default: the current config)"`
+	Team     flaghelpers.TeamFlag     `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *DiffPipelineCommand) Execute([]string) error {
+	_, err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	fromConfig, err := savedConfig(team, command.Pipeline.Ref(), command.From)
+	if err != nil {
+		return err
+	}
+
+	var toConfig atc.Config
+	if command.To != 0 {
+		toConfig, err = savedConfig(team, command.Pipeline.Ref(), command.To)
+		if err != nil {
+			return err
+		}
+	} else {
+		var found bool
+		toConfig, _, found, err = team.PipelineConfig(command.Pipeline.Ref())
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return errors.New("pipeline not found")
+		}
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	if !fromConfig.Diff(stdout, toConfig) {
+		fmt.Println("no differences")
+	}
+
+	return nil
+}
+
+func savedConfig(team concourse.Team, pipelineRef atc.PipelineRef, version int) (atc.Config, error) {
+	configVersion, found, err := team.PipelineConfigVersion(pipelineRef, version)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	if !found {
+		return atc.Config{}, fmt.Errorf("config version %d of pipeline '%s' not found", version, pipelineRef)
+	}
+
+	return configVersion.Config, nil
+}
@@ -37,9 +37,26 @@ type ExecuteCommand struct {
 	Var            []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  unquote:"false"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar        []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    unquote:"false"  description:"Specify a YAML value to set for a variable in the pipeline"`
 	VarsFrom       []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	Local      bool   `long:"local"       description:"Run the task on the local containerd instead of a Concourse cluster. Inputs and outputs are local directories, so the task always runs with full privileges. Linux only."`
+	LocalImage string `long:"local-image" value-name:"PATH" description:"An OCI or Docker image tarball to run the task in, instead of its image_resource (requires --local)"`
+
+	Containerd struct {
+		Socket        string `long:"socket"          default:"/run/containerd/containerd.sock" description:"Path to the socket of the containerd to run local tasks on"`
+		InitBin       string `long:"init-bin"                                                   description:"Path to an init executable to run as PID 1 of local task containers"`
+		CNIPluginsDir string `long:"cni-plugins-dir" default:"/opt/cni/bin"                      description:"Path to the CNI plugins used to network local task containers"`
+	} `group:"Local Execution" namespace:"containerd"`
 }
 
 func (command *ExecuteCommand) Execute(args []string) error {
+	if command.Local {
+		return command.executeLocally(args)
+	}
+
+	if command.LocalImage != "" {
+		return fmt.Errorf("--local-image requires --local")
+	}
+
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
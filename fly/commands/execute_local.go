@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/executehelpers"
+	"github.com/concourse/concourse/fly/commands/internal/localexec"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+func (command *ExecuteCommand) executeLocally(args []string) error {
+	switch {
+	case command.InputsFrom.PipelineRef.Name != "" || command.InputsFrom.JobName != "":
+		return errors.New("--inputs-from cannot be used with --local")
+	case len(command.InputMappings) > 0:
+		return errors.New("--input-mapping cannot be used with --local")
+	case command.Image != "":
+		return errors.New("--image cannot be used with --local; use --local-image instead")
+	case command.Background:
+		return errors.New("--background cannot be used with --local")
+	}
+
+	taskConfig, err := command.CreateTaskConfig(args)
+	if err != nil {
+		return err
+	}
+
+	err = executehelpers.CheckForUnknownInputMappings(command.Inputs, taskConfig.Inputs)
+	if err != nil {
+		return err
+	}
+
+	err = executehelpers.CheckForInputType(command.Inputs)
+	if err != nil {
+		return err
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	inputs := map[string]string{}
+	for _, input := range taskConfig.Inputs {
+		if input.Name == filepath.Base(wd) {
+			inputs[input.Name] = wd
+		}
+	}
+
+	for _, input := range command.Inputs {
+		inputs[input.Name] = input.Path
+	}
+
+	taskOutputs, err := executehelpers.DetermineOutputs(
+		atc.NewPlanFactory(time.Now().Unix()),
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := localOutputs(taskOutputs)
+	if err != nil {
+		return err
+	}
+
+	runner, err := localexec.NewRunner(localexec.Config{
+		ContainerdSocket: command.Containerd.Socket,
+		InitBin:          command.Containerd.InitBin,
+		CNIPluginsDir:    command.Containerd.CNIPluginsDir,
+		WorkDir:          filepath.Join(os.TempDir(), "fly-local"),
+	})
+	if err != nil {
+		return err
+	}
+
+	defer runner.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	terminate := make(chan os.Signal, 1)
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-terminate
+		fmt.Fprintf(ui.Stderr, "\naborting...\n")
+		cancel()
+
+		// if told to terminate again, exit immediately
+		<-terminate
+		fmt.Fprintln(ui.Stderr, "exiting immediately")
+		os.Exit(2)
+	}()
+
+	exitCode, err := runner.Run(ctx, localexec.Task{
+		Config:       taskConfig,
+		Privileged:   true,
+		Inputs:       inputs,
+		Outputs:      outputs,
+		ImageTarball: command.LocalImage,
+		Stdout:       os.Stdout,
+		Stderr:       os.Stderr,
+	})
+	if err != nil {
+		return err
+	}
+
+	runner.Close()
+	os.Exit(exitCode)
+
+	return nil
+}
+
+// localOutputs resolves the directories the task's outputs are written to,
+// creating them if need be.
+func localOutputs(taskOutputs []executehelpers.Output) (map[string]string, error) {
+	outputs := map[string]string{}
+	for _, output := range taskOutputs {
+		dir, err := filepath.Abs(output.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		err = os.MkdirAll(dir, 0755)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs[output.Name] = dir
+	}
+
+	return outputs, nil
+}
@@ -32,25 +32,45 @@ type FlyCommand struct {
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	Webhooks          WebhooksCommand          `command:"webhooks"           alias:"whs" description:"List the team's webhooks"`
+	SetWebhook        SetWebhookCommand        `command:"set-webhook"        alias:"swh" description:"Create or update a webhook that is sent the team's events"`
+	DestroyWebhook    DestroyWebhookCommand    `command:"destroy-webhook"    alias:"dwh" description:"Destroy a webhook and its delivery log"`
+	WebhookDeliveries WebhookDeliveriesCommand `command:"webhook-deliveries" alias:"whd" description:"List the events sent, or to be sent, to a webhook"`
+
+	Activity ActivityCommand `command:"activity" alias:"act" description:"Print the team's builds, jobs, pipelines and resource versions changing as it happens"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
-	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
-	Watch   WatchCommand   `command:"watch"   alias:"w" description:"Stream a build's output"`
+	Dashboard DashboardCommand `command:"dashboard" alias:"db" description:"Show a live dashboard of a team's pipelines, jobs and running builds"`
+
+	Execute       ExecuteCommand       `command:"execute"          alias:"e"   description:"Execute a one-off build using local bits"`
+	RunJobLocally RunJobLocallyCommand `command:"run-job-locally"  alias:"rjl" description:"Run a job of a pipeline config on the local containerd, without a Concourse cluster"`
+	Watch         WatchCommand         `command:"watch"            alias:"w"   description:"Stream a build's output"`
+	Logs          LogsCommand          `command:"logs"             alias:"lg"  description:"Download and search the output of builds"`
 
-	Containers ContainersCommand `command:"containers" alias:"cs" description:"Print the active containers"`
-	Hijack     HijackCommand     `command:"hijack"     alias:"intercept" alias:"i" description:"Execute a command in a container"`
+	Containers        ContainersCommand        `command:"containers"         alias:"cs"  description:"Print the active containers"`
+	Hijack            HijackCommand            `command:"hijack"             alias:"intercept" alias:"i" description:"Execute a command in a container"`
+	InterceptSessions InterceptSessionsCommand `command:"intercept-sessions" alias:"iss" description:"List the recorded intercept sessions"`
+	Replay            ReplayCommand            `command:"replay"             alias:"rpl" description:"Play back or download the recording of an intercept session"`
 
 	Jobs        JobsCommand        `command:"jobs"            alias:"js"  description:"List the jobs in the pipelines"`
 	PausedJobs  PausedJobsCommand  `command:"paused-jobs"     alias:"pjs" description:"List the paused jobs in the pipelines"`
 	PauseJob    PauseJobCommand    `command:"pause-job"       alias:"pj"  description:"Pause a job"`
 	UnpauseJob  UnpauseJobCommand  `command:"unpause-job"     alias:"uj"  description:"Unpause a job"`
 	ScheduleJob ScheduleJobCommand `command:"schedule-job"    alias:"sj"  description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
+	TestResults TestResultsCommand `command:"test-results"    alias:"tr"  description:"Show the test results of a job's builds and the tests that are flaky"`
+	SearchLogs  SearchLogsCommand  `command:"search-logs"     alias:"sl"  description:"Search the team's build logs"`
+	JobSLO      JobSLOCommand      `command:"job-slo"         alias:"slo" description:"Show a job's SLO, how it is doing against it and the builds that breached it"`
 
 	Pipelines                 PipelinesCommand               `command:"pipelines"                 alias:"ps"   description:"List the configured pipelines"`
 	PausedPipelines           PausedPipelinesCommand         `command:"paused-pipelines"          alias:"pps"  description:"List the configured paused pipelines"`
 	DestroyPipeline           DestroyPipelineCommand         `command:"destroy-pipeline"          alias:"dp"   description:"Destroy a pipeline"`
 	GetPipeline               GetPipelineCommand             `command:"get-pipeline"              alias:"gp"   description:"Get a pipeline's current configuration"`
+	PipelineGraph             PipelineGraphCommand           `command:"pipeline-graph"            alias:"pg"   description:"Print the graph of a pipeline's resources and jobs"`
 	SetPipeline               SetPipelineCommand             `command:"set-pipeline"              alias:"sp"   description:"Create or update a pipeline's configuration"`
+	PipelineHistory           PipelineHistoryCommand         `command:"pipeline-history"          alias:"ph"   description:"List the configs saved for a pipeline"`
+	DiffPipeline              DiffPipelineCommand            `command:"diff-pipeline"             alias:"dfp"  description:"Compare saved configs of a pipeline"`
+	RollbackPipeline          RollbackPipelineCommand        `command:"rollback-pipeline"         alias:"rbp"  description:"Restore a previously saved config of a pipeline"`
 	PausePipeline             PausePipelineCommand           `command:"pause-pipeline"            alias:"pp"   description:"Pause a pipeline"`
 	ArchivePipeline           ArchivePipelineCommand         `command:"archive-pipeline"          alias:"ap"   description:"Archive a pipeline"`
 	UnpausePipeline           UnpausePipelineCommand         `command:"unpause-pipeline"          alias:"up"   description:"Un-pause a pipeline"`
@@ -58,6 +78,7 @@ type FlyCommand struct {
 	HidePipeline              HidePipelineCommand            `command:"hide-pipeline"             alias:"hp"   description:"Hide a pipeline from the public"`
 	RenamePipeline            RenamePipelineCommand          `command:"rename-pipeline"           alias:"rp"   description:"Rename a pipeline"`
 	ValidatePipeline          ValidatePipelineCommand        `command:"validate-pipeline"         alias:"vp"   description:"Validate a pipeline config"`
+	LintPipeline              LintPipelineCommand            `command:"lint-pipeline"             alias:"lp"   description:"Check a pipeline config for likely mistakes"`
 	FormatPipeline            FormatPipelineCommand          `command:"format-pipeline"           alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines            OrderPipelinesCommand          `command:"order-pipelines"           alias:"op"   description:"Orders pipelines"`
 	OrderPipelinesWithinGroup OrderInstancedPipelinesCommand `command:"order-instanced-pipelines" alias:"oip"  description:"Orders instanced pipelines within an instance group"`
@@ -79,6 +100,7 @@ type FlyCommand struct {
 	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
 	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
 	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Provenance ProvenanceCommand `command:"provenance"  alias:"pv" description:"Show the signed provenance of a build that ran a put"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
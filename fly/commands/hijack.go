@@ -28,7 +28,7 @@ type HijackCommand struct {
 	Handle         string                   `          long:"handle"                            description:"Handle id of a job to hijack"`
 	Check          flaghelpers.ResourceFlag `short:"c" long:"check" value-name:"PIPELINE/CHECK" description:"Name of a resource's checking container to hijack"`
 	Url            string                   `short:"u" long:"url"                               description:"URL for the build, job, or check container to hijack"`
-	Build          string                   `short:"b" long:"build"                             description:"Build number within the job, or global build ID"`
+	Build          flaghelpers.BuildFlag    `short:"b" long:"build"                             description:"Build number within the job, or global build ID"`
 	StepName       string                   `short:"s" long:"step"                              description:"Name of step to hijack (e.g. build, unit, resource name)"`
 	StepType       string                   `          long:"step-type"                         description:"Type of step to hijack (e.g. get, put, task)"`
 	Attempt        string                   `short:"a" long:"attempt" value-name:"N[,N,...]"    description:"Attempt number of step to hijack."`
@@ -326,7 +326,7 @@ func (command *HijackCommand) getContainerFingerprint(target rc.Target, team con
 	}{
 		{fp: &fingerprint.pipelineName, cmd: pipelineRef.Name},
 		{fp: &fingerprint.pipelineInstanceVars, cmd: pipelineInstanceVars},
-		{fp: &fingerprint.buildNameOrID, cmd: command.Build},
+		{fp: &fingerprint.buildNameOrID, cmd: command.Build.String()},
 		{fp: &fingerprint.stepName, cmd: command.StepName},
 		{fp: &fingerprint.stepType, cmd: command.StepType},
 		{fp: &fingerprint.jobName, cmd: command.Job.JobName},
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type InterceptSessionsCommand struct {
+	Count int                  `short:"c" long:"count" default:"50" description:"Number of sessions you want to limit the return to"`
+	Json  bool                 `long:"json" description:"Print command result as JSON"`
+	Team  flaghelpers.TeamFlag `long:"team" description:"Name of the team whose sessions to list, if different from the target default"`
+}
+
+func (command *InterceptSessionsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := team.InterceptSessions(command.Count)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(sessions)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "started", Color: color.New(color.Bold)},
+			{Contents: "user", Color: color.New(color.Bold)},
+			{Contents: "pipeline/job", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "step", Color: color.New(color.Bold)},
+			{Contents: "command", Color: color.New(color.Bold)},
+			{Contents: "exit status", Color: color.New(color.Bold)},
+			{Contents: "recorded", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, session := range sessions {
+		var job string
+		if session.PipelineName != "" {
+			job = session.PipelineName + "/" + session.JobName
+		}
+
+		exitStatus := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if session.ExitStatus != nil {
+			exitStatus = ui.TableCell{Contents: strconv.Itoa(*session.ExitStatus)}
+		}
+
+		recorded := ui.TableCell{Contents: "no", Color: ui.OffColor}
+		if session.Recorded {
+			recorded = ui.TableCell{Contents: "yes"}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(session.ID)},
+			{Contents: time.Unix(session.StartedAt, 0).Format(timeDateLayout)},
+			stringOrDefault(session.CreatedBy),
+			stringOrDefault(job),
+			stringOrDefault(session.BuildName),
+			stringOrDefault(session.StepName),
+			{Contents: strings.Join(session.Command, " ")},
+			exitStatus,
+			recorded,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -0,0 +1,160 @@
+// Package asciicast reads and plays recordings in the asciicast v2 format
+// (https://docs.asciinema.org/manual/asciicast/v2/), as recorded by the ATC
+// for intercepted sessions.
+package asciicast
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Command   string            `json:"command,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+const (
+	EventOutput = "o"
+	EventInput  = "i"
+	EventResize = "r"
+)
+
+// Event is something that happened in the session, Time after it started.
+type Event struct {
+	Time time.Duration
+	Code string
+	Data string
+}
+
+func (event *Event) UnmarshalJSON(payload []byte) error {
+	var fields []interface{}
+	err := json.Unmarshal(payload, &fields)
+	if err != nil {
+		return err
+	}
+
+	if len(fields) != 3 {
+		return fmt.Errorf("event has %d fields, not 3", len(fields))
+	}
+
+	seconds, ok := fields[0].(float64)
+	if !ok {
+		return errors.New("event time is not a number")
+	}
+
+	code, ok := fields[1].(string)
+	if !ok {
+		return errors.New("event code is not a string")
+	}
+
+	data, ok := fields[2].(string)
+	if !ok {
+		return errors.New("event data is not a string")
+	}
+
+	event.Time = time.Duration(seconds * float64(time.Second))
+	event.Code = code
+	event.Data = data
+
+	return nil
+}
+
+// Read reads a recording.
+func Read(src io.Reader) (Header, []Event, error) {
+	scanner := bufio.NewScanner(src)
+	scanner.Buffer(nil, 16*1024*1024)
+
+	if !scanner.Scan() {
+		if scanner.Err() != nil {
+			return Header{}, nil, scanner.Err()
+		}
+
+		return Header{}, nil, errors.New("recording is empty")
+	}
+
+	var header Header
+	err := json.Unmarshal(scanner.Bytes(), &header)
+	if err != nil {
+		return Header{}, nil, fmt.Errorf("malformed recording header: %w", err)
+	}
+
+	if header.Version != 2 {
+		return Header{}, nil, fmt.Errorf("unsupported recording version %d", header.Version)
+	}
+
+	var events []Event
+	for line := 2; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var event Event
+		err := json.Unmarshal(scanner.Bytes(), &event)
+		if err != nil {
+			return Header{}, nil, fmt.Errorf("malformed event on line %d: %w", line, err)
+		}
+
+		events = append(events, event)
+	}
+
+	return header, events, scanner.Err()
+}
+
+// Player writes the output of a recording as it happened.
+type Player struct {
+	// Speed is how many times faster than real time to play the recording.
+	Speed float64
+
+	// MaxIdle caps how long to wait between events, so that time spent away
+	// from the keyboard isn't replayed. Zero leaves pauses as they were.
+	MaxIdle time.Duration
+
+	// Sleep waits between events.
+	Sleep func(time.Duration)
+}
+
+func (player Player) Play(dst io.Writer, events []Event) error {
+	speed := player.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+
+	sleep := player.Sleep
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+
+	var last time.Duration
+	for _, event := range events {
+		// input is echoed back in the output, so it doesn't need replaying
+		if event.Code != EventOutput {
+			continue
+		}
+
+		wait := event.Time - last
+		last = event.Time
+
+		if player.MaxIdle > 0 && wait > player.MaxIdle {
+			wait = player.MaxIdle
+		}
+
+		if wait > 0 {
+			sleep(time.Duration(float64(wait) / speed))
+		}
+
+		_, err := io.WriteString(dst, event.Data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package asciicast_test
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/asciicast"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Asciicast", func() {
+	recording := strings.Join([]string{
+		`{"version":2,"width":80,"height":24,"timestamp":100,"command":"bash"}`,
+		`[0.5,"o","$ "]`,
+		`[1.25,"i","whoami\r"]`,
+		`[1.5,"o","whoami\r\n"]`,
+		`[2,"r","100x40"]`,
+		`[62,"o","snoopy\r\n"]`,
+	}, "\n") + "\n"
+
+	Describe("Read", func() {
+		It("reads the header and events", func() {
+			header, events, err := asciicast.Read(strings.NewReader(recording))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(header).To(Equal(asciicast.Header{
+				Version:   2,
+				Width:     80,
+				Height:    24,
+				Timestamp: 100,
+				Command:   "bash",
+			}))
+
+			Expect(events).To(Equal([]asciicast.Event{
+				{Time: 500 * time.Millisecond, Code: "o", Data: "$ "},
+				{Time: 1250 * time.Millisecond, Code: "i", Data: "whoami\r"},
+				{Time: 1500 * time.Millisecond, Code: "o", Data: "whoami\r\n"},
+				{Time: 2 * time.Second, Code: "r", Data: "100x40"},
+				{Time: 62 * time.Second, Code: "o", Data: "snoopy\r\n"},
+			}))
+		})
+
+		It("rejects other versions", func() {
+			_, _, err := asciicast.Read(strings.NewReader(`{"version":1}`))
+			Expect(err).To(MatchError("unsupported recording version 1"))
+		})
+
+		It("rejects malformed events", func() {
+			_, _, err := asciicast.Read(strings.NewReader(`{"version":2}` + "\n" + `[1,"o"]`))
+			Expect(err).To(MatchError("malformed event on line 2: event has 2 fields, not 3"))
+		})
+	})
+
+	Describe("Player", func() {
+		var (
+			player asciicast.Player
+			slept  []time.Duration
+			out    *bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			slept = nil
+			out = new(bytes.Buffer)
+
+			player = asciicast.Player{
+				Sleep: func(d time.Duration) { slept = append(slept, d) },
+			}
+		})
+
+		JustBeforeEach(func() {
+			_, events, err := asciicast.Read(strings.NewReader(recording))
+			Expect(err).ToNot(HaveOccurred())
+
+			err = player.Play(out, events)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("writes the output as it happened", func() {
+			Expect(out.String()).To(Equal("$ whoami\r\nsnoopy\r\n"))
+			Expect(slept).To(Equal([]time.Duration{
+				500 * time.Millisecond,
+				time.Second,
+				60500 * time.Millisecond,
+			}))
+		})
+
+		Context("with a speed and max idle time", func() {
+			BeforeEach(func() {
+				player.Speed = 2
+				player.MaxIdle = 2 * time.Second
+			})
+
+			It("shortens the waits", func() {
+				Expect(slept).To(Equal([]time.Duration{
+					250 * time.Millisecond,
+					500 * time.Millisecond,
+					time.Second,
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package buildlogs_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestBuildLogs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Build Logs Suite")
+}
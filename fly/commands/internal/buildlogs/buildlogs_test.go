@@ -0,0 +1,247 @@
+package buildlogs_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/fly/commands/internal/buildlogs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const publicPlan = `{
+  "id": "1",
+  "do": [
+    {"id": "2", "get": {"name": "repo", "resource": "repo", "type": "git"}},
+    {
+      "id": "3",
+      "on_failure": {
+        "step": {
+          "id": "4",
+          "task": {
+            "name": "unit",
+            "privileged": false,
+            "image_get_plan": {"id": "4/image-get", "get": {"name": "image", "type": "registry-image"}}
+          }
+        },
+        "on_failure": {"id": "5", "put": {"name": "notify", "resource": "notify", "type": "slack"}}
+      }
+    },
+    {"id": "6", "across": {"vars": ["x"]}}
+  ]
+}`
+
+type fakeEvents struct {
+	events []atc.Event
+}
+
+func (events *fakeEvents) NextEvent() (atc.Event, error) {
+	if len(events.events) == 0 {
+		return nil, io.EOF
+	}
+
+	ev := events.events[0]
+	events.events = events.events[1:]
+	return ev, nil
+}
+
+func (events *fakeEvents) Close() error {
+	return nil
+}
+
+func stdout(id string, payload string) event.Log {
+	return event.Log{
+		Time:    100,
+		Origin:  event.Origin{ID: event.OriginID(id), Source: event.OriginSourceStdout},
+		Payload: payload,
+	}
+}
+
+func stderr(id string, payload string) event.Log {
+	return event.Log{
+		Time:    200,
+		Origin:  event.Origin{ID: event.OriginID(id), Source: event.OriginSourceStderr},
+		Payload: payload,
+	}
+}
+
+var _ = Describe("Build logs", func() {
+	var steps buildlogs.Steps
+
+	BeforeEach(func() {
+		var err error
+		steps, err = buildlogs.StepsFromPlan(json.RawMessage(publicPlan))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("StepsFromPlan", func() {
+		It("names the steps by their plan IDs", func() {
+			Expect(steps).To(Equal(buildlogs.Steps{
+				"2":           "repo",
+				"4":           "unit",
+				"4/image-get": "unit",
+				"5":           "notify",
+			}))
+		})
+
+		It("names the steps created while the build runs by their parent's", func() {
+			Expect(steps.Name("4/image-get/1")).To(Equal("unit"))
+			Expect(steps.Name("6/0/7")).To(Equal(""))
+			Expect(steps.Name("bogus")).To(Equal(""))
+		})
+
+		It("knows which steps the build has", func() {
+			Expect(steps.Has("unit")).To(BeTrue())
+			Expect(steps.Has("image")).To(BeFalse())
+		})
+	})
+
+	Describe("Filter", func() {
+		It("keeps everything by default", func() {
+			Expect(buildlogs.Filter{}.Keep(steps, event.Status{Status: atc.StatusSucceeded})).To(BeTrue())
+		})
+
+		It("filters by step", func() {
+			filter := buildlogs.Filter{Step: "unit"}
+			Expect(filter.Keep(steps, stdout("4", "hi"))).To(BeTrue())
+			Expect(filter.Keep(steps, event.ImageGet{Origin: event.Origin{ID: "4/image-get"}})).To(BeTrue())
+			Expect(filter.Keep(steps, stdout("2", "hi"))).To(BeFalse())
+			Expect(filter.Keep(steps, event.Status{Status: atc.StatusSucceeded})).To(BeFalse())
+		})
+
+		It("filters by time", func() {
+			filter := buildlogs.Filter{Since: time.Unix(150, 0)}
+			Expect(filter.Keep(steps, stdout("4", "hi"))).To(BeFalse())
+			Expect(filter.Keep(steps, stderr("4", "hi"))).To(BeTrue())
+			Expect(filter.Keep(steps, event.InitializeGet{Origin: event.Origin{ID: "2"}})).To(BeTrue())
+		})
+
+		It("filters to stderr", func() {
+			filter := buildlogs.Filter{StderrOnly: true}
+			Expect(filter.Keep(steps, stdout("4", "hi"))).To(BeFalse())
+			Expect(filter.Keep(steps, stderr("4", "hi"))).To(BeTrue())
+			Expect(filter.Keep(steps, event.Error{Message: "oh no"})).To(BeFalse())
+		})
+	})
+
+	Describe("NewWriter", func() {
+		var (
+			dst    *bytes.Buffer
+			events []atc.Event
+		)
+
+		BeforeEach(func() {
+			dst = new(bytes.Buffer)
+			events = []atc.Event{
+				stdout("2", "fetching\n"),
+				stdout("4", "testing\n"),
+				stderr("4", "FAIL\n"),
+				event.Error{Origin: event.Origin{ID: "5"}, Message: "oh no"},
+				event.Status{Status: atc.StatusErrored},
+			}
+		})
+
+		write := func(format string) {
+			writer, err := buildlogs.NewWriter(format, dst, steps)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, ev := range events {
+				Expect(writer.WriteEvent(ev)).To(Succeed())
+			}
+
+			Expect(writer.Close()).To(Succeed())
+		}
+
+		It("writes text", func() {
+			write(buildlogs.FormatText)
+			Expect(dst.String()).To(Equal("fetching\ntesting\nFAIL\noh no\n"))
+		})
+
+		It("writes events as JSON lines", func() {
+			write(buildlogs.FormatJSON)
+
+			lines := bytes.Split(bytes.TrimSpace(dst.Bytes()), []byte("\n"))
+			Expect(lines).To(HaveLen(5))
+
+			var envelope event.Envelope
+			Expect(json.Unmarshal(lines[2], &envelope)).To(Succeed())
+			Expect(envelope.Event).To(Equal(atc.EventType("log")))
+
+			parsed, err := event.ParseEvent(envelope.Version, envelope.Event, *envelope.Data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed).To(Equal(events[2]))
+		})
+
+		It("writes a gzipped tarball with the logs of each step", func() {
+			write(buildlogs.FormatArchive)
+
+			gzReader, err := gzip.NewReader(dst)
+			Expect(err).ToNot(HaveOccurred())
+
+			files := map[string]string{}
+			tarReader := tar.NewReader(gzReader)
+			for {
+				header, err := tarReader.Next()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+
+				content, err := io.ReadAll(tarReader)
+				Expect(err).ToNot(HaveOccurred())
+
+				files[header.Name] = string(content)
+			}
+
+			Expect(files).To(HaveLen(5))
+			Expect(files["build.log"]).To(Equal("fetching\ntesting\nFAIL\noh no\n"))
+			Expect(files["steps/repo.log"]).To(Equal("fetching\n"))
+			Expect(files["steps/unit.log"]).To(Equal("testing\nFAIL\n"))
+			Expect(files["steps/notify.log"]).To(Equal("oh no\n"))
+			Expect(files["events.json"]).To(ContainSubstring(`"event":"status"`))
+		})
+
+		It("rejects unknown formats", func() {
+			_, err := buildlogs.NewWriter("yaml", dst, steps)
+			Expect(err).To(MatchError("unknown format 'yaml' (must be one of text, json, archive)"))
+		})
+	})
+
+	Describe("Search", func() {
+		It("matches whole lines, even when split across events", func() {
+			src := &fakeEvents{events: []atc.Event{
+				stdout("2", "no error here\n"),
+				stdout("4", "--- FAIL: TestSo"),
+				stderr("4", "warning\n"),
+				stdout("4", "mething\nok\n"),
+				stdout("5", "last FAIL"),
+			}}
+
+			matches, err := buildlogs.Search(src, steps, buildlogs.Filter{}, regexp.MustCompile("FAIL"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(Equal([]buildlogs.Match{
+				{Step: "unit", Line: "--- FAIL: TestSomething"},
+				{Step: "notify", Line: "last FAIL"},
+			}))
+		})
+
+		It("only searches the events passing the filter", func() {
+			src := &fakeEvents{events: []atc.Event{
+				stdout("2", "error\n"),
+				stdout("4", "error\n"),
+			}}
+
+			matches, err := buildlogs.Search(src, steps, buildlogs.Filter{Step: "repo"}, regexp.MustCompile("error"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(Equal([]buildlogs.Match{{Step: "repo", Line: "error"}}))
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package buildlogs
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// Filter selects the events of a build to keep. The zero value keeps all of
+// them.
+type Filter struct {
+	// Step keeps only the events of the step with the given name.
+	Step string
+
+	// Since keeps only the events emitted at or after the given time. Events
+	// without a time are kept.
+	Since time.Time
+
+	// StderrOnly keeps only the logs written to stderr.
+	StderrOnly bool
+}
+
+// eventFields are the fields most events have in common.
+type eventFields struct {
+	Time   int64        `json:"time"`
+	Origin event.Origin `json:"origin"`
+}
+
+func fieldsOf(ev atc.Event) eventFields {
+	var fields eventFields
+
+	payload, err := json.Marshal(ev)
+	if err == nil {
+		_ = json.Unmarshal(payload, &fields)
+	}
+
+	return fields
+}
+
+// Keep returns whether the event passes the filter.
+func (filter Filter) Keep(steps Steps, ev atc.Event) bool {
+	fields := fieldsOf(ev)
+
+	if filter.StderrOnly {
+		log, ok := ev.(event.Log)
+		if !ok || log.Origin.Source != event.OriginSourceStderr {
+			return false
+		}
+	}
+
+	if filter.Step != "" && steps.Name(fields.Origin.ID) != filter.Step {
+		return false
+	}
+
+	if !filter.Since.IsZero() && fields.Time != 0 && fields.Time < filter.Since.Unix() {
+		return false
+	}
+
+	return true
+}
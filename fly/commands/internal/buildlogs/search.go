@@ -0,0 +1,75 @@
+package buildlogs
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
+)
+
+// Match is a line of a build's output matching a search.
+type Match struct {
+	Step string
+	Line string
+}
+
+// Search reads the events of a build, returning the lines logged by it that
+// match the pattern. Logs are reassembled into lines per origin, as the
+// output of a step may be split across events.
+func Search(src eventstream.EventStream, steps Steps, filter Filter, pattern *regexp.Regexp) ([]Match, error) {
+	var matches []Match
+
+	partial := map[event.Origin]string{}
+	var origins []event.Origin
+
+	match := func(origin event.Origin, line string) {
+		line = strings.TrimSuffix(line, "\r")
+		if pattern.MatchString(line) {
+			matches = append(matches, Match{
+				Step: steps.Name(origin.ID),
+				Line: line,
+			})
+		}
+	}
+
+	for {
+		ev, err := src.NextEvent()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return nil, err
+		}
+
+		if !filter.Keep(steps, ev) {
+			continue
+		}
+
+		log, ok := ev.(event.Log)
+		if !ok {
+			continue
+		}
+
+		if _, found := partial[log.Origin]; !found {
+			origins = append(origins, log.Origin)
+		}
+
+		lines := strings.Split(partial[log.Origin]+log.Payload, "\n")
+		for _, line := range lines[:len(lines)-1] {
+			match(log.Origin, line)
+		}
+
+		partial[log.Origin] = lines[len(lines)-1]
+	}
+
+	for _, origin := range origins {
+		if partial[origin] != "" {
+			match(origin, partial[origin])
+		}
+	}
+
+	return matches, nil
+}
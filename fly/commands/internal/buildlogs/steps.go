@@ -0,0 +1,114 @@
+package buildlogs
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/concourse/concourse/atc/event"
+)
+
+// namedStepTypes are the types of steps in a public plan that have names.
+var namedStepTypes = []string{
+	"get",
+	"put",
+	"check",
+	"task",
+	"run",
+	"set_pipeline",
+	"load_var",
+}
+
+// Steps maps the IDs of the plans of a build to the names of the steps they
+// belong to. The IDs are the origins of the events the steps emit.
+type Steps map[event.OriginID]string
+
+// StepsFromPlan names the steps of a build by walking its public plan. Plans
+// nested in a named step, such as those fetching a task's image, are
+// attributed to it.
+func StepsFromPlan(plan json.RawMessage) (Steps, error) {
+	var tree interface{}
+	err := json.Unmarshal(plan, &tree)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := Steps{}
+	steps.walk(tree, "")
+
+	return steps, nil
+}
+
+func (steps Steps) walk(node interface{}, parent string) {
+	switch n := node.(type) {
+	case []interface{}:
+		for _, child := range n {
+			steps.walk(child, parent)
+		}
+
+	case map[string]interface{}:
+		name := parent
+		if id, ok := n["id"].(string); ok {
+			if name == "" {
+				name = stepName(n)
+			}
+
+			if name != "" {
+				steps[event.OriginID(id)] = name
+			}
+		}
+
+		for _, child := range n {
+			steps.walk(child, name)
+		}
+	}
+}
+
+func stepName(plan map[string]interface{}) string {
+	for _, stepType := range namedStepTypes {
+		step, ok := plan[stepType].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := step["name"].(string); ok {
+			return name
+		}
+
+		// run steps are named by the message they send
+		if message, ok := step["message"].(string); ok {
+			return message
+		}
+	}
+
+	return ""
+}
+
+// Name returns the name of the step an event originated from, or the empty
+// string if it isn't known. Origins of steps created while the build runs,
+// such as those of an across step, are prefixed by the ID of the plan that
+// created them.
+func (steps Steps) Name(id event.OriginID) string {
+	for {
+		if name, found := steps[id]; found {
+			return name
+		}
+
+		i := strings.LastIndex(string(id), "/")
+		if i == -1 {
+			return ""
+		}
+
+		id = id[:i]
+	}
+}
+
+// Has returns whether the build has a step with the given name.
+func (steps Steps) Has(name string) bool {
+	for _, stepName := range steps {
+		if stepName == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+package buildlogs
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+const (
+	FormatText    = "text"
+	FormatJSON    = "json"
+	FormatArchive = "archive"
+)
+
+// Formats are the formats logs can be written in.
+var Formats = []string{FormatText, FormatJSON, FormatArchive}
+
+// Writer writes the events of a build in some format. Close must be called
+// once all events are written.
+type Writer interface {
+	WriteEvent(atc.Event) error
+	Close() error
+}
+
+func NewWriter(format string, dst io.Writer, steps Steps) (Writer, error) {
+	switch format {
+	case FormatText:
+		return &textWriter{dst: dst}, nil
+	case FormatJSON:
+		return &jsonWriter{enc: json.NewEncoder(dst)}, nil
+	case FormatArchive:
+		return &archiveWriter{
+			dst:   dst,
+			steps: steps,
+			logs:  map[string]*bytes.Buffer{},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown format '%s' (must be one of %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// textWriter writes the output of the build as is, along with any errors.
+type textWriter struct {
+	dst io.Writer
+}
+
+func (writer *textWriter) WriteEvent(ev atc.Event) error {
+	return writeText(writer.dst, ev)
+}
+
+func (writer *textWriter) Close() error {
+	return nil
+}
+
+func writeText(dst io.Writer, ev atc.Event) error {
+	var err error
+	switch e := ev.(type) {
+	case event.Log:
+		_, err = io.WriteString(dst, e.Payload)
+	case event.Error:
+		_, err = fmt.Fprintln(dst, e.Message)
+	}
+
+	return err
+}
+
+// jsonWriter writes each event as a line of JSON, enveloped as in the build's
+// event stream.
+type jsonWriter struct {
+	enc *json.Encoder
+}
+
+func (writer *jsonWriter) WriteEvent(ev atc.Event) error {
+	return writer.enc.Encode(event.Message{Event: ev})
+}
+
+func (writer *jsonWriter) Close() error {
+	return nil
+}
+
+// archiveWriter writes a gzipped tarball with the events as JSON, the output
+// of the whole build, and the output of each step on its own.
+type archiveWriter struct {
+	dst   io.Writer
+	steps Steps
+
+	events   bytes.Buffer
+	buildLog bytes.Buffer
+	logs     map[string]*bytes.Buffer
+}
+
+func (writer *archiveWriter) WriteEvent(ev atc.Event) error {
+	err := json.NewEncoder(&writer.events).Encode(event.Message{Event: ev})
+	if err != nil {
+		return err
+	}
+
+	err = writeText(&writer.buildLog, ev)
+	if err != nil {
+		return err
+	}
+
+	name := writer.steps.Name(fieldsOf(ev).Origin.ID)
+	if name == "" {
+		return nil
+	}
+
+	log, found := writer.logs[name]
+	if !found {
+		log = new(bytes.Buffer)
+		writer.logs[name] = log
+	}
+
+	return writeText(log, ev)
+}
+
+func (writer *archiveWriter) Close() error {
+	gzWriter := gzip.NewWriter(writer.dst)
+	tarWriter := tar.NewWriter(gzWriter)
+
+	now := time.Now()
+	add := func(name string, content []byte) error {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(content)),
+			ModTime: now,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tarWriter.Write(content)
+		return err
+	}
+
+	err := add("events.json", writer.events.Bytes())
+	if err != nil {
+		return err
+	}
+
+	err = add("build.log", writer.buildLog.Bytes())
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range writer.logs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		err = add("steps/"+strings.ReplaceAll(name, "/", "_")+".log", writer.logs[name].Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return gzWriter.Close()
+}
@@ -0,0 +1,211 @@
+package dashboard
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// Trigger starts a build of the selected job.
+func (dashboard *Dashboard) Trigger(state *State) (string, error) {
+	if state.Pane != JobsPane {
+		return "", errors.New("select a job to trigger")
+	}
+
+	job, found := state.SelectedJob()
+	if !found {
+		return "", errors.New("no job to trigger")
+	}
+
+	build, err := dashboard.Team.CreateJobBuild(jobPipelineRef(job), job.Name)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("started %s #%s", job.Name, build.Name), nil
+}
+
+// Abort aborts the selected running build, or the running build of the
+// selected job.
+func (dashboard *Dashboard) Abort(state *State) (string, error) {
+	var build atc.Build
+	switch state.Pane {
+	case JobsPane:
+		job, found := state.SelectedJob()
+		if !found || job.NextBuild == nil || !job.NextBuild.Abortable() {
+			return "", errors.New("job has no running build")
+		}
+
+		build = *job.NextBuild
+	case BuildsPane:
+		var found bool
+		build, found = state.SelectedBuild()
+		if !found {
+			return "", errors.New("no build to abort")
+		}
+	default:
+		return "", errors.New("select a job or build to abort")
+	}
+
+	err := dashboard.Client.AbortBuild(strconv.Itoa(build.ID))
+	if err != nil {
+		return "", err
+	}
+
+	return "aborted " + buildName(build), nil
+}
+
+// TogglePause pauses the selected pipeline or job, or unpauses it if it's
+// paused.
+func (dashboard *Dashboard) TogglePause(state *State) (string, error) {
+	switch state.Pane {
+	case PipelinesPane:
+		pipeline, found := state.SelectedPipeline()
+		if !found {
+			return "", errors.New("no pipeline to pause")
+		}
+
+		var err error
+		if pipeline.Paused {
+			_, err = dashboard.Team.UnpausePipeline(pipeline.Ref())
+		} else {
+			_, err = dashboard.Team.PausePipeline(pipeline.Ref())
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return pausedMessage(pipeline.Ref().String(), !pipeline.Paused), nil
+	case JobsPane:
+		job, found := state.SelectedJob()
+		if !found {
+			return "", errors.New("no job to pause")
+		}
+
+		var err error
+		if job.Paused {
+			_, err = dashboard.Team.UnpauseJob(jobPipelineRef(job), job.Name)
+		} else {
+			_, err = dashboard.Team.PauseJob(jobPipelineRef(job), job.Name)
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return pausedMessage(job.Name, !job.Paused), nil
+	default:
+		return "", errors.New("select a pipeline or job to pause")
+	}
+}
+
+// Pin pins the selected resource to its latest version.
+func (dashboard *Dashboard) Pin(state *State) (string, error) {
+	resource, found, err := dashboard.selectedResource(state)
+	if err != nil || !found {
+		return "", err
+	}
+
+	pipelineRef := resourcePipelineRef(resource)
+	versions, _, found, err := dashboard.Team.ResourceVersions(pipelineRef, resource.Name, concourse.Page{Limit: 1}, atc.Version{})
+	if err != nil {
+		return "", err
+	}
+
+	if !found || len(versions) == 0 {
+		return "", fmt.Errorf("resource %s has no versions", resource.Name)
+	}
+
+	pinned, err := dashboard.Team.PinResourceVersion(pipelineRef, resource.Name, versions[0].ID)
+	if err != nil {
+		return "", err
+	}
+
+	if !pinned {
+		return "", fmt.Errorf("could not pin %s", resource.Name)
+	}
+
+	return fmt.Sprintf("pinned %s to version %d", resource.Name, versions[0].ID), nil
+}
+
+// Unpin unpins the selected resource.
+func (dashboard *Dashboard) Unpin(state *State) (string, error) {
+	resource, found, err := dashboard.selectedResource(state)
+	if err != nil || !found {
+		return "", err
+	}
+
+	unpinned, err := dashboard.Team.UnpinResource(resourcePipelineRef(resource), resource.Name)
+	if err != nil {
+		return "", err
+	}
+
+	if !unpinned {
+		return "", fmt.Errorf("could not unpin %s", resource.Name)
+	}
+
+	return "unpinned " + resource.Name, nil
+}
+
+// LogsBuild is the build whose logs open for the selection: the selected
+// running build, or the running or else the latest build of the selected
+// job.
+func (dashboard *Dashboard) LogsBuild(state *State) (atc.Build, error) {
+	switch state.Pane {
+	case JobsPane:
+		job, found := state.SelectedJob()
+		if !found {
+			return atc.Build{}, errors.New("no job to show the logs of")
+		}
+
+		if job.NextBuild != nil {
+			return *job.NextBuild, nil
+		}
+
+		if job.FinishedBuild != nil {
+			return *job.FinishedBuild, nil
+		}
+
+		return atc.Build{}, fmt.Errorf("job %s has no builds", job.Name)
+	case BuildsPane:
+		build, found := state.SelectedBuild()
+		if !found {
+			return atc.Build{}, errors.New("no build to show the logs of")
+		}
+
+		return build, nil
+	default:
+		return atc.Build{}, errors.New("select a job or build to show the logs of")
+	}
+}
+
+func (dashboard *Dashboard) selectedResource(state *State) (atc.Resource, bool, error) {
+	if state.Pane != ResourcesPane {
+		return atc.Resource{}, false, errors.New("select a resource to pin or unpin")
+	}
+
+	resource, found := state.SelectedResource()
+	if !found {
+		return atc.Resource{}, false, errors.New("no resource to pin or unpin")
+	}
+
+	return resource, true, nil
+}
+
+func pausedMessage(name string, paused bool) string {
+	if paused {
+		return "paused " + name
+	}
+
+	return "unpaused " + name
+}
+
+func jobPipelineRef(job atc.Job) atc.PipelineRef {
+	return atc.PipelineRef{Name: job.PipelineName, InstanceVars: job.PipelineInstanceVars}
+}
+
+func resourcePipelineRef(resource atc.Resource) atc.PipelineRef {
+	return atc.PipelineRef{Name: resource.PipelineName, InstanceVars: resource.PipelineInstanceVars}
+}
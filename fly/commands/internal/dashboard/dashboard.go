@@ -0,0 +1,297 @@
+package dashboard
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/fly/commands/internal/buildlogs"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// runningBuildsLimit is how many of the team's latest builds are looked
+// through for running ones.
+const runningBuildsLimit = 100
+
+// Dashboard shows the pipelines of a team on a terminal, refreshing them
+// every Interval, and acts on them through key presses.
+type Dashboard struct {
+	Client   concourse.Client
+	Team     concourse.Team
+	Interval time.Duration
+
+	// Size returns the width and height of the terminal.
+	Size func() (int, int)
+
+	// Resized receives whenever the terminal is resized.
+	Resized <-chan os.Signal
+}
+
+// Run draws the dashboard on the terminal until q is pressed. The terminal
+// must be in raw mode.
+func (dashboard *Dashboard) Run(term io.ReadWriter) error {
+	state := &State{Team: dashboard.Team.Name()}
+
+	keys, keyErrs := ReadKeys(term)
+
+	_, err := io.WriteString(term, EnterScreen)
+	if err != nil {
+		return err
+	}
+
+	defer io.WriteString(term, ExitScreen)
+
+	dashboard.refresh(state)
+
+	ticker := time.NewTicker(dashboard.Interval)
+	defer ticker.Stop()
+
+	var logs *logStream
+	defer func() {
+		if logs != nil {
+			logs.Close()
+		}
+	}()
+
+	for {
+		width, height := dashboard.Size()
+		err := Render(term, state, width, height)
+		if err != nil {
+			return err
+		}
+
+		var events <-chan atc.Event
+		if logs != nil {
+			events = logs.events
+		}
+
+		select {
+		case key := <-keys:
+			if key.Key == KeyInterrupt || key.Key == KeyRune && key.Rune == 'q' {
+				return nil
+			}
+
+			if state.Logs != nil {
+				if key.Key == KeyEscape {
+					if logs != nil {
+						logs.Close()
+						logs = nil
+					}
+
+					state.Logs = nil
+					state.Status = ""
+				}
+
+				continue
+			}
+
+			if key.Key == KeyEnter || key.Key == KeyRune && key.Rune == 'l' {
+				logs = dashboard.openLogs(state)
+				continue
+			}
+
+			dashboard.handleKey(state, key)
+
+		case err := <-keyErrs:
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+
+		case <-ticker.C:
+			dashboard.refresh(state)
+
+		case <-dashboard.Resized:
+
+		case ev, ok := <-events:
+			if !ok {
+				logs = nil
+				continue
+			}
+
+			switch e := ev.(type) {
+			case event.Status:
+				state.Logs.Status = e.Status
+			default:
+				logs.writer.WriteEvent(ev)
+			}
+		}
+	}
+}
+
+func (dashboard *Dashboard) handleKey(state *State, key Keystroke) {
+	var action func(*State) (string, error)
+
+	switch key.Key {
+	case KeyUp:
+		dashboard.move(state, -1)
+		return
+	case KeyDown:
+		dashboard.move(state, 1)
+		return
+	case KeyTab:
+		state.NextPane()
+		return
+	case KeyRune:
+		switch key.Rune {
+		case 'k':
+			dashboard.move(state, -1)
+			return
+		case 'j':
+			dashboard.move(state, 1)
+			return
+		case 'r':
+			dashboard.refresh(state)
+			return
+		case 't':
+			action = dashboard.Trigger
+		case 'a':
+			action = dashboard.Abort
+		case 'p':
+			action = dashboard.TogglePause
+		case 'i':
+			action = dashboard.Pin
+		case 'u':
+			action = dashboard.Unpin
+		default:
+			return
+		}
+	default:
+		return
+	}
+
+	status, err := action(state)
+	if err != nil {
+		state.Status = "error: " + err.Error()
+		return
+	}
+
+	state.Status = status
+	dashboard.refresh(state)
+}
+
+func (dashboard *Dashboard) move(state *State, delta int) {
+	state.Move(delta)
+
+	// selecting another pipeline shows its jobs and resources
+	if state.Pane == PipelinesPane {
+		dashboard.refresh(state)
+	}
+}
+
+// refresh fetches everything the dashboard shows. Any error is shown as the
+// status rather than ending the dashboard, as it may well be temporary.
+func (dashboard *Dashboard) refresh(state *State) {
+	err := dashboard.fetch(state)
+	if err != nil {
+		state.Status = "error: " + err.Error()
+		return
+	}
+
+	state.RefreshedAt = time.Now()
+}
+
+func (dashboard *Dashboard) fetch(state *State) error {
+	pipelines, err := dashboard.Team.ListPipelines()
+	if err != nil {
+		return err
+	}
+
+	state.Pipelines = pipelines
+	state.clamp()
+
+	state.Jobs = nil
+	state.Resources = nil
+	if pipeline, found := state.SelectedPipeline(); found {
+		state.Jobs, err = dashboard.Team.ListJobs(pipeline.Ref())
+		if err != nil {
+			return err
+		}
+
+		state.Resources, err = dashboard.Team.ListResources(pipeline.Ref())
+		if err != nil {
+			return err
+		}
+	}
+
+	builds, _, err := dashboard.Team.Builds(concourse.Page{Limit: runningBuildsLimit})
+	if err != nil {
+		return err
+	}
+
+	state.Builds = nil
+	for _, build := range builds {
+		if build.IsRunning() {
+			state.Builds = append(state.Builds, build)
+		}
+	}
+
+	state.clamp()
+
+	return nil
+}
+
+func (dashboard *Dashboard) openLogs(state *State) *logStream {
+	build, err := dashboard.LogsBuild(state)
+	if err != nil {
+		state.Status = "error: " + err.Error()
+		return nil
+	}
+
+	source, err := dashboard.Client.BuildEvents(strconv.Itoa(build.ID))
+	if err != nil {
+		state.Status = "error: " + err.Error()
+		return nil
+	}
+
+	state.Logs = &Logs{Build: build}
+	state.Status = ""
+
+	writer, _ := buildlogs.NewWriter(buildlogs.FormatText, state.Logs, nil)
+
+	stream := &logStream{
+		source: source,
+		writer: writer,
+		events: make(chan atc.Event),
+		done:   make(chan struct{}),
+	}
+
+	go stream.run()
+
+	return stream
+}
+
+// logStream sends the events of a build to the dashboard until they end or
+// it's closed.
+type logStream struct {
+	source concourse.Events
+	writer buildlogs.Writer
+	events chan atc.Event
+	done   chan struct{}
+}
+
+func (stream *logStream) run() {
+	defer close(stream.events)
+
+	for {
+		ev, err := stream.source.NextEvent()
+		if err != nil {
+			return
+		}
+
+		select {
+		case stream.events <- ev:
+		case <-stream.done:
+			return
+		}
+	}
+}
+
+func (stream *logStream) Close() {
+	close(stream.done)
+	stream.source.Close()
+}
@@ -0,0 +1,392 @@
+package dashboard_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/dashboard"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/go-concourse/concourse/concoursefakes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeTerm is a terminal that's typed into through keys, and whose screen is
+// written to out.
+type fakeTerm struct {
+	keys io.Reader
+	out  *bytes.Buffer
+}
+
+func (term fakeTerm) Read(p []byte) (int, error)  { return term.keys.Read(p) }
+func (term fakeTerm) Write(p []byte) (int, error) { return term.out.Write(p) }
+
+var _ = Describe("Dashboard", func() {
+	var (
+		fakeClient *concoursefakes.FakeClient
+		fakeTeam   *concoursefakes.FakeTeam
+
+		board *dashboard.Dashboard
+		state *dashboard.State
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(concoursefakes.FakeClient)
+		fakeTeam = new(concoursefakes.FakeTeam)
+		fakeTeam.NameReturns("main")
+
+		board = &dashboard.Dashboard{
+			Client:   fakeClient,
+			Team:     fakeTeam,
+			Interval: time.Hour,
+			Size:     func() (int, int) { return 120, 30 },
+		}
+
+		state = &dashboard.State{
+			Team: "main",
+			Pipelines: []atc.Pipeline{
+				{Name: "some-pipeline"},
+				{Name: "other-pipeline", Paused: true},
+			},
+			Jobs: []atc.Job{
+				{
+					Name:          "unit",
+					PipelineName:  "some-pipeline",
+					FinishedBuild: &atc.Build{ID: 10, Name: "3", Status: atc.StatusFailed},
+					NextBuild:     &atc.Build{ID: 11, Name: "4", Status: atc.StatusStarted, JobName: "unit", PipelineName: "some-pipeline"},
+				},
+				{
+					Name:         "deploy",
+					PipelineName: "some-pipeline",
+					Paused:       true,
+				},
+			},
+			Resources: []atc.Resource{
+				{Name: "repo", Type: "git", PipelineName: "some-pipeline"},
+				{Name: "image", Type: "registry-image", PipelineName: "some-pipeline", PinnedVersion: atc.Version{"digest": "sha256:abc"}},
+			},
+			Builds: []atc.Build{
+				{ID: 11, Name: "4", Status: atc.StatusStarted, JobName: "unit", PipelineName: "some-pipeline"},
+			},
+		}
+	})
+
+	Describe("ParseKeys", func() {
+		It("parses letters, control keys and arrows", func() {
+			Expect(dashboard.ParseKeys([]byte("t\t\r\x1b[A\x1b[Bq\x03"))).To(Equal([]dashboard.Keystroke{
+				{Key: dashboard.KeyRune, Rune: 't'},
+				{Key: dashboard.KeyTab},
+				{Key: dashboard.KeyEnter},
+				{Key: dashboard.KeyUp},
+				{Key: dashboard.KeyDown},
+				{Key: dashboard.KeyRune, Rune: 'q'},
+				{Key: dashboard.KeyInterrupt},
+			}))
+		})
+
+		It("parses a lone escape", func() {
+			Expect(dashboard.ParseKeys([]byte("\x1b"))).To(Equal([]dashboard.Keystroke{
+				{Key: dashboard.KeyEscape},
+			}))
+		})
+
+		It("drops other escape sequences", func() {
+			Expect(dashboard.ParseKeys([]byte("\x1b[15~j"))).To(Equal([]dashboard.Keystroke{
+				{Key: dashboard.KeyRune, Rune: 'j'},
+			}))
+		})
+	})
+
+	Describe("State", func() {
+		It("keeps the cursor within the rows of the pane", func() {
+			state.Move(-1)
+			Expect(state.Cursor(dashboard.PipelinesPane)).To(Equal(0))
+
+			state.Move(5)
+			Expect(state.Cursor(dashboard.PipelinesPane)).To(Equal(1))
+
+			pipeline, found := state.SelectedPipeline()
+			Expect(found).To(BeTrue())
+			Expect(pipeline.Name).To(Equal("other-pipeline"))
+		})
+
+		It("cycles through the panes", func() {
+			for _, pane := range []dashboard.Pane{
+				dashboard.JobsPane,
+				dashboard.ResourcesPane,
+				dashboard.BuildsPane,
+				dashboard.PipelinesPane,
+			} {
+				state.NextPane()
+				Expect(state.Pane).To(Equal(pane))
+			}
+		})
+	})
+
+	Describe("Logs", func() {
+		It("splits the output into lines, keeping the line yet to end", func() {
+			logs := &dashboard.Logs{}
+			logs.Write([]byte("one\ntw"))
+			logs.Write([]byte("o\r\nthree"))
+
+			Expect(logs.Tail(10)).To(Equal([]string{"one", "two", "three"}))
+			Expect(logs.Tail(2)).To(Equal([]string{"two", "three"}))
+		})
+	})
+
+	Describe("Render", func() {
+		var screen string
+
+		JustBeforeEach(func() {
+			buf := new(bytes.Buffer)
+			err := dashboard.Render(buf, state, 120, 30)
+			Expect(err).NotTo(HaveOccurred())
+
+			screen = buf.String()
+		})
+
+		It("shows each pane", func() {
+			Expect(screen).To(ContainSubstring("team: main"))
+			Expect(screen).To(ContainSubstring("> some-pipeline"))
+			Expect(screen).To(MatchRegexp(`other-pipeline\s+paused`))
+			Expect(screen).To(ContainSubstring("jobs of some-pipeline"))
+			Expect(screen).To(MatchRegexp(`> unit\s+failed #3\s+started #4`))
+			Expect(screen).To(MatchRegexp(`deploy\s+n/a\s+paused`))
+			Expect(screen).To(MatchRegexp(`image\s+registry-image\s+pinned`))
+			Expect(screen).To(MatchRegexp(`some-pipeline/unit #4\s+started`))
+		})
+
+		It("fits the screen", func() {
+			lines := strings.Split(screen, "\r\n")
+			Expect(lines).To(HaveLen(30))
+		})
+
+		Context("when logs are open", func() {
+			BeforeEach(func() {
+				state.Logs = &dashboard.Logs{Build: state.Builds[0]}
+				state.Logs.Write([]byte("running tests\n"))
+			})
+
+			It("shows the logs of the build", func() {
+				Expect(screen).To(ContainSubstring("logs of some-pipeline/unit #4"))
+				Expect(screen).To(ContainSubstring("running tests"))
+				Expect(screen).NotTo(ContainSubstring("jobs of"))
+			})
+		})
+	})
+
+	Describe("actions", func() {
+		Describe("Trigger", func() {
+			BeforeEach(func() {
+				state.Pane = dashboard.JobsPane
+				fakeTeam.CreateJobBuildReturns(atc.Build{Name: "5"}, nil)
+			})
+
+			It("starts a build of the selected job", func() {
+				status, err := board.Trigger(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal("started unit #5"))
+
+				pipelineRef, jobName := fakeTeam.CreateJobBuildArgsForCall(0)
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+				Expect(jobName).To(Equal("unit"))
+			})
+
+			It("requires a job to be selected", func() {
+				state.Pane = dashboard.PipelinesPane
+
+				_, err := board.Trigger(state)
+				Expect(err).To(MatchError("select a job to trigger"))
+				Expect(fakeTeam.CreateJobBuildCallCount()).To(Equal(0))
+			})
+		})
+
+		Describe("Abort", func() {
+			It("aborts the running build of the selected job", func() {
+				state.Pane = dashboard.JobsPane
+
+				_, err := board.Abort(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeClient.AbortBuildArgsForCall(0)).To(Equal("11"))
+			})
+
+			It("aborts the selected running build", func() {
+				state.Pane = dashboard.BuildsPane
+
+				status, err := board.Abort(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal("aborted some-pipeline/unit #4"))
+				Expect(fakeClient.AbortBuildArgsForCall(0)).To(Equal("11"))
+			})
+
+			It("errors when the job isn't running", func() {
+				state.Pane = dashboard.JobsPane
+				state.Move(1)
+
+				_, err := board.Abort(state)
+				Expect(err).To(MatchError("job has no running build"))
+				Expect(fakeClient.AbortBuildCallCount()).To(Equal(0))
+			})
+		})
+
+		Describe("TogglePause", func() {
+			It("pauses an unpaused pipeline", func() {
+				status, err := board.TogglePause(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal("paused some-pipeline"))
+				Expect(fakeTeam.PausePipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+			})
+
+			It("unpauses a paused pipeline", func() {
+				state.Move(1)
+
+				_, err := board.TogglePause(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeTeam.UnpausePipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "other-pipeline"}))
+			})
+
+			It("unpauses a paused job", func() {
+				state.Pane = dashboard.JobsPane
+				state.Move(1)
+
+				status, err := board.TogglePause(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal("unpaused deploy"))
+
+				_, jobName := fakeTeam.UnpauseJobArgsForCall(0)
+				Expect(jobName).To(Equal("deploy"))
+			})
+		})
+
+		Describe("Pin", func() {
+			BeforeEach(func() {
+				state.Pane = dashboard.ResourcesPane
+			})
+
+			It("pins the resource to its latest version", func() {
+				fakeTeam.ResourceVersionsReturns([]atc.ResourceVersion{{ID: 42}}, concourse.Pagination{}, true, nil)
+				fakeTeam.PinResourceVersionReturns(true, nil)
+
+				status, err := board.Pin(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal("pinned repo to version 42"))
+
+				_, _, page, _ := fakeTeam.ResourceVersionsArgsForCall(0)
+				Expect(page.Limit).To(Equal(1))
+
+				_, resourceName, versionID := fakeTeam.PinResourceVersionArgsForCall(0)
+				Expect(resourceName).To(Equal("repo"))
+				Expect(versionID).To(Equal(42))
+			})
+
+			It("errors when the resource has no versions", func() {
+				fakeTeam.ResourceVersionsReturns(nil, concourse.Pagination{}, true, nil)
+
+				_, err := board.Pin(state)
+				Expect(err).To(MatchError("resource repo has no versions"))
+				Expect(fakeTeam.PinResourceVersionCallCount()).To(Equal(0))
+			})
+		})
+
+		Describe("Unpin", func() {
+			It("unpins the selected resource", func() {
+				state.Pane = dashboard.ResourcesPane
+				state.Move(1)
+				fakeTeam.UnpinResourceReturns(true, nil)
+
+				status, err := board.Unpin(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal("unpinned image"))
+			})
+		})
+
+		Describe("LogsBuild", func() {
+			It("picks the running build of the selected job", func() {
+				state.Pane = dashboard.JobsPane
+
+				build, err := board.LogsBuild(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.ID).To(Equal(11))
+			})
+
+			It("errors when the job has no builds", func() {
+				state.Pane = dashboard.JobsPane
+				state.Move(1)
+
+				_, err := board.LogsBuild(state)
+				Expect(err).To(MatchError("job deploy has no builds"))
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var (
+			keys string
+			out  *bytes.Buffer
+
+			runErr error
+		)
+
+		BeforeEach(func() {
+			out = new(bytes.Buffer)
+
+			fakeTeam.ListPipelinesReturns([]atc.Pipeline{{Name: "some-pipeline"}}, nil)
+			fakeTeam.ListJobsReturns([]atc.Job{{Name: "unit", PipelineName: "some-pipeline"}}, nil)
+			fakeTeam.BuildsReturns([]atc.Build{
+				{ID: 2, Status: atc.StatusStarted, JobName: "unit", PipelineName: "some-pipeline", Name: "2"},
+				{ID: 1, Status: atc.StatusSucceeded, JobName: "unit", PipelineName: "some-pipeline", Name: "1"},
+			}, concourse.Pagination{}, nil)
+		})
+
+		JustBeforeEach(func() {
+			runErr = board.Run(fakeTerm{keys: strings.NewReader(keys), out: out})
+		})
+
+		Context("when q is pressed", func() {
+			BeforeEach(func() {
+				keys = "q"
+			})
+
+			It("shows the team's pipelines and running builds until then", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+
+				Expect(out.String()).To(HavePrefix(dashboard.EnterScreen))
+				Expect(out.String()).To(HaveSuffix(dashboard.ExitScreen))
+				Expect(out.String()).To(ContainSubstring("> unit"))
+				Expect(out.String()).To(ContainSubstring("some-pipeline/unit #2"))
+				Expect(out.String()).NotTo(ContainSubstring("some-pipeline/unit #1"))
+			})
+		})
+
+		Context("when a job is triggered", func() {
+			BeforeEach(func() {
+				keys = "\tt"
+				fakeTeam.CreateJobBuildReturns(atc.Build{Name: "3"}, nil)
+			})
+
+			It("shows that it started and refreshes", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+				Expect(fakeTeam.CreateJobBuildCallCount()).To(Equal(1))
+				Expect(fakeTeam.ListPipelinesCallCount()).To(Equal(2))
+				Expect(out.String()).To(ContainSubstring("started unit #3"))
+			})
+		})
+
+		Context("when an action fails", func() {
+			BeforeEach(func() {
+				keys = "\tt"
+				fakeTeam.CreateJobBuildReturns(atc.Build{}, errors.New("nope"))
+			})
+
+			It("shows the error without ending", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+				Expect(out.String()).To(ContainSubstring("error: nope"))
+			})
+		})
+	})
+})
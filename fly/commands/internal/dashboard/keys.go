@@ -0,0 +1,91 @@
+package dashboard
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+type Key int
+
+const (
+	KeyRune Key = iota
+	KeyUp
+	KeyDown
+	KeyTab
+	KeyEnter
+	KeyEscape
+	KeyInterrupt
+)
+
+// Keystroke is a key pressed in the terminal. Rune is only set for KeyRune.
+type Keystroke struct {
+	Key  Key
+	Rune rune
+}
+
+// ReadKeys reads keystrokes from a terminal in raw mode until it fails to
+// read, which is reported on the returned error channel.
+func ReadKeys(src io.Reader) (<-chan Keystroke, <-chan error) {
+	keys := make(chan Keystroke)
+	errs := make(chan error, 1)
+
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			n, err := src.Read(buf)
+			for _, key := range ParseKeys(buf[:n]) {
+				keys <- key
+			}
+
+			if err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	return keys, errs
+}
+
+// ParseKeys splits the bytes of a read from the terminal into keystrokes.
+// Escape sequences for keys other than the arrows are dropped.
+func ParseKeys(b []byte) []Keystroke {
+	var keys []Keystroke
+	for len(b) > 0 {
+		switch {
+		case b[0] == 0x1b && len(b) >= 3 && (b[1] == '[' || b[1] == 'O'):
+			switch b[2] {
+			case 'A':
+				keys = append(keys, Keystroke{Key: KeyUp})
+			case 'B':
+				keys = append(keys, Keystroke{Key: KeyDown})
+			}
+
+			// skip the rest of the sequence, up to its final byte
+			i := 2
+			for i < len(b) && (b[i] < 0x40 || b[i] > 0x7e) {
+				i++
+			}
+
+			b = b[min(i+1, len(b)):]
+			continue
+		case b[0] == 0x1b:
+			keys = append(keys, Keystroke{Key: KeyEscape})
+		case b[0] == 0x03:
+			keys = append(keys, Keystroke{Key: KeyInterrupt})
+		case b[0] == '\t':
+			keys = append(keys, Keystroke{Key: KeyTab})
+		case b[0] == '\r' || b[0] == '\n':
+			keys = append(keys, Keystroke{Key: KeyEnter})
+		default:
+			r, size := utf8.DecodeRune(b)
+			keys = append(keys, Keystroke{Key: KeyRune, Rune: r})
+			b = b[size:]
+			continue
+		}
+
+		b = b[1:]
+	}
+
+	return keys
+}
@@ -0,0 +1,278 @@
+package dashboard
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+const (
+	EnterScreen = "\x1b[?1049h\x1b[?25l"
+	ExitScreen  = "\x1b[?25h\x1b[?1049l"
+
+	clearScreen = "\x1b[H\x1b[2J"
+	resetColor  = "\x1b[0m"
+)
+
+const help = "tab: next pane  ↑/↓: move  t: trigger  a: abort  p: pause/unpause  i: pin latest  u: unpin  enter: logs  r: refresh  q: quit"
+const logsHelp = "esc: back  q: quit"
+
+var (
+	titleColor        = color.New(color.Bold)
+	focusedTitleColor = color.New(color.Bold, color.Underline)
+)
+
+// Render draws the state over the whole terminal screen, which is width
+// columns by height rows.
+func Render(dst io.Writer, state *State, width int, height int) error {
+	var lines []string
+	if state.Logs != nil {
+		lines = renderLogs(state, width, height)
+	} else {
+		lines = renderPanes(state, width, height)
+	}
+
+	_, err := io.WriteString(dst, clearScreen+strings.Join(lines, "\r\n"))
+	return err
+}
+
+func renderPanes(state *State, width int, height int) []string {
+	lines := []string{
+		header(state, width),
+		"",
+	}
+
+	// leave room for the header, status and help lines, and each pane's
+	// title
+	rows := (height-len(lines)-2)/len(panes) - 1
+	if rows < 1 {
+		rows = 1
+	}
+
+	for _, pane := range panes {
+		lines = append(lines, paneTitle(state, pane, width))
+
+		data := paneRows(state, pane)
+		if len(data) == 0 {
+			lines = append(lines, ui.OffColor.Sprint(truncate("  none", width)))
+			continue
+		}
+
+		start := 0
+		if cursor := state.Cursor(pane); cursor >= rows {
+			start = cursor - rows + 1
+		}
+
+		end := min(start+rows, len(data))
+		widths := columnWidths(data)
+		for i := start; i < end; i++ {
+			marker := "  "
+			if i == state.Cursor(pane) {
+				marker = "> "
+			}
+
+			lines = append(lines, renderRow(marker, data[i], widths, width))
+		}
+	}
+
+	for len(lines) < height-2 {
+		lines = append(lines, "")
+	}
+
+	return append(lines, truncate(state.Status, width), ui.OffColor.Sprint(truncate(help, width)))
+}
+
+func renderLogs(state *State, width int, height int) []string {
+	logs := state.Logs
+
+	status := logs.Status
+	if status == "" {
+		status = logs.Build.Status
+	}
+
+	lines := []string{
+		titleColor.Sprint(truncate("logs of "+buildName(logs.Build), width)) + "  " + ui.BuildStatusCell(status).Color.Sprint(status),
+		"",
+	}
+
+	for _, line := range logs.Tail(height - len(lines) - 2) {
+		lines = append(lines, truncate(line, width)+resetColor)
+	}
+
+	for len(lines) < height-2 {
+		lines = append(lines, "")
+	}
+
+	return append(lines, truncate(state.Status, width), ui.OffColor.Sprint(truncate(logsHelp, width)))
+}
+
+func header(state *State, width int) string {
+	refreshed := "never"
+	if !state.RefreshedAt.IsZero() {
+		refreshed = state.RefreshedAt.Format(time.TimeOnly)
+	}
+
+	return titleColor.Sprint(truncate(fmt.Sprintf("team: %s  refreshed: %s", state.Team, refreshed), width))
+}
+
+func paneTitle(state *State, pane Pane, width int) string {
+	title := pane.String()
+	if pane == JobsPane || pane == ResourcesPane {
+		if pipeline, found := state.SelectedPipeline(); found {
+			title += " of " + pipeline.Ref().String()
+		}
+	}
+
+	if pane == state.Pane {
+		return focusedTitleColor.Sprint(truncate(title, width))
+	}
+
+	return titleColor.Sprint(truncate(title, width))
+}
+
+func paneRows(state *State, pane Pane) []ui.TableRow {
+	var rows []ui.TableRow
+	switch pane {
+	case PipelinesPane:
+		for _, pipeline := range state.Pipelines {
+			rows = append(rows, ui.TableRow{
+				{Contents: pipeline.Ref().String()},
+				pausedCell(pipeline.Paused),
+			})
+		}
+	case JobsPane:
+		for _, job := range state.Jobs {
+			rows = append(rows, jobRow(job))
+		}
+	case ResourcesPane:
+		for _, resource := range state.Resources {
+			pinned := ui.TableCell{}
+			if resource.PinnedVersion != nil {
+				pinned = ui.TableCell{Contents: "pinned", Color: ui.OnColor}
+			}
+
+			rows = append(rows, ui.TableRow{
+				{Contents: resource.Name},
+				{Contents: resource.Type},
+				pinned,
+			})
+		}
+	case BuildsPane:
+		for _, build := range state.Builds {
+			rows = append(rows, ui.TableRow{
+				{Contents: buildName(build)},
+				ui.BuildStatusCell(build.Status),
+				{Contents: elapsed(build)},
+			})
+		}
+	}
+
+	return rows
+}
+
+func jobRow(job atc.Job) ui.TableRow {
+	finished := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	if job.FinishedBuild != nil {
+		finished = ui.BuildStatusCell(job.FinishedBuild.Status)
+		finished.Contents = fmt.Sprintf("%s #%s", finished.Contents, job.FinishedBuild.Name)
+	}
+
+	next := ui.TableCell{}
+	if job.NextBuild != nil {
+		next = ui.BuildStatusCell(job.NextBuild.Status)
+		next.Contents = fmt.Sprintf("%s #%s", next.Contents, job.NextBuild.Name)
+	}
+
+	return ui.TableRow{
+		{Contents: job.Name},
+		finished,
+		next,
+		pausedCell(job.Paused),
+	}
+}
+
+func pausedCell(paused bool) ui.TableCell {
+	if paused {
+		return ui.TableCell{Contents: "paused", Color: ui.PausedColor}
+	}
+
+	return ui.TableCell{}
+}
+
+func buildName(build atc.Build) string {
+	if build.OneOff() {
+		return fmt.Sprintf("one-off build %d", build.ID)
+	}
+
+	pipelineRef := atc.PipelineRef{Name: build.PipelineName, InstanceVars: build.PipelineInstanceVars}
+	return fmt.Sprintf("%s/%s #%s", pipelineRef, build.JobName, build.Name)
+}
+
+func elapsed(build atc.Build) string {
+	if build.StartTime == 0 {
+		return ""
+	}
+
+	return time.Since(time.Unix(build.StartTime, 0)).Truncate(time.Second).String()
+}
+
+func columnWidths(rows []ui.TableRow) []int {
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+
+			widths[i] = max(widths[i], len(cell.Contents))
+		}
+	}
+
+	return widths
+}
+
+// renderRow lines up the cells of the row in columns, cutting it off at the
+// width of the screen.
+func renderRow(marker string, row ui.TableRow, widths []int, width int) string {
+	line := marker
+	remaining := width - len(marker)
+	for i, cell := range row {
+		if remaining <= 0 {
+			break
+		}
+
+		contents := truncate(cell.Contents, remaining)
+		remaining -= len(contents)
+		if cell.Color != nil {
+			contents = cell.Color.Sprint(contents)
+		}
+
+		line += contents
+
+		padding := min(widths[i]-len(cell.Contents)+2, remaining)
+		if i+1 < len(row) && padding > 0 {
+			line += strings.Repeat(" ", padding)
+			remaining -= padding
+		}
+	}
+
+	return strings.TrimRight(line, " ")
+}
+
+func truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+
+	return string(runes[:width])
+}
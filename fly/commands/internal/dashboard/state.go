@@ -0,0 +1,178 @@
+package dashboard
+
+import (
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type Pane int
+
+const (
+	PipelinesPane Pane = iota
+	JobsPane
+	ResourcesPane
+	BuildsPane
+)
+
+var panes = []Pane{PipelinesPane, JobsPane, ResourcesPane, BuildsPane}
+
+func (pane Pane) String() string {
+	switch pane {
+	case PipelinesPane:
+		return "pipelines"
+	case JobsPane:
+		return "jobs"
+	case ResourcesPane:
+		return "resources"
+	case BuildsPane:
+		return "running builds"
+	default:
+		return "unknown"
+	}
+}
+
+// maxLogLines is how many lines of a build's logs are kept for the log view.
+const maxLogLines = 10000
+
+// State is what the dashboard shows. The jobs and resources are those of the
+// selected pipeline.
+type State struct {
+	Team string
+
+	Pipelines []atc.Pipeline
+	Jobs      []atc.Job
+	Resources []atc.Resource
+	Builds    []atc.Build
+
+	Pane    Pane
+	cursors [4]int
+
+	// Logs is set while the logs of a build are open.
+	Logs *Logs
+
+	// Status is the outcome of the last action, or the last error.
+	Status      string
+	RefreshedAt time.Time
+}
+
+func (state *State) len(pane Pane) int {
+	switch pane {
+	case PipelinesPane:
+		return len(state.Pipelines)
+	case JobsPane:
+		return len(state.Jobs)
+	case ResourcesPane:
+		return len(state.Resources)
+	case BuildsPane:
+		return len(state.Builds)
+	default:
+		return 0
+	}
+}
+
+// Cursor is the row selected in the pane.
+func (state *State) Cursor(pane Pane) int {
+	return state.cursors[pane]
+}
+
+// Move moves the cursor of the focused pane by delta rows, staying within
+// its rows.
+func (state *State) Move(delta int) {
+	state.cursors[state.Pane] += delta
+	state.clamp()
+}
+
+// NextPane focuses the pane after the focused one, wrapping around.
+func (state *State) NextPane() {
+	state.Pane = panes[(int(state.Pane)+1)%len(panes)]
+}
+
+// clamp keeps the cursors within the rows of their panes, e.g. after a
+// refresh removed some.
+func (state *State) clamp() {
+	for _, pane := range panes {
+		cursor := state.cursors[pane]
+		if cursor >= state.len(pane) {
+			cursor = state.len(pane) - 1
+		}
+
+		if cursor < 0 {
+			cursor = 0
+		}
+
+		state.cursors[pane] = cursor
+	}
+}
+
+func (state *State) SelectedPipeline() (atc.Pipeline, bool) {
+	if len(state.Pipelines) == 0 {
+		return atc.Pipeline{}, false
+	}
+
+	return state.Pipelines[state.cursors[PipelinesPane]], true
+}
+
+func (state *State) SelectedJob() (atc.Job, bool) {
+	if len(state.Jobs) == 0 {
+		return atc.Job{}, false
+	}
+
+	return state.Jobs[state.cursors[JobsPane]], true
+}
+
+func (state *State) SelectedResource() (atc.Resource, bool) {
+	if len(state.Resources) == 0 {
+		return atc.Resource{}, false
+	}
+
+	return state.Resources[state.cursors[ResourcesPane]], true
+}
+
+func (state *State) SelectedBuild() (atc.Build, bool) {
+	if len(state.Builds) == 0 {
+		return atc.Build{}, false
+	}
+
+	return state.Builds[state.cursors[BuildsPane]], true
+}
+
+// Logs are the logs of a build as they stream in, split into lines.
+type Logs struct {
+	Build  atc.Build
+	Status atc.BuildStatus
+
+	lines   []string
+	partial string
+}
+
+func (logs *Logs) Write(p []byte) (int, error) {
+	// carriage returns would move the cursor outside the log view
+	output := strings.ReplaceAll(string(p), "\r", "")
+
+	chunks := strings.Split(logs.partial+output, "\n")
+	logs.partial = chunks[len(chunks)-1]
+	logs.lines = append(logs.lines, chunks[:len(chunks)-1]...)
+
+	if len(logs.lines) > maxLogLines {
+		logs.lines = logs.lines[len(logs.lines)-maxLogLines:]
+	}
+
+	return len(p), nil
+}
+
+// Tail returns up to the last n lines of the logs, including a line that is
+// yet to end.
+func (logs *Logs) Tail(n int) []string {
+	lines := logs.lines
+	if logs.partial != "" {
+		lines = append(lines[:len(lines):len(lines)], logs.partial)
+	}
+
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return lines
+}
@@ -0,0 +1,92 @@
+package flaghelpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// completedBuilds is how many of the latest builds are offered for completion.
+const completedBuilds = 50
+
+// BuildFlag is a build name within the job given with --job, or a build ID
+// when there is no job.
+type BuildFlag string
+
+func (flag *BuildFlag) Complete(match string) []flags.Completion {
+	c, ok := completionTarget(true)
+	if !ok {
+		return []flags.Completion{}
+	}
+
+	var job JobFlag
+	if value := argValue("j", "job"); value != "" {
+		if job.UnmarshalFlag(value) != nil {
+			return []flags.Completion{}
+		}
+	}
+
+	builds, err := cached(c, c.teamKey("builds/"+job.String()), func() ([]flags.Completion, error) {
+		var (
+			builds []atc.Build
+			err    error
+		)
+
+		page := concourse.Page{Limit: completedBuilds}
+		if job.JobName != "" {
+			builds, _, _, err = c.team.JobBuilds(job.PipelineRef, job.JobName, page)
+		} else {
+			builds, _, err = c.team.Builds(page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var comps []flags.Completion
+		for _, build := range builds {
+			if job.JobName != "" {
+				comps = append(comps, flags.Completion{
+					Item:        build.Name,
+					Description: string(build.Status),
+				})
+			} else {
+				comps = append(comps, flags.Completion{
+					Item:        strconv.Itoa(build.ID),
+					Description: buildDescription(build),
+				})
+			}
+		}
+
+		return comps, nil
+	})
+	if err != nil {
+		return []flags.Completion{}
+	}
+
+	comps := []flags.Completion{}
+	for _, build := range builds {
+		if strings.HasPrefix(build.Item, match) {
+			comps = append(comps, build)
+		}
+	}
+
+	return comps
+}
+
+func (flag BuildFlag) String() string {
+	return string(flag)
+}
+
+func buildDescription(build atc.Build) string {
+	if build.JobName == "" {
+		return fmt.Sprintf("one-off %s", build.Status)
+	}
+
+	pipelineRef := atc.PipelineRef{Name: build.PipelineName, InstanceVars: build.PipelineInstanceVars}
+	return fmt.Sprintf("%s/%s #%s %s", pipelineRef, build.JobName, build.Name, build.Status)
+}
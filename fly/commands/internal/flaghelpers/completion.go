@@ -1,13 +1,24 @@
 package flaghelpers
 
 import (
+	"encoding/json"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
+// CompletionCacheTTLEnv overrides how long values fetched for completion are
+// reused, e.g. "2m". Setting it to "0" turns the cache off.
+const CompletionCacheTTLEnv = "FLY_COMPLETION_CACHE_TTL"
+
+const defaultCompletionCacheTTL = 30 * time.Second
+
 type flyCommand struct {
 	Target rc.TargetName `short:"t" long:"target" description:"Concourse target name"`
 }
@@ -29,3 +40,196 @@ func parseFlags() flyCommand {
 
 	return fly
 }
+
+// completionTarget loads the target being completed against, along with the
+// team given with --team, or the target's team otherwise. When validate is
+// set, the target is validated before anything is fetched from it.
+func completionTarget(validate bool) (completer, bool) {
+	fly := parseFlags()
+
+	target, err := rc.LoadTarget(fly.Target, false)
+	if err != nil {
+		return completer{}, false
+	}
+
+	team := target.Team()
+	if name := argValue("", "team"); name != "" {
+		team = target.Client().Team(name)
+	}
+
+	return completer{
+		targetName: fly.Target,
+		target:     target,
+		team:       team,
+		validate:   validate,
+	}, true
+}
+
+// argValue finds the value given to a flag of the command being completed.
+// Flags are otherwise only parsed once the command line is complete.
+func argValue(short string, long string) string {
+	args := os.Args[1:]
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+
+		var value string
+		switch {
+		case arg == "--"+long || (short != "" && arg == "-"+short):
+			if i+1 < len(args) {
+				value = args[i+1]
+			}
+		case strings.HasPrefix(arg, "--"+long+"="):
+			value = strings.TrimPrefix(arg, "--"+long+"=")
+		case short != "" && strings.HasPrefix(arg, "-"+short) && !strings.HasPrefix(arg, "--"):
+			value = strings.TrimPrefix(arg[2:], "=")
+		}
+
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+type completer struct {
+	targetName rc.TargetName
+	target     rc.Target
+	team       concourse.Team
+	validate   bool
+}
+
+// teamKey scopes a cache key to the team being completed against.
+func (c completer) teamKey(key string) string {
+	return c.team.Name() + "/" + key
+}
+
+// cached returns what fetch returned for the key, unless it was cached
+// recently by another completion for the same target. Completing a single
+// flag runs fly once for every press of tab, so without it each press would
+// wait on the API.
+func cached[T any](c completer, key string, fetch func() (T, error)) (T, error) {
+	if c.validate {
+		fetch = validated(c.target, fetch)
+	}
+
+	ttl := completionCacheTTL()
+	if ttl <= 0 {
+		return fetch()
+	}
+
+	path := rc.CompletionCachePath(c.targetName)
+
+	entries := map[string]completionCacheEntry{}
+	payload, err := os.ReadFile(path)
+	if err == nil {
+		// a corrupt cache is just refetched
+		_ = json.Unmarshal(payload, &entries)
+	}
+
+	var value T
+
+	entry, found := entries[key]
+	if found && time.Since(entry.FetchedAt) < ttl {
+		err := json.Unmarshal(entry.Value, &value)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	value, err = fetch()
+	if err != nil {
+		return value, err
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return value, nil
+	}
+
+	now := time.Now()
+	for key, entry := range entries {
+		if now.Sub(entry.FetchedAt) >= ttl {
+			delete(entries, key)
+		}
+	}
+
+	entries[key] = completionCacheEntry{
+		FetchedAt: now,
+		Value:     encoded,
+	}
+
+	// failing to cache only makes the next completion slower
+	_ = writeCompletionCache(path, entries)
+
+	return value, nil
+}
+
+// validated validates the target before fetching from it, so that a cached
+// completion doesn't have to ask the target for its version.
+func validated[T any](target rc.Target, fetch func() (T, error)) func() (T, error) {
+	return func() (T, error) {
+		err := target.Validate()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+
+		return fetch()
+	}
+}
+
+type completionCacheEntry struct {
+	FetchedAt time.Time       `json:"fetched_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+func completionCacheTTL() time.Duration {
+	raw, found := os.LookupEnv(CompletionCacheTTLEnv)
+	if !found {
+		return defaultCompletionCacheTTL
+	}
+
+	ttl, err := time.ParseDuration(raw)
+	if err != nil {
+		return defaultCompletionCacheTTL
+	}
+
+	return ttl
+}
+
+func writeCompletionCache(path string, entries map[string]completionCacheEntry) error {
+	payload, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	// completions for the same target can run at once, so the cache is
+	// replaced rather than written in place
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(payload)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
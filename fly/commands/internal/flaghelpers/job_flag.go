@@ -8,7 +8,6 @@ import (
 	"github.com/jessevdk/go-flags"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/rc"
 )
 
 type JobFlag struct {
@@ -47,35 +46,63 @@ func (flag *JobFlag) UnmarshalFlag(value string) error {
 }
 
 func (flag *JobFlag) Complete(match string) []flags.Completion {
-	fly := parseFlags()
-
-	target, err := rc.LoadTarget(fly.Target, false)
-	if err != nil {
+	c, ok := completionTarget(true)
+	if !ok {
 		return []flags.Completion{}
 	}
 
-	err = target.Validate()
-	if err != nil {
-		return []flags.Completion{}
-	}
+	return c.completeInPipeline(match, func(pipelineRef atc.PipelineRef) ([]string, error) {
+		return cached(c, c.teamKey("jobs/"+pipelineRef.String()), func() ([]string, error) {
+			jobs, err := c.team.ListJobs(pipelineRef)
+			if err != nil {
+				return nil, err
+			}
+
+			var names []string
+			for _, job := range jobs {
+				names = append(names, job.Name)
+			}
+
+			return names, nil
+		})
+	})
+}
 
-	team := target.Team()
+// completeInPipeline completes a <pipeline>/<name> value, first with the
+// pipeline (and its instance vars), then with the names in it.
+func (c completer) completeInPipeline(match string, names func(atc.PipelineRef) ([]string, error)) []flags.Completion {
 	var comps []flags.Completion
+
+	completeNames := func(pipelineRef atc.PipelineRef, prefix string) {
+		names, err := names(pipelineRef)
+		if err != nil {
+			return
+		}
+
+		for _, name := range names {
+			if strings.HasPrefix(name, prefix) {
+				comps = append(comps, flags.Completion{Item: fmt.Sprintf("%s/%s", pipelineRef.String(), name)})
+			}
+		}
+	}
+
 	vs := strings.SplitN(match, "/", 3)
 
 	if len(vs) == 1 {
-		pipelines, err := team.ListPipelines()
+		pipelines, err := c.pipelines()
 		if err != nil {
 			return comps
 		}
 
+		seen := map[string]bool{}
 		for _, pipeline := range pipelines {
-			if strings.HasPrefix(pipeline.Name, vs[0]) {
+			if strings.HasPrefix(pipeline.Name, vs[0]) && !seen[pipeline.Name] {
+				seen[pipeline.Name] = true
 				comps = append(comps, flags.Completion{Item: pipeline.Name + "/"})
 			}
 		}
 	} else if len(vs) == 2 {
-		pipelines, err := team.ListPipelines()
+		pipelines, err := c.pipelines()
 		if err != nil {
 			return comps
 		}
@@ -83,36 +110,20 @@ func (flag *JobFlag) Complete(match string) []flags.Completion {
 		pipelineRef, err := parsePipelineRef(vs[0], vs[1])
 		if err == nil {
 			for _, pipeline := range pipelines {
-				if strings.HasPrefix(pipeline.Ref().String(), pipelineRef.String()) {
-					comps = append(comps, flags.Completion{Item: pipeline.Ref().String() + "/"})
+				if strings.HasPrefix(pipeline.String(), pipelineRef.String()) {
+					comps = append(comps, flags.Completion{Item: pipeline.String() + "/"})
 				}
 			}
 		} else {
-			pipelineRef := atc.PipelineRef{Name: vs[0]}
-			jobs, err := team.ListJobs(pipelineRef)
-			if err != nil {
-				return comps
-			}
-			for _, job := range jobs {
-				if strings.HasPrefix(job.Name, vs[1]) {
-					comps = append(comps, flags.Completion{Item: fmt.Sprintf("%s/%s", pipelineRef.String(), job.Name)})
-				}
-			}
+			completeNames(atc.PipelineRef{Name: vs[0]}, vs[1])
 		}
 	} else if len(vs) == 3 {
 		pipelineRef, err := parsePipelineRef(vs[0], vs[1])
 		if err != nil {
 			return comps
 		}
-		jobs, err := team.ListJobs(pipelineRef)
-		if err != nil {
-			return comps
-		}
-		for _, job := range jobs {
-			if strings.HasPrefix(job.Name, vs[2]) {
-				comps = append(comps, flags.Completion{Item: fmt.Sprintf("%s/%s", pipelineRef.String(), job.Name)})
-			}
-		}
+
+		completeNames(pipelineRef, vs[2])
 	}
 
 	return comps
@@ -7,7 +7,6 @@ import (
 	"github.com/jessevdk/go-flags"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
@@ -60,29 +59,38 @@ func (flag *PipelineFlag) UnmarshalFlag(value string) error {
 }
 
 func (flag *PipelineFlag) Complete(match string) []flags.Completion {
-	fly := parseFlags()
-
-	target, err := rc.LoadTarget(fly.Target, false)
-	if err != nil {
+	c, ok := completionTarget(true)
+	if !ok {
 		return []flags.Completion{}
 	}
 
-	err = target.Validate()
-	if err != nil {
-		return []flags.Completion{}
-	}
-
-	pipelines, err := target.Team().ListPipelines()
+	pipelines, err := c.pipelines()
 	if err != nil {
 		return []flags.Completion{}
 	}
 
 	comps := []flags.Completion{}
 	for _, pipeline := range pipelines {
-		if strings.HasPrefix(pipeline.Ref().String(), match) {
-			comps = append(comps, flags.Completion{Item: pipeline.Ref().String()})
+		if strings.HasPrefix(pipeline.String(), match) {
+			comps = append(comps, flags.Completion{Item: pipeline.String()})
 		}
 	}
 
 	return comps
 }
+
+func (c completer) pipelines() ([]atc.PipelineRef, error) {
+	return cached(c, c.teamKey("pipelines"), func() ([]atc.PipelineRef, error) {
+		pipelines, err := c.team.ListPipelines()
+		if err != nil {
+			return nil, err
+		}
+
+		var refs []atc.PipelineRef
+		for _, pipeline := range pipelines {
+			refs = append(refs, pipeline.Ref())
+		}
+
+		return refs, nil
+	})
+}
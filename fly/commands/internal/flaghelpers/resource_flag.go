@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jessevdk/go-flags"
+
 	"github.com/concourse/concourse/atc"
 )
 
@@ -42,3 +44,26 @@ func (flag *ResourceFlag) UnmarshalFlag(value string) error {
 
 	return nil
 }
+
+func (flag *ResourceFlag) Complete(match string) []flags.Completion {
+	c, ok := completionTarget(true)
+	if !ok {
+		return []flags.Completion{}
+	}
+
+	return c.completeInPipeline(match, func(pipelineRef atc.PipelineRef) ([]string, error) {
+		return cached(c, c.teamKey("resources/"+pipelineRef.String()), func() ([]string, error) {
+			resources, err := c.team.ListResources(pipelineRef)
+			if err != nil {
+				return nil, err
+			}
+
+			var names []string
+			for _, resource := range resources {
+				names = append(names, resource.Name)
+			}
+
+			return names, nil
+		})
+	})
+}
@@ -12,22 +12,32 @@ import (
 type TeamFlag string
 
 func (flag *TeamFlag) Complete(match string) []flags.Completion {
-	fly := parseFlags()
-
-	target, err := rc.LoadTarget(fly.Target, false)
-	if err != nil {
+	c, ok := completionTarget(false)
+	if !ok {
 		return []flags.Completion{}
 	}
 
-	teams, err := target.Client().ListTeams()
+	teams, err := cached(c, "teams", func() ([]string, error) {
+		teams, err := c.target.Client().ListTeams()
+		if err != nil {
+			return nil, err
+		}
+
+		var names []string
+		for _, team := range teams {
+			names = append(names, team.Name)
+		}
+
+		return names, nil
+	})
 	if err != nil {
 		return []flags.Completion{}
 	}
 
 	comps := []flags.Completion{}
 	for _, team := range teams {
-		if strings.HasPrefix(team.Name, match) {
-			comps = append(comps, flags.Completion{Item: team.Name})
+		if strings.HasPrefix(team, match) {
+			comps = append(comps, flags.Completion{Item: team})
 		}
 	}
 
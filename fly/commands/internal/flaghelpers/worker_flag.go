@@ -3,29 +3,38 @@ package flaghelpers
 import (
 	"strings"
 
-	"github.com/concourse/concourse/fly/rc"
 	"github.com/jessevdk/go-flags"
 )
 
 type WorkerFlag string
 
 func (flag *WorkerFlag) Complete(match string) []flags.Completion {
-	fly := parseFlags()
-
-	target, err := rc.LoadTarget(fly.Target, false)
-	if err != nil {
+	c, ok := completionTarget(false)
+	if !ok {
 		return []flags.Completion{}
 	}
 
-	workers, err := target.Client().ListWorkers()
+	workers, err := cached(c, "workers", func() ([]string, error) {
+		workers, err := c.target.Client().ListWorkers()
+		if err != nil {
+			return nil, err
+		}
+
+		var names []string
+		for _, worker := range workers {
+			names = append(names, worker.Name)
+		}
+
+		return names, nil
+	})
 	if err != nil {
 		return []flags.Completion{}
 	}
 
 	comps := []flags.Completion{}
 	for _, worker := range workers {
-		if strings.HasPrefix(worker.Name, match) {
-			comps = append(comps, flags.Completion{Item: worker.Name})
+		if strings.HasPrefix(worker, match) {
+			comps = append(comps, flags.Completion{Item: worker})
 		}
 	}
 
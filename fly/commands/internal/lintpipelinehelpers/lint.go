@@ -0,0 +1,255 @@
+package lintpipelinehelpers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/concourse/concourse"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configlint"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	FormatText  = "text"
+	FormatJSON  = "json"
+	FormatSARIF = "sarif"
+)
+
+// Formats are the formats findings can be written in.
+var Formats = []string{FormatText, FormatJSON, FormatSARIF}
+
+// ErrFindings is returned when the pipeline has error or warning findings,
+// so that fly exits non-zero. Notes alone don't fail the lint.
+var ErrFindings = errors.New("pipeline has lint findings")
+
+// Lint lints the pipeline config and writes its findings to dst. The config
+// doesn't have to be valid, so that everything wrong with it can be reported
+// at once; validate-pipeline checks that it can be set. The config path is
+// where SARIF results are located.
+func Lint(dst io.Writer, yamlTemplate templatehelpers.YamlTemplateWithParams, configPath string, disabled []string, format string) error {
+	for _, id := range disabled {
+		if _, found := configlint.LookupRule(id); !found {
+			return fmt.Errorf("unknown lint rule '%s'", id)
+		}
+	}
+
+	evaluatedTemplate, err := yamlTemplate.Evaluate(true, false)
+	if err != nil {
+		return err
+	}
+
+	var config atc.Config
+	if err := yaml.Unmarshal(evaluatedTemplate, &config); err != nil {
+		return err
+	}
+
+	findings := configlint.Lint(config, disabled...)
+
+	err = Write(dst, configPath, findings, format)
+	if err != nil {
+		return err
+	}
+
+	for _, finding := range findings {
+		if finding.Level != configlint.LevelNote {
+			return ErrFindings
+		}
+	}
+
+	return nil
+}
+
+// Write writes the findings in the format.
+func Write(dst io.Writer, configPath string, findings []configlint.Finding, format string) error {
+	switch format {
+	case FormatText:
+		return writeText(dst, findings)
+	case FormatJSON:
+		enc := json.NewEncoder(dst)
+		enc.SetIndent("", "  ")
+		return enc.Encode(findings)
+	case FormatSARIF:
+		enc := json.NewEncoder(dst)
+		enc.SetIndent("", "  ")
+		return enc.Encode(sarifLog(configPath, findings))
+	default:
+		return fmt.Errorf("unknown format '%s' (must be one of %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// ListRules writes the catalog of rules.
+func ListRules(dst io.Writer) error {
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "rule", Color: color.New(color.Bold)},
+			{Contents: "level", Color: color.New(color.Bold)},
+			{Contents: "description", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, rule := range configlint.Rules {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: rule.ID},
+			levelCell(rule.Level),
+			{Contents: rule.Description},
+		})
+	}
+
+	return table.Render(dst, true)
+}
+
+func writeText(dst io.Writer, findings []configlint.Finding) error {
+	if len(findings) == 0 {
+		_, err := fmt.Fprintln(dst, "looks good")
+		return err
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "level", Color: color.New(color.Bold)},
+			{Contents: "rule", Color: color.New(color.Bold)},
+			{Contents: "location", Color: color.New(color.Bold)},
+			{Contents: "message", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, finding := range findings {
+		table.Data = append(table.Data, ui.TableRow{
+			levelCell(finding.Level),
+			{Contents: finding.RuleID},
+			{Contents: finding.Location},
+			{Contents: finding.Message},
+		})
+	}
+
+	return table.Render(dst, true)
+}
+
+func levelCell(level configlint.Level) ui.TableCell {
+	cell := ui.TableCell{Contents: string(level)}
+
+	switch level {
+	case configlint.LevelError:
+		cell.Color = ui.ErroredColor
+	case configlint.LevelWarning:
+		cell.Color = ui.StartedColor
+	default:
+		cell.Color = ui.PendingColor
+	}
+
+	return cell
+}
+
+// the subset of SARIF 2.1.0 that code review tools need to show findings
+type sarif struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level configlint.Level `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string           `json:"ruleId"`
+	RuleIndex int              `json:"ruleIndex"`
+	Level     configlint.Level `json:"level"`
+	Message   sarifMessage     `json:"message"`
+	Locations []sarifLocation  `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	LogicalLocations []sarifLogical        `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifact `json:"artifactLocation"`
+}
+
+type sarifArtifact struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogical struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+func sarifLog(configPath string, findings []configlint.Finding) sarif {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "fly",
+				Version:        concourse.Version,
+				InformationURI: "https://concourse-ci.org",
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	ruleIndex := map[string]int{}
+	for i, rule := range configlint.Rules {
+		ruleIndex[rule.ID] = i
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifConfiguration{Level: rule.Level},
+		})
+	}
+
+	for _, finding := range findings {
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    finding.RuleID,
+			RuleIndex: ruleIndex[finding.RuleID],
+			Level:     finding.Level,
+			Message:   sarifMessage{Text: finding.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifact{URI: configPath},
+				},
+				LogicalLocations: []sarifLogical{{FullyQualifiedName: finding.Location}},
+			}},
+		})
+	}
+
+	return sarif{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+}
@@ -0,0 +1,144 @@
+package lintpipelinehelpers_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc/configlint"
+	"github.com/concourse/concourse/fly/commands/internal/lintpipelinehelpers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Write", func() {
+	var (
+		findings []configlint.Finding
+		format   string
+
+		out *bytes.Buffer
+		err error
+	)
+
+	BeforeEach(func() {
+		findings = []configlint.Finding{
+			{
+				RuleID:   "privileged-task",
+				Level:    configlint.LevelWarning,
+				Location: "jobs.unit.task(test)",
+				Message:  "task 'test' is privileged",
+			},
+		}
+
+		out = new(bytes.Buffer)
+	})
+
+	JustBeforeEach(func() {
+		err = lintpipelinehelpers.Write(out, "ci/pipeline.yml", findings, format)
+	})
+
+	Context("as JSON", func() {
+		BeforeEach(func() {
+			format = lintpipelinehelpers.FormatJSON
+		})
+
+		It("writes the findings", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out.String()).To(MatchJSON(`[
+				{
+					"rule_id": "privileged-task",
+					"level": "warning",
+					"location": "jobs.unit.task(test)",
+					"message": "task 'test' is privileged"
+				}
+			]`))
+		})
+
+		Context("when there are no findings", func() {
+			BeforeEach(func() {
+				findings = []configlint.Finding{}
+			})
+
+			It("writes an empty list", func() {
+				Expect(out.String()).To(MatchJSON(`[]`))
+			})
+		})
+	})
+
+	Context("as SARIF", func() {
+		BeforeEach(func() {
+			format = lintpipelinehelpers.FormatSARIF
+		})
+
+		It("writes a run with the rule catalog and results", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			var log struct {
+				Version string `json:"version"`
+				Runs    []struct {
+					Tool struct {
+						Driver struct {
+							Name  string `json:"name"`
+							Rules []struct {
+								ID string `json:"id"`
+							} `json:"rules"`
+						} `json:"driver"`
+					} `json:"tool"`
+					Results []json.RawMessage `json:"results"`
+				} `json:"runs"`
+			}
+			Expect(json.Unmarshal(out.Bytes(), &log)).To(Succeed())
+
+			Expect(log.Version).To(Equal("2.1.0"))
+			Expect(log.Runs).To(HaveLen(1))
+			Expect(log.Runs[0].Tool.Driver.Name).To(Equal("fly"))
+			Expect(log.Runs[0].Tool.Driver.Rules).To(HaveLen(len(configlint.Rules)))
+			Expect(log.Runs[0].Results).To(HaveLen(1))
+
+			Expect(string(log.Runs[0].Results[0])).To(MatchJSON(`{
+				"ruleId": "privileged-task",
+				"ruleIndex": 4,
+				"level": "warning",
+				"message": {"text": "task 'test' is privileged"},
+				"locations": [
+					{
+						"physicalLocation": {"artifactLocation": {"uri": "ci/pipeline.yml"}},
+						"logicalLocations": [{"fullyQualifiedName": "jobs.unit.task(test)"}]
+					}
+				]
+			}`))
+		})
+	})
+
+	Context("as text", func() {
+		BeforeEach(func() {
+			format = lintpipelinehelpers.FormatText
+		})
+
+		It("writes a table of findings", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out.String()).To(ContainSubstring("privileged-task"))
+			Expect(out.String()).To(ContainSubstring("jobs.unit.task(test)"))
+		})
+
+		Context("when there are no findings", func() {
+			BeforeEach(func() {
+				findings = nil
+			})
+
+			It("says so", func() {
+				Expect(out.String()).To(Equal("looks good\n"))
+			})
+		})
+	})
+
+	Context("in an unknown format", func() {
+		BeforeEach(func() {
+			format = "xml"
+		})
+
+		It("errors", func() {
+			Expect(err).To(MatchError("unknown format 'xml' (must be one of text, json, sarif)"))
+		})
+	})
+})
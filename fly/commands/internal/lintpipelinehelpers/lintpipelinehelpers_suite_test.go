@@ -0,0 +1,13 @@
+package lintpipelinehelpers_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLintpipelinehelpers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Lint-Pipeline Helpers Suite")
+}
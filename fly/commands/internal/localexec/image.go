@@ -0,0 +1,44 @@
+package localexec
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/containerd/containerd/reference/docker"
+)
+
+var ErrNoImage = errors.New("task has no image_resource; specify an image with --local-image")
+
+// imageRef determines the reference of the image configured by a task's
+// registry-image or docker-image image_resource.
+func imageRef(resource *atc.ImageResource) (string, error) {
+	if resource == nil {
+		return "", ErrNoImage
+	}
+
+	if resource.Type != "registry-image" && resource.Type != "docker-image" {
+		return "", fmt.Errorf("image_resource of type '%s' is not supported in local mode", resource.Type)
+	}
+
+	repository, _ := resource.Source["repository"].(string)
+	if repository == "" {
+		return "", errors.New("image_resource has no repository")
+	}
+
+	ref := repository
+	if digest, found := resource.Version["digest"]; found {
+		ref += "@" + digest
+	} else if tag, found := resource.Source["tag"]; found {
+		ref += ":" + fmt.Sprint(tag)
+	} else {
+		ref += ":latest"
+	}
+
+	named, err := docker.ParseDockerRef(ref)
+	if err != nil {
+		return "", fmt.Errorf("image_resource: %w", err)
+	}
+
+	return named.String(), nil
+}
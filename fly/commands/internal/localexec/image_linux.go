@@ -0,0 +1,169 @@
+//go:build linux
+// +build linux
+
+package localexec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/archive"
+	"github.com/containerd/containerd/archive/compression"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/images"
+	imagearchive "github.com/containerd/containerd/images/archive"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// fetchImage fetches the task's image into containerd's content store and
+// unpacks it onto rootfs.
+func (runner *Runner) fetchImage(ctx context.Context, task Task, rootfs string) (ImageConfig, error) {
+	target, err := runner.resolveImage(ctx, task.Config.ImageResource, task.ImageTarball)
+	if err != nil {
+		return ImageConfig{}, err
+	}
+
+	return unpackImage(ctx, runner.client.ContentStore(), target, rootfs)
+}
+
+// fetchImageResource fetches an image into dir the way the registry-image
+// resource does: unpacked onto a rootfs directory, with its config written
+// to a metadata.json file next to it.
+func (runner *Runner) fetchImageResource(ctx context.Context, image atc.ImageResource, dir string) error {
+	target, err := runner.resolveImage(ctx, &image, "")
+	if err != nil {
+		return err
+	}
+
+	config, err := unpackImage(ctx, runner.client.ContentStore(), target, filepath.Join(dir, "rootfs"))
+	if err != nil {
+		return err
+	}
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		"env":  config.Env,
+		"user": config.User,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, "metadata.json"), metadata, 0644)
+}
+
+func (runner *Runner) resolveImage(ctx context.Context, image *atc.ImageResource, imageTarball string) (specs.Descriptor, error) {
+	if imageTarball != "" {
+		tarball, err := os.Open(imageTarball)
+		if err != nil {
+			return specs.Descriptor{}, err
+		}
+
+		defer tarball.Close()
+
+		imgs, err := runner.client.Import(ctx, tarball,
+			containerd.WithDigestRef(imagearchive.DigestTranslator("fly-local")),
+		)
+		if err != nil {
+			return specs.Descriptor{}, fmt.Errorf("import image: %w", err)
+		}
+
+		if len(imgs) == 0 {
+			return specs.Descriptor{}, fmt.Errorf("no image found in %s", imageTarball)
+		}
+
+		return imgs[0].Target, nil
+	}
+
+	ref, err := imageRef(image)
+	if err != nil {
+		return specs.Descriptor{}, err
+	}
+
+	img, err := runner.client.Pull(ctx, ref,
+		containerd.WithResolver(registryResolver(image.Source)),
+	)
+	if err != nil {
+		return specs.Descriptor{}, fmt.Errorf("pull image: %w", err)
+	}
+
+	return img.Target(), nil
+}
+
+// registryResolver authenticates with the credentials in the source of a
+// registry-image image_resource, if any.
+func registryResolver(source atc.Source) remotes.Resolver {
+	username, _ := source["username"].(string)
+	password, _ := source["password"].(string)
+
+	authorizer := docker.NewDockerAuthorizer(
+		docker.WithAuthCreds(func(string) (string, string, error) {
+			return username, password, nil
+		}),
+	)
+
+	return docker.NewResolver(docker.ResolverOptions{
+		Hosts: docker.ConfigureDefaultRegistries(docker.WithAuthorizer(authorizer)),
+	})
+}
+
+func unpackImage(ctx context.Context, store content.Store, target specs.Descriptor, rootfs string) (ImageConfig, error) {
+	manifest, err := images.Manifest(ctx, store, target, platforms.Default())
+	if err != nil {
+		return ImageConfig{}, err
+	}
+
+	err = os.MkdirAll(rootfs, 0755)
+	if err != nil {
+		return ImageConfig{}, err
+	}
+
+	for _, layer := range manifest.Layers {
+		err := applyLayer(ctx, store, layer, rootfs)
+		if err != nil {
+			return ImageConfig{}, fmt.Errorf("apply layer %s: %w", layer.Digest, err)
+		}
+	}
+
+	blob, err := content.ReadBlob(ctx, store, manifest.Config)
+	if err != nil {
+		return ImageConfig{}, err
+	}
+
+	var config specs.Image
+	err = json.Unmarshal(blob, &config)
+	if err != nil {
+		return ImageConfig{}, fmt.Errorf("parse image config: %w", err)
+	}
+
+	return ImageConfig{
+		Env:  config.Config.Env,
+		User: config.Config.User,
+	}, nil
+}
+
+func applyLayer(ctx context.Context, store content.Store, layer specs.Descriptor, dest string) error {
+	blob, err := store.ReaderAt(ctx, layer)
+	if err != nil {
+		return err
+	}
+
+	defer blob.Close()
+
+	decompressed, err := compression.DecompressStream(content.NewReader(blob))
+	if err != nil {
+		return err
+	}
+
+	defer decompressed.Close()
+
+	_, err = archive.Apply(ctx, dest, decompressed)
+	return err
+}
@@ -0,0 +1,52 @@
+package localexec
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("imageRef", func() {
+	It("defaults to the latest tag on Docker Hub", func() {
+		Expect(imageRef(&atc.ImageResource{
+			Type:   "registry-image",
+			Source: atc.Source{"repository": "busybox"},
+		})).To(Equal("docker.io/library/busybox:latest"))
+	})
+
+	It("uses the tag from the source", func() {
+		Expect(imageRef(&atc.ImageResource{
+			Type:   "docker-image",
+			Source: atc.Source{"repository": "registry.example.com/some/image", "tag": 1.2},
+		})).To(Equal("registry.example.com/some/image:1.2"))
+	})
+
+	It("pins the digest from the version", func() {
+		digest := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+		Expect(imageRef(&atc.ImageResource{
+			Type:    "registry-image",
+			Source:  atc.Source{"repository": "busybox", "tag": "1.36"},
+			Version: atc.Version{"digest": digest},
+		})).To(Equal("docker.io/library/busybox@" + digest))
+	})
+
+	It("errors without an image_resource", func() {
+		_, err := imageRef(nil)
+		Expect(err).To(Equal(ErrNoImage))
+	})
+
+	It("errors for other resource types", func() {
+		_, err := imageRef(&atc.ImageResource{
+			Type:   "s3",
+			Source: atc.Source{"repository": "busybox"},
+		})
+		Expect(err).To(MatchError("image_resource of type 's3' is not supported in local mode"))
+	})
+
+	It("errors without a repository", func() {
+		_, err := imageRef(&atc.ImageResource{Type: "registry-image"})
+		Expect(err).To(MatchError("image_resource has no repository"))
+	})
+})
@@ -0,0 +1,337 @@
+package localexec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/builds"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/worker/localruntime"
+	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/vars"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Job is a job of a pipeline to be run on the local runtime.
+type Job struct {
+	Pipeline     atc.Config
+	PipelineName string
+	Name         string
+
+	// Inputs map the names of resources to directories on the host, which
+	// stand in for the versions their get steps would fetch.
+	Inputs map[string]string
+
+	Vars vars.StaticVariables
+}
+
+// imageFetcher fetches the image of an image resource into dir, laid out as
+// by the registry-image resource.
+type imageFetcher func(ctx context.Context, image atc.ImageResource, dir string) error
+
+// JobBuild is a build of a job on the local runtime. Its plan is created by
+// the same planner as builds on a cluster, and run by the same steps, except
+// for gets, which use local directories, and puts, which are only dry runs.
+//
+// Its events can be read as they are emitted, to be rendered like those of
+// any other build.
+type JobBuild struct {
+	job  Job
+	plan atc.Plan
+
+	events    chan atc.Event
+	closeOnce sync.Once
+}
+
+func NewJobBuild(job Job) (*JobBuild, error) {
+	jobConfig, found := job.Pipeline.Jobs.Lookup(job.Name)
+	if !found {
+		return nil, fmt.Errorf("job '%s' not found in pipeline", job.Name)
+	}
+
+	var resources db.SchedulerResources
+	for _, resource := range job.Pipeline.Resources {
+		schedulerResource := db.SchedulerResource{
+			Name:                 resource.Name,
+			Type:                 resource.Type,
+			Source:               resource.Source,
+			ExposeBuildCreatedBy: resource.ExposeBuildCreatedBy,
+		}
+
+		schedulerResource.ApplySourceDefaults(job.Pipeline.ResourceTypes)
+
+		resources = append(resources, schedulerResource)
+	}
+
+	var inputs []db.BuildInput
+	for _, input := range jobConfig.Inputs() {
+		resource, found := resources.Lookup(input.Resource)
+		if !found {
+			return nil, fmt.Errorf("resource '%s' not found in pipeline", input.Resource)
+		}
+
+		// the planner only needs to know that there is a version; which
+		// one doesn't matter as it's never fetched
+		version := atc.Version{}
+		if dir, found := job.Inputs[input.Resource]; found {
+			version = atc.Version{"path": dir}
+		} else if !isImageType(resource.Type) {
+			return nil, fmt.Errorf("no local directory given for resource '%s' (use -i %s=PATH)", input.Resource, input.Resource)
+		}
+
+		inputs = append(inputs, db.BuildInput{
+			Name:    input.Name,
+			Version: version,
+		})
+	}
+
+	plan, err := builds.NewPlanner(atc.NewPlanFactory(time.Now().Unix())).Create(
+		jobConfig.StepConfig(),
+		resources,
+		job.Pipeline.ResourceTypes,
+		job.Pipeline.Prototypes,
+		job.Pipeline.Egress,
+		inputs,
+		true,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create build plan: %w", err)
+	}
+
+	// puts are dry runs, so there are no outputs to attest to
+	var provenanceErr error
+	plan.Each(func(p *atc.Plan) {
+		if p.Put != nil && p.Put.Provenance != "" && provenanceErr == nil {
+			provenanceErr = fmt.Errorf("put '%s' requests provenance, which is not generated when running locally", p.Put.Name)
+		}
+	})
+	if provenanceErr != nil {
+		return nil, provenanceErr
+	}
+
+	return &JobBuild{
+		job:  job,
+		plan: plan,
+
+		events: make(chan atc.Event, 100),
+	}, nil
+}
+
+func (jobBuild *JobBuild) Plan() atc.Plan {
+	return jobBuild.plan
+}
+
+// NextEvent returns the next event emitted by the build, or io.EOF once the
+// build has finished.
+func (jobBuild *JobBuild) NextEvent() (atc.Event, error) {
+	ev, ok := <-jobBuild.events
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return ev, nil
+}
+
+func (jobBuild *JobBuild) Close() error {
+	return nil
+}
+
+// finish ends the stream of events, if it hasn't ended already.
+func (jobBuild *JobBuild) finish() {
+	jobBuild.closeOnce.Do(func() {
+		close(jobBuild.events)
+	})
+}
+
+// run runs the build's plan on the worker, finishing with a status event
+// just as a build on a cluster does. Everything the build created on the
+// worker is removed afterwards.
+func (jobBuild *JobBuild) run(ctx context.Context, worker *localruntime.Worker, fetchImage imageFetcher) error {
+	defer jobBuild.finish()
+
+	build := &localBuild{
+		job:       jobBuild.job,
+		plan:      jobBuild.plan,
+		startTime: time.Now(),
+		events:    jobBuild.events,
+	}
+
+	stepperFactory := engine.NewStepperFactory(
+		newCoreStepFactory(jobBuild.job, worker, fetchImage),
+		"",
+		nil,
+		policy.NoopChecker{},
+		nil,
+		nil,
+		nil,
+	)
+
+	stepper, err := stepperFactory.StepperForBuild(build)
+	if err != nil {
+		return err
+	}
+
+	logger := lager.NewLogger("fly-local")
+	state := exec.NewRunState(stepper, jobBuild.job.Vars, false)
+
+	succeeded, runErr := state.Run(lagerctx.NewContext(ctx, logger), jobBuild.plan)
+
+	var status atc.BuildStatus
+	if errors.Is(runErr, context.Canceled) {
+		status = atc.StatusAborted
+	} else if runErr != nil {
+		status = atc.StatusErrored
+	} else if succeeded {
+		status = atc.StatusSucceeded
+	} else {
+		status = atc.StatusFailed
+	}
+
+	build.finish(db.BuildStatus(status))
+
+	build.SaveEvent(event.Status{
+		Status: status,
+		Time:   time.Now().Unix(),
+	})
+
+	return worker.Cleanup()
+}
+
+// localBuild stands in for the build in the database, which the steps and
+// their delegates report to. Only the methods they call are implemented;
+// events are passed on rather than saved, and the rest fail through
+// unsupportedBuild.
+type localBuild struct {
+	unsupportedBuild
+
+	job       Job
+	plan      atc.Plan
+	startTime time.Time
+	events    chan<- atc.Event
+
+	statusLock sync.Mutex
+	status     db.BuildStatus
+	endTime    time.Time
+}
+
+func (build *localBuild) ID() int                                         { return 0 }
+func (build *localBuild) Name() string                                    { return "local" }
+func (build *localBuild) TeamID() int                                     { return 0 }
+func (build *localBuild) TeamName() string                                { return "main" }
+func (build *localBuild) JobID() int                                      { return 0 }
+func (build *localBuild) JobName() string                                 { return build.job.Name }
+func (build *localBuild) PipelineID() int                                 { return 0 }
+func (build *localBuild) PipelineName() string                            { return build.job.PipelineName }
+func (build *localBuild) PipelineInstanceVars() atc.InstanceVars          { return nil }
+func (build *localBuild) ResourceID() int                                 { return 0 }
+func (build *localBuild) Schema() string                                  { return "exec.v2" }
+func (build *localBuild) StartTime() time.Time                            { return build.startTime }
+func (build *localBuild) CreatedBy() *string                              { return nil }
+func (build *localBuild) TracingAttrs() tracing.Attrs                     { return tracing.Attrs{} }
+func (build *localBuild) SaveImageResourceVersion(db.ResourceCache) error { return nil }
+func (build *localBuild) RunStateID() string                              { return "build:local" }
+func (build *localBuild) AllAssociatedTeamNames() []string                { return []string{build.TeamName()} }
+func (build *localBuild) PrivatePlan() atc.Plan                           { return build.plan }
+func (build *localBuild) PublicPlan() *json.RawMessage                    { return build.plan.Public() }
+func (build *localBuild) HasPlan() bool                                   { return true }
+func (build *localBuild) CreateTime() time.Time                           { return build.startTime }
+func (build *localBuild) IsManuallyTriggered() bool                       { return true }
+func (build *localBuild) IsScheduled() bool                               { return true }
+func (build *localBuild) InputsReady() bool                               { return true }
+func (build *localBuild) Reload() (bool, error)                           { return true, nil }
+func (build *localBuild) IsAborted() bool                                 { return false }
+func (build *localBuild) SpanContext() propagation.TextMapCarrier         { return propagation.MapCarrier{} }
+func (build *localBuild) ResourceCacheUser() db.ResourceCacheUser         { return db.ForBuild(build.ID()) }
+
+func (build *localBuild) PipelineRef() atc.PipelineRef {
+	return atc.PipelineRef{Name: build.job.PipelineName}
+}
+
+func (build *localBuild) LagerData() lager.Data {
+	return lager.Data{
+		"build":    build.Name(),
+		"team":     build.TeamName(),
+		"pipeline": build.PipelineName(),
+		"job":      build.JobName(),
+	}
+}
+
+func (build *localBuild) SyslogTag(origin event.OriginID) string {
+	return strings.Join([]string{build.TeamName(), build.PipelineName(), build.JobName(), build.Name(), origin.String()}, "/")
+}
+
+// Variables are the ones given on the command line, as there are no var
+// sources to fetch them from.
+func (build *localBuild) Variables(lager.Logger, creds.Secrets, creds.VarSourcePool) (vars.Variables, error) {
+	return build.job.Vars, nil
+}
+
+func (build *localBuild) finish(status db.BuildStatus) {
+	build.statusLock.Lock()
+	defer build.statusLock.Unlock()
+
+	build.status = status
+	build.endTime = time.Now()
+}
+
+func (build *localBuild) Status() db.BuildStatus {
+	build.statusLock.Lock()
+	defer build.statusLock.Unlock()
+
+	if build.status == "" {
+		return db.BuildStatusStarted
+	}
+
+	return build.status
+}
+
+func (build *localBuild) EndTime() time.Time {
+	build.statusLock.Lock()
+	defer build.statusLock.Unlock()
+
+	return build.endTime
+}
+
+func (build *localBuild) IsRunning() bool {
+	return !build.IsCompleted()
+}
+
+func (build *localBuild) IsCompleted() bool {
+	return build.Status() != db.BuildStatusStarted
+}
+
+func (build *localBuild) ContainerOwner(planID atc.PlanID) db.ContainerOwner {
+	return db.NewBuildStepContainerOwner(0, planID, 0)
+}
+
+// AddAnnotations has nowhere to record the annotations, but succeeds so that
+// the task delegate goes on to pass them on in an event.
+func (build *localBuild) AddAnnotations(atc.BuildAnnotations) error {
+	return nil
+}
+
+// SaveTestResults has nowhere to record the results either. The task step
+// goes on to annotate the build with their counts, which is how they're
+// shown.
+func (build *localBuild) SaveTestResults(string, []atc.TestResult) error {
+	return nil
+}
+
+func (build *localBuild) SaveEvent(ev atc.Event) error {
+	build.events <- ev
+	return nil
+}
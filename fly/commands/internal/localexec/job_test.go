@@ -0,0 +1,515 @@
+package localexec
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/worker/localruntime"
+	"github.com/concourse/concourse/vars"
+	"sigs.k8s.io/yaml"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const pipelineYAML = `
+resources:
+- name: repo
+  type: git
+  source: {uri: https://example.com/repo.git}
+- name: image
+  type: registry-image
+  source: {repository: busybox}
+- name: notify
+  type: slack-notification
+  source: {url: https://example.com/hook}
+
+jobs:
+- name: unit
+  plan:
+  - get: repo
+    trigger: true
+  - task: test
+    config:
+      platform: linux
+      image_resource:
+        type: registry-image
+        source: {repository: golang}
+      inputs: [{name: repo}]
+      run: {path: go, args: [test, ./...]}
+    on_failure:
+      put: notify
+      params: {text: failed}
+  - put: repo
+    params: {repository: repo}
+
+- name: uses-image
+  plan:
+  - get: image
+  - task: test
+    image: image
+    config:
+      platform: linux
+      run: {path: "true"}
+
+- name: across
+  plan:
+  - task: greet
+    across:
+    - var: greeting
+      values: [hello, goodbye]
+    config: &echo
+      platform: linux
+      image_resource:
+        type: registry-image
+        source: {repository: busybox}
+      run: {path: echo, args: ["((.:greeting))"]}
+
+- name: try
+  plan:
+  - try:
+      task: fail
+      config:
+        <<: *echo
+        run: {path: "false"}
+
+- name: retry
+  plan:
+  - task: flaky
+    attempts: 3
+    config:
+      <<: *echo
+      run: {path: flaky}
+
+- name: annotate
+  plan:
+  - task: annotate
+    config:
+      <<: *echo
+      run: {path: annotate}
+
+- name: report
+  plan:
+  - task: test
+    reports: [results/junit.xml]
+    config:
+      <<: *echo
+      outputs: [{name: results}]
+      run: {path: test}
+
+- name: provenance
+  plan:
+  - put: repo
+    provenance: attestation
+
+- name: load-var
+  plan:
+  - get: repo
+  - load_var: version
+    file: repo/version.txt
+  - task: show
+    config:
+      <<: *echo
+      run: {path: echo, args: ["((.:version))"]}
+`
+
+var _ = Describe("JobBuild", func() {
+	var (
+		dir    string
+		config atc.Config
+		job    Job
+
+		fakeBackend   *gardenfakes.FakeClient
+		fakeContainer *gardenfakes.FakeContainer
+		fakeProcess   *gardenfakes.FakeProcess
+		fetchedImages []atc.ImageResource
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "local-job")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(yaml.Unmarshal([]byte(pipelineYAML), &config)).To(Succeed())
+
+		repo := filepath.Join(dir, "repo")
+		Expect(os.MkdirAll(repo, 0755)).To(Succeed())
+
+		job = Job{
+			Pipeline:     config,
+			PipelineName: "some-pipeline",
+			Name:         "unit",
+			Inputs:       map[string]string{"repo": repo},
+		}
+
+		fakeProcess = new(gardenfakes.FakeProcess)
+		fakeContainer = new(gardenfakes.FakeContainer)
+		fakeContainer.RunReturns(fakeProcess, nil)
+		fakeBackend = new(gardenfakes.FakeClient)
+		fakeBackend.CreateReturns(fakeContainer, nil)
+
+		fetchedImages = nil
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	fetchImage := func(ctx context.Context, image atc.ImageResource, dir string) error {
+		fetchedImages = append(fetchedImages, image)
+		return os.MkdirAll(filepath.Join(dir, "rootfs"), 0755)
+	}
+
+	run := func(jobBuild *JobBuild) []atc.Event {
+		worker := localruntime.NewWorker("local", fakeBackend, filepath.Join(dir, "worker"))
+
+		runErr := make(chan error, 1)
+		go func() {
+			runErr <- jobBuild.run(context.Background(), worker, fetchImage)
+		}()
+
+		var events []atc.Event
+		for {
+			ev, err := jobBuild.NextEvent()
+			if err != nil {
+				break
+			}
+
+			events = append(events, ev)
+		}
+
+		Expect(<-runErr).To(Succeed())
+
+		return events
+	}
+
+	logs := func(events []atc.Event) string {
+		var output string
+		for _, ev := range events {
+			if log, ok := ev.(event.Log); ok {
+				output += log.Payload
+			}
+		}
+		return output
+	}
+
+	Describe("NewJobBuild", func() {
+		It("fails for unknown jobs", func() {
+			job.Name = "bogus"
+
+			_, err := NewJobBuild(job)
+			Expect(err).To(MatchError("job 'bogus' not found in pipeline"))
+		})
+
+		It("requires a directory for inputs that can't be fetched locally", func() {
+			job.Inputs = nil
+
+			_, err := NewJobBuild(job)
+			Expect(err).To(MatchError("no local directory given for resource 'repo' (use -i repo=PATH)"))
+		})
+
+		It("does not require a directory for registry-image inputs", func() {
+			job.Name = "uses-image"
+			job.Inputs = nil
+
+			_, err := NewJobBuild(job)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("fails for puts requesting provenance", func() {
+			job.Name = "provenance"
+
+			_, err := NewJobBuild(job)
+			Expect(err).To(MatchError("put 'repo' requests provenance, which is not generated when running locally"))
+		})
+
+		It("creates the plan with the server's planner", func() {
+			jobBuild, err := NewJobBuild(job)
+			Expect(err).ToNot(HaveOccurred())
+
+			plan := jobBuild.Plan()
+			Expect(plan.Do).ToNot(BeNil())
+			Expect((*plan.Do)[0].Get.Name).To(Equal("repo"))
+			Expect((*plan.Do)[0].Get.Version).To(Equal(&atc.Version{"path": job.Inputs["repo"]}))
+			Expect((*plan.Do)[1].OnFailure).ToNot(BeNil())
+			Expect((*plan.Do)[2].OnSuccess.Step.Put.Resource).To(Equal("repo"))
+		})
+	})
+
+	Describe("running", func() {
+		var events []atc.Event
+
+		JustBeforeEach(func() {
+			jobBuild, err := NewJobBuild(job)
+			Expect(err).ToNot(HaveOccurred())
+
+			events = run(jobBuild)
+		})
+
+		Context("when the task succeeds", func() {
+			BeforeEach(func() {
+				fakeProcess.WaitReturns(0, nil)
+			})
+
+			It("uses the local directory for gets", func() {
+				Expect(events).To(ContainElement(And(
+					BeAssignableToTypeOf(event.FinishGet{}),
+					HaveField("FetchedVersion", atc.Version{"path": job.Inputs["repo"]}),
+				)))
+			})
+
+			It("runs the task in the fetched image, with a copy of the input", func() {
+				Expect(fetchedImages).To(Equal([]atc.ImageResource{{
+					Type:   "registry-image",
+					Source: atc.Source{"repository": "golang"},
+				}}))
+
+				Expect(fakeBackend.CreateCallCount()).To(Equal(1))
+				spec := fakeBackend.CreateArgsForCall(0)
+				Expect(spec.RootFSPath).To(HavePrefix("raw://" + filepath.Join(dir, "worker", "volumes")))
+				Expect(spec.BindMounts).To(ContainElement(HaveField("DstPath", "/tmp/build/a94a8fe5/repo")))
+
+				processSpec, _ := fakeContainer.RunArgsForCall(0)
+				Expect(processSpec.Path).To(Equal("go"))
+				Expect(processSpec.Args).To(Equal([]string{"test", "./..."}))
+			})
+
+			It("only pretends to put", func() {
+				Expect(logs(events)).To(ContainSubstring("dry run: not putting to resource 'repo' (type git)"))
+				Expect(logs(events)).ToNot(ContainSubstring("resource 'notify'"))
+			})
+
+			It("succeeds", func() {
+				Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusSucceeded))
+			})
+
+			It("cleans up", func() {
+				Expect(fakeBackend.DestroyCallCount()).To(Equal(1))
+				Expect(filepath.Join(dir, "worker", "volumes")).ToNot(BeADirectory())
+				Expect(job.Inputs["repo"]).To(BeADirectory())
+			})
+		})
+
+		Context("when the task fails", func() {
+			BeforeEach(func() {
+				fakeProcess.WaitReturns(1, nil)
+			})
+
+			It("runs the failure hook and fails", func() {
+				Expect(logs(events)).To(ContainSubstring("dry run: not putting to resource 'notify' (type slack-notification)"))
+				Expect(logs(events)).ToNot(ContainSubstring("resource 'repo'"))
+				Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusFailed))
+			})
+		})
+
+		Context("when the task errors", func() {
+			BeforeEach(func() {
+				fakeContainer.RunReturns(nil, garden.ExecutableNotFoundError{Message: "go: not found"})
+			})
+
+			It("errors", func() {
+				Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusErrored))
+			})
+		})
+
+		Context("when a step runs across values", func() {
+			BeforeEach(func() {
+				job.Name = "across"
+				fakeProcess.WaitReturns(0, nil)
+			})
+
+			It("runs the step for each value", func() {
+				Expect(fakeContainer.RunCallCount()).To(Equal(2))
+
+				var args [][]string
+				for i := 0; i < fakeContainer.RunCallCount(); i++ {
+					processSpec, _ := fakeContainer.RunArgsForCall(i)
+					args = append(args, processSpec.Args)
+				}
+
+				Expect(args).To(ConsistOf([]string{"hello"}, []string{"goodbye"}))
+				Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusSucceeded))
+			})
+		})
+
+		Context("when a step in a try fails", func() {
+			BeforeEach(func() {
+				job.Name = "try"
+				fakeProcess.WaitReturns(1, nil)
+			})
+
+			It("succeeds anyway", func() {
+				Expect(fakeContainer.RunCallCount()).To(Equal(1))
+				Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusSucceeded))
+			})
+		})
+
+		Context("when a step with attempts fails", func() {
+			BeforeEach(func() {
+				job.Name = "retry"
+			})
+
+			Context("and then succeeds", func() {
+				BeforeEach(func() {
+					fakeProcess.WaitReturnsOnCall(0, 1, nil)
+					fakeProcess.WaitReturnsOnCall(1, 1, nil)
+					fakeProcess.WaitReturnsOnCall(2, 0, nil)
+				})
+
+				It("retries until it succeeds", func() {
+					Expect(fakeContainer.RunCallCount()).To(Equal(3))
+					Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusSucceeded))
+				})
+			})
+
+			Context("on every attempt", func() {
+				BeforeEach(func() {
+					fakeProcess.WaitReturns(1, nil)
+				})
+
+				It("gives up after the last attempt", func() {
+					Expect(fakeContainer.RunCallCount()).To(Equal(3))
+					Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusFailed))
+				})
+			})
+		})
+
+		Context("when a var is loaded from an input", func() {
+			BeforeEach(func() {
+				job.Name = "load-var"
+				fakeProcess.WaitReturns(0, nil)
+
+				Expect(os.WriteFile(filepath.Join(job.Inputs["repo"], "version.txt"), []byte("1.2.3\n"), 0644)).To(Succeed())
+			})
+
+			It("passes the var to later steps", func() {
+				Expect(fakeContainer.RunCallCount()).To(Equal(1))
+				processSpec, _ := fakeContainer.RunArgsForCall(0)
+				Expect(processSpec.Args).To(Equal([]string{"1.2.3"}))
+				Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusSucceeded))
+			})
+		})
+
+		Context("when a task writes build annotations", func() {
+			BeforeEach(func() {
+				job.Name = "annotate"
+
+				fakeContainer.RunStub = func(processSpec garden.ProcessSpec, _ garden.ProcessIO) (garden.Process, error) {
+					spec := fakeBackend.CreateArgsForCall(fakeBackend.CreateCallCount() - 1)
+					for _, mount := range spec.BindMounts {
+						if mount.DstPath == processSpec.Dir {
+							err := os.WriteFile(filepath.Join(mount.SrcPath, ".build-annotations.json"), []byte(`{"summary":"all good"}`), 0644)
+							Expect(err).ToNot(HaveOccurred())
+						}
+					}
+
+					return fakeProcess, nil
+				}
+				fakeProcess.WaitReturns(0, nil)
+			})
+
+			It("emits the annotations", func() {
+				Expect(events).To(ContainElement(And(
+					BeAssignableToTypeOf(event.Annotations{}),
+					HaveField("Annotations", atc.BuildAnnotations{Step: "annotate", Summary: "all good"}),
+				)))
+				Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusSucceeded))
+			})
+		})
+
+		Context("when a task reports test results", func() {
+			BeforeEach(func() {
+				job.Name = "report"
+
+				fakeContainer.RunStub = func(processSpec garden.ProcessSpec, _ garden.ProcessIO) (garden.Process, error) {
+					spec := fakeBackend.CreateArgsForCall(fakeBackend.CreateCallCount() - 1)
+					for _, mount := range spec.BindMounts {
+						if mount.DstPath == filepath.Join(processSpec.Dir, "results") {
+							err := os.WriteFile(filepath.Join(mount.SrcPath, "junit.xml"), []byte(`<testsuite name="unit">
+  <testcase name="passes"/>
+  <testcase name="fails"><failure message="nope"/></testcase>
+</testsuite>`), 0644)
+							Expect(err).ToNot(HaveOccurred())
+						}
+					}
+
+					return fakeProcess, nil
+				}
+				fakeProcess.WaitReturns(0, nil)
+			})
+
+			It("emits the counts of the results", func() {
+				Expect(events).To(ContainElement(And(
+					BeAssignableToTypeOf(event.Annotations{}),
+					HaveField("Annotations.Tests", &atc.BuildAnnotationTests{Passed: 1, Failed: 1}),
+				)))
+				Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusSucceeded))
+			})
+		})
+
+		Context("when a registry-image resource is fetched", func() {
+			BeforeEach(func() {
+				job.Name = "uses-image"
+				job.Inputs = nil
+			})
+
+			It("fetches the image for the task to run in", func() {
+				Expect(fetchedImages).To(Equal([]atc.ImageResource{{
+					Type:   "registry-image",
+					Source: atc.Source{"repository": "busybox"},
+				}}))
+
+				spec := fakeBackend.CreateArgsForCall(0)
+				Expect(spec.RootFSPath).To(HaveSuffix("/rootfs"))
+				Expect(events[len(events)-1]).To(HaveField("Status", atc.StatusSucceeded))
+			})
+		})
+	})
+
+	Describe("localBuild", func() {
+		var build *localBuild
+
+		BeforeEach(func() {
+			job.Vars = vars.StaticVariables{"greeting": "hello"}
+			build = &localBuild{job: job}
+		})
+
+		It("fails calls to methods it has nothing to stand in for", func() {
+			_, err := build.Artifacts()
+			Expect(err).To(MatchError("running locally does not support db.Build.Artifacts"))
+
+			Expect(build.ResourceTypeID()).To(BeZero())
+		})
+
+		It("stands in for the methods the engine calls", func() {
+			Expect(build.RunStateID()).To(Equal("build:local"))
+			Expect(build.LagerData()).To(HaveKeyWithValue("job", "unit"))
+			Expect(build.ResourceCacheUser()).ToNot(BeNil())
+
+			found, err := build.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			variables, err := build.Variables(nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(variables).To(Equal(job.Vars))
+		})
+
+		It("is running until it finishes", func() {
+			Expect(build.IsRunning()).To(BeTrue())
+			Expect(build.Status()).To(Equal(db.BuildStatusStarted))
+
+			build.finish(db.BuildStatusSucceeded)
+
+			Expect(build.IsRunning()).To(BeFalse())
+			Expect(build.Status()).To(Equal(db.BuildStatusSucceeded))
+			Expect(build.EndTime()).ToNot(BeZero())
+		})
+	})
+})
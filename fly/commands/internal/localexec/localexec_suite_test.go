@@ -0,0 +1,13 @@
+package localexec
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLocalExec(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Local Exec Suite")
+}
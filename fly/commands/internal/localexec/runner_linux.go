@@ -0,0 +1,204 @@
+//go:build linux
+// +build linux
+
+package localexec
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc/worker/localruntime"
+	"github.com/concourse/concourse/worker/baggageclaim/volume/copy"
+	"github.com/concourse/concourse/worker/runtime"
+	"github.com/concourse/concourse/worker/runtime/libcontainerd"
+	"github.com/containerd/containerd"
+)
+
+const (
+	// containerdNamespace keeps the containers and images of local tasks
+	// apart from those of a worker sharing the same containerd.
+	containerdNamespace = "fly"
+
+	requestTimeout = 5 * time.Minute
+)
+
+// networkConfig differs from the worker's so that both can run on the same
+// host.
+var networkConfig = runtime.CNINetworkConfig{
+	BridgeName:  "fly0",
+	NetworkName: "fly",
+	IPv4: runtime.CNIv4NetworkConfig{
+		Subnet: "10.81.0.0/16",
+	},
+}
+
+// Runner runs tasks on containerd, through the same runtime as workers do.
+type Runner struct {
+	config Config
+
+	client  *containerd.Client
+	backend runtime.GardenBackend
+}
+
+func NewRunner(config Config) (*Runner, error) {
+	_, err := os.Stat(config.ContainerdSocket)
+	if err != nil {
+		return nil, fmt.Errorf("containerd socket not found at %s: %w", config.ContainerdSocket, err)
+	}
+
+	err = os.MkdirAll(config.WorkDir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := runtime.NewCNINetwork(
+		runtime.WithCNIBinariesDir(config.CNIPluginsDir),
+		runtime.WithCNIFileStore(runtime.FileStoreWithWorkDir(config.WorkDir)),
+		runtime.WithCNINetworkConfig(networkConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new cni network: %w", err)
+	}
+
+	backendOpts := []runtime.GardenBackendOpt{runtime.WithNetwork(network)}
+	if config.InitBin != "" {
+		backendOpts = append(backendOpts, runtime.WithInitBinPath(config.InitBin))
+	}
+
+	backend, err := runtime.NewGardenBackend(
+		libcontainerd.New(config.ContainerdSocket, containerdNamespace, requestTimeout),
+		backendOpts...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new garden backend: %w", err)
+	}
+
+	err = backend.Start()
+	if err != nil {
+		return nil, fmt.Errorf("start runtime: %w", err)
+	}
+
+	client, err := containerd.New(config.ContainerdSocket, containerd.WithDefaultNamespace(containerdNamespace))
+	if err != nil {
+		backend.Stop()
+		return nil, fmt.Errorf("connect to containerd: %w", err)
+	}
+
+	return &Runner{
+		config: config,
+
+		client:  client,
+		backend: backend,
+	}, nil
+}
+
+// Run runs the task to completion, returning its exit status. If ctx is
+// cancelled, the task is stopped.
+func (runner *Runner) Run(ctx context.Context, task Task) (int, error) {
+	handle, err := newHandle()
+	if err != nil {
+		return 0, err
+	}
+
+	runDir := filepath.Join(runner.config.WorkDir, handle)
+	err = os.MkdirAll(runDir, 0755)
+	if err != nil {
+		return 0, err
+	}
+
+	defer os.RemoveAll(runDir)
+
+	rootfs := filepath.Join(runDir, "rootfs")
+	image, err := runner.fetchImage(ctx, task, rootfs)
+	if err != nil {
+		return 0, err
+	}
+
+	mounts, copies, err := taskMounts(task, func(path string) (string, error) {
+		dir := filepath.Join(runDir, path)
+		return dir, os.MkdirAll(dir, 0755)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for dir, hostDir := range copies {
+		err := copy.Cp(false, hostDir, dir)
+		if err != nil {
+			return 0, fmt.Errorf("copy input %s: %w", hostDir, err)
+		}
+	}
+
+	containerSpec, processSpec := taskSpecs(handle, task, rootfs, image, mounts)
+
+	container, err := runner.backend.Create(containerSpec)
+	if err != nil {
+		return 0, fmt.Errorf("create container: %w", err)
+	}
+
+	defer runner.backend.Destroy(handle)
+
+	process, err := container.Run(processSpec, garden.ProcessIO{
+		Stdout: task.Stdout,
+		Stderr: task.Stderr,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("run task: %w", err)
+	}
+
+	type result struct {
+		status int
+		err    error
+	}
+
+	exited := make(chan result, 1)
+	go func() {
+		status, err := process.Wait()
+		exited <- result{status, err}
+	}()
+
+	select {
+	case res := <-exited:
+		return res.status, res.err
+	case <-ctx.Done():
+		err := container.Stop(false)
+		if err != nil {
+			return 0, err
+		}
+
+		<-exited
+		return 0, ctx.Err()
+	}
+}
+
+// RunJob runs the job's build to completion. Its outcome is reported by the
+// build's events, which must be read for the build to progress. If ctx is
+// cancelled, the build is aborted.
+func (runner *Runner) RunJob(ctx context.Context, jobBuild *JobBuild) error {
+	defer jobBuild.finish()
+
+	handle, err := newHandle()
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(runner.config.WorkDir, handle)
+	defer os.RemoveAll(dir)
+
+	worker := localruntime.NewWorker("local", &runner.backend, dir)
+
+	return jobBuild.run(ctx, worker, runner.fetchImageResource)
+}
+
+func (runner *Runner) Close() error {
+	err := runner.client.Close()
+	if err != nil {
+		return err
+	}
+
+	return runner.backend.Stop()
+}
@@ -0,0 +1,26 @@
+//go:build !linux
+// +build !linux
+
+package localexec
+
+import "context"
+
+// Runner runs tasks on containerd, which is only available on Linux.
+type Runner struct{}
+
+func NewRunner(Config) (*Runner, error) {
+	return nil, ErrUnsupportedPlatform
+}
+
+func (*Runner) Run(context.Context, Task) (int, error) {
+	return 0, ErrUnsupportedPlatform
+}
+
+func (*Runner) RunJob(_ context.Context, jobBuild *JobBuild) error {
+	jobBuild.finish()
+	return ErrUnsupportedPlatform
+}
+
+func (*Runner) Close() error {
+	return nil
+}
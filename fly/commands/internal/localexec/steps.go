@@ -0,0 +1,321 @@
+package localexec
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/localruntime"
+)
+
+// imageTypes are the resource types whose images can be fetched locally.
+var imageTypes = []string{"registry-image", "docker-image"}
+
+func isImageType(resourceType string) bool {
+	for _, imageType := range imageTypes {
+		if resourceType == imageType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// coreStepFactory builds the steps of a local build. Tasks and load_var
+// steps are the same as on a cluster; gets, puts and the like are stubbed.
+type coreStepFactory struct {
+	job        Job
+	worker     *localruntime.Worker
+	streamer   exec.Streamer
+	fetchImage imageFetcher
+}
+
+func newCoreStepFactory(job Job, worker *localruntime.Worker, fetchImage imageFetcher) engine.CoreStepFactory {
+	return &coreStepFactory{
+		job:        job,
+		worker:     worker,
+		streamer:   newStreamer(),
+		fetchImage: fetchImage,
+	}
+}
+
+func newStreamer() exec.Streamer {
+	return worker.NewStreamer(nil, compression.NewGzipCompression(), 0, worker.P2PConfig{})
+}
+
+func (factory *coreStepFactory) GetStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(&getStep{
+		planID:          plan.ID,
+		plan:            *plan.Get,
+		dir:             factory.job.Inputs[plan.Get.Resource],
+		worker:          factory.worker,
+		fetchImage:      factory.fetchImage,
+		delegateFactory: delegateFactory,
+	}, delegateFactory)
+}
+
+func (factory *coreStepFactory) PutStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(&putStep{
+		planID:          plan.ID,
+		plan:            *plan.Put,
+		delegateFactory: delegateFactory,
+	}, delegateFactory)
+}
+
+func (factory *coreStepFactory) TaskStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	sum := sha1.Sum([]byte(plan.Task.Name))
+	containerMetadata.WorkingDirectory = filepath.Join("/tmp", "build", fmt.Sprintf("%x", sum[:4]))
+
+	taskStep := exec.NewTaskStep(
+		plan.ID,
+		*plan.Task,
+		atc.ContainerLimits{},
+		stepMetadata,
+		containerMetadata,
+		nil,
+		factory.worker,
+		factory.streamer,
+		delegateFactory,
+		0,
+	)
+
+	return exec.LogError(taskStep, delegateFactory)
+}
+
+func (factory *coreStepFactory) RunStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(exec.NewRunStep(plan.ID, *plan.Run, delegateFactory), delegateFactory)
+}
+
+// CheckStep only comes up when fetching images, which are always fetched at
+// the version they are referenced by.
+func (factory *coreStepFactory) CheckStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return &checkStep{planID: plan.ID}
+}
+
+func (factory *coreStepFactory) SetPipelineStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(&setPipelineStep{
+		plan:            *plan.SetPipeline,
+		delegateFactory: delegateFactory,
+	}, delegateFactory)
+}
+
+func (factory *coreStepFactory) LoadVarStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	loadVarStep := exec.NewLoadVarStep(
+		plan.ID,
+		*plan.LoadVar,
+		stepMetadata,
+		delegateFactory,
+		factory.streamer,
+	)
+
+	return exec.LogError(loadVarStep, delegateFactory)
+}
+
+// Artifacts are only used by one-off builds, never by jobs.
+func (factory *coreStepFactory) ArtifactInputStep(atc.Plan, db.Build) exec.Step {
+	return exec.IdentityStep{}
+}
+
+func (factory *coreStepFactory) ArtifactOutputStep(atc.Plan, db.Build) exec.Step {
+	return exec.IdentityStep{}
+}
+
+// getStep provides the directory given for the resource in place of the
+// fetched version. Images of registry-image resources are fetched for real,
+// as there's nothing to stand in for them.
+type getStep struct {
+	planID atc.PlanID
+	plan   atc.GetPlan
+	dir    string
+
+	worker          *localruntime.Worker
+	fetchImage      imageFetcher
+	delegateFactory engine.DelegateFactory
+}
+
+func (step *getStep) Run(ctx context.Context, state exec.RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx).Session("get-step", lager.Data{
+		"step-name": step.plan.Name,
+	})
+
+	delegate := step.delegateFactory.GetDelegate(state)
+	delegate.Initializing(logger)
+
+	source, err := creds.NewSource(state, step.plan.Source).Evaluate()
+	if err != nil {
+		return false, err
+	}
+
+	delegate.Starting(logger)
+
+	var volume localruntime.Volume
+	var version atc.Version
+	switch {
+	case step.dir != "":
+		fmt.Fprintf(delegate.Stdout(), "using local directory %s\n", step.dir)
+
+		volume, err = step.worker.ImportDir(step.dir)
+		version = atc.Version{"path": step.dir}
+
+	case step.plan.Resource != "" && step.plan.VersionFrom != nil:
+		fmt.Fprintln(delegate.Stdout(), "the version created by the put is not fetched; using an empty directory")
+
+		volume, err = step.worker.CreateVolume()
+
+	case isImageType(step.plan.Type):
+		image := atc.ImageResource{
+			Type:   step.plan.Type,
+			Source: source,
+		}
+
+		if step.plan.Version != nil && len(*step.plan.Version) != 0 {
+			image.Version = *step.plan.Version
+		}
+
+		volume, err = step.worker.CreateVolume()
+		if err == nil {
+			err = step.fetchImage(ctx, image, volume.Path())
+		}
+
+	case step.plan.Resource != "":
+		return false, fmt.Errorf("no local directory given for resource '%s' (use -i %s=PATH)", step.plan.Resource, step.plan.Resource)
+
+	default:
+		return false, fmt.Errorf("resources of type '%s' cannot be fetched locally", step.plan.Type)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	state.StoreResult(step.planID, exec.GetResult{
+		Name: step.plan.Name,
+	})
+
+	state.ArtifactRepository().RegisterArtifact(build.ArtifactName(step.plan.Name), volume, false)
+
+	delegate.Finished(logger, 0, resource.VersionResult{Version: version})
+
+	return true, nil
+}
+
+// putStep is a dry run, printing what would have been put.
+type putStep struct {
+	planID atc.PlanID
+	plan   atc.PutPlan
+
+	delegateFactory engine.DelegateFactory
+}
+
+func (step *putStep) Run(ctx context.Context, state exec.RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx).Session("put-step", lager.Data{
+		"step-name": step.plan.Name,
+	})
+
+	delegate := step.delegateFactory.PutDelegate(state)
+	delegate.Initializing(logger)
+	delegate.Starting(logger)
+
+	stdout := delegate.Stdout()
+	fmt.Fprintf(stdout, "dry run: not putting to resource '%s' (type %s)\n", step.plan.Resource, step.plan.Type)
+
+	if len(step.plan.Params) != 0 {
+		params, err := json.MarshalIndent(step.plan.Params, "", "  ")
+		if err != nil {
+			return false, err
+		}
+
+		fmt.Fprintf(stdout, "params: %s\n", params)
+	}
+
+	if step.plan.Inputs != nil && !step.plan.Inputs.All && !step.plan.Inputs.Detect {
+		inputs := append([]string{}, step.plan.Inputs.Specified...)
+		sort.Strings(inputs)
+
+		fmt.Fprintf(stdout, "inputs: %s\n", strings.Join(inputs, ", "))
+	}
+
+	state.StoreResult(step.planID, atc.Version{})
+
+	delegate.Finished(logger, 0, resource.VersionResult{})
+
+	return true, nil
+}
+
+type checkStep struct {
+	planID atc.PlanID
+}
+
+func (step *checkStep) Run(ctx context.Context, state exec.RunState) (bool, error) {
+	state.StoreResult(step.planID, atc.Version{})
+	return true, nil
+}
+
+// setPipelineStep is a dry run, as no pipelines are set outside of a
+// cluster.
+type setPipelineStep struct {
+	plan atc.SetPipelinePlan
+
+	delegateFactory engine.DelegateFactory
+}
+
+func (step *setPipelineStep) Run(ctx context.Context, state exec.RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx).Session("set-pipeline-step")
+
+	delegate := step.delegateFactory.BuildStepDelegate(state)
+	delegate.Initializing(logger)
+	delegate.Starting(logger)
+
+	fmt.Fprintf(delegate.Stdout(), "dry run: not setting pipeline '%s' from %s\n", step.plan.Name, step.plan.File)
+
+	delegate.Finished(logger, true)
+
+	return true, nil
+}
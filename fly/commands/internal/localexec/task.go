@@ -0,0 +1,197 @@
+package localexec
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+)
+
+var ErrUnsupportedPlatform = errors.New("local mode is only supported on Linux")
+
+// Task is a task to be run on the local runtime.
+type Task struct {
+	Config     atc.TaskConfig
+	Privileged bool
+
+	// Inputs and Outputs map the names of the task's inputs and outputs to
+	// directories on the host. Inputs are copied into the container, whereas
+	// outputs are written to directly.
+	Inputs  map[string]string
+	Outputs map[string]string
+
+	// ImageTarball is an OCI or Docker image tarball to run the task in
+	// instead of the task's image_resource.
+	ImageTarball string
+
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// ImageConfig is the part of an image's config that affects how processes
+// are run in it.
+type ImageConfig struct {
+	Env  []string
+	User string
+}
+
+// mounts are the host directories a task's inputs, outputs and caches are
+// backed by, keyed by their path relative to the task's working directory.
+type mounts map[string]string
+
+// taskSpecs builds the specs of the container and of the process running a
+// task in it.
+func taskSpecs(handle string, task Task, rootfs string, image ImageConfig, mounts mounts) (garden.ContainerSpec, garden.ProcessSpec) {
+	workDir := filepath.Join("/tmp", "build", handle[len(handle)-8:])
+
+	var paths []string
+	for path := range mounts {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var bindMounts []garden.BindMount
+	for _, path := range paths {
+		bindMounts = append(bindMounts, garden.BindMount{
+			SrcPath: mounts[path],
+			DstPath: filepath.Join(workDir, path),
+			Mode:    garden.BindMountModeRW,
+			Origin:  garden.BindMountOriginHost,
+		})
+	}
+
+	params := task.Config.Params.Env()
+	sort.Strings(params)
+
+	containerSpec := garden.ContainerSpec{
+		Handle:     handle,
+		Image:      garden.ImageRef{URI: "raw://" + rootfs},
+		Privileged: task.Privileged,
+		BindMounts: bindMounts,
+		Env:        append(append([]string{}, image.Env...), params...),
+		Limits:     gardenLimits(task.Config.Limits),
+	}
+
+	user := task.Config.Run.User
+	if user == "" {
+		user = image.User
+	}
+
+	dir := task.Config.Run.Dir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(workDir, dir)
+	}
+
+	processSpec := garden.ProcessSpec{
+		Path: task.Config.Run.Path,
+		Args: task.Config.Run.Args,
+		Dir:  dir,
+		User: user,
+	}
+
+	return containerSpec, processSpec
+}
+
+// taskMounts determines the directories backing the task's inputs, outputs
+// and caches. The directories the inputs are to be copied to are returned
+// separately, mapped to the directories they're copied from.
+func taskMounts(task Task, scratchDir func(string) (string, error)) (mounts, map[string]string, error) {
+	mounts := mounts{}
+	copies := map[string]string{}
+
+	for _, input := range task.Config.Inputs {
+		hostDir, found := task.Inputs[input.Name]
+		if !found {
+			if input.Optional {
+				continue
+			}
+
+			return nil, nil, fmt.Errorf("missing required input `%s`", input.Name)
+		}
+
+		dir, err := scratchDir(filepath.Join("inputs", input.Name))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		copies[dir] = hostDir
+		mounts[mountPath(input.Path, input.Name)] = dir
+	}
+
+	for _, output := range task.Config.Outputs {
+		hostDir, found := task.Outputs[output.Name]
+		if !found {
+			dir, err := scratchDir(filepath.Join("outputs", output.Name))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			hostDir = dir
+		}
+
+		mounts[mountPath(output.Path, output.Name)] = hostDir
+	}
+
+	for i, cache := range task.Config.Caches {
+		dir, err := scratchDir(filepath.Join("caches", fmt.Sprint(i)))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		mounts[cache.Path] = dir
+	}
+
+	return mounts, copies, nil
+}
+
+func mountPath(path string, name string) string {
+	if path != "" {
+		return path
+	}
+
+	return name
+}
+
+func gardenLimits(limits *atc.ContainerLimits) garden.Limits {
+	var gardenLimits garden.Limits
+	if limits == nil {
+		return gardenLimits
+	}
+
+	if limits.CPU != nil {
+		gardenLimits.CPU = garden.CPULimits{LimitInShares: uint64(*limits.CPU)}
+	}
+
+	if limits.Memory != nil {
+		gardenLimits.Memory = garden.MemoryLimits{LimitInBytes: uint64(*limits.Memory)}
+	}
+
+	return gardenLimits
+}
+
+func newHandle() (string, error) {
+	suffix := make([]byte, 4)
+	_, err := rand.Read(suffix)
+	if err != nil {
+		return "", err
+	}
+
+	return "fly-local-" + hex.EncodeToString(suffix), nil
+}
+
+// Config configures how tasks are run on the local runtime.
+type Config struct {
+	ContainerdSocket string
+	InitBin          string
+	CNIPluginsDir    string
+
+	// WorkDir holds the state of the runtime and the scratch directories of
+	// running tasks.
+	WorkDir string
+}
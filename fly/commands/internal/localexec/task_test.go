@@ -0,0 +1,176 @@
+package localexec
+
+import (
+	"errors"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task", func() {
+	var task Task
+
+	BeforeEach(func() {
+		cpu := atc.CPULimit(512)
+		memory := atc.MemoryLimit(1024)
+
+		task = Task{
+			Config: atc.TaskConfig{
+				Platform: "linux",
+				Inputs: []atc.TaskInputConfig{
+					{Name: "some-input"},
+					{Name: "mapped-input", Path: "some/path"},
+					{Name: "optional-input", Optional: true},
+				},
+				Outputs: []atc.TaskOutputConfig{
+					{Name: "some-output"},
+					{Name: "discarded-output"},
+				},
+				Caches: []atc.TaskCacheConfig{
+					{Path: "some-cache"},
+				},
+				Params: atc.TaskEnv{"FOO": "bar", "BAZ": "buzz"},
+				Limits: &atc.ContainerLimits{CPU: &cpu, Memory: &memory},
+				Run: atc.TaskRunConfig{
+					Path: "some-script",
+					Args: []string{"some", "args"},
+					Dir:  "some-input",
+				},
+			},
+			Privileged: true,
+			Inputs: map[string]string{
+				"some-input":   "/host/some-input",
+				"mapped-input": "/host/mapped-input",
+			},
+			Outputs: map[string]string{
+				"some-output": "/host/some-output",
+			},
+		}
+	})
+
+	scratchDir := func(path string) (string, error) {
+		return filepath.Join("/scratch", path), nil
+	}
+
+	Describe("taskMounts", func() {
+		It("copies inputs and mounts outputs and caches", func() {
+			dirs, copies, err := taskMounts(task, scratchDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(dirs).To(Equal(mounts{
+				"some-input":       "/scratch/inputs/some-input",
+				"some/path":        "/scratch/inputs/mapped-input",
+				"some-output":      "/host/some-output",
+				"discarded-output": "/scratch/outputs/discarded-output",
+				"some-cache":       "/scratch/caches/0",
+			}))
+
+			Expect(copies).To(Equal(map[string]string{
+				"/scratch/inputs/some-input":   "/host/some-input",
+				"/scratch/inputs/mapped-input": "/host/mapped-input",
+			}))
+		})
+
+		Context("when a required input is missing", func() {
+			BeforeEach(func() {
+				delete(task.Inputs, "some-input")
+			})
+
+			It("errors", func() {
+				_, _, err := taskMounts(task, scratchDir)
+				Expect(err).To(MatchError("missing required input `some-input`"))
+			})
+		})
+
+		Context("when a scratch directory cannot be created", func() {
+			disaster := errors.New("nope")
+
+			It("returns the error", func() {
+				_, _, err := taskMounts(task, func(string) (string, error) {
+					return "", disaster
+				})
+				Expect(err).To(Equal(disaster))
+			})
+		})
+	})
+
+	Describe("taskSpecs", func() {
+		var (
+			image ImageConfig
+
+			containerSpec garden.ContainerSpec
+			processSpec   garden.ProcessSpec
+		)
+
+		BeforeEach(func() {
+			image = ImageConfig{
+				Env:  []string{"PATH=/usr/bin"},
+				User: "image-user",
+			}
+		})
+
+		JustBeforeEach(func() {
+			containerSpec, processSpec = taskSpecs("fly-local-abcd1234", task, "/some/rootfs", image, mounts{
+				"some-output": "/host/some-output",
+				"some-input":  "/scratch/inputs/some-input",
+			})
+		})
+
+		It("runs the container from the rootfs", func() {
+			Expect(containerSpec.Handle).To(Equal("fly-local-abcd1234"))
+			Expect(containerSpec.Image).To(Equal(garden.ImageRef{URI: "raw:///some/rootfs"}))
+			Expect(containerSpec.Privileged).To(BeTrue())
+		})
+
+		It("mounts the directories under the working directory", func() {
+			Expect(containerSpec.BindMounts).To(Equal([]garden.BindMount{
+				{
+					SrcPath: "/scratch/inputs/some-input",
+					DstPath: "/tmp/build/abcd1234/some-input",
+					Mode:    garden.BindMountModeRW,
+					Origin:  garden.BindMountOriginHost,
+				},
+				{
+					SrcPath: "/host/some-output",
+					DstPath: "/tmp/build/abcd1234/some-output",
+					Mode:    garden.BindMountModeRW,
+					Origin:  garden.BindMountOriginHost,
+				},
+			}))
+		})
+
+		It("sets the params after the image's env", func() {
+			Expect(containerSpec.Env).To(Equal([]string{"PATH=/usr/bin", "BAZ=buzz", "FOO=bar"}))
+		})
+
+		It("applies the limits", func() {
+			Expect(containerSpec.Limits).To(Equal(garden.Limits{
+				CPU:    garden.CPULimits{LimitInShares: 512},
+				Memory: garden.MemoryLimits{LimitInBytes: 1024},
+			}))
+		})
+
+		It("runs the process in the working directory as the image's user", func() {
+			Expect(processSpec).To(Equal(garden.ProcessSpec{
+				Path: "some-script",
+				Args: []string{"some", "args"},
+				Dir:  "/tmp/build/abcd1234/some-input",
+				User: "image-user",
+			}))
+		})
+
+		Context("when the task specifies a user", func() {
+			BeforeEach(func() {
+				task.Config.Run.User = "task-user"
+			})
+
+			It("takes precedence over the image's", func() {
+				Expect(processSpec.User).To(Equal("task-user"))
+			})
+		})
+	})
+})
@@ -0,0 +1,351 @@
+package localexec
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/vars"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// unsupported is the error returned by build methods that have nothing to
+// stand in for them when a job runs locally.
+func unsupported(method string) error {
+	return fmt.Errorf("running locally does not support db.Build.%s", method)
+}
+
+// unsupportedBuild implements db.Build by failing every call that can return
+// an error, with a message naming the method; the rest return zero values.
+// localBuild embeds it so that a step calling a method localBuild doesn't
+// implement fails rather than dereferencing a nil pointer.
+type unsupportedBuild struct{}
+
+var _ db.Build = unsupportedBuild{}
+
+func (unsupportedBuild) PipelineID() int {
+	return 0
+}
+
+func (unsupportedBuild) PipelineName() string {
+	return ""
+}
+
+func (unsupportedBuild) PipelineInstanceVars() atc.InstanceVars {
+	return nil
+}
+
+func (unsupportedBuild) PipelineRef() atc.PipelineRef {
+	return atc.PipelineRef{}
+}
+
+func (unsupportedBuild) Pipeline() (db.Pipeline, bool, error) {
+	return nil, false, unsupported("Pipeline")
+}
+
+func (unsupportedBuild) ID() int {
+	return 0
+}
+
+func (unsupportedBuild) Name() string {
+	return ""
+}
+
+func (unsupportedBuild) RunStateID() string {
+	return ""
+}
+
+func (unsupportedBuild) TeamID() int {
+	return 0
+}
+
+func (unsupportedBuild) TeamName() string {
+	return ""
+}
+
+func (unsupportedBuild) Job() (db.Job, bool, error) {
+	return nil, false, unsupported("Job")
+}
+
+func (unsupportedBuild) AllAssociatedTeamNames() []string {
+	return nil
+}
+
+func (unsupportedBuild) JobID() int {
+	return 0
+}
+
+func (unsupportedBuild) JobName() string {
+	return ""
+}
+
+func (unsupportedBuild) ResourceID() int {
+	return 0
+}
+
+func (unsupportedBuild) ResourceName() string {
+	return ""
+}
+
+func (unsupportedBuild) ResourceTypeID() int {
+	return 0
+}
+
+func (unsupportedBuild) Schema() string {
+	return ""
+}
+
+func (unsupportedBuild) PrivatePlan() atc.Plan {
+	return atc.Plan{}
+}
+
+func (unsupportedBuild) PublicPlan() *json.RawMessage {
+	return nil
+}
+
+func (unsupportedBuild) HasPlan() bool {
+	return false
+}
+
+func (unsupportedBuild) Comment() string {
+	return ""
+}
+
+func (unsupportedBuild) Annotations() []atc.BuildAnnotations {
+	return nil
+}
+
+func (unsupportedBuild) Status() db.BuildStatus {
+	return ""
+}
+
+func (unsupportedBuild) CreateTime() time.Time {
+	return time.Time{}
+}
+
+func (unsupportedBuild) StartTime() time.Time {
+	return time.Time{}
+}
+
+func (unsupportedBuild) EndTime() time.Time {
+	return time.Time{}
+}
+
+func (unsupportedBuild) ReapTime() time.Time {
+	return time.Time{}
+}
+
+func (unsupportedBuild) IsManuallyTriggered() bool {
+	return false
+}
+
+func (unsupportedBuild) IsScheduled() bool {
+	return false
+}
+
+func (unsupportedBuild) IsRunning() bool {
+	return false
+}
+
+func (unsupportedBuild) IsCompleted() bool {
+	return false
+}
+
+func (unsupportedBuild) InputsReady() bool {
+	return false
+}
+
+func (unsupportedBuild) RerunOf() int {
+	return 0
+}
+
+func (unsupportedBuild) RerunOfName() string {
+	return ""
+}
+
+func (unsupportedBuild) RerunNumber() int {
+	return 0
+}
+
+func (unsupportedBuild) CreatedBy() *string {
+	return nil
+}
+
+func (unsupportedBuild) LagerData() lager.Data {
+	return nil
+}
+
+func (unsupportedBuild) TracingAttrs() tracing.Attrs {
+	return nil
+}
+
+func (unsupportedBuild) SyslogTag(event.OriginID) string {
+	return ""
+}
+
+func (unsupportedBuild) Reload() (bool, error) {
+	return false, unsupported("Reload")
+}
+
+func (unsupportedBuild) ResourcesChecked() (bool, error) {
+	return false, unsupported("ResourcesChecked")
+}
+
+func (unsupportedBuild) AcquireTrackingLock(lager.Logger, time.Duration) (lock.Lock, bool, error) {
+	return nil, false, unsupported("AcquireTrackingLock")
+}
+
+func (unsupportedBuild) Interceptible() (bool, error) {
+	return false, unsupported("Interceptible")
+}
+
+func (unsupportedBuild) Preparation() (db.BuildPreparation, bool, error) {
+	return db.BuildPreparation{}, false, unsupported("Preparation")
+}
+
+func (unsupportedBuild) Start(atc.Plan) (bool, error) {
+	return false, unsupported("Start")
+}
+
+func (unsupportedBuild) Finish(db.BuildStatus) error {
+	return unsupported("Finish")
+}
+
+func (unsupportedBuild) Variables(lager.Logger, creds.Secrets, creds.VarSourcePool) (vars.Variables, error) {
+	return nil, unsupported("Variables")
+}
+
+func (unsupportedBuild) SetComment(string) error {
+	return unsupported("SetComment")
+}
+
+func (unsupportedBuild) SetInterceptible(bool) error {
+	return unsupported("SetInterceptible")
+}
+
+func (unsupportedBuild) AddAnnotations(atc.BuildAnnotations) error {
+	return unsupported("AddAnnotations")
+}
+
+func (unsupportedBuild) TestResults() ([]atc.TestResult, error) {
+	return nil, unsupported("TestResults")
+}
+
+func (unsupportedBuild) SaveTestResults(string, []atc.TestResult) error {
+	return unsupported("SaveTestResults")
+}
+
+func (unsupportedBuild) Events(uint) (db.EventSource, error) {
+	return nil, unsupported("Events")
+}
+
+func (unsupportedBuild) SaveEvent(atc.Event) error {
+	return unsupported("SaveEvent")
+}
+
+func (unsupportedBuild) Artifacts() ([]db.WorkerArtifact, error) {
+	return nil, unsupported("Artifacts")
+}
+
+func (unsupportedBuild) Artifact(int) (db.WorkerArtifact, error) {
+	return nil, unsupported("Artifact")
+}
+
+func (unsupportedBuild) SaveOutput(string, db.ResourceCache, atc.Source, atc.Version, db.ResourceConfigMetadataFields, string, string) error {
+	return unsupported("SaveOutput")
+}
+
+func (unsupportedBuild) AdoptInputsAndPipes() ([]db.BuildInput, bool, error) {
+	return nil, false, unsupported("AdoptInputsAndPipes")
+}
+
+func (unsupportedBuild) AdoptRerunInputsAndPipes() ([]db.BuildInput, bool, error) {
+	return nil, false, unsupported("AdoptRerunInputsAndPipes")
+}
+
+func (unsupportedBuild) Resources() ([]db.BuildInput, []db.BuildOutput, error) {
+	return nil, nil, unsupported("Resources")
+}
+
+func (unsupportedBuild) SaveImageResourceVersion(db.ResourceCache) error {
+	return unsupported("SaveImageResourceVersion")
+}
+
+func (unsupportedBuild) ImageVersions() ([]db.BuildImageVersion, error) {
+	return nil, unsupported("ImageVersions")
+}
+
+func (unsupportedBuild) WorkerNames() ([]string, error) {
+	return nil, unsupported("WorkerNames")
+}
+
+func (unsupportedBuild) Provenance() (atc.ProvenanceEnvelope, bool, error) {
+	return atc.ProvenanceEnvelope{}, false, unsupported("Provenance")
+}
+
+func (unsupportedBuild) SaveProvenance(atc.ProvenanceEnvelope) error {
+	return unsupported("SaveProvenance")
+}
+
+func (unsupportedBuild) SaveSLOBreach(atc.JobSLOStats, []atc.JobSLOViolation) error {
+	return unsupported("SaveSLOBreach")
+}
+
+func (unsupportedBuild) Delete() (bool, error) {
+	return false, unsupported("Delete")
+}
+
+func (unsupportedBuild) MarkAsAborted() error {
+	return unsupported("MarkAsAborted")
+}
+
+func (unsupportedBuild) IsAborted() bool {
+	return false
+}
+
+func (unsupportedBuild) AbortNotifier() (db.Notifier, error) {
+	return nil, unsupported("AbortNotifier")
+}
+
+func (unsupportedBuild) IsDrained() bool {
+	return false
+}
+
+func (unsupportedBuild) SetDrained(bool) error {
+	return unsupported("SetDrained")
+}
+
+func (unsupportedBuild) EventsArchive() string {
+	return ""
+}
+
+func (unsupportedBuild) SetEventsArchive(string) error {
+	return unsupported("SetEventsArchive")
+}
+
+func (unsupportedBuild) SpanContext() propagation.TextMapCarrier {
+	return nil
+}
+
+func (unsupportedBuild) SavePipeline(atc.PipelineRef, int, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error) {
+	return nil, false, unsupported("SavePipeline")
+}
+
+func (unsupportedBuild) ResourceCacheUser() db.ResourceCacheUser {
+	return nil
+}
+
+func (unsupportedBuild) ContainerOwner(atc.PlanID) db.ContainerOwner {
+	return nil
+}
+
+func (unsupportedBuild) OnCheckBuildStart() error {
+	return unsupported("OnCheckBuildStart")
+}
@@ -0,0 +1,134 @@
+package pipelinegraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	FormatDOT     = "dot"
+	FormatMermaid = "mermaid"
+	FormatJSON    = "json"
+)
+
+// Formats are the formats a graph can be written in.
+var Formats = []string{FormatDOT, FormatMermaid, FormatJSON}
+
+// Write writes the graph in the format. In the DOT and Mermaid formats, edges
+// that trigger the job are solid and the others are dashed, as in the web
+// UI.
+func Write(dst io.Writer, name string, graph atc.PipelineGraph, format string) error {
+	switch format {
+	case FormatDOT:
+		return writeDOT(dst, name, graph)
+	case FormatMermaid:
+		return writeMermaid(dst, graph)
+	case FormatJSON:
+		enc := json.NewEncoder(dst)
+		enc.SetIndent("", "  ")
+		return enc.Encode(graph)
+	default:
+		return fmt.Errorf("unknown format '%s' (must be one of %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+func writeDOT(dst io.Writer, name string, graph atc.PipelineGraph) error {
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "digraph %s {\n", dotQuote(name))
+	fmt.Fprintln(&out, "  rankdir=LR;")
+
+	for _, node := range graph.Nodes {
+		switch node.Kind {
+		case atc.GraphNodeResource:
+			fmt.Fprintf(&out, "  %s [label=%s, shape=box, style=rounded];\n", dotQuote(node.ID), dotQuote(node.Name))
+		default:
+			fmt.Fprintf(&out, "  %s [label=%s, shape=box, style=filled];\n", dotQuote(node.ID), dotQuote(node.Name))
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		attrs := []string{"style=" + edgeStyle(edge)}
+		if edge.Resource != "" {
+			attrs = append(attrs, "label="+dotQuote(edge.Resource))
+		}
+
+		fmt.Fprintf(&out, "  %s -> %s [%s];\n", dotQuote(edge.From), dotQuote(edge.To), strings.Join(attrs, ", "))
+	}
+
+	fmt.Fprintln(&out, "}")
+
+	_, err := io.WriteString(dst, out.String())
+	return err
+}
+
+func writeMermaid(dst io.Writer, graph atc.PipelineGraph) error {
+	var out strings.Builder
+
+	fmt.Fprintln(&out, "flowchart LR")
+
+	// node IDs have characters mermaid doesn't allow, so refer to them by
+	// their position instead
+	ids := map[string]string{}
+	for i, node := range graph.Nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[node.ID] = id
+
+		switch node.Kind {
+		case atc.GraphNodeResource:
+			fmt.Fprintf(&out, "  %s([%s])\n", id, mermaidQuote(node.Name))
+		default:
+			fmt.Fprintf(&out, "  %s[%s]\n", id, mermaidQuote(node.Name))
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		arrow := "-->"
+		if edgeStyle(edge) == "dashed" {
+			arrow = "-.->"
+		}
+
+		if edge.Resource != "" {
+			arrow += "|" + mermaidQuote(edge.Resource) + "|"
+		}
+
+		fmt.Fprintf(&out, "  %s %s %s\n", mermaidID(ids, edge.From), arrow, mermaidID(ids, edge.To))
+	}
+
+	_, err := io.WriteString(dst, out.String())
+	return err
+}
+
+func edgeStyle(edge atc.GraphEdge) string {
+	if edge.Kind == atc.GraphEdgeOutput || edge.Trigger {
+		return "solid"
+	}
+
+	return "dashed"
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
+
+// mermaidID is the ID of the node in the mermaid graph. Passed constraints
+// may name jobs that aren't in the config, in which case a node is declared
+// for them where they're first referred to.
+func mermaidID(ids map[string]string, nodeID string) string {
+	if id, found := ids[nodeID]; found {
+		return id
+	}
+
+	id := fmt.Sprintf("n%d", len(ids))
+	ids[nodeID] = id
+
+	return id + "[" + mermaidQuote(nodeID) + "]"
+}
@@ -0,0 +1,105 @@
+package pipelinegraph_test
+
+import (
+	"bytes"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/pipelinegraph"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Write", func() {
+	var (
+		graph atc.PipelineGraph
+		out   *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		graph = atc.PipelineGraph{
+			Nodes: []atc.GraphNode{
+				{ID: "resource:repo", Kind: atc.GraphNodeResource, Name: "repo", Type: "git"},
+				{ID: "resource:image", Kind: atc.GraphNodeResource, Name: "image", Type: "registry-image"},
+				{ID: "job:unit", Kind: atc.GraphNodeJob, Name: "unit"},
+				{ID: "job:build", Kind: atc.GraphNodeJob, Name: "build"},
+			},
+			Edges: []atc.GraphEdge{
+				{From: "resource:repo", To: "job:unit", Kind: atc.GraphEdgeInput, Trigger: true},
+				{From: "job:unit", To: "job:build", Kind: atc.GraphEdgePassed, Resource: "repo"},
+				{From: "job:build", To: "resource:image", Kind: atc.GraphEdgeOutput},
+			},
+		}
+
+		out = new(bytes.Buffer)
+	})
+
+	It("writes DOT", func() {
+		err := pipelinegraph.Write(out, `some-"pipeline"`, graph, pipelinegraph.FormatDOT)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.String()).To(Equal(`digraph "some-\"pipeline\"" {
+  rankdir=LR;
+  "resource:repo" [label="repo", shape=box, style=rounded];
+  "resource:image" [label="image", shape=box, style=rounded];
+  "job:unit" [label="unit", shape=box, style=filled];
+  "job:build" [label="build", shape=box, style=filled];
+  "resource:repo" -> "job:unit" [style=solid];
+  "job:unit" -> "job:build" [style=dashed, label="repo"];
+  "job:build" -> "resource:image" [style=solid];
+}
+`))
+	})
+
+	It("writes a Mermaid flowchart", func() {
+		err := pipelinegraph.Write(out, "some-pipeline", graph, pipelinegraph.FormatMermaid)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.String()).To(Equal(`flowchart LR
+  n0(["repo"])
+  n1(["image"])
+  n2["unit"]
+  n3["build"]
+  n0 --> n2
+  n2 -.->|"repo"| n3
+  n3 --> n1
+`))
+	})
+
+	It("declares nodes for jobs only named by edges in Mermaid", func() {
+		graph.Edges = append(graph.Edges,
+			atc.GraphEdge{From: "job:gone", To: "job:build", Kind: atc.GraphEdgePassed, Resource: "repo", Trigger: true},
+			atc.GraphEdge{From: "job:gone", To: "job:unit", Kind: atc.GraphEdgePassed, Resource: "repo", Trigger: true},
+		)
+
+		err := pipelinegraph.Write(out, "some-pipeline", graph, pipelinegraph.FormatMermaid)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.String()).To(ContainSubstring(`n4["job:gone"] -->|"repo"| n3` + "\n"))
+		Expect(out.String()).To(ContainSubstring(`n4 -->|"repo"| n2` + "\n"))
+	})
+
+	It("writes JSON", func() {
+		err := pipelinegraph.Write(out, "some-pipeline", graph, pipelinegraph.FormatJSON)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(out.String()).To(MatchJSON(`{
+			"nodes": [
+				{"id": "resource:repo", "kind": "resource", "name": "repo", "type": "git"},
+				{"id": "resource:image", "kind": "resource", "name": "image", "type": "registry-image"},
+				{"id": "job:unit", "kind": "job", "name": "unit"},
+				{"id": "job:build", "kind": "job", "name": "build"}
+			],
+			"edges": [
+				{"from": "resource:repo", "to": "job:unit", "kind": "input", "trigger": true},
+				{"from": "job:unit", "to": "job:build", "kind": "passed", "resource": "repo"},
+				{"from": "job:build", "to": "resource:image", "kind": "output"}
+			]
+		}`))
+	})
+
+	It("errors on an unknown format", func() {
+		err := pipelinegraph.Write(out, "some-pipeline", graph, "svg")
+		Expect(err).To(MatchError("unknown format 'svg' (must be one of dot, mermaid, json)"))
+	})
+})
@@ -52,13 +52,20 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
-	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
+	var newConfig atc.Config
+	err = yaml.Unmarshal([]byte(evaluatedTemplate), &newConfig)
 	if err != nil {
 		return err
 	}
 
-	var newConfig atc.Config
-	err = yaml.Unmarshal([]byte(evaluatedTemplate), &newConfig)
+	return atcConfig.Apply(newConfig, evaluatedTemplate)
+}
+
+// Apply shows how the config differs from the pipeline's current one and,
+// once confirmed, saves it. The payload is the config as it's sent to the
+// ATC.
+func (atcConfig ATCConfig) Apply(newConfig atc.Config, payload []byte) error {
+	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
 	if err != nil {
 		return err
 	}
@@ -114,7 +121,7 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 	created, updated, warnings, err := atcConfig.Team.CreateOrUpdatePipelineConfig(
 		atcConfig.PipelineRef,
 		existingConfigVersion,
-		evaluatedTemplate,
+		payload,
 		atcConfig.CheckCredentials,
 	)
 	if err != nil {
@@ -0,0 +1,154 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type JobSLOCommand struct {
+	Job      flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to show the SLO of"`
+	Breaches int                 `short:"c" long:"count" default:"10" description:"Number of the most recent breaches to show"`
+	Json     bool                `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *JobSLOCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	report, found, err := target.Team().JobSLO(command.Job.PipelineRef, command.Job.JobName, command.Breaches)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s/%s not found", command.Job.PipelineRef.String(), command.Job.JobName)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(report)
+	}
+
+	slo := atc.JobSLO{}
+	if report.SLO != nil {
+		slo = *report.SLO
+	}
+
+	targets := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "measure", Color: color.New(color.Bold)},
+			{Contents: "target", Color: color.New(color.Bold)},
+			{Contents: "current", Color: color.New(color.Bold)},
+		},
+	}
+
+	successRate := ui.TableCell{Contents: sloPercent(report.Stats.SuccessRate)}
+	if slo.SuccessRate > 0 && report.Stats.SuccessRate < slo.SuccessRate {
+		successRate.Color = ui.FailedColor
+	}
+
+	meanDuration := ui.TableCell{Contents: sloDuration(report.Stats.MeanDuration)}
+	if target := slo.TargetDuration(); target > 0 && report.Stats.MeanDuration > target.Seconds() {
+		meanDuration.Color = ui.FailedColor
+	}
+
+	targets.Data = []ui.TableRow{
+		{
+			{Contents: "builds"},
+			{Contents: strconv.Itoa(slo.WindowSize())},
+			{Contents: strconv.Itoa(report.Stats.Builds)},
+		},
+		{
+			{Contents: "success rate"},
+			sloTarget(slo.SuccessRate > 0, sloPercent(slo.SuccessRate)),
+			successRate,
+		},
+		{
+			{Contents: "mean duration"},
+			sloTarget(slo.Duration != "", sloDuration(slo.TargetDuration().Seconds())),
+			meanDuration,
+		},
+		{
+			{Contents: "duration stddev"},
+			{Contents: fmt.Sprintf("anomaly above %gσ", slo.Threshold())},
+			{Contents: sloDuration(report.Stats.StdDevDuration)},
+		},
+	}
+
+	err = targets.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+
+	if len(report.Breaches) == 0 {
+		fmt.Println("no slo breaches")
+		return nil
+	}
+
+	fmt.Println(ui.Embolden("breaches:"))
+
+	breaches := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "violation", Color: color.New(color.Bold)},
+			{Contents: "value", Color: color.New(color.Bold)},
+			{Contents: "target", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, breach := range report.Breaches {
+		for _, violation := range breach.Violations {
+			breaches.Data = append(breaches.Data, ui.TableRow{
+				{Contents: breach.Build.Name},
+				ui.BuildStatusCell(breach.Build.Status),
+				{Contents: string(violation.Kind)},
+				{Contents: sloValue(violation.Kind, violation.Value), Color: ui.FailedColor},
+				{Contents: sloValue(violation.Kind, violation.Target)},
+			})
+		}
+	}
+
+	return breaches.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func sloTarget(set bool, contents string) ui.TableCell {
+	if !set {
+		return ui.TableCell{Contents: "none", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: contents}
+}
+
+func sloValue(kind atc.JobSLOViolationKind, value float64) string {
+	if kind == atc.JobSLOViolationSuccessRate {
+		return sloPercent(value)
+	}
+
+	return sloDuration(value)
+}
+
+func sloPercent(rate float64) string {
+	return fmt.Sprintf("%.1f%%", rate*100)
+}
+
+func sloDuration(seconds float64) string {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Second).String()
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"errors"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/lintpipelinehelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+)
+
+type LintPipelineCommand struct {
+	Config    atc.PathFlag `short:"c" long:"config"                          description:"Pipeline configuration file"`
+	Disable   []string     `short:"d" long:"disable"  value-name:"RULE"      description:"Skip a lint rule, in addition to those disabled by the config's lint section. Can be specified multiple times."`
+	Format    string       `long:"format"  default:"text"  choice:"text" choice:"json" choice:"sarif"  description:"Format to print findings in"`
+	ListRules bool         `long:"list-rules"                                description:"List the lint rules and exit"`
+
+	Var     []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       unquote:"false"  value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
+	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  unquote:"false"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
+
+	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+}
+
+func (command *LintPipelineCommand) Execute(args []string) error {
+	if command.ListRules {
+		return lintpipelinehelpers.ListRules(os.Stdout)
+	}
+
+	if command.Config == "" {
+		return errors.New("the required flag `-c, --config' was not specified")
+	}
+
+	yamlTemplate := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar, nil)
+	return lintpipelinehelpers.Lint(os.Stdout, yamlTemplate, string(command.Config), command.Disable, command.Format)
+}
@@ -32,6 +32,7 @@ type LoginCommand struct {
 	ClientCertPath atc.PathFlag `long:"client-cert" description:"Path to a PEM-encoded client certificate file."`
 	ClientKeyPath  atc.PathFlag `long:"client-key" description:"Path to a PEM-encoded client key file."`
 	OpenBrowser    bool         `short:"b" long:"open-browser" description:"Open browser to the auth endpoint"`
+	Device         bool         `long:"device" description:"Log in on another device, e.g. when there is no browser on this one. Prints a URL to visit and a code to enter there, then waits for the login to complete"`
 
 	BrowserOnly bool
 }
@@ -92,6 +93,10 @@ func (command *LoginCommand) Execute(args []string) error {
 		return errors.New("unexpected argument [" + strings.Join(args, ", ") + "]")
 	}
 
+	if command.Device && (command.Username != "" || command.Password != "") {
+		return errors.New("--device cannot be used with --username or --password")
+	}
+
 	err = target.ValidateWithWarningOnly()
 	if err != nil {
 		return err
@@ -120,7 +125,7 @@ func (command *LoginCommand) Execute(args []string) error {
 		return err
 	}
 
-	isRawMode := pty.IsTerminal() && !command.BrowserOnly
+	isRawMode := pty.IsTerminal() && !command.BrowserOnly && !command.Device
 	if isRawMode {
 		state, err := terminal.MakeRaw(int(os.Stdin.Fd()))
 		if err != nil {
@@ -134,10 +139,16 @@ func (command *LoginCommand) Execute(args []string) error {
 	}
 
 	if semver.Compare(legacySemver) <= 0 && semver.Compare(devSemver) != 0 {
+		if command.Device {
+			return errors.New("--device is not supported by this version of Concourse")
+		}
+
 		// Legacy Auth Support
 		tokenType, tokenValue, err = command.legacyAuth(target, command.BrowserOnly, isRawMode)
 	} else {
-		if command.Username != "" && command.Password != "" {
+		if command.Device {
+			tokenType, tokenValue, err = command.deviceGrant(client)
+		} else if command.Username != "" && command.Password != "" {
 			tokenType, tokenValue, err = command.passwordGrant(client, command.Username, command.Password)
 		} else {
 			tokenType, tokenValue, err = command.authCodeGrant(client.URL(), command.BrowserOnly, isRawMode)
@@ -195,6 +206,60 @@ func (command *LoginCommand) passwordGrant(client concourse.Client, username, pa
 	return token.TokenType, token.AccessToken, nil
 }
 
+// deviceGrant logs in with the OAuth 2.0 device authorization grant (RFC
+// 8628): the user completes the login in a browser anywhere, while fly polls
+// for the token.
+func (command *LoginCommand) deviceGrant(client concourse.Client) (string, string, error) {
+	oauth2Config := oauth2.Config{
+		ClientID:     "fly",
+		ClientSecret: "Zmx5",
+		Endpoint: oauth2.Endpoint{
+			DeviceAuthURL: client.URL() + "/sky/issuer/device/code",
+			TokenURL:      client.URL() + "/sky/issuer/token",
+			AuthStyle:     oauth2.AuthStyleInHeader,
+		},
+		Scopes: []string{"openid", "profile", "email", "federated:id", "groups"},
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, client.HTTPClient())
+
+	// the device authorization request is unauthenticated, but dex checks
+	// the client secret given with it once the user has logged in
+	deviceAuth, err := oauth2Config.DeviceAuth(ctx, oauth2.SetAuthURLParam("client_secret", oauth2Config.ClientSecret))
+	if err != nil {
+		return "", "", fmt.Errorf("request device code: %w", err)
+	}
+
+	fmt.Println("navigate to the following URL in a browser on any device:")
+	fmt.Println("")
+	fmt.Printf("  %s\n", deviceAuth.VerificationURI)
+	fmt.Println("")
+	fmt.Printf("and enter the code: %s\n", deviceAuth.UserCode)
+	fmt.Println("")
+
+	if command.OpenBrowser && deviceAuth.VerificationURIComplete != "" {
+		_ = open.Start(deviceAuth.VerificationURIComplete)
+	}
+
+	fmt.Println("waiting for the login to complete...")
+
+	token, err := oauth2Config.DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &retrieveErr) && retrieveErr.ErrorCode == "expired_token") {
+			return "", "", errors.New("the code expired before the login was completed")
+		}
+
+		if errors.As(err, &retrieveErr) && retrieveErr.ErrorCode == "access_denied" {
+			return "", "", errors.New("the login was denied")
+		}
+
+		return "", "", err
+	}
+
+	return token.TokenType, token.AccessToken, nil
+}
+
 func (command *LoginCommand) authCodeGrant(targetUrl string, browserOnly bool, isRawMode bool) (string, string, error) {
 	var tokenStr string
 
@@ -0,0 +1,243 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/buildlogs"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type LogsCommand struct {
+	Job   flaghelpers.JobFlag   `short:"j" long:"job"   value-name:"PIPELINE/JOB" description:"Get the logs of a build of the given job"`
+	Build flaghelpers.BuildFlag `short:"b" long:"build"                           description:"Get the logs of a specific build"`
+	Team  flaghelpers.TeamFlag  `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+
+	Step   string `long:"step"   value-name:"NAME" description:"Only include the output of the step with the given name"`
+	Since  string `long:"since"  value-name:"TIME" description:"Only include output emitted since the given time (RFC3339) or duration ago (e.g. 30m)"`
+	Stderr bool   `long:"stderr"                   description:"Only include output written to stderr"`
+
+	Format string `long:"format" default:"text" choice:"text" choice:"json" choice:"archive" description:"Format of the logs: raw text, build events as JSON, or a gzipped tarball of both"`
+	Output string `short:"o" long:"output" value-name:"PATH" description:"File to write the logs to, instead of stdout"`
+
+	Grep   string `long:"grep"   value-name:"PATTERN" description:"Search the output of the job's most recent builds for lines matching the regular expression"`
+	Builds int    `long:"builds" default:"10"         description:"Number of builds to search with --grep"`
+}
+
+func (command *LogsCommand) Execute(args []string) error {
+	since, err := command.since()
+	if err != nil {
+		return err
+	}
+
+	filter := buildlogs.Filter{
+		Step:       command.Step,
+		Since:      since,
+		StderrOnly: command.Stderr,
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	if command.Grep != "" {
+		return command.grep(target.Client(), team, filter)
+	}
+
+	return command.logs(target.Client(), team, filter)
+}
+
+func (command *LogsCommand) since() (time.Time, error) {
+	if command.Since == "" {
+		return time.Time{}, nil
+	}
+
+	duration, err := time.ParseDuration(command.Since)
+	if err == nil {
+		return time.Now().Add(-duration), nil
+	}
+
+	since, err := time.Parse(time.RFC3339, command.Since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since '%s': must be a time (RFC3339) or a duration", command.Since)
+	}
+
+	return since, nil
+}
+
+func (command *LogsCommand) logs(client concourse.Client, team concourse.Team, filter buildlogs.Filter) error {
+	var buildID int
+	if command.Job.JobName != "" || command.Build == "" {
+		build, err := GetBuild(client, team, command.Job.JobName, command.Build.String(), command.Job.PipelineRef)
+		if err != nil {
+			return err
+		}
+
+		buildID = build.ID
+	} else {
+		var err error
+		buildID, err = strconv.Atoi(command.Build.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	steps, err := buildSteps(client, buildID)
+	if err != nil {
+		return err
+	}
+
+	if command.Step != "" && !steps.Has(command.Step) {
+		return fmt.Errorf("build has no step named '%s'", command.Step)
+	}
+
+	var dst io.Writer = os.Stdout
+	if command.Output != "" {
+		file, err := os.Create(command.Output)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		dst = file
+	}
+
+	writer, err := buildlogs.NewWriter(command.Format, dst, steps)
+	if err != nil {
+		return err
+	}
+
+	eventSource, err := client.BuildEvents(strconv.Itoa(buildID))
+	if err != nil {
+		return err
+	}
+
+	defer eventSource.Close()
+
+	for {
+		ev, err := eventSource.NextEvent()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return err
+		}
+
+		if !filter.Keep(steps, ev) {
+			continue
+		}
+
+		err = writer.WriteEvent(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+// grep searches the finished builds of the job, oldest first, so that the
+// first build to log a line is listed first.
+func (command *LogsCommand) grep(client concourse.Client, team concourse.Team, filter buildlogs.Filter) error {
+	if command.Job.JobName == "" {
+		return errors.New("--grep requires a job (-j)")
+	}
+
+	if command.Build != "" {
+		return errors.New("--grep searches the most recent builds of the job and cannot be given a build (-b)")
+	}
+
+	pattern, err := regexp.Compile(command.Grep)
+	if err != nil {
+		return fmt.Errorf("invalid --grep pattern: %w", err)
+	}
+
+	builds, _, found, err := team.JobBuilds(command.Job.PipelineRef, command.Job.JobName, concourse.Page{Limit: command.Builds})
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("job not found")
+	}
+
+	matched := false
+	for i := len(builds) - 1; i >= 0; i-- {
+		build := builds[i]
+		if build.IsRunning() {
+			continue
+		}
+
+		steps, err := buildSteps(client, build.ID)
+		if err != nil {
+			return err
+		}
+
+		if command.Step != "" && !steps.Has(command.Step) {
+			continue
+		}
+
+		eventSource, err := client.BuildEvents(strconv.Itoa(build.ID))
+		if err != nil {
+			return err
+		}
+
+		matches, err := buildlogs.Search(eventSource, steps, filter, pattern)
+		eventSource.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, match := range matches {
+			matched = true
+
+			step := match.Step
+			if step == "" {
+				step = "-"
+			}
+
+			fmt.Printf("#%s %s: %s\n", build.Name, ui.Embolden("%s", step), match.Line)
+		}
+	}
+
+	if !matched {
+		fmt.Fprintln(ui.Stderr, "no matches found")
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+func buildSteps(client concourse.Client, buildID int) (buildlogs.Steps, error) {
+	plan, found, err := client.BuildPlan(buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found || plan.Plan == nil {
+		return buildlogs.Steps{}, nil
+	}
+
+	return buildlogs.StepsFromPlan(*plan.Plan)
+}
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"errors"
+	"os"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/pipelinegraph"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type PipelineGraphCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to draw the graph of"`
+	Format   string                   `long:"format" default:"dot" choice:"dot" choice:"mermaid" choice:"json" description:"Format of the graph: Graphviz DOT, a Mermaid flowchart, or JSON"`
+	Team     flaghelpers.TeamFlag     `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *PipelineGraphCommand) Execute([]string) error {
+	_, err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	config, _, found, err := team.PipelineConfig(command.Pipeline.Ref())
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	return pipelinegraph.Write(os.Stdout, command.Pipeline.Ref().String(), config.Graph(), command.Format)
+}
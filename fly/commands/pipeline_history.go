@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type PipelineHistoryCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to list the saved configs of"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Team     flaghelpers.TeamFlag     `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *PipelineHistoryCommand) Execute([]string) error {
+	_, err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	versions, found, err := team.PipelineConfigVersions(command.Pipeline.Ref())
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(versions)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "saved at", Color: color.New(color.Bold)},
+			{Contents: "saved by", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, version := range versions {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(version.Version)},
+			{Contents: time.Unix(version.CreatedAt, 0).Format(timeDateLayout)},
+			savedByCell(version),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func savedByCell(version atc.ConfigVersion) ui.TableCell {
+	if version.BuildID != 0 {
+		step := fmt.Sprintf("set_pipeline in build %d", version.BuildID)
+		if version.JobName != "" {
+			step = fmt.Sprintf("set_pipeline in %s #%s", version.JobName, version.BuildName)
+		}
+
+		if version.CreatedBy != "" {
+			step += " (" + version.CreatedBy + ")"
+		}
+
+		return ui.TableCell{Contents: step}
+	}
+
+	if version.CreatedBy != "" {
+		return ui.TableCell{Contents: version.CreatedBy}
+	}
+
+	return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+}
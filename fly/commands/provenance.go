@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type ProvenanceCommand struct {
+	Job   flaghelpers.JobFlag   `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to show the provenance of a build of"`
+	Build flaghelpers.BuildFlag `short:"b" long:"build" required:"true" description:"If job is specified: build number. If job not specified: build id"`
+	Team  flaghelpers.TeamFlag  `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+	Json  bool                  `long:"json" description:"Print the signed provenance envelope as JSON"`
+}
+
+func (command *ProvenanceCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	team, err = command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build.String())
+	} else {
+		build, exists, err = team.JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build.String())
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	envelope, found, err := target.Client().BuildProvenance(build.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("build has no provenance")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(envelope)
+	}
+
+	statement, err := envelope.Statement()
+	if err != nil {
+		return err
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "kind", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "digest", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, subject := range statement.Subject {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: "output"},
+			{Contents: subject.Name},
+			{Contents: formatDigest(subject.Digest)},
+		})
+	}
+
+	for _, dependency := range statement.Predicate.BuildDefinition.ResolvedDependencies {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: "input"},
+			{Contents: dependency.Name},
+			{Contents: formatDigest(dependency.Digest)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func formatDigest(digest map[string]string) string {
+	algorithms := make([]string, 0, len(digest))
+	for algorithm := range digest {
+		algorithms = append(algorithms, algorithm)
+	}
+
+	sort.Strings(algorithms)
+
+	values := make([]string, len(algorithms))
+	for i, algorithm := range algorithms {
+		values[i] = algorithm + ":" + digest[algorithm]
+	}
+
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/asciicast"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ReplayCommand struct {
+	Session int                  `short:"s" long:"session" required:"true" description:"ID of the intercept session to replay, as listed by intercept-sessions"`
+	Output  string               `short:"o" long:"output" description:"Save the recording in asciicast format to this file instead of playing it"`
+	Speed   float64              `long:"speed" default:"1" description:"How many times faster than real time to play the recording"`
+	MaxIdle time.Duration        `long:"max-idle" default:"2s" description:"Longest pause to play between events. Set to 0 to play pauses as they were"`
+	Team    flaghelpers.TeamFlag `long:"team" description:"Name of the team the session belongs to, if different from the target default"`
+}
+
+func (command *ReplayCommand) Execute([]string) error {
+	if command.Speed <= 0 {
+		return errors.New("speed must be greater than 0")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	recording, found, err := team.InterceptSessionRecording(command.Session)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("session not found or not recorded")
+	}
+
+	defer recording.Close()
+
+	if command.Output != "" {
+		file, err := os.Create(command.Output)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		_, err = io.Copy(file, recording)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "saved recording to %s\n", command.Output)
+
+		return file.Close()
+	}
+
+	_, events, err := asciicast.Read(recording)
+	if err != nil {
+		return err
+	}
+
+	player := asciicast.Player{
+		Speed:   command.Speed,
+		MaxIdle: command.MaxIdle,
+	}
+
+	return player.Play(os.Stdout, events)
+}
@@ -13,13 +13,13 @@ import (
 )
 
 type RerunBuildCommand struct {
-	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of the job that you want to rerun a build for"`
-	Build string              `short:"b" long:"build" required:"true" description:"The number of the build to rerun"`
-	Watch bool                `short:"w" long:"watch" description:"Start watching the rerun build output"`
+	Job   flaghelpers.JobFlag   `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of the job that you want to rerun a build for"`
+	Build flaghelpers.BuildFlag `short:"b" long:"build" required:"true" description:"The number of the build to rerun"`
+	Watch bool                  `short:"w" long:"watch" description:"Start watching the rerun build output"`
 }
 
 func (command *RerunBuildCommand) Execute(args []string) error {
-	jobName, buildName := command.Job.JobName, command.Build
+	jobName, buildName := command.Job.JobName, command.Build.String()
 	pipelineRef := command.Job.PipelineRef
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/setpipelinehelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type RollbackPipelineCommand struct {
+	Pipeline        flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to roll back"`
+	To              int                      `long:"to" required:"true" value-name:"VERSION" description:"Version of the config to roll back to, as listed by pipeline-history"`
+	SkipInteractive bool                     `short:"n" long:"non-interactive" description:"Skips interactions, uses default values"`
+	Team            flaghelpers.TeamFlag     `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *RollbackPipelineCommand) Execute([]string) error {
+	_, err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	config, err := savedConfig(team, command.Pipeline.Ref(), command.To)
+	if err != nil {
+		return err
+	}
+
+	payload, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("rolling back to config version %d\n\n", command.To)
+
+	atcConfig := setpipelinehelpers.ATCConfig{
+		Team:            team,
+		PipelineRef:     command.Pipeline.Ref(),
+		TargetName:      Fly.Target,
+		Target:          target.Client().URL(),
+		SkipInteraction: command.SkipInteractive,
+		GivenTeamName:   string(command.Team),
+	}
+
+	return atcConfig.Apply(config, payload)
+}
@@ -0,0 +1,185 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/localexec"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/vars"
+	"sigs.k8s.io/yaml"
+)
+
+type RunJobLocallyCommand struct {
+	Config atc.PathFlag                `short:"c" long:"config" required:"true" description:"Pipeline configuration file"`
+	Job    string                      `short:"j" long:"job"    required:"true" value-name:"JOB" description:"Name of the job to run"`
+	Inputs []flaghelpers.InputPairFlag `short:"i" long:"input"  value-name:"RESOURCE=PATH" description:"A directory to use in place of fetching a resource (can be specified multiple times)"`
+
+	Var      []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       unquote:"false"  value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
+	YAMLVar  []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  unquote:"false"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
+	VarsFrom []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	Containerd struct {
+		Socket        string `long:"socket"          default:"/run/containerd/containerd.sock" description:"Path to the socket of the containerd to run the job's steps on"`
+		InitBin       string `long:"init-bin"                                                   description:"Path to an init executable to run as PID 1 of the job's containers"`
+		CNIPluginsDir string `long:"cni-plugins-dir" default:"/opt/cni/bin"                      description:"Path to the CNI plugins used to network the job's containers"`
+	} `group:"Local Execution" namespace:"containerd"`
+}
+
+func (command *RunJobLocallyCommand) Execute(args []string) error {
+	config, err := command.pipelineConfig()
+	if err != nil {
+		return err
+	}
+
+	credVars, err := command.vars()
+	if err != nil {
+		return err
+	}
+
+	inputs := map[string]string{}
+	for _, input := range command.Inputs {
+		dir, err := filepath.Abs(input.Path)
+		if err != nil {
+			return err
+		}
+
+		if _, found := config.Resources.Lookup(input.Name); !found {
+			return fmt.Errorf("unknown resource '%s'", input.Name)
+		}
+
+		inputs[input.Name] = dir
+	}
+
+	jobBuild, err := localexec.NewJobBuild(localexec.Job{
+		Pipeline:     config,
+		PipelineName: filepath.Base(string(command.Config)),
+		Name:         command.Job,
+		Inputs:       inputs,
+		Vars:         credVars,
+	})
+	if err != nil {
+		return err
+	}
+
+	runner, err := localexec.NewRunner(localexec.Config{
+		ContainerdSocket: command.Containerd.Socket,
+		InitBin:          command.Containerd.InitBin,
+		CNIPluginsDir:    command.Containerd.CNIPluginsDir,
+		WorkDir:          filepath.Join(os.TempDir(), "fly-local"),
+	})
+	if err != nil {
+		return err
+	}
+
+	defer runner.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	terminate := make(chan os.Signal, 1)
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-terminate
+		fmt.Fprintf(ui.Stderr, "\naborting...\n")
+		cancel()
+
+		// if told to terminate again, exit immediately
+		<-terminate
+		fmt.Fprintln(ui.Stderr, "exiting immediately")
+		os.Exit(2)
+	}()
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- runner.RunJob(ctx, jobBuild)
+	}()
+
+	exitCode := eventstream.Render(os.Stdout, jobBuild, eventstream.RenderOptions{})
+
+	err = <-runErr
+	if err != nil {
+		return err
+	}
+
+	runner.Close()
+	os.Exit(exitCode)
+
+	return nil
+}
+
+// pipelineConfig loads the pipeline the same way set-pipeline does, except
+// that it must be valid as is.
+func (command *RunJobLocallyCommand) pipelineConfig() (atc.Config, error) {
+	yamlTemplate := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar, nil)
+
+	evaluatedTemplate, err := yamlTemplate.Evaluate(false, false)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	var config atc.Config
+	err = yaml.Unmarshal(evaluatedTemplate, &config)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	_, errorMessages := configvalidate.Validate(config)
+	if len(errorMessages) > 0 {
+		displayhelpers.ShowErrors("Error loading config", errorMessages)
+		return atc.Config{}, errors.New("configuration invalid")
+	}
+
+	return config, nil
+}
+
+// vars are the variables given on the command line, which are also used to
+// interpolate task configs and the like while the job runs, as credentials
+// would be on a cluster. Flags take precedence over files, and later files
+// over earlier ones.
+func (command *RunJobLocallyCommand) vars() (vars.StaticVariables, error) {
+	credVars := vars.StaticVariables{}
+
+	for _, path := range command.VarsFrom {
+		content, err := os.ReadFile(string(path))
+		if err != nil {
+			return nil, fmt.Errorf("could not read template variables file (%s): %s", string(path), err.Error())
+		}
+
+		var fileVars vars.StaticVariables
+		err = yaml.Unmarshal(content, &fileVars)
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal template variables (%s): %s", string(path), err.Error())
+		}
+
+		for name, value := range fileVars {
+			credVars[name] = value
+		}
+	}
+
+	var flagVarPairs vars.KVPairs
+	for _, f := range command.Var {
+		flagVarPairs = append(flagVarPairs, vars.KVPair(f))
+	}
+	for _, f := range command.YAMLVar {
+		flagVarPairs = append(flagVarPairs, vars.KVPair(f))
+	}
+
+	for name, value := range flagVarPairs.Expand() {
+		credVars[name] = value
+	}
+
+	return credVars, nil
+}
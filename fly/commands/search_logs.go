@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type SearchLogsCommand struct {
+	Query string              `short:"q" long:"query" required:"true" description:"Words, \"quoted phrases\" and -excluded words to search the build logs for"`
+	Job   flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to search the build logs of"`
+	Since string              `long:"since" description:"Only search output logged at or after this time"`
+	Until string              `long:"until" description:"Only search output logged before this time"`
+	Count int                 `short:"c" long:"count" default:"50" description:"Number of matches to show"`
+	Json  bool                `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *SearchLogsCommand) Execute([]string) error {
+	search := concourse.BuildLogSearch{
+		Query:       command.Query,
+		PipelineRef: command.Job.PipelineRef,
+		JobName:     command.Job.JobName,
+		Limit:       command.Count,
+	}
+
+	var err error
+	if command.Since != "" {
+		search.Since, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Until != "" {
+		search.Until, err = time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Since != "" && command.Until != "" && search.Since.After(search.Until) {
+		return errors.New("Cannot have --since after --until")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	matches, found, err := target.Team().SearchBuildLogs(search)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s/%s not found", command.Job.PipelineRef.String(), command.Job.JobName)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(matches)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "time", Color: color.New(color.Bold)},
+			{Contents: "output", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, match := range matches {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(match.BuildID)},
+			{Contents: matchBuildName(match)},
+			{Contents: time.Unix(match.Time, 0).Local().Format(timeDateLayout)},
+			{Contents: strings.Join(strings.Fields(match.Payload), " ")},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func matchBuildName(match atc.BuildLogMatch) string {
+	var names []string
+	if match.PipelineName != "" {
+		pipelineRef := atc.PipelineRef{
+			Name:         match.PipelineName,
+			InstanceVars: match.PipelineInstanceVars,
+		}
+
+		names = append(names, pipelineRef.String())
+	}
+
+	if match.JobName != "" {
+		names = append(names, match.JobName)
+	}
+
+	names = append(names, match.BuildName)
+
+	return strings.Join(names, "/")
+}
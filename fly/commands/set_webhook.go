@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type SetWebhookCommand struct {
+	Webhook string               `short:"w" long:"webhook" required:"true" description:"Name of the webhook to create or update"`
+	URL     string               `short:"u" long:"url"     required:"true" description:"URL the team's events are POSTed to"`
+	Events  []string             `short:"e" long:"event"   description:"Event to send to the webhook (build_status, pipeline_config, pipeline_paused, pipeline_unpaused, worker_state or job_slo_breach). Can be specified multiple times. Defaults to every event"`
+	Secret  string               `long:"secret" description:"Secret used to sign the payloads. Generated and printed once if not specified"`
+	Team    flaghelpers.TeamFlag `long:"team" description:"Name of the team the webhook belongs to, if different from the target default"`
+}
+
+func (command *SetWebhookCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	secret := command.Secret
+	generated := secret == ""
+	if generated {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			return err
+		}
+	}
+
+	webhook := atc.Webhook{
+		URL:    command.URL,
+		Secret: secret,
+	}
+
+	for _, event := range command.Events {
+		webhook.Events = append(webhook.Events, atc.WebhookEvent(event))
+	}
+
+	err = webhook.Validate()
+	if err != nil {
+		return err
+	}
+
+	_, err = team.SetWebhook(command.Webhook, webhook)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("webhook `%s` set\n", command.Webhook)
+
+	if generated {
+		fmt.Println()
+		fmt.Println("payloads will be signed with the following secret, which will not be shown again:")
+		fmt.Println()
+		fmt.Println("  " + secret)
+	}
+
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
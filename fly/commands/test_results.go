@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type TestResultsCommand struct {
+	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to show the test results of"`
+	Count int                 `short:"c" long:"count" default:"25" description:"Number of the job's builds with test results to look at"`
+	Json  bool                `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *TestResultsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	results, found, err := target.Team().JobTestResults(command.Job.PipelineRef, command.Job.JobName, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s/%s not found", command.Job.PipelineRef.String(), command.Job.JobName)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(results)
+	}
+
+	builds := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "passed", Color: color.New(color.Bold)},
+			{Contents: "failed", Color: color.New(color.Bold)},
+			{Contents: "skipped", Color: color.New(color.Bold)},
+			{Contents: "duration", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, build := range results.Builds {
+		failed := ui.TableCell{Contents: strconv.Itoa(build.Summary.Failed + build.Summary.Errored)}
+		if build.Summary.Failed+build.Summary.Errored > 0 {
+			failed.Color = ui.FailedColor
+		}
+
+		builds.Data = append(builds.Data, ui.TableRow{
+			{Contents: build.BuildName},
+			ui.BuildStatusCell(build.Status),
+			{Contents: strconv.Itoa(build.Summary.Passed)},
+			failed,
+			{Contents: strconv.Itoa(build.Summary.Skipped)},
+			{Contents: testDuration(build.Summary.Duration)},
+		})
+	}
+
+	err = builds.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+
+	if len(results.Flaky) == 0 {
+		fmt.Println("no flaky tests")
+		return nil
+	}
+
+	fmt.Println(ui.Embolden("flaky tests:"))
+
+	flaky := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "suite", Color: color.New(color.Bold)},
+			{Contents: "test", Color: color.New(color.Bold)},
+			{Contents: "passed", Color: color.New(color.Bold)},
+			{Contents: "failed", Color: color.New(color.Bold)},
+			{Contents: "last failed", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, test := range results.Flaky {
+		flaky.Data = append(flaky.Data, ui.TableRow{
+			{Contents: test.Suite},
+			{Contents: testName(test)},
+			{Contents: strconv.Itoa(test.Passed)},
+			{Contents: strconv.Itoa(test.Failed), Color: ui.FailedColor},
+			{Contents: test.LastFailedBuild},
+		})
+	}
+
+	return flaky.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func testName(test atc.FlakyTest) string {
+	if test.ClassName == "" {
+		return test.Name
+	}
+
+	return test.ClassName + "." + test.Name
+}
+
+func testDuration(seconds float64) string {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond).String()
+}
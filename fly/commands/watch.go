@@ -14,12 +14,12 @@ import (
 )
 
 type WatchCommand struct {
-	Job                      flaghelpers.JobFlag  `short:"j" long:"job"         value-name:"PIPELINE/JOB"  description:"Watches builds of the given job"`
-	Build                    string               `short:"b" long:"build"                                  description:"Watches a specific build"`
-	Url                      string               `short:"u" long:"url"                                    description:"URL for the build or job to watch"`
-	Timestamp                bool                 `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
-	IgnoreEventParsingErrors bool                 `long:"ignore-event-parsing-errors"                      description:"Ignore event parsing errors"`
-	Team                     flaghelpers.TeamFlag `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+	Job                      flaghelpers.JobFlag   `short:"j" long:"job"         value-name:"PIPELINE/JOB"  description:"Watches builds of the given job"`
+	Build                    flaghelpers.BuildFlag `short:"b" long:"build"                                  description:"Watches a specific build"`
+	Url                      string                `short:"u" long:"url"                                    description:"URL for the build or job to watch"`
+	Timestamp                bool                  `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
+	IgnoreEventParsingErrors bool                  `long:"ignore-event-parsing-errors"                      description:"Ignore event parsing errors"`
+	Team                     flaghelpers.TeamFlag  `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
 }
 
 func getBuildIDFromURL(target rc.Target, urlParam string, team concourse.Team) (int, error) {
@@ -95,13 +95,13 @@ func (command *WatchCommand) Execute(args []string) error {
 	var buildId int
 	client := target.Client()
 	if command.Job.JobName != "" || command.Build == "" && command.Url == "" {
-		build, err := GetBuild(client, team, command.Job.JobName, command.Build, command.Job.PipelineRef)
+		build, err := GetBuild(client, team, command.Job.JobName, command.Build.String(), command.Job.PipelineRef)
 		if err != nil {
 			return err
 		}
 		buildId = build.ID
 	} else if command.Build != "" {
-		buildId, err = strconv.Atoi(command.Build)
+		buildId, err = strconv.Atoi(command.Build.String())
 
 		if err != nil {
 			return err
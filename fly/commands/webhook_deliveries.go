@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WebhookDeliveriesCommand struct {
+	Webhook string               `short:"w" long:"webhook" required:"true" description:"Name of the webhook whose deliveries to list"`
+	Count   int                  `short:"c" long:"count" default:"50" description:"Number of deliveries you want to limit the return to"`
+	Json    bool                 `long:"json" description:"Print command result as JSON"`
+	Team    flaghelpers.TeamFlag `long:"team" description:"Name of the team the webhook belongs to, if different from the target default"`
+}
+
+func (command *WebhookDeliveriesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	deliveries, found, err := team.WebhookDeliveries(command.Webhook, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("webhook not found")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(deliveries)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "event", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "attempts", Color: color.New(color.Bold)},
+			{Contents: "response", Color: color.New(color.Bold)},
+			{Contents: "next attempt", Color: color.New(color.Bold)},
+			{Contents: "error", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, delivery := range deliveries {
+		response := stringOrDefault("")
+		if delivery.ResponseStatus != 0 {
+			response = ui.TableCell{Contents: strconv.Itoa(delivery.ResponseStatus)}
+		}
+
+		nextAttempt := stringOrDefault("")
+		if delivery.NextAttemptAt != 0 {
+			nextAttempt = ui.TableCell{Contents: time.Unix(delivery.NextAttemptAt, 0).Format(timeDateLayout)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(delivery.ID)},
+			{Contents: string(delivery.Event)},
+			{Contents: time.Unix(delivery.CreatedAt, 0).Format(timeDateLayout)},
+			webhookDeliveryStatusCell(delivery.Status),
+			{Contents: strconv.Itoa(delivery.Attempts)},
+			response,
+			nextAttempt,
+			stringOrDefault(delivery.Error),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func webhookDeliveryStatusCell(status atc.WebhookDeliveryStatus) ui.TableCell {
+	cell := ui.TableCell{Contents: string(status)}
+
+	switch status {
+	case atc.WebhookDeliveryPending:
+		cell.Color = ui.StartedColor
+	case atc.WebhookDeliveryDelivered:
+		cell.Color = ui.SucceededColor
+	case atc.WebhookDeliveryFailed:
+		cell.Color = ui.FailedColor
+	}
+
+	return cell
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WebhooksCommand struct {
+	Json bool                 `long:"json" description:"Print command result as JSON"`
+	Team flaghelpers.TeamFlag `long:"team" description:"Name of the team whose webhooks to list, if different from the target default"`
+}
+
+func (command *WebhooksCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := team.ListWebhooks()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(webhooks)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "url", Color: color.New(color.Bold)},
+			{Contents: "events", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, webhook := range webhooks {
+		events := ui.TableCell{Contents: "all", Color: ui.OffColor}
+		if len(webhook.Events) > 0 {
+			var names []string
+			for _, event := range webhook.Events {
+				names = append(names, string(event))
+			}
+
+			events = ui.TableCell{Contents: strings.Join(names, ",")}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: webhook.Name},
+			{Contents: webhook.URL},
+			events,
+			{Contents: time.Unix(webhook.CreatedAt, 0).Format(timeDateLayout)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
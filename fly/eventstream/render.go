@@ -74,6 +74,10 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.Annotations:
+			dstImpl.SetTimestamp(e.Time)
+			renderAnnotations(dstImpl, e)
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			dstImpl.SetTimestamp(0)
@@ -119,6 +123,28 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 	}
 }
 
+func renderAnnotations(dst io.Writer, e event.Annotations) {
+	fmt.Fprintf(dst, "\x1b[1mannotations:\x1b[0m\n")
+
+	if tests := e.Annotations.Tests; tests != nil {
+		fmt.Fprintf(dst, "  tests: %d passed, %d failed, %d skipped\n", tests.Passed, tests.Failed, tests.Skipped)
+	}
+
+	for _, field := range e.Annotations.Metadata {
+		fmt.Fprintf(dst, "  %s: %s\n", field.Name, field.Value)
+	}
+
+	for _, link := range e.Annotations.Links {
+		fmt.Fprintf(dst, "  %s: %s\n", link.Name, link.URL)
+	}
+
+	if e.Annotations.Summary != "" {
+		for _, line := range strings.Split(strings.TrimRight(e.Annotations.Summary, "\n"), "\n") {
+			fmt.Fprintf(dst, "  %s\n", line)
+		}
+	}
+}
+
 func isEventParseError(err error) bool {
 	if _, ok := err.(event.UnknownEventTypeError); ok {
 		return true
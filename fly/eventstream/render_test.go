@@ -318,6 +318,32 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when an Annotations event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Annotations{
+				Time: time.Now().Unix(),
+				Annotations: atc.BuildAnnotations{
+					Step:     "unit",
+					Metadata: []atc.MetadataField{{Name: "commit", Value: "abc123"}},
+					Links:    []atc.BuildAnnotationLink{{Name: "preview", URL: "https://example.com"}},
+					Summary:  "all good\nreally\n",
+					Tests:    &atc.BuildAnnotationTests{Passed: 1234, Failed: 1},
+				},
+			}
+		})
+
+		It("prints them", func() {
+			Expect(out.Contents()).To(ContainSubstring(
+				"\x1b[1mannotations:\x1b[0m\n" +
+					"  tests: 1234 passed, 1 failed, 0 skipped\n" +
+					"  commit: abc123\n" +
+					"  preview: https://example.com\n" +
+					"  all good\n" +
+					"  really\n",
+			))
+		})
+	})
+
 	Context("when a SelectedWorker event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.SelectedWorker{
@@ -0,0 +1,163 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"regexp"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("activity", func() {
+		var (
+			flyCmd *exec.Cmd
+			events []atc.ActivityEvent
+		)
+
+		yes := true
+		no := false
+
+		streamActivity := func(path string, query string) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", path, query),
+				func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+
+					for id, e := range events {
+						payload, err := json.Marshal(e)
+						Expect(err).NotTo(HaveOccurred())
+
+						err = sse.Event{
+							ID:   fmt.Sprintf("%d", id),
+							Name: "event",
+							Data: payload,
+						}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+					}
+				},
+			)
+		}
+
+		BeforeEach(func() {
+			events = []atc.ActivityEvent{
+				{
+					Type:         atc.ActivityBuildFinished,
+					Time:         100,
+					TeamName:     "main",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					BuildID:      42,
+					BuildName:    "7",
+					BuildStatus:  atc.StatusSucceeded,
+				},
+				{
+					Type:                 atc.ActivityPipelineChanged,
+					Time:                 200,
+					TeamName:             "main",
+					PipelineName:         "some-pipeline",
+					PipelineInstanceVars: atc.InstanceVars{"branch": "main"},
+					Paused:               &yes,
+					Archived:             &no,
+					Public:               &no,
+				},
+				{
+					Type: atc.ActivityResync,
+					Time: 300,
+				},
+			}
+		})
+
+		Context("for the target's team", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "activity")
+
+				atcServer.AppendHandlers(streamActivity("/api/v1/teams/main/activity", ""))
+			})
+
+			It("prints each event as it happens", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(regexp.QuoteMeta(time.Unix(100, 0).Format("2006-01-02@15:04:05-0700") + "  main/some-pipeline/some-job  build #7 succeeded")))
+				Expect(sess.Out).To(gbytes.Say(regexp.QuoteMeta(time.Unix(200, 0).Format("2006-01-02@15:04:05-0700") + "  main/some-pipeline/branch:main  paused, hidden")))
+				Expect(sess.Out).To(gbytes.Say(regexp.QuoteMeta(time.Unix(300, 0).Format("2006-01-02@15:04:05-0700") + "  -  events may have been missed")))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints each event as a line of json", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say(regexp.QuoteMeta(`{"type":"build_finished","time":100,"team_name":"main","pipeline_name":"some-pipeline","job_name":"some-job","build_id":42,"build_name":"7","build_status":"succeeded"}`)))
+				})
+			})
+		})
+
+		Context("when limited to some events across every team", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "activity", "--all", "-e", "build_finished", "-e", "job_changed")
+
+				events = events[:1]
+				atcServer.AppendHandlers(streamActivity("/api/v1/activity", "type=build_finished&type=job_changed"))
+			})
+
+			It("streams the cluster's activity", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(`main/some-pipeline/some-job  build #7 succeeded`))
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "activity", "--all")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/activity"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("forbidden"))
+			})
+		})
+
+		Context("with an unknown event", func() {
+			It("errors without connecting", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "activity", "-e", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("Invalid value `bogus'"))
+			})
+		})
+	})
+})
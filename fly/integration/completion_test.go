@@ -0,0 +1,167 @@
+package integration_test
+
+import (
+	"os"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("completion", func() {
+		complete := func(env []string, args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName}, args...)...)
+			flyCmd.Env = append(append(os.Environ(), "GO_FLAGS_COMPLETION=1"), env...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+
+			return sess
+		}
+
+		Describe("resources", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{Name: "some-pipeline"},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Resource{
+							{Name: "repo"},
+							{Name: "release"},
+							{Name: "image"},
+						}),
+					),
+				)
+			})
+
+			It("returns the matching resources of the pipeline", func() {
+				sess := complete(nil, "check-resource", "-r", "some-pipeline/re")
+				Expect(string(sess.Out.Contents())).To(Equal("some-pipeline/release\nsome-pipeline/repo\n"))
+			})
+		})
+
+		Describe("builds", func() {
+			Context("of a job", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds", "limit=50"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Build{
+								{ID: 13, Name: "12", Status: atc.StatusSucceeded},
+								{ID: 11, Name: "11", Status: atc.StatusFailed},
+								{ID: 5, Name: "2", Status: atc.StatusSucceeded},
+							}),
+						),
+					)
+				})
+
+				It("returns the matching build names", func() {
+					sess := complete(nil, "watch", "-j", "some-pipeline/some-job", "-b", "1")
+					Expect(string(sess.Out.Contents())).To(Equal("11\n12\n"))
+				})
+			})
+
+			Context("without a job", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds", "limit=50"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Build{
+								{ID: 13, Name: "12", PipelineName: "some-pipeline", JobName: "some-job", Status: atc.StatusSucceeded},
+								{ID: 2, Name: "2", Status: atc.StatusFailed},
+							}),
+						),
+					)
+				})
+
+				It("returns the build ids", func() {
+					sess := complete([]string{"GO_FLAGS_COMPLETION=verbose"}, "abort-build", "-b", "")
+					Expect(sess.Out).To(gbytes.Say(`13 +# some-pipeline/some-job #12 succeeded`))
+					Expect(sess.Out).To(gbytes.Say(`2 +# one-off failed`))
+				})
+			})
+		})
+
+		Describe("with --team", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/other-team/pipelines"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{Name: "other-pipeline"},
+						}),
+					),
+				)
+			})
+
+			It("completes with that team's values", func() {
+				sess := complete(nil, "get-pipeline", "--team", "other-team", "-p", "")
+				Expect(string(sess.Out.Contents())).To(Equal("other-pipeline\n"))
+			})
+		})
+
+		Describe("caching", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{Name: "some-pipeline"},
+							{Name: "another-pipeline"},
+						}),
+					),
+					infoHandler(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{Name: "new-pipeline"},
+						}),
+					),
+				)
+			})
+
+			It("reuses values fetched recently", func() {
+				sess := complete(nil, "get-pipeline", "-p", "")
+				Expect(string(sess.Out.Contents())).To(Equal("another-pipeline\nsome-pipeline\n"))
+
+				sess = complete(nil, "pause-pipeline", "-p", "some")
+				Expect(string(sess.Out.Contents())).To(Equal("some-pipeline\n"))
+
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(5))
+			})
+
+			It("can be turned off", func() {
+				complete(nil, "get-pipeline", "-p", "")
+
+				sess := complete([]string{"FLY_COMPLETION_CACHE_TTL=0"}, "get-pipeline", "-p", "")
+				Expect(string(sess.Out.Contents())).To(Equal("new-pipeline\n"))
+
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(7))
+			})
+		})
+
+		Describe("fish", func() {
+			It("asks fly for the values of flags", func() {
+				flyCmd := exec.Command(flyPath, "completion", "--shell", "fish")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say(`function __fly_complete`))
+				Expect(sess.Out).To(gbytes.Say(`complete -c fly -n "__fish_seen_subcommand_from  watch" -d "Watches builds of the given job" --l "job" -s "j" -x -a "\(__fly_complete\)"`))
+			})
+		})
+	})
+})
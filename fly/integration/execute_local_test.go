@@ -0,0 +1,102 @@
+package integration_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --local", func() {
+		var (
+			tmpdir         string
+			taskConfigPath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = os.MkdirTemp("", "fly-local-execute")
+			Expect(err).NotTo(HaveOccurred())
+
+			taskConfigPath = filepath.Join(tmpdir, "task.yml")
+
+			err = os.WriteFile(
+				taskConfigPath,
+				[]byte(`---
+platform: linux
+
+image_resource:
+  type: registry-image
+  source:
+    repository: busybox
+
+outputs:
+- name: some-output
+
+run:
+  path: true
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		run := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{"execute", "--local", "-c", taskConfigPath}, args...)...)
+			flyCmd.Dir = tmpdir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			return sess
+		}
+
+		It("does not need a target", func() {
+			sess := run("--containerd-socket", filepath.Join(tmpdir, "missing.sock"))
+			Expect(sess.Err).To(gbytes.Say("containerd socket not found at " + filepath.Join(tmpdir, "missing.sock")))
+		})
+
+		It("rejects --inputs-from", func() {
+			sess := run("-j", "some-pipeline/some-job")
+			Expect(sess.Err).To(gbytes.Say("--inputs-from cannot be used with --local"))
+		})
+
+		It("rejects --image", func() {
+			sess := run("--image", "some-image")
+			Expect(sess.Err).To(gbytes.Say("--image cannot be used with --local"))
+		})
+
+		It("rejects --background", func() {
+			sess := run("-b")
+			Expect(sess.Err).To(gbytes.Say("--background cannot be used with --local"))
+		})
+
+		It("rejects unknown outputs", func() {
+			sess := run("-o", "bogus=.")
+			Expect(sess.Err).To(gbytes.Say("unknown output 'bogus'"))
+		})
+
+		Context("without --local", func() {
+			It("rejects --local-image", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "execute", "-c", taskConfigPath, "--local-image", "image.tar")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--local-image requires --local"))
+			})
+		})
+	})
+})
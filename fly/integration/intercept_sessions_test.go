@@ -0,0 +1,162 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("intercept-sessions", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "intercept-sessions")
+		})
+
+		Context("when sessions are returned from the API", func() {
+			BeforeEach(func() {
+				exitStatus := 0
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/intercept-sessions", "limit=50"),
+						ghttp.RespondWithJSONEncoded(200, []atc.InterceptSession{
+							{
+								ID:              2,
+								ContainerHandle: "some-handle",
+								CreatedBy:       "some-user",
+								Command:         []string{"bash"},
+								BuildID:         42,
+								BuildName:       "7",
+								PipelineName:    "some-pipeline",
+								JobName:         "deploy",
+								StepName:        "push",
+								StartedAt:       100,
+								EndedAt:         160,
+								ExitStatus:      &exitStatus,
+								Recorded:        true,
+							},
+							{
+								ID:              1,
+								ContainerHandle: "other-handle",
+								Command:         []string{"sh", "-c", "env"},
+								StartedAt:       50,
+							},
+						}),
+					),
+				)
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"id": 2,
+							"container_handle": "some-handle",
+							"created_by": "some-user",
+							"command": ["bash"],
+							"build_id": 42,
+							"build_name": "7",
+							"pipeline_name": "some-pipeline",
+							"job_name": "deploy",
+							"step_name": "push",
+							"started_at": 100,
+							"ended_at": 160,
+							"exit_status": 0,
+							"recorded": true
+						},
+						{
+							"id": 1,
+							"container_handle": "other-handle",
+							"command": ["sh", "-c", "env"],
+							"started_at": 50,
+							"recorded": false
+						}
+					]`))
+				})
+			})
+
+			It("lists them to the user, newest first", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "started", Color: color.New(color.Bold)},
+						{Contents: "user", Color: color.New(color.Bold)},
+						{Contents: "pipeline/job", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "step", Color: color.New(color.Bold)},
+						{Contents: "command", Color: color.New(color.Bold)},
+						{Contents: "exit status", Color: color.New(color.Bold)},
+						{Contents: "recorded", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: time.Unix(100, 0).Format("2006-01-02@15:04:05-0700")}, {Contents: "some-user"}, {Contents: "some-pipeline/deploy"}, {Contents: "7"}, {Contents: "push"}, {Contents: "bash"}, {Contents: "0"}, {Contents: "yes"}},
+						{{Contents: "1"}, {Contents: time.Unix(50, 0).Format("2006-01-02@15:04:05-0700")}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "sh -c env"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "no", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+		})
+
+		Context("when --count is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--count", "5")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/intercept-sessions", "limit=5"),
+						ghttp.RespondWithJSONEncoded(200, []atc.InterceptSession{}),
+					),
+				)
+			})
+
+			It("limits the sessions returned", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("and the api returns an internal server error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/intercept-sessions"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+})
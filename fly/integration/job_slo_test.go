@@ -0,0 +1,166 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("job-slo", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "job-slo", "-j", "some-pipeline/some-job", "-c", "5")
+		})
+
+		Context("when the job exists", func() {
+			var report atc.JobSLOReport
+
+			BeforeEach(func() {
+				report = atc.JobSLOReport{
+					SLO: &atc.JobSLO{Duration: "10m", SuccessRate: 0.9, Window: 10},
+					Stats: atc.JobSLOStats{
+						Builds:         8,
+						Succeeded:      6,
+						SuccessRate:    0.75,
+						MeanDuration:   540,
+						StdDevDuration: 30,
+					},
+					Breaches: []atc.JobSLOBreach{
+						{
+							Build: atc.Build{ID: 8, Name: "8", Status: atc.StatusFailed},
+							Violations: []atc.JobSLOViolation{
+								{Kind: atc.JobSLOViolationSuccessRate, Value: 0.75, Target: 0.9},
+							},
+						},
+						{
+							Build: atc.Build{ID: 7, Name: "7", Status: atc.StatusSucceeded},
+							Violations: []atc.JobSLOViolation{
+								{Kind: atc.JobSLOViolationDuration, Value: 2700, Target: 600},
+								{Kind: atc.JobSLOViolationDurationAnomaly, Value: 2700, Target: 630},
+							},
+						},
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/slo", "breaches=5"),
+						ghttp.RespondWithJSONEncoded(200, report),
+					),
+				)
+			})
+
+			It("shows the targets and the breaches", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "measure", Color: color.New(color.Bold)},
+						{Contents: "target", Color: color.New(color.Bold)},
+						{Contents: "current", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "builds"}, {Contents: "10"}, {Contents: "8"}},
+						{{Contents: "success rate"}, {Contents: "90.0%"}, {Contents: "75.0%", Color: color.New(color.FgRed)}},
+						{{Contents: "mean duration"}, {Contents: "10m0s"}, {Contents: "9m0s"}},
+						{{Contents: "duration stddev"}, {Contents: "anomaly above 3σ"}, {Contents: "30s"}},
+					},
+				}))
+				Expect(sess.Out).To(gbytes.Say("breaches:"))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "violation", Color: color.New(color.Bold)},
+						{Contents: "value", Color: color.New(color.Bold)},
+						{Contents: "target", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "8"}, {Contents: "failed", Color: color.New(color.FgRed)}, {Contents: "success_rate"}, {Contents: "75.0%", Color: color.New(color.FgRed)}, {Contents: "90.0%"}},
+						{{Contents: "7"}, {Contents: "succeeded", Color: color.New(color.FgGreen)}, {Contents: "duration"}, {Contents: "45m0s", Color: color.New(color.FgRed)}, {Contents: "10m0s"}},
+						{{Contents: "7"}, {Contents: "succeeded", Color: color.New(color.FgGreen)}, {Contents: "duration_anomaly"}, {Contents: "45m0s", Color: color.New(color.FgRed)}, {Contents: "10m30s"}},
+					},
+				}))
+			})
+
+			Context("when the job has no SLO or breaches", func() {
+				BeforeEach(func() {
+					report.SLO = nil
+					report.Breaches = []atc.JobSLOBreach{}
+				})
+
+				It("shows no targets and says there are no breaches", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "measure", Color: color.New(color.Bold)},
+							{Contents: "target", Color: color.New(color.Bold)},
+							{Contents: "current", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "builds"}, {Contents: "20"}, {Contents: "8"}},
+							{{Contents: "success rate"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "75.0%"}},
+							{{Contents: "mean duration"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "9m0s"}},
+							{{Contents: "duration stddev"}, {Contents: "anomaly above 3σ"}, {Contents: "30s"}},
+						},
+					}))
+					Expect(sess.Out).To(gbytes.Say("no slo breaches"))
+				})
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+					report.Breaches = []atc.JobSLOBreach{}
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"slo": {"duration": "10m", "success_rate": 0.9, "window": 10},
+						"stats": {"builds": 8, "succeeded": 6, "success_rate": 0.75, "mean_duration": 540, "stddev_duration": 30},
+						"breaches": []
+					}`))
+				})
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/slo", "breaches=5"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("some-pipeline/some-job not found"))
+			})
+		})
+	})
+})
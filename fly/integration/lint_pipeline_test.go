@@ -0,0 +1,95 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("lint-pipeline", func() {
+		It("prints the findings and fails", func() {
+			flyCmd := exec.Command(flyPath, "lint-pipeline", "-c", "fixtures/lint-pipeline.yml")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+
+			Expect(sess.Out).To(gbytes.Say(`unused-resource\s+resources\.spare`))
+			Expect(sess.Out).To(gbytes.Say(`privileged-task\s+jobs\.unit\.task\(test\)`))
+			Expect(sess.Out).To(gbytes.Say(`missing-on-failure\s+jobs\.unit`))
+			Expect(sess.Err).To(gbytes.Say("pipeline has lint findings"))
+		})
+
+		It("skips disabled rules", func() {
+			flyCmd := exec.Command(
+				flyPath, "lint-pipeline",
+				"-c", "fixtures/lint-pipeline.yml",
+				"--disable", "unused-resource",
+				"--disable", "privileged-task",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(sess.Out).To(gbytes.Say(`missing-on-failure`))
+			Expect(sess.Out).ToNot(gbytes.Say(`unused-resource`))
+		})
+
+		It("rejects unknown rules", func() {
+			flyCmd := exec.Command(flyPath, "lint-pipeline", "-c", "fixtures/lint-pipeline.yml", "--disable", "bogus")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("unknown lint rule 'bogus'"))
+		})
+
+		It("prints SARIF", func() {
+			flyCmd := exec.Command(flyPath, "lint-pipeline", "-c", "fixtures/lint-pipeline.yml", "--format", "sarif")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+
+			var log struct {
+				Version string `json:"version"`
+				Runs    []struct {
+					Results []struct {
+						RuleID string `json:"ruleId"`
+					} `json:"results"`
+				} `json:"runs"`
+			}
+			Expect(json.Unmarshal(sess.Out.Contents(), &log)).To(Succeed())
+			Expect(log.Version).To(Equal("2.1.0"))
+			Expect(log.Runs[0].Results).To(HaveLen(3))
+			Expect(log.Runs[0].Results[0].RuleID).To(Equal("unused-resource"))
+		})
+
+		It("lists the rules", func() {
+			flyCmd := exec.Command(flyPath, "lint-pipeline", "--list-rules")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("unused-resource"))
+			Expect(sess.Out).To(gbytes.Say("inline-credential"))
+		})
+	})
+})
@@ -3,13 +3,21 @@ package integration_test
 import (
 	"bufio"
 	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
 	"encoding/base64"
 	"fmt"
 	"net/http"
+	"net/http/cookiejar"
+	"net/url"
 	"os"
 	"os/exec"
 	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"github.com/concourse/dex/storage/memory"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -18,6 +26,8 @@ import (
 	"github.com/onsi/gomega/ghttp"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/skymarshal/dexserver"
+	skylogger "github.com/concourse/concourse/skymarshal/logger"
 )
 
 var _ = Describe("login Command", func() {
@@ -478,6 +488,94 @@ var _ = Describe("login Command", func() {
 			})
 		})
 
+		Context("with device authorization grant", func() {
+			BeforeEach(func() {
+				logger := lagertest.NewTestLogger("dex")
+
+				signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+				Expect(err).NotTo(HaveOccurred())
+
+				dexServer, err := dexserver.NewDexServer(&dexserver.DexConfig{
+					Logger:            logger,
+					IssuerURL:         loginATCServer.URL() + "/sky/issuer",
+					SigningKey:        signingKey,
+					Expiration:        time.Hour,
+					Clients:           map[string]string{"fly": "Zmx5"},
+					Users:             map[string]string{"some-user": "some-password"},
+					PasswordConnector: "local",
+					RedirectURL:       loginATCServer.URL() + "/sky/callback",
+					Storage:           memory.New(skylogger.New(logger)),
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				issuer := regexp.MustCompile(`^/sky/issuer/`)
+				callback := dexserver.NewDeviceCallbackHandler("/sky/issuer", dexServer)
+				for _, method := range []string{"GET", "POST"} {
+					loginATCServer.RouteToHandler(method, issuer, dexServer.ServeHTTP)
+					loginATCServer.RouteToHandler(method, dexserver.DeviceCallbackPath, callback.ServeHTTP)
+				}
+
+				loginATCServer.AppendHandlers(
+					infoHandler(),
+					userInfoHandler(),
+				)
+			})
+
+			It("saves the token issued by dex once the login is completed in a browser", func() {
+				flyCmd = exec.Command(flyPath, "-t", "some-target", "login", "-c", loginATCServer.URL(), "--device")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say(regexp.QuoteMeta(loginATCServer.URL() + "/sky/issuer/device")))
+				Eventually(sess.Out).Should(gbytes.Say(`and enter the code: (\S+)`))
+
+				userCode := regexp.MustCompile(`and enter the code: (\S+)`).FindSubmatch(sess.Out.Contents())[1]
+
+				jar, err := cookiejar.New(nil)
+				Expect(err).NotTo(HaveOccurred())
+				browser := &http.Client{Jar: jar}
+
+				By("entering the code, which leads to the login form")
+				resp, err := browser.PostForm(loginATCServer.URL()+"/sky/issuer/device/auth/verify_code", url.Values{
+					"user_code": {string(userCode)},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				Expect(resp.Request.URL.Path).To(Equal("/sky/issuer/auth/local/login"))
+
+				By("logging in, which completes at the device callback")
+				resp, err = browser.PostForm(resp.Request.URL.String(), url.Values{
+					"login":    {"some-user"},
+					"password": {"some-password"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				Expect(resp.Request.URL.Path).To(Equal("/device/callback"))
+
+				Eventually(sess.Out, 30*time.Second).Should(gbytes.Say("target saved"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				flyrc, err := os.ReadFile(homeDir + "/.flyrc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(flyrc)).To(MatchRegexp(`value: \S+`))
+			})
+
+			It("cannot be used with a username and password", func() {
+				flyCmd = exec.Command(flyPath, "-t", "some-target", "login", "-c", loginATCServer.URL(), "--device", "-u", "some_username", "-p", "some_password")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("--device cannot be used with --username or --password"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+
 		Context("with password grant", func() {
 			BeforeEach(func() {
 				credentials := base64.StdEncoding.EncodeToString([]byte("fly:Zmx5"))
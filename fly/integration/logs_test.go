@@ -0,0 +1,179 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("logs", func() {
+		plan := json.RawMessage(`{"id":"1","do":[
+			{"id":"2","get":{"name":"repo","resource":"repo","type":"git"}},
+			{"id":"3","task":{"name":"unit","privileged":false}}
+		]}`)
+
+		planHandler := func(buildID int) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", fmt.Sprintf("/api/v1/builds/%d/plan", buildID)),
+				ghttp.RespondWithJSONEncoded(200, atc.PublicBuildPlan{Schema: "exec.v2", Plan: &plan}),
+			)
+		}
+
+		eventsHandler := func(buildID int, events ...atc.Event) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", fmt.Sprintf("/api/v1/builds/%d/events", buildID)),
+				func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+
+					for i, e := range events {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						err = sse.Event{ID: fmt.Sprintf("%d", i), Name: "event", Data: payload}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+					}
+
+					err := sse.Event{Name: "end"}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+		}
+
+		log := func(id string, source event.OriginSource, payload string) event.Log {
+			return event.Log{
+				Time:    100,
+				Origin:  event.Origin{ID: event.OriginID(id), Source: source},
+				Payload: payload,
+			}
+		}
+
+		logs := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "logs"}, args...)...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			return sess
+		}
+
+		Context("with a build of a job", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/42"),
+						ghttp.RespondWithJSONEncoded(200, atc.Build{ID: 123, Name: "42", Status: "failed"}),
+					),
+					planHandler(123),
+					eventsHandler(123,
+						log("2", event.OriginSourceStdout, "cloning\n"),
+						log("3", event.OriginSourceStdout, "running tests\n"),
+						log("3", event.OriginSourceStderr, "FAIL\n"),
+						event.Status{Status: atc.StatusFailed},
+					),
+				)
+			})
+
+			It("prints the output of the build", func() {
+				sess := logs("-j", "some-pipeline/some-job", "-b", "42")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(string(sess.Out.Contents())).To(Equal("cloning\nrunning tests\nFAIL\n"))
+			})
+
+			It("filters to the output of a step", func() {
+				sess := logs("-j", "some-pipeline/some-job", "-b", "42", "--step", "unit")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(string(sess.Out.Contents())).To(Equal("running tests\nFAIL\n"))
+			})
+
+			It("filters to stderr", func() {
+				sess := logs("-j", "some-pipeline/some-job", "-b", "42", "--stderr")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(string(sess.Out.Contents())).To(Equal("FAIL\n"))
+			})
+
+			It("prints the events as JSON", func() {
+				sess := logs("-j", "some-pipeline/some-job", "-b", "42", "--format", "json")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say(`"event":"log"`))
+				Expect(sess.Out).To(gbytes.Say(`"event":"status"`))
+			})
+
+			It("writes an archive to a file", func() {
+				dir, err := os.MkdirTemp("", "fly-logs")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.RemoveAll(dir)
+
+				path := filepath.Join(dir, "logs.tgz")
+				sess := logs("-j", "some-pipeline/some-job", "-b", "42", "--format", "archive", "-o", path)
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				content, err := os.ReadFile(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(content[:2]).To(Equal([]byte{0x1f, 0x8b}))
+			})
+
+			It("fails for steps the build doesn't have", func() {
+				sess := logs("-j", "some-pipeline/some-job", "-b", "42", "--step", "bogus")
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("build has no step named 'bogus'"))
+			})
+		})
+
+		Context("with --grep", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds", "limit=3"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Build{
+							{ID: 4, Name: "4", Status: "started"},
+							{ID: 3, Name: "3", Status: "failed"},
+							{ID: 2, Name: "2", Status: "succeeded"},
+						}),
+					),
+					planHandler(2),
+					eventsHandler(2, log("3", event.OriginSourceStdout, "ok\n")),
+					planHandler(3),
+					eventsHandler(3,
+						log("2", event.OriginSourceStdout, "cloning\n"),
+						log("3", event.OriginSourceStdout, "panic: something\nbroke\n"),
+					),
+				)
+			})
+
+			It("lists the matching lines of the finished builds, oldest first", func() {
+				sess := logs("-j", "some-pipeline/some-job", "--grep", "panic|ok", "--builds", "3")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say(`#2 unit: ok\n`))
+				Expect(sess.Out).To(gbytes.Say(`#3 unit: panic: something\n`))
+			})
+
+			It("exits 1 when nothing matches", func() {
+				sess := logs("-j", "some-pipeline/some-job", "--grep", "bogus", "--builds", "3")
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("no matches found"))
+			})
+		})
+
+		It("requires a job to grep", func() {
+			sess := logs("--grep", "error")
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say(`--grep requires a job \(-j\)`))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("pipeline-graph", func() {
+		graph := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "pipeline-graph"}, args...)...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			return sess
+		}
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				config := atc.Config{
+					Resources: atc.ResourceConfigs{{Name: "repo", Type: "git"}},
+					Jobs: atc.JobConfigs{
+						{
+							Name:         "unit",
+							PlanSequence: []atc.Step{{Config: &atc.GetStep{Name: "repo", Trigger: true}}},
+						},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: config}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+					),
+				)
+			})
+
+			It("prints the graph as DOT by default", func() {
+				sess := graph("-p", "some-pipeline")
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say(`digraph "some-pipeline" \{`))
+				Expect(sess.Out).To(gbytes.Say(`"resource:repo" -> "job:unit" \[style=solid\];`))
+			})
+
+			It("prints the graph as a Mermaid flowchart", func() {
+				sess := graph("-p", "some-pipeline", "--format", "mermaid")
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say(`flowchart LR`))
+				Expect(sess.Out).To(gbytes.Say(`n0 --> n1`))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess := graph("-p", "some-pipeline")
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("pipeline not found"))
+			})
+		})
+
+		It("rejects unknown formats", func() {
+			sess := graph("-p", "some-pipeline", "--format", "svg")
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("Invalid value `svg'"))
+		})
+	})
+})
@@ -0,0 +1,229 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		oldConfig atc.Config
+		newConfig atc.Config
+	)
+
+	BeforeEach(func() {
+		oldConfig = atc.Config{
+			Jobs: atc.JobConfigs{
+				{
+					Name:         "some-job",
+					PlanSequence: []atc.Step{{Config: &atc.TaskStep{Name: "unit"}}},
+				},
+			},
+		}
+
+		newConfig = atc.Config{
+			Jobs: atc.JobConfigs{
+				{
+					Name:         "some-job",
+					PlanSequence: []atc.Step{{Config: &atc.TaskStep{Name: "integration"}}},
+				},
+			},
+		}
+	})
+
+	fly := func(args ...string) *gexec.Session {
+		flyCmd := exec.Command(flyPath, append([]string{"-t", targetName}, args...)...)
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		<-sess.Exited
+		return sess
+	}
+
+	Describe("pipeline-history", func() {
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.ConfigVersion{
+							{Version: 3, CreatedAt: 300, BuildID: 42, BuildName: "7", JobName: "reconfigure"},
+							{Version: 2, CreatedAt: 200, BuildID: 43},
+							{Version: 1, CreatedAt: 100, CreatedBy: "some-user"},
+						}),
+					),
+				)
+			})
+
+			It("lists the saved configs and who saved them", func() {
+				sess := fly("pipeline-history", "-p", "some-pipeline")
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say(`3\s+.+\s+set_pipeline in reconfigure #7`))
+				Expect(sess.Out).To(gbytes.Say(`2\s+.+\s+set_pipeline in build 43`))
+				Expect(sess.Out).To(gbytes.Say(`1\s+.+\s+some-user`))
+			})
+
+			It("prints the versions as JSON with --json", func() {
+				sess := fly("pipeline-history", "-p", "some-pipeline", "--json")
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{"version": 3, "created_at": 300, "build_id": 42, "build_name": "7", "job_name": "reconfigure"},
+					{"version": 2, "created_at": 200, "build_id": 43},
+					{"version": 1, "created_at": 100, "created_by": "some-user"}
+				]`))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess := fly("pipeline-history", "-p", "some-pipeline")
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("pipeline not found"))
+			})
+		})
+	})
+
+	Describe("diff-pipeline", func() {
+		Context("when both versions exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/1"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigVersionResponse{
+							ConfigVersion: atc.ConfigVersion{Version: 1},
+							Config:        oldConfig,
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/2"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigVersionResponse{
+							ConfigVersion: atc.ConfigVersion{Version: 2},
+							Config:        newConfig,
+						}),
+					),
+				)
+			})
+
+			It("shows how the configs differ", func() {
+				sess := fly("diff-pipeline", "-p", "some-pipeline", "--from", "1", "--to", "2")
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say(`job some-job has changed`))
+				Expect(sess.Out).To(gbytes.Say(`task: unit`))
+				Expect(sess.Out).To(gbytes.Say(`task: integration`))
+			})
+		})
+
+		Context("when --to is not given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/1"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigVersionResponse{
+							ConfigVersion: atc.ConfigVersion{Version: 1},
+							Config:        oldConfig,
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: oldConfig}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+					),
+				)
+			})
+
+			It("compares against the current config", func() {
+				sess := fly("diff-pipeline", "-p", "some-pipeline", "--from", "1")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("no differences"))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/9"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess := fly("diff-pipeline", "-p", "some-pipeline", "--from", "9", "--to", "2")
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("config version 9 of pipeline 'some-pipeline' not found"))
+			})
+		})
+	})
+
+	Describe("rollback-pipeline", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/1"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigVersionResponse{
+						ConfigVersion: atc.ConfigVersion{Version: 1},
+						Config:        oldConfig,
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: newConfig}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Pipeline{Name: "some-pipeline", TeamName: "main"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+					ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+					func(w http.ResponseWriter, r *http.Request) {
+						var receivedConfig atc.Config
+						err := yaml.Unmarshal(getConfig(r), &receivedConfig)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(receivedConfig).To(Equal(oldConfig))
+					},
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Pipeline{Name: "some-pipeline", TeamName: "main"}),
+				),
+			)
+		})
+
+		It("saves the old config over the current one", func() {
+			sess := fly("rollback-pipeline", "-p", "some-pipeline", "--to", "1", "-n")
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(sess.Out).To(gbytes.Say("rolling back to config version 1"))
+			Expect(sess.Out).To(gbytes.Say(`task: integration`))
+			Expect(sess.Out).To(gbytes.Say(`task: unit`))
+			Expect(sess.Out).To(gbytes.Say("configuration updated"))
+
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(9))
+		})
+	})
+})
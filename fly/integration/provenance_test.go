@@ -0,0 +1,123 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("provenance", func() {
+		var (
+			flyCmd   *exec.Cmd
+			envelope atc.ProvenanceEnvelope
+		)
+
+		BeforeEach(func() {
+			payload, err := json.Marshal(atc.ProvenanceStatement{
+				Type: atc.ProvenanceStatementType,
+				Subject: []atc.ProvenanceResourceDescriptor{
+					{Name: "some-image", Digest: map[string]string{"sha256": "abcdef"}},
+				},
+				PredicateType: atc.ProvenancePredicateType,
+				Predicate: atc.Provenance{
+					BuildDefinition: atc.ProvenanceBuildDefinition{
+						ResolvedDependencies: []atc.ProvenanceResourceDescriptor{
+							{Name: "some-repo", Digest: map[string]string{"gitCommit": "0123456789abcdef0123456789abcdef01234567"}},
+						},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			envelope = atc.ProvenanceEnvelope{
+				PayloadType: atc.ProvenancePayloadType,
+				Payload:     payload,
+				Signatures:  []atc.ProvenanceSignature{{KeyID: "some-key", Sig: []byte("some-sig")}},
+			}
+
+			flyCmd = exec.Command(flyPath, "-t", targetName, "provenance", "-b", "23")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 23, Name: "42"}),
+				),
+			)
+		})
+
+		Context("when the build has provenance", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/23/provenance"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, envelope),
+					),
+				)
+			})
+
+			It("shows the build's outputs and inputs", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "kind", Color: color.New(color.Bold)},
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "digest", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "output"}, {Contents: "some-image"}, {Contents: "sha256:abcdef"}},
+						{{Contents: "input"}, {Contents: "some-repo"}, {Contents: "gitCommit:0123456789abcdef0123456789abcdef01234567"}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the signed envelope", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+
+					expected, err := json.Marshal(envelope)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(sess.Out.Contents()).To(MatchJSON(expected))
+				})
+			})
+		})
+
+		Context("when the build has no provenance", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/23/provenance"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("build has no provenance"))
+			})
+		})
+	})
+})
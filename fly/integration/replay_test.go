@@ -0,0 +1,111 @@
+package integration_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("replay", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		recording := strings.Join([]string{
+			`{"version":2,"width":80,"height":24,"timestamp":100,"command":"bash"}`,
+			`[0.1,"o","$ "]`,
+			`[0.2,"i","whoami\r"]`,
+			`[0.3,"o","whoami\r\n"]`,
+			`[600,"o","root\r\n"]`,
+		}, "\n") + "\n"
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "replay", "-s", "2")
+		})
+
+		Context("when the session was recorded", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/intercept-sessions/2/recording"),
+						ghttp.RespondWith(200, recording, map[string][]string{
+							"Content-Type": {"application/x-asciicast"},
+						}),
+					),
+				)
+			})
+
+			It("plays the output, capping the pauses", func() {
+				flyCmd.Args = append(flyCmd.Args, "--speed", "10", "--max-idle", "100ms")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(string(sess.Out.Contents())).To(Equal("$ whoami\r\nroot\r\n"))
+			})
+
+			Context("when --output is given", func() {
+				var output string
+
+				BeforeEach(func() {
+					output = filepath.Join(GinkgoT().TempDir(), "session.cast")
+					flyCmd.Args = append(flyCmd.Args, "--output", output)
+				})
+
+				It("saves the recording", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Err).To(gbytes.Say("saved recording to " + output))
+
+					contents, err := os.ReadFile(output)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(contents)).To(Equal(recording))
+				})
+			})
+		})
+
+		Context("when the session is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/intercept-sessions/2/recording"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("session not found or not recorded"))
+			})
+		})
+
+		Context("when the speed is not positive", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--speed", "0")
+			})
+
+			It("errors without asking the api", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("speed must be greater than 0"))
+			})
+		})
+	})
+})
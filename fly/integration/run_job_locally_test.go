@@ -0,0 +1,97 @@
+package integration_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("run-job-locally", func() {
+		var (
+			tmpdir       string
+			pipelinePath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = os.MkdirTemp("", "fly-run-job-locally")
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelinePath = filepath.Join(tmpdir, "pipeline.yml")
+
+			err = os.WriteFile(
+				pipelinePath,
+				[]byte(`---
+resources:
+- name: repo
+  type: git
+  source: {uri: ((repo-uri))}
+
+jobs:
+- name: some-job
+  plan:
+  - get: repo
+  - task: some-task
+    file: repo/task.yml
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(os.Mkdir(filepath.Join(tmpdir, "repo"), 0755)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		run := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{"run-job-locally", "-c", pipelinePath}, args...)...)
+			flyCmd.Dir = tmpdir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			return sess
+		}
+
+		It("does not need a target", func() {
+			sess := run(
+				"-j", "some-job",
+				"-i", "repo="+filepath.Join(tmpdir, "repo"),
+				"--containerd-socket", filepath.Join(tmpdir, "missing.sock"),
+			)
+			Expect(sess.Err).To(gbytes.Say("containerd socket not found at " + filepath.Join(tmpdir, "missing.sock")))
+		})
+
+		It("fails for unknown jobs", func() {
+			sess := run("-j", "bogus", "-i", "repo="+filepath.Join(tmpdir, "repo"))
+			Expect(sess.Err).To(gbytes.Say("job 'bogus' not found in pipeline"))
+		})
+
+		It("fails for unknown resources", func() {
+			sess := run("-j", "some-job", "-i", "bogus="+filepath.Join(tmpdir, "repo"))
+			Expect(sess.Err).To(gbytes.Say("unknown resource 'bogus'"))
+		})
+
+		It("requires directories for inputs", func() {
+			sess := run("-j", "some-job")
+			Expect(sess.Err).To(gbytes.Say(`no local directory given for resource 'repo' \(use -i repo=PATH\)`))
+		})
+
+		It("fails for invalid pipelines", func() {
+			Expect(os.WriteFile(pipelinePath, []byte("jobs: [{name: some-job, plan: [{get: bogus}]}]"), 0644)).To(Succeed())
+
+			sess := run("-j", "some-job")
+			Expect(sess.Err).To(gbytes.Say("configuration invalid"))
+		})
+	})
+})
@@ -0,0 +1,152 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("search-logs", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "-q", "connection refused")
+		})
+
+		Context("when logs match", func() {
+			var matches []atc.BuildLogMatch
+
+			BeforeEach(func() {
+				matches = []atc.BuildLogMatch{
+					{
+						BuildID:      4,
+						BuildName:    "2",
+						JobName:      "some-job",
+						PipelineName: "some-pipeline",
+						TeamName:     "main",
+						Time:         100,
+						Payload:      "error: connection refused\n",
+					},
+					{
+						BuildID:   3,
+						BuildName: "3",
+						TeamName:  "main",
+						Time:      50,
+						Payload:   "connection\nrefused\n",
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds/search", "q=connection+refused&limit=50"),
+						ghttp.RespondWithJSONEncoded(200, matches),
+					),
+				)
+			})
+
+			It("shows the matching output", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "time", Color: color.New(color.Bold)},
+						{Contents: "output", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "4"}, {Contents: "some-pipeline/some-job/2"}, {Contents: time.Unix(100, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "error: connection refused"}},
+						{{Contents: "3"}, {Contents: "3"}, {Contents: time.Unix(50, 0).Local().Format("2006-01-02@15:04:05-0700")}, {Contents: "connection refused"}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{"build_id": 4, "build_name": "2", "job_name": "some-job", "pipeline_name": "some-pipeline", "team_name": "main", "time": 100, "payload": "error: connection refused\n"},
+						{"build_id": 3, "build_name": "3", "team_name": "main", "time": 50, "payload": "connection\nrefused\n"}
+					]`))
+				})
+			})
+		})
+
+		Context("when narrowed down to a job and time", func() {
+			BeforeEach(func() {
+				since := time.Unix(100, 0).Local().Format("2006-01-02 15:04:05")
+				until := time.Unix(200, 0).Local().Format("2006-01-02 15:04:05")
+				flyCmd.Args = append(flyCmd.Args, "-j", "some-pipeline/some-job", "--since", since, "--until", until, "-c", "5")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds/search", "q=connection+refused&pipeline_name=some-pipeline&job_name=some-job&since=100&until=200&limit=5"),
+						ghttp.RespondWithJSONEncoded(200, []atc.BuildLogMatch{}),
+					),
+				)
+			})
+
+			It("passes the filters along", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "-j", "some-pipeline/some-job")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds/search"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("some-pipeline/some-job not found"))
+			})
+		})
+
+		Context("when --since is after --until", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--since", "2020-02-02 00:00:00", "--until", "2020-01-01 00:00:00")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("Cannot have --since after --until"))
+			})
+		})
+	})
+})
@@ -0,0 +1,148 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("test-results", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "test-results", "-j", "some-pipeline/some-job", "-c", "10")
+		})
+
+		Context("when the job exists", func() {
+			var results atc.JobTestResults
+
+			BeforeEach(func() {
+				results = atc.JobTestResults{
+					Builds: []atc.BuildTestSummary{
+						{
+							BuildID:   2,
+							BuildName: "2",
+							Status:    atc.StatusFailed,
+							Summary:   atc.TestSummary{Total: 4, Passed: 2, Failed: 1, Errored: 1, Duration: 1.5},
+						},
+						{
+							BuildID:   1,
+							BuildName: "1",
+							Status:    atc.StatusSucceeded,
+							Summary:   atc.TestSummary{Total: 4, Passed: 3, Skipped: 1, Duration: 2},
+						},
+					},
+					Flaky: []atc.FlakyTest{
+						{Suite: "api", ClassName: "api.Users", Name: "deletes", Passed: 1, Failed: 1, LastFailedBuild: "2"},
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/test-results", "builds=10"),
+						ghttp.RespondWithJSONEncoded(200, results),
+					),
+				)
+			})
+
+			It("shows the builds and the flaky tests", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "passed", Color: color.New(color.Bold)},
+						{Contents: "failed", Color: color.New(color.Bold)},
+						{Contents: "skipped", Color: color.New(color.Bold)},
+						{Contents: "duration", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: "failed", Color: color.New(color.FgRed)}, {Contents: "2"}, {Contents: "2", Color: color.New(color.FgRed)}, {Contents: "0"}, {Contents: "1.5s"}},
+						{{Contents: "1"}, {Contents: "succeeded", Color: color.New(color.FgGreen)}, {Contents: "3"}, {Contents: "0"}, {Contents: "1"}, {Contents: "2s"}},
+					},
+				}))
+				Expect(sess.Out).To(gbytes.Say("flaky tests:"))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "suite", Color: color.New(color.Bold)},
+						{Contents: "test", Color: color.New(color.Bold)},
+						{Contents: "passed", Color: color.New(color.Bold)},
+						{Contents: "failed", Color: color.New(color.Bold)},
+						{Contents: "last failed", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "api"}, {Contents: "api.Users.deletes"}, {Contents: "1"}, {Contents: "1", Color: color.New(color.FgRed)}, {Contents: "2"}},
+					},
+				}))
+			})
+
+			Context("when no tests are flaky", func() {
+				BeforeEach(func() {
+					results.Flaky = []atc.FlakyTest{}
+				})
+
+				It("says so", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say("no flaky tests"))
+				})
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"builds": [
+							{"build_id": 2, "build_name": "2", "status": "failed", "summary": {"total": 4, "passed": 2, "failed": 1, "errored": 1, "skipped": 0, "duration": 1.5}},
+							{"build_id": 1, "build_name": "1", "status": "succeeded", "summary": {"total": 4, "passed": 3, "failed": 0, "errored": 0, "skipped": 1, "duration": 2}}
+						],
+						"flaky": [
+							{"suite": "api", "class_name": "api.Users", "name": "deletes", "passed": 1, "failed": 1, "last_failed_build": "2"}
+						]
+					}`))
+				})
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/test-results", "builds=10"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("some-pipeline/some-job not found"))
+			})
+		})
+	})
+})
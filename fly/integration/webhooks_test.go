@@ -0,0 +1,321 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("webhooks", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "webhooks")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/webhooks"),
+					ghttp.RespondWithJSONEncoded(200, []atc.Webhook{
+						{
+							Name:      "some-webhook",
+							URL:       "https://example.com/hook",
+							Events:    []atc.WebhookEvent{"build_status", "worker_state"},
+							CreatedAt: 100,
+						},
+						{
+							Name:      "other-webhook",
+							URL:       "https://example.com/other",
+							CreatedAt: 200,
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists them to the user", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "url", Color: color.New(color.Bold)},
+					{Contents: "events", Color: color.New(color.Bold)},
+					{Contents: "created", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{{Contents: "some-webhook"}, {Contents: "https://example.com/hook"}, {Contents: "build_status,worker_state"}, {Contents: time.Unix(100, 0).Format("2006-01-02@15:04:05-0700")}},
+					{{Contents: "other-webhook"}, {Contents: "https://example.com/other"}, {Contents: "all", Color: color.New(color.Faint)}, {Contents: time.Unix(200, 0).Format("2006-01-02@15:04:05-0700")}},
+				},
+			}))
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints response in json as stdout", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{
+						"name": "some-webhook",
+						"url": "https://example.com/hook",
+						"events": ["build_status", "worker_state"],
+						"created_at": 100
+					},
+					{
+						"name": "other-webhook",
+						"url": "https://example.com/other",
+						"created_at": 200
+					}
+				]`))
+			})
+		})
+	})
+
+	Describe("set-webhook", func() {
+		var (
+			flyCmd   *exec.Cmd
+			received atc.Webhook
+		)
+
+		BeforeEach(func() {
+			received = atc.Webhook{}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/webhooks/some-webhook"),
+					func(w http.ResponseWriter, r *http.Request) {
+						body, err := io.ReadAll(r.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(json.Unmarshal(body, &received)).To(Succeed())
+					},
+					ghttp.RespondWithJSONEncoded(200, atc.Webhook{
+						Name:      "some-webhook",
+						URL:       "https://example.com/hook",
+						CreatedAt: 100,
+					}),
+				),
+			)
+		})
+
+		Context("with a secret and events", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "set-webhook",
+					"-w", "some-webhook",
+					"-u", "https://example.com/hook",
+					"-e", "build_status",
+					"-e", "pipeline_paused",
+					"--secret", "some-secret",
+				)
+			})
+
+			It("saves the webhook", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("webhook `some-webhook` set"))
+				Expect(sess.Out).NotTo(gbytes.Say("secret"))
+
+				Expect(received).To(Equal(atc.Webhook{
+					URL:    "https://example.com/hook",
+					Secret: "some-secret",
+					Events: []atc.WebhookEvent{"build_status", "pipeline_paused"},
+				}))
+			})
+		})
+
+		Context("without a secret", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "set-webhook",
+					"-w", "some-webhook",
+					"-u", "https://example.com/hook",
+				)
+			})
+
+			It("generates one and prints it once", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(received.Secret).To(MatchRegexp(`^[0-9a-f]{64}$`))
+				Expect(received.Events).To(BeEmpty())
+				Expect(sess.Out).To(gbytes.Say("webhook `some-webhook` set"))
+				Expect(sess.Out).To(gbytes.Say("will not be shown again"))
+				Expect(sess.Out).To(gbytes.Say(received.Secret))
+			})
+		})
+
+		Context("with an unknown event", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "set-webhook",
+					"-w", "some-webhook",
+					"-u", "https://example.com/hook",
+					"-e", "bogus",
+				)
+			})
+
+			It("fails without saving the webhook", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("unknown event 'bogus'"))
+				Expect(received).To(BeZero())
+			})
+		})
+	})
+
+	Describe("destroy-webhook", func() {
+		var (
+			flyCmd *exec.Cmd
+			stdin  io.Writer
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "destroy-webhook", "-w", "some-webhook")
+
+			var err error
+			stdin, err = flyCmd.StdinPipe()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/webhooks/some-webhook"),
+						ghttp.RespondWith(204, ""),
+					),
+				)
+			})
+
+			It("destroys the webhook", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say("are you sure?"))
+				fmt.Fprintf(stdin, "y\n")
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("`some-webhook` deleted"))
+			})
+		})
+
+		Context("when the user declines", func() {
+			It("bails out", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say("are you sure?"))
+				fmt.Fprintf(stdin, "n\n")
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("bailing out"))
+			})
+		})
+	})
+
+	Describe("webhook-deliveries", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "webhook-deliveries", "-w", "some-webhook", "-c", "5")
+		})
+
+		Context("when the webhook exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/webhooks/some-webhook/deliveries", "limit=5"),
+						ghttp.RespondWithJSONEncoded(200, []atc.WebhookDelivery{
+							{
+								ID:             2,
+								Event:          "pipeline_paused",
+								Payload:        json.RawMessage(`{}`),
+								Status:         "pending",
+								Attempts:       1,
+								ResponseStatus: 502,
+								Error:          "unexpected response: 502 Bad Gateway",
+								CreatedAt:      100,
+								LastAttemptAt:  110,
+								NextAttemptAt:  140,
+							},
+							{
+								ID:             1,
+								Event:          "build_status",
+								Payload:        json.RawMessage(`{}`),
+								Status:         "delivered",
+								Attempts:       1,
+								ResponseStatus: 200,
+								CreatedAt:      50,
+								LastAttemptAt:  51,
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists them to the user", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "event", Color: color.New(color.Bold)},
+						{Contents: "created", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "attempts", Color: color.New(color.Bold)},
+						{Contents: "response", Color: color.New(color.Bold)},
+						{Contents: "next attempt", Color: color.New(color.Bold)},
+						{Contents: "error", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: "pipeline_paused"}, {Contents: time.Unix(100, 0).Format("2006-01-02@15:04:05-0700")}, {Contents: "pending", Color: color.New(color.FgYellow)}, {Contents: "1"}, {Contents: "502"}, {Contents: time.Unix(140, 0).Format("2006-01-02@15:04:05-0700")}, {Contents: "unexpected response: 502 Bad Gateway"}},
+						{{Contents: "1"}, {Contents: "build_status"}, {Contents: time.Unix(50, 0).Format("2006-01-02@15:04:05-0700")}, {Contents: "delivered", Color: color.New(color.FgGreen)}, {Contents: "1"}, {Contents: "200"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/webhooks/some-webhook/deliveries", "limit=5"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("webhook not found"))
+			})
+		})
+	})
+})
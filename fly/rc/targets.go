@@ -50,6 +50,12 @@ func flyrcPath() string {
 	return filepath.Join(userHomeDir(), ".flyrc")
 }
 
+// CompletionCachePath is where values fetched from the target for shell
+// completion are cached between invocations.
+func CompletionCachePath(targetName TargetName) string {
+	return filepath.Join(userHomeDir(), ".fly", "completion", string(targetName)+".json")
+}
+
 func LogoutTarget(targetName TargetName) error {
 	flyTargets, err := LoadTargets()
 	if err != nil {
@@ -0,0 +1,79 @@
+package concourse
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+	"github.com/vito/go-sse/sse"
+)
+
+type ActivityEvents interface {
+	NextEvent() (atc.ActivityEvent, error)
+	Close() error
+}
+
+func (client *client) ClusterActivity(types []atc.ActivityEventType) (ActivityEvents, error) {
+	return connectToActivity(client.connection, internal.Request{
+		RequestName: atc.ClusterActivity,
+		Query:       activityQuery(types),
+	})
+}
+
+func (team *team) TeamActivity(types []atc.ActivityEventType) (ActivityEvents, error) {
+	return connectToActivity(team.connection, internal.Request{
+		RequestName: atc.TeamActivity,
+		Params: rata.Params{
+			"team_name": team.Name(),
+		},
+		Query: activityQuery(types),
+	})
+}
+
+func activityQuery(types []atc.ActivityEventType) url.Values {
+	query := url.Values{}
+	for _, t := range types {
+		query.Add("type", string(t))
+	}
+
+	return query
+}
+
+func connectToActivity(connection internal.Connection, request internal.Request) (ActivityEvents, error) {
+	source, err := connection.ConnectToEventStream(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &activityEvents{source: source}, nil
+}
+
+type activityEvents struct {
+	source *sse.EventSource
+}
+
+func (events *activityEvents) NextEvent() (atc.ActivityEvent, error) {
+	se, err := events.source.Next()
+	if err != nil {
+		return atc.ActivityEvent{}, err
+	}
+
+	if se.Name != "event" {
+		return atc.ActivityEvent{}, fmt.Errorf("unknown event name: %s", se.Name)
+	}
+
+	var event atc.ActivityEvent
+	err = json.Unmarshal(se.Data, &event)
+	if err != nil {
+		return atc.ActivityEvent{}, err
+	}
+
+	return event, nil
+}
+
+func (events *activityEvents) Close() error {
+	return events.source.Close()
+}
@@ -0,0 +1,106 @@
+package concourse_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("ATC Handler Activity", func() {
+	activityHandler := func(path string, query string, events ...atc.ActivityEvent) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path, query),
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+				w.WriteHeader(http.StatusOK)
+
+				for id, e := range events {
+					payload, err := json.Marshal(e)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = sse.Event{
+						ID:   fmt.Sprintf("%d", id),
+						Name: "event",
+						Data: payload,
+					}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				}
+			},
+		)
+	}
+
+	buildFinished := atc.ActivityEvent{
+		Type:        atc.ActivityBuildFinished,
+		Time:        100,
+		TeamName:    "some-team",
+		BuildID:     42,
+		BuildStatus: atc.StatusSucceeded,
+	}
+
+	Describe("TeamActivity", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				activityHandler("/api/v1/teams/some-team/activity", "type=build_finished", buildFinished),
+			)
+		})
+
+		It("streams the team's events until the server closes the stream", func() {
+			events, err := team.TeamActivity([]atc.ActivityEventType{atc.ActivityBuildFinished})
+			Expect(err).NotTo(HaveOccurred())
+
+			defer events.Close()
+
+			event, err := events.NextEvent()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event).To(Equal(buildFinished))
+
+			_, err = events.NextEvent()
+			Expect(err).To(Equal(io.EOF))
+		})
+	})
+
+	Describe("ClusterActivity", func() {
+		Context("when the user is an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					activityHandler("/api/v1/activity", "", buildFinished),
+				)
+			})
+
+			It("streams every team's events", func() {
+				events, err := client.ClusterActivity(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				defer events.Close()
+
+				event, err := events.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event).To(Equal(buildFinished))
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/activity"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns ErrForbidden", func() {
+				_, err := client.ClusterActivity(nil)
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+})
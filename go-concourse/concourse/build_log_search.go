@@ -0,0 +1,74 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// BuildLogSearch is a search of a team's build logs, optionally narrowed down
+// to one of its jobs and to a window of time.
+type BuildLogSearch struct {
+	Query       string
+	PipelineRef atc.PipelineRef
+	JobName     string
+	Since       time.Time
+	Until       time.Time
+	Limit       int
+}
+
+func (s BuildLogSearch) QueryParams() url.Values {
+	queryParams := url.Values{}
+	queryParams.Set(atc.BuildLogSearchQuery, s.Query)
+
+	if s.JobName != "" {
+		for k, v := range s.PipelineRef.QueryParams() {
+			queryParams[k] = v
+		}
+
+		queryParams.Set(atc.BuildLogSearchPipelineName, s.PipelineRef.Name)
+		queryParams.Set(atc.BuildLogSearchJobName, s.JobName)
+	}
+
+	if !s.Since.IsZero() {
+		queryParams.Set(atc.BuildLogSearchSince, strconv.FormatInt(s.Since.Unix(), 10))
+	}
+
+	if !s.Until.IsZero() {
+		queryParams.Set(atc.BuildLogSearchUntil, strconv.FormatInt(s.Until.Unix(), 10))
+	}
+
+	if s.Limit > 0 {
+		queryParams.Set(atc.PaginationQueryLimit, strconv.Itoa(s.Limit))
+	}
+
+	return queryParams
+}
+
+func (team *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, bool, error) {
+	params := rata.Params{
+		"team_name": team.Name(),
+	}
+
+	var matches []atc.BuildLogMatch
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.SearchBuildLogs,
+		Params:      params,
+		Query:       search.QueryParams(),
+	}, &internal.Response{
+		Result: &matches,
+	})
+
+	switch err.(type) {
+	case nil:
+		return matches, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -0,0 +1,87 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Log Search", func() {
+	Describe("SearchBuildLogs", func() {
+		expectedURL := "/api/v1/teams/some-team/builds/search"
+
+		Context("when the search succeeds", func() {
+			var expectedMatches []atc.BuildLogMatch
+
+			BeforeEach(func() {
+				expectedMatches = []atc.BuildLogMatch{
+					{BuildID: 4, BuildName: "2", JobName: "myjob", PipelineName: "mypipeline", TeamName: "some-team", Time: 150, Payload: "connection refused\n"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "job_name=myjob&limit=10&pipeline_name=mypipeline&q=connection+refused&since=100&until=200&vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMatches),
+					),
+				)
+			})
+
+			It("returns the matching logs", func() {
+				matches, found, err := team.SearchBuildLogs(concourse.BuildLogSearch{
+					Query:       "connection refused",
+					PipelineRef: atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}},
+					JobName:     "myjob",
+					Since:       time.Unix(100, 0),
+					Until:       time.Unix(200, 0),
+					Limit:       10,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(matches).To(Equal(expectedMatches))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "job_name=myjob&pipeline_name=mypipeline&q=refused"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.SearchBuildLogs(concourse.BuildLogSearch{
+					Query:       "refused",
+					PipelineRef: atc.PipelineRef{Name: "mypipeline"},
+					JobName:     "myjob",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the search is forbidden", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "q=refused"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, _, err := team.SearchBuildLogs(concourse.BuildLogSearch{Query: "refused"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Provenance", func() {
+	Describe("BuildProvenance", func() {
+		expectedURL := "/api/v1/builds/6/provenance"
+
+		Context("when the build has provenance", func() {
+			var expectedEnvelope atc.ProvenanceEnvelope
+
+			BeforeEach(func() {
+				expectedEnvelope = atc.ProvenanceEnvelope{
+					PayloadType: atc.ProvenancePayloadType,
+					Payload:     []byte(`{"_type":"https://in-toto.io/Statement/v1"}`),
+					Signatures:  []atc.ProvenanceSignature{{KeyID: "some-key", Sig: []byte("some-sig")}},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEnvelope),
+					),
+				)
+			})
+
+			It("returns the signed envelope", func() {
+				envelope, found, err := client.BuildProvenance(6)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(envelope).To(Equal(expectedEnvelope))
+			})
+		})
+
+		Context("when the build has no provenance", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := client.BuildProvenance(6)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -19,6 +19,7 @@ type Client interface {
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
+	BuildProvenance(buildID int) (atc.ProvenanceEnvelope, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
@@ -35,6 +36,7 @@ type Client interface {
 	Team(teamName string) Team
 	UserInfo() (atc.UserInfo, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+	ClusterActivity(types []atc.ActivityEventType) (ActivityEvents, error)
 }
 
 type client struct {
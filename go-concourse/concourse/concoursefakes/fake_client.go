@@ -66,6 +66,21 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildProvenanceStub        func(int) (atc.ProvenanceEnvelope, bool, error)
+	buildProvenanceMutex       sync.RWMutex
+	buildProvenanceArgsForCall []struct {
+		arg1 int
+	}
+	buildProvenanceReturns struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
+	buildProvenanceReturnsOnCall map[int]struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}
 	BuildResourcesStub        func(int) (atc.BuildInputsOutputs, bool, error)
 	buildResourcesMutex       sync.RWMutex
 	buildResourcesArgsForCall []struct {
@@ -96,6 +111,19 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	ClusterActivityStub        func([]atc.ActivityEventType) (concourse.ActivityEvents, error)
+	clusterActivityMutex       sync.RWMutex
+	clusterActivityArgsForCall []struct {
+		arg1 []atc.ActivityEventType
+	}
+	clusterActivityReturns struct {
+		result1 concourse.ActivityEvents
+		result2 error
+	}
+	clusterActivityReturnsOnCall map[int]struct {
+		result1 concourse.ActivityEvents
+		result2 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -553,6 +581,73 @@ func (fake *FakeClient) BuildPlanReturnsOnCall(i int, result1 atc.PublicBuildPla
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildProvenance(arg1 int) (atc.ProvenanceEnvelope, bool, error) {
+	fake.buildProvenanceMutex.Lock()
+	ret, specificReturn := fake.buildProvenanceReturnsOnCall[len(fake.buildProvenanceArgsForCall)]
+	fake.buildProvenanceArgsForCall = append(fake.buildProvenanceArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.BuildProvenanceStub
+	fakeReturns := fake.buildProvenanceReturns
+	fake.recordInvocation("BuildProvenance", []interface{}{arg1})
+	fake.buildProvenanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildProvenanceCallCount() int {
+	fake.buildProvenanceMutex.RLock()
+	defer fake.buildProvenanceMutex.RUnlock()
+	return len(fake.buildProvenanceArgsForCall)
+}
+
+func (fake *FakeClient) BuildProvenanceCalls(stub func(int) (atc.ProvenanceEnvelope, bool, error)) {
+	fake.buildProvenanceMutex.Lock()
+	defer fake.buildProvenanceMutex.Unlock()
+	fake.BuildProvenanceStub = stub
+}
+
+func (fake *FakeClient) BuildProvenanceArgsForCall(i int) int {
+	fake.buildProvenanceMutex.RLock()
+	defer fake.buildProvenanceMutex.RUnlock()
+	argsForCall := fake.buildProvenanceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildProvenanceReturns(result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.buildProvenanceMutex.Lock()
+	defer fake.buildProvenanceMutex.Unlock()
+	fake.BuildProvenanceStub = nil
+	fake.buildProvenanceReturns = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildProvenanceReturnsOnCall(i int, result1 atc.ProvenanceEnvelope, result2 bool, result3 error) {
+	fake.buildProvenanceMutex.Lock()
+	defer fake.buildProvenanceMutex.Unlock()
+	fake.BuildProvenanceStub = nil
+	if fake.buildProvenanceReturnsOnCall == nil {
+		fake.buildProvenanceReturnsOnCall = make(map[int]struct {
+			result1 atc.ProvenanceEnvelope
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildProvenanceReturnsOnCall[i] = struct {
+		result1 atc.ProvenanceEnvelope
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) BuildResources(arg1 int) (atc.BuildInputsOutputs, bool, error) {
 	fake.buildResourcesMutex.Lock()
 	ret, specificReturn := fake.buildResourcesReturnsOnCall[len(fake.buildResourcesArgsForCall)]
@@ -687,6 +782,75 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) ClusterActivity(arg1 []atc.ActivityEventType) (concourse.ActivityEvents, error) {
+	var arg1Copy []atc.ActivityEventType
+	if arg1 != nil {
+		arg1Copy = make([]atc.ActivityEventType, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.clusterActivityMutex.Lock()
+	ret, specificReturn := fake.clusterActivityReturnsOnCall[len(fake.clusterActivityArgsForCall)]
+	fake.clusterActivityArgsForCall = append(fake.clusterActivityArgsForCall, struct {
+		arg1 []atc.ActivityEventType
+	}{arg1Copy})
+	stub := fake.ClusterActivityStub
+	fakeReturns := fake.clusterActivityReturns
+	fake.recordInvocation("ClusterActivity", []interface{}{arg1Copy})
+	fake.clusterActivityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ClusterActivityCallCount() int {
+	fake.clusterActivityMutex.RLock()
+	defer fake.clusterActivityMutex.RUnlock()
+	return len(fake.clusterActivityArgsForCall)
+}
+
+func (fake *FakeClient) ClusterActivityCalls(stub func([]atc.ActivityEventType) (concourse.ActivityEvents, error)) {
+	fake.clusterActivityMutex.Lock()
+	defer fake.clusterActivityMutex.Unlock()
+	fake.ClusterActivityStub = stub
+}
+
+func (fake *FakeClient) ClusterActivityArgsForCall(i int) []atc.ActivityEventType {
+	fake.clusterActivityMutex.RLock()
+	defer fake.clusterActivityMutex.RUnlock()
+	argsForCall := fake.clusterActivityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ClusterActivityReturns(result1 concourse.ActivityEvents, result2 error) {
+	fake.clusterActivityMutex.Lock()
+	defer fake.clusterActivityMutex.Unlock()
+	fake.ClusterActivityStub = nil
+	fake.clusterActivityReturns = struct {
+		result1 concourse.ActivityEvents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ClusterActivityReturnsOnCall(i int, result1 concourse.ActivityEvents, result2 error) {
+	fake.clusterActivityMutex.Lock()
+	defer fake.clusterActivityMutex.Unlock()
+	fake.ClusterActivityStub = nil
+	if fake.clusterActivityReturnsOnCall == nil {
+		fake.clusterActivityReturnsOnCall = make(map[int]struct {
+			result1 concourse.ActivityEvents
+			result2 error
+		})
+	}
+	fake.clusterActivityReturnsOnCall[i] = struct {
+		result1 concourse.ActivityEvents
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -1648,10 +1812,14 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildEventsMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
+	fake.buildProvenanceMutex.RLock()
+	defer fake.buildProvenanceMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.clusterActivityMutex.RLock()
+	defer fake.clusterActivityMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
@@ -340,6 +340,19 @@ type FakeTeam struct {
 	destroyTeamReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DestroyWebhookStub        func(string) (bool, error)
+	destroyWebhookMutex       sync.RWMutex
+	destroyWebhookArgsForCall []struct {
+		arg1 string
+	}
+	destroyWebhookReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyWebhookReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DisableResourceVersionStub        func(atc.PipelineRef, string, int) (bool, error)
 	disableResourceVersionMutex       sync.RWMutex
 	disableResourceVersionArgsForCall []struct {
@@ -432,6 +445,34 @@ type FakeTeam struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InterceptSessionRecordingStub        func(int) (io.ReadCloser, bool, error)
+	interceptSessionRecordingMutex       sync.RWMutex
+	interceptSessionRecordingArgsForCall []struct {
+		arg1 int
+	}
+	interceptSessionRecordingReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	interceptSessionRecordingReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	InterceptSessionsStub        func(int) ([]atc.InterceptSession, error)
+	interceptSessionsMutex       sync.RWMutex
+	interceptSessionsArgsForCall []struct {
+		arg1 int
+	}
+	interceptSessionsReturns struct {
+		result1 []atc.InterceptSession
+		result2 error
+	}
+	interceptSessionsReturnsOnCall map[int]struct {
+		result1 []atc.InterceptSession
+		result2 error
+	}
 	JobStub        func(atc.PipelineRef, string) (atc.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
@@ -484,6 +525,40 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	JobSLOStub        func(atc.PipelineRef, string, int) (atc.JobSLOReport, bool, error)
+	jobSLOMutex       sync.RWMutex
+	jobSLOArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+	}
+	jobSLOReturns struct {
+		result1 atc.JobSLOReport
+		result2 bool
+		result3 error
+	}
+	jobSLOReturnsOnCall map[int]struct {
+		result1 atc.JobSLOReport
+		result2 bool
+		result3 error
+	}
+	JobTestResultsStub        func(atc.PipelineRef, string, int) (atc.JobTestResults, bool, error)
+	jobTestResultsMutex       sync.RWMutex
+	jobTestResultsArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+	}
+	jobTestResultsReturns struct {
+		result1 atc.JobTestResults
+		result2 bool
+		result3 error
+	}
+	jobTestResultsReturnsOnCall map[int]struct {
+		result1 atc.JobTestResults
+		result2 bool
+		result3 error
+	}
 	ListContainersStub        func(map[string]string) ([]atc.Container, error)
 	listContainersMutex       sync.RWMutex
 	listContainersArgsForCall []struct {
@@ -579,6 +654,18 @@ type FakeTeam struct {
 		result1 []atc.Volume
 		result2 error
 	}
+	ListWebhooksStub        func() ([]atc.Webhook, error)
+	listWebhooksMutex       sync.RWMutex
+	listWebhooksArgsForCall []struct {
+	}
+	listWebhooksReturns struct {
+		result1 []atc.Webhook
+		result2 error
+	}
+	listWebhooksReturnsOnCall map[int]struct {
+		result1 []atc.Webhook
+		result2 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -704,6 +791,37 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PipelineConfigVersionStub        func(atc.PipelineRef, int) (atc.ConfigVersionResponse, bool, error)
+	pipelineConfigVersionMutex       sync.RWMutex
+	pipelineConfigVersionArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}
+	pipelineConfigVersionReturns struct {
+		result1 atc.ConfigVersionResponse
+		result2 bool
+		result3 error
+	}
+	pipelineConfigVersionReturnsOnCall map[int]struct {
+		result1 atc.ConfigVersionResponse
+		result2 bool
+		result3 error
+	}
+	PipelineConfigVersionsStub        func(atc.PipelineRef) ([]atc.ConfigVersion, bool, error)
+	pipelineConfigVersionsMutex       sync.RWMutex
+	pipelineConfigVersionsArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	pipelineConfigVersionsReturns struct {
+		result1 []atc.ConfigVersion
+		result2 bool
+		result3 error
+	}
+	pipelineConfigVersionsReturnsOnCall map[int]struct {
+		result1 []atc.ConfigVersion
+		result2 bool
+		result3 error
+	}
 	RenamePipelineStub        func(string, string) (bool, []concourse.ConfigWarning, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -816,6 +934,21 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SearchBuildLogsStub        func(concourse.BuildLogSearch) ([]atc.BuildLogMatch, bool, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		arg1 concourse.BuildLogSearch
+	}
+	searchBuildLogsReturns struct {
+		result1 []atc.BuildLogMatch
+		result2 bool
+		result3 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []atc.BuildLogMatch
+		result2 bool
+		result3 error
+	}
 	SetJobBuildCommentStub        func(atc.PipelineRef, string, string, string) (bool, error)
 	setJobBuildCommentMutex       sync.RWMutex
 	setJobBuildCommentArgsForCall []struct {
@@ -847,6 +980,33 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SetWebhookStub        func(string, atc.Webhook) (atc.Webhook, error)
+	setWebhookMutex       sync.RWMutex
+	setWebhookArgsForCall []struct {
+		arg1 string
+		arg2 atc.Webhook
+	}
+	setWebhookReturns struct {
+		result1 atc.Webhook
+		result2 error
+	}
+	setWebhookReturnsOnCall map[int]struct {
+		result1 atc.Webhook
+		result2 error
+	}
+	TeamActivityStub        func([]atc.ActivityEventType) (concourse.ActivityEvents, error)
+	teamActivityMutex       sync.RWMutex
+	teamActivityArgsForCall []struct {
+		arg1 []atc.ActivityEventType
+	}
+	teamActivityReturns struct {
+		result1 concourse.ActivityEvents
+		result2 error
+	}
+	teamActivityReturnsOnCall map[int]struct {
+		result1 concourse.ActivityEvents
+		result2 error
+	}
 	UnpauseJobStub        func(atc.PipelineRef, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -888,6 +1048,22 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	WebhookDeliveriesStub        func(string, int) ([]atc.WebhookDelivery, bool, error)
+	webhookDeliveriesMutex       sync.RWMutex
+	webhookDeliveriesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	webhookDeliveriesReturns struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}
+	webhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -2349,6 +2525,70 @@ func (fake *FakeTeam) DestroyTeamReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DestroyWebhook(arg1 string) (bool, error) {
+	fake.destroyWebhookMutex.Lock()
+	ret, specificReturn := fake.destroyWebhookReturnsOnCall[len(fake.destroyWebhookArgsForCall)]
+	fake.destroyWebhookArgsForCall = append(fake.destroyWebhookArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DestroyWebhookStub
+	fakeReturns := fake.destroyWebhookReturns
+	fake.recordInvocation("DestroyWebhook", []interface{}{arg1})
+	fake.destroyWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DestroyWebhookCallCount() int {
+	fake.destroyWebhookMutex.RLock()
+	defer fake.destroyWebhookMutex.RUnlock()
+	return len(fake.destroyWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyWebhookCalls(stub func(string) (bool, error)) {
+	fake.destroyWebhookMutex.Lock()
+	defer fake.destroyWebhookMutex.Unlock()
+	fake.DestroyWebhookStub = stub
+}
+
+func (fake *FakeTeam) DestroyWebhookArgsForCall(i int) string {
+	fake.destroyWebhookMutex.RLock()
+	defer fake.destroyWebhookMutex.RUnlock()
+	argsForCall := fake.destroyWebhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyWebhookReturns(result1 bool, result2 error) {
+	fake.destroyWebhookMutex.Lock()
+	defer fake.destroyWebhookMutex.Unlock()
+	fake.DestroyWebhookStub = nil
+	fake.destroyWebhookReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyWebhookReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyWebhookMutex.Lock()
+	defer fake.destroyWebhookMutex.Unlock()
+	fake.DestroyWebhookStub = nil
+	if fake.destroyWebhookReturnsOnCall == nil {
+		fake.destroyWebhookReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyWebhookReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DisableResourceVersion(arg1 atc.PipelineRef, arg2 string, arg3 int) (bool, error) {
 	fake.disableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.disableResourceVersionReturnsOnCall[len(fake.disableResourceVersionArgsForCall)]
@@ -2790,6 +3030,137 @@ func (fake *FakeTeam) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeTeam) InterceptSessionRecording(arg1 int) (io.ReadCloser, bool, error) {
+	fake.interceptSessionRecordingMutex.Lock()
+	ret, specificReturn := fake.interceptSessionRecordingReturnsOnCall[len(fake.interceptSessionRecordingArgsForCall)]
+	fake.interceptSessionRecordingArgsForCall = append(fake.interceptSessionRecordingArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.InterceptSessionRecordingStub
+	fakeReturns := fake.interceptSessionRecordingReturns
+	fake.recordInvocation("InterceptSessionRecording", []interface{}{arg1})
+	fake.interceptSessionRecordingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) InterceptSessionRecordingCallCount() int {
+	fake.interceptSessionRecordingMutex.RLock()
+	defer fake.interceptSessionRecordingMutex.RUnlock()
+	return len(fake.interceptSessionRecordingArgsForCall)
+}
+
+func (fake *FakeTeam) InterceptSessionRecordingCalls(stub func(int) (io.ReadCloser, bool, error)) {
+	fake.interceptSessionRecordingMutex.Lock()
+	defer fake.interceptSessionRecordingMutex.Unlock()
+	fake.InterceptSessionRecordingStub = stub
+}
+
+func (fake *FakeTeam) InterceptSessionRecordingArgsForCall(i int) int {
+	fake.interceptSessionRecordingMutex.RLock()
+	defer fake.interceptSessionRecordingMutex.RUnlock()
+	argsForCall := fake.interceptSessionRecordingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) InterceptSessionRecordingReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.interceptSessionRecordingMutex.Lock()
+	defer fake.interceptSessionRecordingMutex.Unlock()
+	fake.InterceptSessionRecordingStub = nil
+	fake.interceptSessionRecordingReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) InterceptSessionRecordingReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.interceptSessionRecordingMutex.Lock()
+	defer fake.interceptSessionRecordingMutex.Unlock()
+	fake.InterceptSessionRecordingStub = nil
+	if fake.interceptSessionRecordingReturnsOnCall == nil {
+		fake.interceptSessionRecordingReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.interceptSessionRecordingReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) InterceptSessions(arg1 int) ([]atc.InterceptSession, error) {
+	fake.interceptSessionsMutex.Lock()
+	ret, specificReturn := fake.interceptSessionsReturnsOnCall[len(fake.interceptSessionsArgsForCall)]
+	fake.interceptSessionsArgsForCall = append(fake.interceptSessionsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.InterceptSessionsStub
+	fakeReturns := fake.interceptSessionsReturns
+	fake.recordInvocation("InterceptSessions", []interface{}{arg1})
+	fake.interceptSessionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) InterceptSessionsCallCount() int {
+	fake.interceptSessionsMutex.RLock()
+	defer fake.interceptSessionsMutex.RUnlock()
+	return len(fake.interceptSessionsArgsForCall)
+}
+
+func (fake *FakeTeam) InterceptSessionsCalls(stub func(int) ([]atc.InterceptSession, error)) {
+	fake.interceptSessionsMutex.Lock()
+	defer fake.interceptSessionsMutex.Unlock()
+	fake.InterceptSessionsStub = stub
+}
+
+func (fake *FakeTeam) InterceptSessionsArgsForCall(i int) int {
+	fake.interceptSessionsMutex.RLock()
+	defer fake.interceptSessionsMutex.RUnlock()
+	argsForCall := fake.interceptSessionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) InterceptSessionsReturns(result1 []atc.InterceptSession, result2 error) {
+	fake.interceptSessionsMutex.Lock()
+	defer fake.interceptSessionsMutex.Unlock()
+	fake.InterceptSessionsStub = nil
+	fake.interceptSessionsReturns = struct {
+		result1 []atc.InterceptSession
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) InterceptSessionsReturnsOnCall(i int, result1 []atc.InterceptSession, result2 error) {
+	fake.interceptSessionsMutex.Lock()
+	defer fake.interceptSessionsMutex.Unlock()
+	fake.InterceptSessionsStub = nil
+	if fake.interceptSessionsReturnsOnCall == nil {
+		fake.interceptSessionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.InterceptSession
+			result2 error
+		})
+	}
+	fake.interceptSessionsReturnsOnCall[i] = struct {
+		result1 []atc.InterceptSession
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Job(arg1 atc.PipelineRef, arg2 string) (atc.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
@@ -2999,6 +3370,144 @@ func (fake *FakeTeam) JobBuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) JobSLO(arg1 atc.PipelineRef, arg2 string, arg3 int) (atc.JobSLOReport, bool, error) {
+	fake.jobSLOMutex.Lock()
+	ret, specificReturn := fake.jobSLOReturnsOnCall[len(fake.jobSLOArgsForCall)]
+	fake.jobSLOArgsForCall = append(fake.jobSLOArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.JobSLOStub
+	fakeReturns := fake.jobSLOReturns
+	fake.recordInvocation("JobSLO", []interface{}{arg1, arg2, arg3})
+	fake.jobSLOMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobSLOCallCount() int {
+	fake.jobSLOMutex.RLock()
+	defer fake.jobSLOMutex.RUnlock()
+	return len(fake.jobSLOArgsForCall)
+}
+
+func (fake *FakeTeam) JobSLOCalls(stub func(atc.PipelineRef, string, int) (atc.JobSLOReport, bool, error)) {
+	fake.jobSLOMutex.Lock()
+	defer fake.jobSLOMutex.Unlock()
+	fake.JobSLOStub = stub
+}
+
+func (fake *FakeTeam) JobSLOArgsForCall(i int) (atc.PipelineRef, string, int) {
+	fake.jobSLOMutex.RLock()
+	defer fake.jobSLOMutex.RUnlock()
+	argsForCall := fake.jobSLOArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) JobSLOReturns(result1 atc.JobSLOReport, result2 bool, result3 error) {
+	fake.jobSLOMutex.Lock()
+	defer fake.jobSLOMutex.Unlock()
+	fake.JobSLOStub = nil
+	fake.jobSLOReturns = struct {
+		result1 atc.JobSLOReport
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobSLOReturnsOnCall(i int, result1 atc.JobSLOReport, result2 bool, result3 error) {
+	fake.jobSLOMutex.Lock()
+	defer fake.jobSLOMutex.Unlock()
+	fake.JobSLOStub = nil
+	if fake.jobSLOReturnsOnCall == nil {
+		fake.jobSLOReturnsOnCall = make(map[int]struct {
+			result1 atc.JobSLOReport
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobSLOReturnsOnCall[i] = struct {
+		result1 atc.JobSLOReport
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobTestResults(arg1 atc.PipelineRef, arg2 string, arg3 int) (atc.JobTestResults, bool, error) {
+	fake.jobTestResultsMutex.Lock()
+	ret, specificReturn := fake.jobTestResultsReturnsOnCall[len(fake.jobTestResultsArgsForCall)]
+	fake.jobTestResultsArgsForCall = append(fake.jobTestResultsArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.JobTestResultsStub
+	fakeReturns := fake.jobTestResultsReturns
+	fake.recordInvocation("JobTestResults", []interface{}{arg1, arg2, arg3})
+	fake.jobTestResultsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobTestResultsCallCount() int {
+	fake.jobTestResultsMutex.RLock()
+	defer fake.jobTestResultsMutex.RUnlock()
+	return len(fake.jobTestResultsArgsForCall)
+}
+
+func (fake *FakeTeam) JobTestResultsCalls(stub func(atc.PipelineRef, string, int) (atc.JobTestResults, bool, error)) {
+	fake.jobTestResultsMutex.Lock()
+	defer fake.jobTestResultsMutex.Unlock()
+	fake.JobTestResultsStub = stub
+}
+
+func (fake *FakeTeam) JobTestResultsArgsForCall(i int) (atc.PipelineRef, string, int) {
+	fake.jobTestResultsMutex.RLock()
+	defer fake.jobTestResultsMutex.RUnlock()
+	argsForCall := fake.jobTestResultsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) JobTestResultsReturns(result1 atc.JobTestResults, result2 bool, result3 error) {
+	fake.jobTestResultsMutex.Lock()
+	defer fake.jobTestResultsMutex.Unlock()
+	fake.JobTestResultsStub = nil
+	fake.jobTestResultsReturns = struct {
+		result1 atc.JobTestResults
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobTestResultsReturnsOnCall(i int, result1 atc.JobTestResults, result2 bool, result3 error) {
+	fake.jobTestResultsMutex.Lock()
+	defer fake.jobTestResultsMutex.Unlock()
+	fake.JobTestResultsStub = nil
+	if fake.jobTestResultsReturnsOnCall == nil {
+		fake.jobTestResultsReturnsOnCall = make(map[int]struct {
+			result1 atc.JobTestResults
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobTestResultsReturnsOnCall[i] = struct {
+		result1 atc.JobTestResults
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ListContainers(arg1 map[string]string) ([]atc.Container, error) {
 	fake.listContainersMutex.Lock()
 	ret, specificReturn := fake.listContainersReturnsOnCall[len(fake.listContainersArgsForCall)]
@@ -3439,6 +3948,62 @@ func (fake *FakeTeam) ListVolumesReturnsOnCall(i int, result1 []atc.Volume, resu
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListWebhooks() ([]atc.Webhook, error) {
+	fake.listWebhooksMutex.Lock()
+	ret, specificReturn := fake.listWebhooksReturnsOnCall[len(fake.listWebhooksArgsForCall)]
+	fake.listWebhooksArgsForCall = append(fake.listWebhooksArgsForCall, struct {
+	}{})
+	stub := fake.ListWebhooksStub
+	fakeReturns := fake.listWebhooksReturns
+	fake.recordInvocation("ListWebhooks", []interface{}{})
+	fake.listWebhooksMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListWebhooksCallCount() int {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	return len(fake.listWebhooksArgsForCall)
+}
+
+func (fake *FakeTeam) ListWebhooksCalls(stub func() ([]atc.Webhook, error)) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = stub
+}
+
+func (fake *FakeTeam) ListWebhooksReturns(result1 []atc.Webhook, result2 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	fake.listWebhooksReturns = struct {
+		result1 []atc.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListWebhooksReturnsOnCall(i int, result1 []atc.Webhook, result2 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	if fake.listWebhooksReturnsOnCall == nil {
+		fake.listWebhooksReturnsOnCall = make(map[int]struct {
+			result1 []atc.Webhook
+			result2 error
+		})
+	}
+	fake.listWebhooksReturnsOnCall[i] = struct {
+		result1 []atc.Webhook
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -4028,6 +4593,141 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PipelineConfigVersion(arg1 atc.PipelineRef, arg2 int) (atc.ConfigVersionResponse, bool, error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigVersionReturnsOnCall[len(fake.pipelineConfigVersionArgsForCall)]
+	fake.pipelineConfigVersionArgsForCall = append(fake.pipelineConfigVersionArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.PipelineConfigVersionStub
+	fakeReturns := fake.pipelineConfigVersionReturns
+	fake.recordInvocation("PipelineConfigVersion", []interface{}{arg1, arg2})
+	fake.pipelineConfigVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigVersionCallCount() int {
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	return len(fake.pipelineConfigVersionArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigVersionCalls(stub func(atc.PipelineRef, int) (atc.ConfigVersionResponse, bool, error)) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigVersionArgsForCall(i int) (atc.PipelineRef, int) {
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	argsForCall := fake.pipelineConfigVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineConfigVersionReturns(result1 atc.ConfigVersionResponse, result2 bool, result3 error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = nil
+	fake.pipelineConfigVersionReturns = struct {
+		result1 atc.ConfigVersionResponse
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersionReturnsOnCall(i int, result1 atc.ConfigVersionResponse, result2 bool, result3 error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = nil
+	if fake.pipelineConfigVersionReturnsOnCall == nil {
+		fake.pipelineConfigVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.ConfigVersionResponse
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigVersionReturnsOnCall[i] = struct {
+		result1 atc.ConfigVersionResponse
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersions(arg1 atc.PipelineRef) ([]atc.ConfigVersion, bool, error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigVersionsReturnsOnCall[len(fake.pipelineConfigVersionsArgsForCall)]
+	fake.pipelineConfigVersionsArgsForCall = append(fake.pipelineConfigVersionsArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	stub := fake.PipelineConfigVersionsStub
+	fakeReturns := fake.pipelineConfigVersionsReturns
+	fake.recordInvocation("PipelineConfigVersions", []interface{}{arg1})
+	fake.pipelineConfigVersionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCallCount() int {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	return len(fake.pipelineConfigVersionsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCalls(stub func(atc.PipelineRef) ([]atc.ConfigVersion, bool, error)) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsArgsForCall(i int) atc.PipelineRef {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	argsForCall := fake.pipelineConfigVersionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturns(result1 []atc.ConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	fake.pipelineConfigVersionsReturns = struct {
+		result1 []atc.ConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturnsOnCall(i int, result1 []atc.ConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	if fake.pipelineConfigVersionsReturnsOnCall == nil {
+		fake.pipelineConfigVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.ConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigVersionsReturnsOnCall[i] = struct {
+		result1 []atc.ConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, []concourse.ConfigWarning, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -4503,6 +5203,73 @@ func (fake *FakeTeam) ScheduleJobReturnsOnCall(i int, result1 bool, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SearchBuildLogs(arg1 concourse.BuildLogSearch) ([]atc.BuildLogMatch, bool, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		arg1 concourse.BuildLogSearch
+	}{arg1})
+	stub := fake.SearchBuildLogsStub
+	fakeReturns := fake.searchBuildLogsReturns
+	fake.recordInvocation("SearchBuildLogs", []interface{}{arg1})
+	fake.searchBuildLogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeTeam) SearchBuildLogsCalls(stub func(concourse.BuildLogSearch) ([]atc.BuildLogMatch, bool, error)) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = stub
+}
+
+func (fake *FakeTeam) SearchBuildLogsArgsForCall(i int) concourse.BuildLogSearch {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	argsForCall := fake.searchBuildLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturns(result1 []atc.BuildLogMatch, result2 bool, result3 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []atc.BuildLogMatch
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturnsOnCall(i int, result1 []atc.BuildLogMatch, result2 bool, result3 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildLogMatch
+			result2 bool
+			result3 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []atc.BuildLogMatch
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) SetJobBuildComment(arg1 atc.PipelineRef, arg2 string, arg3 string, arg4 string) (bool, error) {
 	fake.setJobBuildCommentMutex.Lock()
 	ret, specificReturn := fake.setJobBuildCommentReturnsOnCall[len(fake.setJobBuildCommentArgsForCall)]
@@ -4636,6 +5403,140 @@ func (fake *FakeTeam) SetPinCommentReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetWebhook(arg1 string, arg2 atc.Webhook) (atc.Webhook, error) {
+	fake.setWebhookMutex.Lock()
+	ret, specificReturn := fake.setWebhookReturnsOnCall[len(fake.setWebhookArgsForCall)]
+	fake.setWebhookArgsForCall = append(fake.setWebhookArgsForCall, struct {
+		arg1 string
+		arg2 atc.Webhook
+	}{arg1, arg2})
+	stub := fake.SetWebhookStub
+	fakeReturns := fake.setWebhookReturns
+	fake.recordInvocation("SetWebhook", []interface{}{arg1, arg2})
+	fake.setWebhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SetWebhookCallCount() int {
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
+	return len(fake.setWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) SetWebhookCalls(stub func(string, atc.Webhook) (atc.Webhook, error)) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = stub
+}
+
+func (fake *FakeTeam) SetWebhookArgsForCall(i int) (string, atc.Webhook) {
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
+	argsForCall := fake.setWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) SetWebhookReturns(result1 atc.Webhook, result2 error) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = nil
+	fake.setWebhookReturns = struct {
+		result1 atc.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SetWebhookReturnsOnCall(i int, result1 atc.Webhook, result2 error) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = nil
+	if fake.setWebhookReturnsOnCall == nil {
+		fake.setWebhookReturnsOnCall = make(map[int]struct {
+			result1 atc.Webhook
+			result2 error
+		})
+	}
+	fake.setWebhookReturnsOnCall[i] = struct {
+		result1 atc.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) TeamActivity(arg1 []atc.ActivityEventType) (concourse.ActivityEvents, error) {
+	var arg1Copy []atc.ActivityEventType
+	if arg1 != nil {
+		arg1Copy = make([]atc.ActivityEventType, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.teamActivityMutex.Lock()
+	ret, specificReturn := fake.teamActivityReturnsOnCall[len(fake.teamActivityArgsForCall)]
+	fake.teamActivityArgsForCall = append(fake.teamActivityArgsForCall, struct {
+		arg1 []atc.ActivityEventType
+	}{arg1Copy})
+	stub := fake.TeamActivityStub
+	fakeReturns := fake.teamActivityReturns
+	fake.recordInvocation("TeamActivity", []interface{}{arg1Copy})
+	fake.teamActivityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) TeamActivityCallCount() int {
+	fake.teamActivityMutex.RLock()
+	defer fake.teamActivityMutex.RUnlock()
+	return len(fake.teamActivityArgsForCall)
+}
+
+func (fake *FakeTeam) TeamActivityCalls(stub func([]atc.ActivityEventType) (concourse.ActivityEvents, error)) {
+	fake.teamActivityMutex.Lock()
+	defer fake.teamActivityMutex.Unlock()
+	fake.TeamActivityStub = stub
+}
+
+func (fake *FakeTeam) TeamActivityArgsForCall(i int) []atc.ActivityEventType {
+	fake.teamActivityMutex.RLock()
+	defer fake.teamActivityMutex.RUnlock()
+	argsForCall := fake.teamActivityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) TeamActivityReturns(result1 concourse.ActivityEvents, result2 error) {
+	fake.teamActivityMutex.Lock()
+	defer fake.teamActivityMutex.Unlock()
+	fake.TeamActivityStub = nil
+	fake.teamActivityReturns = struct {
+		result1 concourse.ActivityEvents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) TeamActivityReturnsOnCall(i int, result1 concourse.ActivityEvents, result2 error) {
+	fake.teamActivityMutex.Lock()
+	defer fake.teamActivityMutex.Unlock()
+	fake.TeamActivityStub = nil
+	if fake.teamActivityReturnsOnCall == nil {
+		fake.teamActivityReturnsOnCall = make(map[int]struct {
+			result1 concourse.ActivityEvents
+			result2 error
+		})
+	}
+	fake.teamActivityReturnsOnCall[i] = struct {
+		result1 concourse.ActivityEvents
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -4830,6 +5731,74 @@ func (fake *FakeTeam) UnpinResourceReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) WebhookDeliveries(arg1 string, arg2 int) ([]atc.WebhookDelivery, bool, error) {
+	fake.webhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.webhookDeliveriesReturnsOnCall[len(fake.webhookDeliveriesArgsForCall)]
+	fake.webhookDeliveriesArgsForCall = append(fake.webhookDeliveriesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.WebhookDeliveriesStub
+	fakeReturns := fake.webhookDeliveriesReturns
+	fake.recordInvocation("WebhookDeliveries", []interface{}{arg1, arg2})
+	fake.webhookDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCallCount() int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	return len(fake.webhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCalls(stub func(string, int) ([]atc.WebhookDelivery, bool, error)) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) WebhookDeliveriesArgsForCall(i int) (string, int) {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.webhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturns(result1 []atc.WebhookDelivery, result2 bool, result3 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	fake.webhookDeliveriesReturns = struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturnsOnCall(i int, result1 []atc.WebhookDelivery, result2 bool, result3 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	if fake.webhookDeliveriesReturnsOnCall == nil {
+		fake.webhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WebhookDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.webhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -4877,6 +5846,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.deletePipelineMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
+	fake.destroyWebhookMutex.RLock()
+	defer fake.destroyWebhookMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
 	defer fake.disableResourceVersionMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
@@ -4891,12 +5862,20 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.hidePipelineMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.interceptSessionRecordingMutex.RLock()
+	defer fake.interceptSessionRecordingMutex.RUnlock()
+	fake.interceptSessionsMutex.RLock()
+	defer fake.interceptSessionsMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	fake.jobBuildMutex.RLock()
 	defer fake.jobBuildMutex.RUnlock()
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
+	fake.jobSLOMutex.RLock()
+	defer fake.jobSLOMutex.RUnlock()
+	fake.jobTestResultsMutex.RLock()
+	defer fake.jobTestResultsMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
@@ -4911,6 +5890,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.listSharedForResourceTypeMutex.RUnlock()
 	fake.listVolumesMutex.RLock()
 	defer fake.listVolumesMutex.RUnlock()
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.orderingPipelinesMutex.RLock()
@@ -4929,6 +5910,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -4943,16 +5928,24 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceVersionsMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
 	fake.setJobBuildCommentMutex.RLock()
 	defer fake.setJobBuildCommentMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
+	fake.teamActivityMutex.RLock()
+	defer fake.teamActivityMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
 	defer fake.unpausePipelineMutex.RUnlock()
 	fake.unpinResourceMutex.RLock()
 	defer fake.unpinResourceMutex.RUnlock()
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
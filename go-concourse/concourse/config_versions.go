@@ -0,0 +1,60 @@
+package concourse
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) PipelineConfigVersions(pipelineRef atc.PipelineRef) ([]atc.ConfigVersion, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	var versions []atc.ConfigVersion
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListConfigVersions,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &versions,
+	})
+
+	switch err.(type) {
+	case nil:
+		return versions, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) PipelineConfigVersion(pipelineRef atc.PipelineRef, version int) (atc.ConfigVersionResponse, bool, error) {
+	params := rata.Params{
+		"pipeline_name":  pipelineRef.Name,
+		"team_name":      team.Name(),
+		"config_version": strconv.Itoa(version),
+	}
+
+	var configVersion atc.ConfigVersionResponse
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetConfigVersion,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &configVersion,
+	})
+
+	switch err.(type) {
+	case nil:
+		return configVersion, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.ConfigVersionResponse{}, false, nil
+	default:
+		return atc.ConfigVersionResponse{}, false, err
+	}
+}
@@ -0,0 +1,110 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Config Versions", func() {
+	var pipelineRef atc.PipelineRef
+
+	BeforeEach(func() {
+		pipelineRef = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+	})
+
+	Describe("PipelineConfigVersions", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/versions"
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.ConfigVersion{
+							{Version: 2, JobName: "reconfigure", BuildName: "3"},
+							{Version: 1, CreatedBy: "some-user"},
+						}),
+					),
+				)
+			})
+
+			It("returns the versions", func() {
+				versions, found, err := team.PipelineConfigVersions(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions).To(Equal([]atc.ConfigVersion{
+					{Version: 2, JobName: "reconfigure", BuildName: "3"},
+					{Version: 1, CreatedBy: "some-user"},
+				}))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.PipelineConfigVersions(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("PipelineConfigVersion", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/versions/3"
+
+		Context("when the version exists", func() {
+			var expected atc.ConfigVersionResponse
+
+			BeforeEach(func() {
+				expected = atc.ConfigVersionResponse{
+					ConfigVersion: atc.ConfigVersion{Version: 3, CreatedBy: "some-user", CreatedAt: 100},
+					Config:        atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expected),
+					),
+				)
+			})
+
+			It("returns the version with its config", func() {
+				version, found, err := team.PipelineConfigVersion(pipelineRef, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(version).To(Equal(expected))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.PipelineConfigVersion(pipelineRef, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
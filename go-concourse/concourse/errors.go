@@ -34,3 +34,13 @@ type InvalidConfigError struct {
 func (c InvalidConfigError) Error() string {
 	return fmt.Sprintf("invalid pipeline config:\n%s", strings.Join(c.Errors, "\n"))
 }
+
+// InvalidWebhookError is returned when saving a webhook is rejected.
+type InvalidWebhookError struct {
+	Errors []string `json:"errors"`
+}
+
+// Error lists the reasons the webhook was rejected.
+func (err InvalidWebhookError) Error() string {
+	return strings.Join(err.Errors, "\n")
+}
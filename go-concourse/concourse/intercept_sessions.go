@@ -0,0 +1,56 @@
+package concourse
+
+import (
+	"io"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) InterceptSessions(limit int) ([]atc.InterceptSession, error) {
+	params := rata.Params{
+		"team_name": team.Name(),
+	}
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Set(atc.PaginationQueryLimit, strconv.Itoa(limit))
+	}
+
+	var sessions []atc.InterceptSession
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListInterceptSessions,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &sessions,
+	})
+
+	return sessions, err
+}
+
+func (team *team) InterceptSessionRecording(sessionID int) (io.ReadCloser, bool, error) {
+	params := rata.Params{
+		"team_name":  team.Name(),
+		"session_id": strconv.Itoa(sessionID),
+	}
+
+	response := internal.Response{}
+	err := team.connection.Send(internal.Request{
+		RequestName:        atc.GetInterceptRecording,
+		Params:             params,
+		ReturnResponseBody: true,
+	}, &response)
+
+	switch err.(type) {
+	case nil:
+		return response.Result.(io.ReadCloser), true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
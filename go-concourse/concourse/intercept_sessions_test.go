@@ -0,0 +1,81 @@
+package concourse_test
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Intercept Sessions", func() {
+	Describe("InterceptSessions", func() {
+		expectedURL := "/api/v1/teams/some-team/intercept-sessions"
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, "limit=10"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.InterceptSession{
+						{ID: 2, ContainerHandle: "some-handle", CreatedBy: "some-user", Command: []string{"bash"}},
+					}),
+				),
+			)
+		})
+
+		It("returns the sessions", func() {
+			sessions, err := team.InterceptSessions(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(Equal([]atc.InterceptSession{
+				{ID: 2, ContainerHandle: "some-handle", CreatedBy: "some-user", Command: []string{"bash"}},
+			}))
+		})
+	})
+
+	Describe("InterceptSessionRecording", func() {
+		expectedURL := "/api/v1/teams/some-team/intercept-sessions/2/recording"
+
+		Context("when the recording exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusOK, `{"version":2}`+"\n"),
+					),
+				)
+			})
+
+			It("returns the recording", func() {
+				recording, found, err := team.InterceptSessionRecording(2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				defer recording.Close()
+
+				payload, err := io.ReadAll(recording)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(payload)).To(Equal(`{"version":2}` + "\n"))
+			})
+		})
+
+		Context("when the recording does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.InterceptSessionRecording(2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
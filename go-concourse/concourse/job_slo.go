@@ -0,0 +1,45 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) JobSLO(pipelineRef atc.PipelineRef, jobName string, breaches int) (atc.JobSLOReport, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"team_name":     team.Name(),
+	}
+
+	query := pipelineRef.QueryParams()
+	if breaches > 0 {
+		if query == nil {
+			query = url.Values{}
+		}
+
+		query.Set("breaches", strconv.Itoa(breaches))
+	}
+
+	var report atc.JobSLOReport
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetJobSLO,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &report,
+	})
+
+	switch err.(type) {
+	case nil:
+		return report, true, nil
+	case internal.ResourceNotFoundError:
+		return report, false, nil
+	default:
+		return report, false, err
+	}
+}
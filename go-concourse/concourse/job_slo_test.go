@@ -0,0 +1,66 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Job SLO", func() {
+	Describe("JobSLO", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/slo"
+		pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+		Context("when pipeline/job exists", func() {
+			var expectedReport atc.JobSLOReport
+
+			BeforeEach(func() {
+				expectedReport = atc.JobSLOReport{
+					SLO:   &atc.JobSLO{Duration: "10m"},
+					Stats: atc.JobSLOStats{Builds: 2, Succeeded: 2, SuccessRate: 1, MeanDuration: 540},
+					Breaches: []atc.JobSLOBreach{
+						{
+							Build:      atc.Build{ID: 7, Name: "7", JobName: "myjob"},
+							Violations: []atc.JobSLOViolation{{Kind: atc.JobSLOViolationDuration, Value: 660, Target: 600}},
+						},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "breaches=5&vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedReport),
+					),
+				)
+			})
+
+			It("returns the job's SLO report", func() {
+				report, found, err := team.JobSLO(pipelineRef, "myjob", 5)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(report).To(Equal(expectedReport))
+			})
+		})
+
+		Context("when pipeline/job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, ""),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.JobSLO(atc.PipelineRef{Name: "mypipeline"}, "myjob", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -29,6 +29,8 @@ type Team interface {
 	RenamePipeline(oldName, newName string) (bool, []ConfigWarning, error)
 	ListPipelines() ([]atc.Pipeline, error)
 	PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, string, bool, error)
+	PipelineConfigVersions(pipelineRef atc.PipelineRef) ([]atc.ConfigVersion, bool, error)
+	PipelineConfigVersion(pipelineRef atc.PipelineRef, version int) (atc.ConfigVersionResponse, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
 
 	CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error)
@@ -43,6 +45,8 @@ type Team interface {
 	SetJobBuildComment(pipelineRef atc.PipelineRef, jobName string, buildName string, comment string) (bool, error)
 	ListJobs(pipelineRef atc.PipelineRef) ([]atc.Job, error)
 	ScheduleJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
+	JobTestResults(pipelineRef atc.PipelineRef, jobName string, builds int) (atc.JobTestResults, bool, error)
+	JobSLO(pipelineRef atc.PipelineRef, jobName string, breaches int) (atc.JobSLOReport, bool, error)
 
 	PauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
 	UnpauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
@@ -73,9 +77,18 @@ type Team interface {
 
 	ListContainers(queryList map[string]string) ([]atc.Container, error)
 	GetContainer(id string) (atc.Container, error)
+	InterceptSessions(limit int) ([]atc.InterceptSession, error)
+	InterceptSessionRecording(sessionID int) (io.ReadCloser, bool, error)
 	ListVolumes() ([]atc.Volume, error)
+	ListWebhooks() ([]atc.Webhook, error)
+	SetWebhook(name string, webhook atc.Webhook) (atc.Webhook, error)
+	DestroyWebhook(name string) (bool, error)
+	WebhookDeliveries(name string, limit int) ([]atc.WebhookDelivery, bool, error)
+
+	TeamActivity(types []atc.ActivityEventType) (ActivityEvents, error)
 	CreateBuild(plan atc.Plan) (atc.Build, error)
 	Builds(page Page) ([]atc.Build, Pagination, error)
+	SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, bool, error)
 	OrderingPipelines(pipelineNames []string) error
 	OrderingPipelinesWithinGroup(groupName string, instanceVars []atc.InstanceVars) error
 
@@ -0,0 +1,45 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) JobTestResults(pipelineRef atc.PipelineRef, jobName string, builds int) (atc.JobTestResults, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"team_name":     team.Name(),
+	}
+
+	query := pipelineRef.QueryParams()
+	if builds > 0 {
+		if query == nil {
+			query = url.Values{}
+		}
+
+		query.Set("builds", strconv.Itoa(builds))
+	}
+
+	var results atc.JobTestResults
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListJobTests,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &results,
+	})
+
+	switch err.(type) {
+	case nil:
+		return results, true, nil
+	case internal.ResourceNotFoundError:
+		return results, false, nil
+	default:
+		return results, false, err
+	}
+}
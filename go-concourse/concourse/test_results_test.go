@@ -0,0 +1,64 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Test Results", func() {
+	Describe("JobTestResults", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/test-results"
+		pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+		Context("when pipeline/job exists", func() {
+			var expectedResults atc.JobTestResults
+
+			BeforeEach(func() {
+				expectedResults = atc.JobTestResults{
+					Builds: []atc.BuildTestSummary{
+						{BuildID: 2, BuildName: "2", Status: atc.StatusFailed, Summary: atc.TestSummary{Total: 2, Passed: 1, Failed: 1}},
+					},
+					Flaky: []atc.FlakyTest{
+						{Suite: "api", Name: "deletes", Passed: 3, Failed: 1, LastFailedBuild: "2"},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "builds=10&vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedResults),
+					),
+				)
+			})
+
+			It("returns the job's test history", func() {
+				results, found, err := team.JobTestResults(pipelineRef, "myjob", 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(results).To(Equal(expectedResults))
+			})
+		})
+
+		Context("when pipeline/job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, ""),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.JobTestResults(atc.PipelineRef{Name: "mypipeline"}, "myjob", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
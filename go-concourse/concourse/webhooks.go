@@ -0,0 +1,117 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListWebhooks() ([]atc.Webhook, error) {
+	params := rata.Params{
+		"team_name": team.Name(),
+	}
+
+	var webhooks []atc.Webhook
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListWebhooks,
+		Params:      params,
+	}, &internal.Response{
+		Result: &webhooks,
+	})
+
+	return webhooks, err
+}
+
+func (team *team) SetWebhook(name string, webhook atc.Webhook) (atc.Webhook, error) {
+	params := rata.Params{
+		"team_name":    team.Name(),
+		"webhook_name": name,
+	}
+
+	payload, err := json.Marshal(webhook)
+	if err != nil {
+		return atc.Webhook{}, err
+	}
+
+	var saved atc.Webhook
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.SetWebhook,
+		Params:      params,
+		Body:        bytes.NewBuffer(payload),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &saved,
+	})
+
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		if unexpectedResponseError.StatusCode == http.StatusBadRequest {
+			var invalidWebhookErr InvalidWebhookError
+
+			err = json.Unmarshal([]byte(unexpectedResponseError.Body), &invalidWebhookErr)
+			if err != nil {
+				return atc.Webhook{}, err
+			}
+
+			return atc.Webhook{}, invalidWebhookErr
+		}
+	}
+
+	return saved, err
+}
+
+func (team *team) DestroyWebhook(name string) (bool, error) {
+	params := rata.Params{
+		"team_name":    team.Name(),
+		"webhook_name": name,
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyWebhook,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (team *team) WebhookDeliveries(name string, limit int) ([]atc.WebhookDelivery, bool, error) {
+	params := rata.Params{
+		"team_name":    team.Name(),
+		"webhook_name": name,
+	}
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Set(atc.PaginationQueryLimit, strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.WebhookDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListWebhookDeliveries,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+
+	switch err.(type) {
+	case nil:
+		return deliveries, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -0,0 +1,173 @@
+package concourse_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Webhooks", func() {
+	Describe("ListWebhooks", func() {
+		expectedURL := "/api/v1/teams/some-team/webhooks"
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Webhook{
+						{Name: "some-webhook", URL: "https://example.com/hook", Events: []atc.WebhookEvent{"build_status"}},
+					}),
+				),
+			)
+		})
+
+		It("returns the webhooks", func() {
+			webhooks, err := team.ListWebhooks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhooks).To(Equal([]atc.Webhook{
+				{Name: "some-webhook", URL: "https://example.com/hook", Events: []atc.WebhookEvent{"build_status"}},
+			}))
+		})
+	})
+
+	Describe("SetWebhook", func() {
+		expectedURL := "/api/v1/teams/some-team/webhooks/some-webhook"
+
+		webhook := atc.Webhook{
+			URL:    "https://example.com/hook",
+			Secret: "some-secret",
+		}
+
+		Context("when the webhook is saved", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSONRepresenting(webhook),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Webhook{
+							Name:      "some-webhook",
+							URL:       "https://example.com/hook",
+							CreatedAt: 100,
+						}),
+					),
+				)
+			})
+
+			It("returns the saved webhook", func() {
+				saved, err := team.SetWebhook("some-webhook", webhook)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(saved).To(Equal(atc.Webhook{
+					Name:      "some-webhook",
+					URL:       "https://example.com/hook",
+					CreatedAt: 100,
+				}))
+			})
+		})
+
+		Context("when the webhook is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusBadRequest, `{"errors":["invalid webhook:\nsecret is required"]}`),
+					),
+				)
+			})
+
+			It("returns the reasons", func() {
+				_, err := team.SetWebhook("some-webhook", webhook)
+				Expect(err).To(Equal(concourse.InvalidWebhookError{
+					Errors: []string{"invalid webhook:\nsecret is required"},
+				}))
+				Expect(err).To(MatchError("invalid webhook:\nsecret is required"))
+			})
+		})
+	})
+
+	Describe("DestroyWebhook", func() {
+		expectedURL := "/api/v1/teams/some-team/webhooks/some-webhook"
+
+		Context("when the webhook exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("destroys it", func() {
+				found, err := team.DestroyWebhook("some-webhook")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := team.DestroyWebhook("some-webhook")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("WebhookDeliveries", func() {
+		expectedURL := "/api/v1/teams/some-team/webhooks/some-webhook/deliveries"
+
+		Context("when the webhook exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=5"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.WebhookDelivery{
+							{ID: 1, Event: "build_status", Payload: json.RawMessage(`{"event":"build_status"}`), Status: "delivered", Attempts: 1, ResponseStatus: 200},
+						}),
+					),
+				)
+			})
+
+			It("returns the deliveries", func() {
+				deliveries, found, err := team.WebhookDeliveries("some-webhook", 5)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(deliveries).To(Equal([]atc.WebhookDelivery{
+					{ID: 1, Event: "build_status", Payload: json.RawMessage(`{"event":"build_status"}`), Status: "delivered", Attempts: 1, ResponseStatus: 200},
+				}))
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.WebhookDeliveries("some-webhook", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"embed"
 	"errors"
 	"io/fs"
+	"net/http"
 	"strings"
 	"time"
 
@@ -18,6 +19,25 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// DeviceCallbackPath is the redirect URI dex uses for the device
+// authorization grant. dex only resolves it against the issuer for public
+// clients, so for our clients the browser is sent to it as-is and it has to be
+// served by NewDeviceCallbackHandler.
+const DeviceCallbackPath = "/device/callback"
+
+// NewDeviceCallbackHandler serves dex's device callback outside of the
+// issuer, by rewriting the request to the callback under issuerPath.
+func NewDeviceCallbackHandler(issuerPath string, dexHandler http.Handler) http.Handler {
+	callbackPath := strings.TrimRight(issuerPath, "/") + DeviceCallbackPath
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.Clone(r.Context())
+		r.URL.Path = callbackPath
+		r.URL.RawPath = ""
+		dexHandler.ServeHTTP(w, r)
+	})
+}
+
 type DexConfig struct {
 	Logger            lager.Logger
 	IssuerURL         string
@@ -88,9 +108,11 @@ func NewDexServerConfig(config *DexConfig) (server.Config, error) {
 
 	for clientId, clientSecret := range config.Clients {
 		clients = append(clients, storage.Client{
-			ID:           clientId,
-			Secret:       clientSecret,
-			RedirectURIs: []string{config.RedirectURL},
+			ID:     clientId,
+			Secret: clientSecret,
+			// dex redirects to its own device callback to complete logins
+			// using the device authorization grant, e.g. 'fly login --device'
+			RedirectURIs: []string{config.RedirectURL, DeviceCallbackPath},
 		})
 	}
 
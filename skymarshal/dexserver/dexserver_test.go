@@ -219,6 +219,12 @@ var _ = Describe("Dex Server", func() {
 				Expect(clients[0].Secret).To(Equal("some-client-secret"))
 				Expect(clients[0].RedirectURIs).To(ContainElement("http://example.com"))
 			})
+
+			It("allows the clients to use the device authorization grant", func() {
+				clients, err := storage.ListClients()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clients[0].RedirectURIs).To(ContainElement("/device/callback"))
+			})
 		})
 	})
 })
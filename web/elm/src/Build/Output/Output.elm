@@ -255,6 +255,11 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        Annotated origin annotations ->
+            ( updateStep origin.id (addAnnotations annotations) model
+            , effects
+            )
+
         BuildStatus status _ ->
             let
                 newSt =
@@ -387,6 +392,11 @@ setStepFinish mtime step =
     { step | finish = mtime }
 
 
+addAnnotations : Concourse.Metadata -> Step -> Step
+addAnnotations annotations step =
+    { step | metadata = step.metadata ++ annotations }
+
+
 setSetPipelineChanged : Bool -> Step -> Step
 setSetPipelineChanged changed step =
     { step | changed = changed }
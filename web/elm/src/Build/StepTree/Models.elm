@@ -208,6 +208,7 @@ type BuildEvent
     | StartPut Origin Time.Posix
     | FinishPut Origin Int Concourse.Version Concourse.Metadata (Maybe Time.Posix)
     | SetPipelineChanged Origin Bool
+    | Annotated Origin Concourse.Metadata
     | Log Origin String (Maybe Time.Posix)
     | WaitingForWorker Origin (Maybe Time.Posix)
     | SelectedWorker Origin String (Maybe Time.Posix)
@@ -910,6 +910,7 @@ type alias FeatureFlags =
     , pipeline_instances : Bool
     , cache_streamed_volumes : Bool
     , resource_causality : Bool
+    , build_log_search : Bool
     }
 
 
@@ -922,6 +923,7 @@ defaultFeatureFlags =
     , pipeline_instances = False
     , cache_streamed_volumes = False
     , resource_causality = False
+    , build_log_search = False
     }
 
 
@@ -230,6 +230,13 @@ decodeBuildEvent =
                                 (Json.Decode.field "changed" Json.Decode.bool)
                             )
 
+                    "annotations" ->
+                        Json.Decode.field "data"
+                            (Json.Decode.map2 Annotated
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "annotations" decodeAnnotations)
+                            )
+
                     "image-check" ->
                         Json.Decode.field "data"
                             (Json.Decode.map2 ImageCheck
@@ -291,6 +298,58 @@ decodeFinishResource cons =
         (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
 
 
+{-| Annotations are shown in the step's metadata table, so they are flattened
+into rows: the test counts, then the metadata, then the links, then the
+summary.
+-}
+decodeAnnotations : Json.Decode.Decoder Concourse.Metadata
+decodeAnnotations =
+    let
+        optional field decoder =
+            Json.Decode.maybe (Json.Decode.field field decoder)
+
+        optionalList field decoder =
+            Json.Decode.map (Maybe.withDefault []) <|
+                optional field (Json.Decode.list decoder)
+
+        tests =
+            optional "tests" <|
+                Json.Decode.map3
+                    (\passed failed skipped ->
+                        Concourse.MetadataField "tests" <|
+                            String.fromInt passed
+                                ++ " passed, "
+                                ++ String.fromInt failed
+                                ++ " failed, "
+                                ++ String.fromInt skipped
+                                ++ " skipped"
+                    )
+                    (Json.Decode.field "passed" Json.Decode.int)
+                    (Json.Decode.field "failed" Json.Decode.int)
+                    (Json.Decode.map (Maybe.withDefault 0) <| optional "skipped" Json.Decode.int)
+
+        link =
+            Json.Decode.map2 Concourse.MetadataField
+                (Json.Decode.field "name" Json.Decode.string)
+                (Json.Decode.field "url" Json.Decode.string)
+
+        summary =
+            Json.Decode.map (Maybe.map (Concourse.MetadataField "summary")) <|
+                optional "summary" Json.Decode.string
+    in
+    Json.Decode.map4
+        (\t metadata links s ->
+            List.filterMap identity [ t ]
+                ++ metadata
+                ++ links
+                ++ List.filterMap identity [ s ]
+        )
+        tests
+        (Json.Decode.map (Maybe.withDefault []) <| optional "metadata" Concourse.decodeMetadata)
+        (optionalList "links" link)
+        summary
+
+
 decodeErrorEvent : Json.Decode.Decoder BuildEvent
 decodeErrorEvent =
     Json.Decode.map3
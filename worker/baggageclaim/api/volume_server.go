@@ -723,10 +723,12 @@ type volumeCreationHandler interface {
 
 func (vs *VolumeServer) creationFailed(w http.ResponseWriter, err error) (volume.Volume, error) {
 	var code int
-	switch err {
-	case volume.ErrParentVolumeNotFound:
+	switch {
+	case errors.Is(err, volume.ErrParentVolumeNotFound):
 		code = httpUnprocessableEntity
-	case volume.ErrNoParentVolumeProvided:
+	case errors.Is(err, volume.ErrNoParentVolumeProvided):
+		code = httpUnprocessableEntity
+	case errors.Is(err, volume.ErrInvalidManifest):
 		code = httpUnprocessableEntity
 	default:
 		code = http.StatusInternalServerError
@@ -53,9 +53,6 @@ var _ = Describe("Volume Server", func() {
 	JustBeforeEach(func() {
 		logger := lagertest.NewTestLogger("volume-server")
 
-		fs, err := volume.NewFilesystem(&driver.NaiveDriver{}, volumeDir)
-		Expect(err).NotTo(HaveOccurred())
-
 		privilegedNamespacer := &uidgid.UidNamespacer{
 			Translator: uidgid.NewTranslator(uidgid.NewPrivilegedMapper()),
 			Logger:     logger.Session("uid-namespacer"),
@@ -66,6 +63,9 @@ var _ = Describe("Volume Server", func() {
 			Logger:     logger.Session("uid-namespacer"),
 		}
 
+		fs, err := volume.NewFilesystem(&driver.NaiveDriver{}, volumeDir, privilegedNamespacer, unprivilegedNamespacer)
+		Expect(err).NotTo(HaveOccurred())
+
 		repo := volume.NewRepository(
 			fs,
 			volume.NewLockManager(),
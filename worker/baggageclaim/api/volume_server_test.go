@@ -56,9 +56,6 @@ var _ = Describe("Volume Server", func() {
 	JustBeforeEach(func() {
 		logger := lagertest.NewTestLogger("volume-server")
 
-		fs, err := volume.NewFilesystem(&driver.NaiveDriver{}, volumeDir)
-		Expect(err).NotTo(HaveOccurred())
-
 		var privilegedNamespacer, unprivilegedNamespacer uidgid.Namespacer
 		if runtime.GOOS == "linux" {
 			privilegedNamespacer = &uidgid.UidNamespacer{
@@ -75,6 +72,9 @@ var _ = Describe("Volume Server", func() {
 			unprivilegedNamespacer = &uidgid.NoopNamespacer{}
 		}
 
+		fs, err := volume.NewFilesystem(&driver.NaiveDriver{}, volumeDir, privilegedNamespacer, unprivilegedNamespacer)
+		Expect(err).NotTo(HaveOccurred())
+
 		repo := volume.NewRepository(
 			fs,
 			volume.NewLockManager(),
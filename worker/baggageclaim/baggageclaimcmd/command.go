@@ -31,7 +31,7 @@ type BaggageclaimCommand struct {
 
 	VolumesDir flag.Dir `long:"volumes" required:"true" description:"Directory in which to place volume data."`
 
-	Driver string `long:"driver" default:"detect" choice:"detect" choice:"naive" choice:"btrfs" choice:"overlay" description:"Driver to use for managing volumes."`
+	Driver string `long:"driver" default:"detect" choice:"detect" choice:"naive" choice:"btrfs" choice:"overlay" description:"Driver to use for managing volumes. With the overlay driver, images fetched as an OCI image layout or an image archive share their layers between volumes; images fetched as a rootfs are not deduplicated."`
 
 	BtrfsBin string `long:"btrfs-bin" default:"btrfs" description:"Path to btrfs binary"`
 	MkfsBin  string `long:"mkfs-bin" default:"mkfs.btrfs" description:"Path to mkfs.btrfs binary"`
@@ -80,7 +80,7 @@ func (cmd *BaggageclaimCommand) Runner(args []string) (ifrit.Runner, error) {
 		return nil, err
 	}
 
-	filesystem, err := volume.NewFilesystem(driver, cmd.VolumesDir.Path())
+	filesystem, err := volume.NewFilesystem(driver, cmd.VolumesDir.Path(), privilegedNamespacer, unprivilegedNamespacer)
 	if err != nil {
 		logger.Error("failed-to-initialize-filesystem", err)
 		return nil, err
@@ -16,6 +16,7 @@ const (
 	StrategyEmpty       = "empty"
 	StrategyCopyOnWrite = "cow"
 	StrategyImport      = "import"
+	StrategyOCIImage    = "oci-image"
 )
 
 //go:generate counterfeiter . Client
@@ -209,6 +210,45 @@ func (COWStrategy) String() string {
 	return StrategyCopyOnWrite
 }
 
+// OCIImageStrategy creates a volume holding the root filesystem of an OCI
+// image that's laid out in another Volume. The image's layers are unpacked
+// into a store shared by all the volumes on the server, so that a layer is
+// only ever stored once.
+type OCIImageStrategy struct {
+	// The parent volume containing the image.
+	Parent Volume
+
+	// The path within the parent volume of either an OCI image layout
+	// directory or an image archive, such as the image.tar written by `docker
+	// save` or by the registry-image resource.
+	Path string
+
+	// The digest of the image manifest to create the root filesystem from.
+	// Left empty for an image archive, which holds a single image.
+	Manifest string
+}
+
+func (strategy OCIImageStrategy) Encode() *json.RawMessage {
+	payload, _ := json.Marshal(struct {
+		Type     string `json:"type"`
+		Volume   string `json:"volume"`
+		Path     string `json:"path"`
+		Manifest string `json:"manifest"`
+	}{
+		Type:     "oci-image",
+		Volume:   strategy.Parent.Handle(),
+		Path:     strategy.Path,
+		Manifest: strategy.Manifest,
+	})
+
+	msg := json.RawMessage(payload)
+	return &msg
+}
+
+func (OCIImageStrategy) String() string {
+	return StrategyOCIImage
+}
+
 // EmptyStrategy created a new empty volume.
 type EmptyStrategy struct{}
 
@@ -10,3 +10,13 @@ type Driver interface {
 
 	Recover(Filesystem) error
 }
+
+// LayeredDriver is implemented by drivers that can create a volume by
+// stacking layers, e.g. as overlay lower dirs, without copying them.
+type LayeredDriver interface {
+	Driver
+
+	// CreateLayeredVolume creates a volume on top of the layers in the
+	// given directories, bottom-most first.
+	CreateLayeredVolume(FilesystemInitVolume, []string) error
+}
@@ -15,6 +15,7 @@ import (
 	"code.cloudfoundry.org/lager/v3/lagertest"
 
 	"github.com/concourse/concourse/worker/baggageclaim/fs"
+	"github.com/concourse/concourse/worker/baggageclaim/uidgid"
 	"github.com/concourse/concourse/worker/baggageclaim/volume"
 	"github.com/concourse/concourse/worker/baggageclaim/volume/driver"
 )
@@ -48,7 +49,7 @@ var _ = Describe("BtrFS", func() {
 
 		fsDriver = driver.NewBtrFSDriver(logger, "btrfs")
 
-		volumeFs, err = volume.NewFilesystem(fsDriver, volumesDir)
+		volumeFs, err = volume.NewFilesystem(fsDriver, volumesDir, uidgid.NoopNamespacer{}, uidgid.NoopNamespacer{})
 		Expect(err).ToNot(HaveOccurred())
 	})
 
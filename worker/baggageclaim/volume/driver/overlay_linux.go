@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"syscall"
 
 	"github.com/concourse/concourse/worker/baggageclaim/volume"
@@ -94,6 +96,19 @@ func (driver *OverlayDriver) CreateCopyOnWriteLayer(
 	return driver.overlayMount(child, rootParent)
 }
 
+func (driver *OverlayDriver) CreateLayeredVolume(
+	vol volume.FilesystemInitVolume,
+	layers []string,
+) error {
+	path := vol.DataPath()
+	err := os.MkdirAll(path, 0755)
+	if err != nil {
+		return err
+	}
+
+	return driver.layeredMount(vol, layers)
+}
+
 func (driver *OverlayDriver) Recover(fs volume.Filesystem) error {
 	vols, err := fs.ListVolumes()
 	if err != nil {
@@ -120,6 +135,19 @@ func (driver *OverlayDriver) Recover(fs volume.Filesystem) error {
 			continue
 		}
 
+		layers, err := vol.LoadLayers()
+		if err != nil {
+			return fmt.Errorf("get layers: %w", err)
+		}
+
+		if len(layers) > 0 {
+			err = driver.layeredMount(vol, layers)
+			if err != nil {
+				return fmt.Errorf("recover layered mount: %w", err)
+			}
+			continue
+		}
+
 		err = driver.bindMount(vol)
 		if err != nil {
 			return fmt.Errorf("recover bind mount: %w", err)
@@ -193,6 +221,30 @@ func (driver *OverlayDriver) bindMount(vol volume.FilesystemVolume) error {
 }
 
 func (driver *OverlayDriver) overlayMount(child volume.FilesystemVolume, parent volume.FilesystemLiveVolume) error {
+	return driver.mount(child, parent.DataPath())
+}
+
+// layeredMount mounts the volume on top of the layers, which are given
+// bottom-most first whereas overlay wants the top-most lower dir first.
+func (driver *OverlayDriver) layeredMount(vol volume.FilesystemVolume, layers []string) error {
+	lowerDirs := slices.Clone(layers)
+	slices.Reverse(lowerDirs)
+
+	// overlay requires at least one lower dir
+	if len(lowerDirs) == 0 {
+		emptyDir := filepath.Join(driver.OverlaysDir, "empty")
+		err := os.MkdirAll(emptyDir, 0755)
+		if err != nil {
+			return err
+		}
+
+		lowerDirs = []string{emptyDir}
+	}
+
+	return driver.mount(vol, strings.Join(lowerDirs, ":"))
+}
+
+func (driver *OverlayDriver) mount(child volume.FilesystemVolume, lowerDir string) error {
 	childDir := driver.layerDir(child)
 	err := os.MkdirAll(childDir, 0755)
 	if err != nil {
@@ -207,9 +259,9 @@ func (driver *OverlayDriver) overlayMount(child volume.FilesystemVolume, parent
 
 	opts := fmt.Sprintf(
 		mountOpts,
-		parent.DataPath(), //lowerdir
-		childDir,          //upperdir
-		workDir,           //workdir
+		lowerDir, //lowerdir
+		childDir, //upperdir
+		workDir,  //workdir
 	)
 
 	err = syscall.Mount("overlay", child.DataPath(), "overlay", 0, opts)
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/concourse/concourse/worker/baggageclaim/uidgid"
 	"github.com/concourse/concourse/worker/baggageclaim/volume"
 	"github.com/concourse/concourse/worker/baggageclaim/volume/driver"
 
@@ -26,7 +27,7 @@ var _ = Describe("Overlay", func() {
 			overlayDriver := driver.NewOverlayDriver(overlaysDir)
 
 			volumesDir := filepath.Join(tmpdir, "volumes")
-			fs, err = volume.NewFilesystem(overlayDriver, volumesDir)
+			fs, err = volume.NewFilesystem(overlayDriver, volumesDir, uidgid.NoopNamespacer{}, uidgid.NoopNamespacer{})
 			Expect(err).ToNot(HaveOccurred())
 		})
 
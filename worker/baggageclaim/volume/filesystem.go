@@ -1,14 +1,20 @@
 package volume
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/worker/baggageclaim/uidgid"
+	"github.com/opencontainers/go-digest"
 )
 
 //go:generate counterfeiter . Filesystem
 
 type Filesystem interface {
 	NewVolume(string) (FilesystemInitVolume, error)
+	NewLayeredVolume(lager.Logger, string, []Layer, bool) (FilesystemInitVolume, error)
 	LookupVolume(string) (FilesystemLiveVolume, bool, error)
 	ListVolumes() ([]FilesystemLiveVolume, error)
 }
@@ -32,6 +38,10 @@ type FilesystemVolume interface {
 
 	Parent() (FilesystemLiveVolume, bool, error)
 
+	// LoadLayers returns the directories of the layers a layered volume is
+	// made of, bottom-most first. It returns none for any other volume.
+	LoadLayers() ([]string, error)
+
 	Destroy() error
 }
 
@@ -63,9 +73,17 @@ type filesystem struct {
 	initDir string
 	liveDir string
 	deadDir string
+
+	privilegedLayers   *layerStore
+	unprivilegedLayers *layerStore
 }
 
-func NewFilesystem(driver Driver, parentDir string) (Filesystem, error) {
+func NewFilesystem(
+	driver Driver,
+	parentDir string,
+	privilegedNamespacer uidgid.Namespacer,
+	unprivilegedNamespacer uidgid.Namespacer,
+) (Filesystem, error) {
 	initDir := filepath.Join(parentDir, initDirname)
 	liveDir := filepath.Join(parentDir, liveDirname)
 	deadDir := filepath.Join(parentDir, deadDirname)
@@ -85,13 +103,39 @@ func NewFilesystem(driver Driver, parentDir string) (Filesystem, error) {
 		return nil, err
 	}
 
-	return &filesystem{
+	privilegedLayers, err := newLayerStore(
+		filepath.Join(parentDir, layersDirname, privilegedLayersDirname),
+		privilegedNamespacer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	unprivilegedLayers, err := newLayerStore(
+		filepath.Join(parentDir, layersDirname, unprivilegedLayersDirname),
+		unprivilegedNamespacer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := &filesystem{
 		driver: driver,
 
 		initDir: initDir,
 		liveDir: liveDir,
 		deadDir: deadDir,
-	}, nil
+
+		privilegedLayers:   privilegedLayers,
+		unprivilegedLayers: unprivilegedLayers,
+	}
+
+	err = fs.recoverLayers()
+	if err != nil {
+		return nil, err
+	}
+
+	return fs, nil
 }
 
 func (fs *filesystem) NewVolume(handle string) (FilesystemInitVolume, error) {
@@ -109,6 +153,107 @@ func (fs *filesystem) NewVolume(handle string) (FilesystemInitVolume, error) {
 	return volume, nil
 }
 
+// NewLayeredVolume creates a volume from the layers of an image. Drivers
+// that can stack the layers share them with other volumes through the layer
+// store, while other drivers get a volume with the layers applied onto it.
+//
+// The shared layers are already namespaced for a privileged or unprivileged
+// volume, so a volume stacked on them must not be namespaced again.
+func (fs *filesystem) NewLayeredVolume(logger lager.Logger, handle string, layers []Layer, privileged bool) (FilesystemInitVolume, error) {
+	driver, ok := fs.driver.(LayeredDriver)
+	if !ok {
+		return fs.newFlattenedVolume(handle, layers)
+	}
+
+	refs := LayerRefs{
+		Privileged: privileged,
+		Digests:    make([]digest.Digest, len(layers)),
+	}
+
+	for i, layer := range layers {
+		refs.Digests[i] = layer.Digest
+	}
+
+	store := fs.layerStore(privileged)
+
+	layerDirs, err := store.acquire(logger, layers, true)
+	if err != nil {
+		return nil, err
+	}
+
+	volume, err := fs.initRawVolume(handle)
+	if err != nil {
+		store.release(refs.Digests)
+		return nil, err
+	}
+
+	err = (&Metadata{volume.dir}).StoreLayers(refs)
+	if err != nil {
+		volume.cleanup()
+		store.release(refs.Digests)
+		return nil, err
+	}
+
+	err = driver.CreateLayeredVolume(volume, layerDirs)
+	if err != nil {
+		volume.cleanup()
+		store.release(refs.Digests)
+		return nil, err
+	}
+
+	return volume, nil
+}
+
+func (fs *filesystem) newFlattenedVolume(handle string, layers []Layer) (FilesystemInitVolume, error) {
+	volume, err := fs.NewVolume(handle)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, layer := range layers {
+		err := applyLayer(layer, volume.DataPath(), false)
+		if err != nil {
+			volume.Destroy()
+			return nil, fmt.Errorf("apply layer %s: %w", layer.Digest, err)
+		}
+	}
+
+	return volume, nil
+}
+
+// recoverLayers counts the references the volumes hold to layers and gets
+// rid of the layers no volume references.
+func (fs *filesystem) recoverLayers() error {
+	volumes, err := fs.ListVolumes()
+	if err != nil {
+		return err
+	}
+
+	for _, volume := range volumes {
+		refs, err := (&Metadata{fs.liveVolumePath(volume.Handle())}).Layers()
+		if err != nil {
+			return fmt.Errorf("load layers of volume %s: %w", volume.Handle(), err)
+		}
+
+		fs.layerStore(refs.Privileged).ref(refs.Digests)
+	}
+
+	err = fs.privilegedLayers.prune()
+	if err != nil {
+		return err
+	}
+
+	return fs.unprivilegedLayers.prune()
+}
+
+func (fs *filesystem) layerStore(privileged bool) *layerStore {
+	if privileged {
+		return fs.privilegedLayers
+	}
+
+	return fs.unprivilegedLayers
+}
+
 func (fs *filesystem) LookupVolume(handle string) (FilesystemLiveVolume, bool, error) {
 	volumePath := fs.liveVolumePath(handle)
 
@@ -247,6 +392,26 @@ func (base *baseVolume) Parent() (FilesystemLiveVolume, bool, error) {
 	}, true, nil
 }
 
+func (base *baseVolume) LoadLayers() ([]string, error) {
+	refs, err := base.loadLayerRefs()
+	if err != nil {
+		return nil, err
+	}
+
+	store := base.fs.layerStore(refs.Privileged)
+
+	var dirs []string
+	for _, dgst := range refs.Digests {
+		dirs = append(dirs, store.path(dgst))
+	}
+
+	return dirs, nil
+}
+
+func (base *baseVolume) loadLayerRefs() (LayerRefs, error) {
+	return (&Metadata{base.dir}).Layers()
+}
+
 func (base *baseVolume) Destroy() error {
 	deadDir := base.fs.deadVolumePath(base.handle)
 
@@ -327,10 +492,20 @@ type deadVolume struct {
 }
 
 func (vol *deadVolume) Destroy() error {
-	err := vol.fs.driver.DestroyVolume(vol)
+	refs, err := vol.loadLayerRefs()
+	if err != nil {
+		return err
+	}
+
+	err = vol.fs.driver.DestroyVolume(vol)
+	if err != nil {
+		return err
+	}
+
+	err = vol.cleanup()
 	if err != nil {
 		return err
 	}
 
-	return vol.cleanup()
+	return vol.fs.layerStore(refs.Privileged).release(refs.Digests)
 }
@@ -0,0 +1,328 @@
+package volume_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"github.com/concourse/concourse/worker/baggageclaim/uidgid/uidgidfakes"
+	"github.com/concourse/concourse/worker/baggageclaim/volume"
+	"github.com/concourse/concourse/worker/baggageclaim/volume/copy"
+	"github.com/concourse/concourse/worker/baggageclaim/volume/driver"
+	"github.com/opencontainers/go-digest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// stackingDriver stands in for a driver stacking layers, by copying them
+type stackingDriver struct {
+	driver.NaiveDriver
+
+	layers [][]string
+}
+
+func (driver *stackingDriver) CreateLayeredVolume(vol volume.FilesystemInitVolume, layers []string) error {
+	driver.layers = append(driver.layers, layers)
+
+	err := os.Mkdir(vol.DataPath(), 0755)
+	if err != nil {
+		return err
+	}
+
+	for _, layer := range layers {
+		err := copy.Cp(false, layer, vol.DataPath())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ = Describe("Filesystem", func() {
+	var (
+		volumesDir string
+		blobsDir   string
+
+		fakePrivilegedNamespacer   *uidgidfakes.FakeNamespacer
+		fakeUnprivilegedNamespacer *uidgidfakes.FakeNamespacer
+	)
+
+	layerTar := func(files map[string]string) []byte {
+		buf := new(bytes.Buffer)
+
+		tarWriter := tar.NewWriter(buf)
+		for name, content := range files {
+			Expect(tarWriter.WriteHeader(&tar.Header{
+				Name: name,
+				Mode: 0644,
+				Size: int64(len(content)),
+			})).To(Succeed())
+
+			_, err := tarWriter.Write([]byte(content))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(tarWriter.Close()).To(Succeed())
+
+		return buf.Bytes()
+	}
+
+	writeLayer := func(files map[string]string) volume.Layer {
+		buf := new(bytes.Buffer)
+
+		gzipWriter := gzip.NewWriter(buf)
+		_, err := gzipWriter.Write(layerTar(files))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gzipWriter.Close()).To(Succeed())
+
+		dgst := digest.FromBytes(buf.Bytes())
+		blob := filepath.Join(blobsDir, dgst.Encoded())
+		Expect(os.WriteFile(blob, buf.Bytes(), 0644)).To(Succeed())
+
+		return volume.Layer{Digest: dgst, Blob: blob}
+	}
+
+	layerDir := func(layer volume.Layer) string {
+		return filepath.Join(volumesDir, "layers", "unprivileged", "sha256", layer.Digest.Encoded())
+	}
+
+	privilegedLayerDir := func(layer volume.Layer) string {
+		return filepath.Join(volumesDir, "layers", "privileged", "sha256", layer.Digest.Encoded())
+	}
+
+	newFilesystem := func(driver volume.Driver) volume.Filesystem {
+		fs, err := volume.NewFilesystem(driver, volumesDir, fakePrivilegedNamespacer, fakeUnprivilegedNamespacer)
+		Expect(err).ToNot(HaveOccurred())
+
+		return fs
+	}
+
+	BeforeEach(func() {
+		var err error
+		volumesDir, err = os.MkdirTemp("", "volumes")
+		Expect(err).ToNot(HaveOccurred())
+
+		blobsDir, err = os.MkdirTemp("", "blobs")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakePrivilegedNamespacer = new(uidgidfakes.FakeNamespacer)
+		fakeUnprivilegedNamespacer = new(uidgidfakes.FakeNamespacer)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(volumesDir)).To(Succeed())
+		Expect(os.RemoveAll(blobsDir)).To(Succeed())
+	})
+
+	Describe("NewLayeredVolume", func() {
+		var (
+			baseLayer  volume.Layer
+			otherLayer volume.Layer
+		)
+
+		BeforeEach(func() {
+			baseLayer = writeLayer(map[string]string{"base": "base"})
+			otherLayer = writeLayer(map[string]string{"other": "other"})
+		})
+
+		Context("when the driver stacks layers", func() {
+			var (
+				layeredDriver *stackingDriver
+				fs            volume.Filesystem
+			)
+
+			BeforeEach(func() {
+				layeredDriver = &stackingDriver{}
+				fs = newFilesystem(layeredDriver)
+			})
+
+			newLiveVolume := func(handle string, layers ...volume.Layer) volume.FilesystemLiveVolume {
+				initVolume, err := fs.NewLayeredVolume(lagertest.NewTestLogger("test"), handle, layers, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				liveVolume, err := initVolume.Initialize()
+				Expect(err).ToNot(HaveOccurred())
+
+				return liveVolume
+			}
+
+			It("creates the volume on top of the unpacked layers", func() {
+				vol := newLiveVolume("some-volume", baseLayer, otherLayer)
+
+				Expect(layeredDriver.layers).To(Equal([][]string{
+					{layerDir(baseLayer), layerDir(otherLayer)},
+				}))
+				Expect(filepath.Join(layerDir(baseLayer), "base")).To(BeAnExistingFile())
+				Expect(filepath.Join(vol.DataPath(), "other")).To(BeAnExistingFile())
+
+				layers, err := vol.LoadLayers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(layers).To(Equal([]string{layerDir(baseLayer), layerDir(otherLayer)}))
+			})
+
+			It("shares layers between volumes until none references them", func() {
+				vol := newLiveVolume("some-volume", baseLayer, otherLayer)
+				otherVol := newLiveVolume("other-volume", baseLayer)
+
+				Expect(layeredDriver.layers[1]).To(Equal([]string{layerDir(baseLayer)}))
+
+				Expect(vol.Destroy()).To(Succeed())
+				Expect(layerDir(baseLayer)).To(BeADirectory())
+				Expect(layerDir(otherLayer)).ToNot(BeADirectory())
+
+				Expect(otherVol.Destroy()).To(Succeed())
+				Expect(layerDir(baseLayer)).ToNot(BeADirectory())
+			})
+
+			It("recounts references when reopened", func() {
+				newLiveVolume("some-volume", baseLayer)
+				otherVol := newLiveVolume("other-volume", otherLayer)
+
+				// forget about the other volume without releasing its layers
+				Expect(os.RemoveAll(filepath.Join(volumesDir, "live", otherVol.Handle()))).To(Succeed())
+
+				reopened := newFilesystem(layeredDriver)
+
+				Expect(layerDir(baseLayer)).To(BeADirectory())
+				Expect(layerDir(otherLayer)).ToNot(BeADirectory())
+
+				vol, found, err := reopened.LookupVolume("some-volume")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(vol.Destroy()).To(Succeed())
+				Expect(layerDir(baseLayer)).ToNot(BeADirectory())
+			})
+
+			It("namespaces the layers as they're unpacked", func() {
+				newLiveVolume("some-volume", baseLayer)
+
+				Expect(fakePrivilegedNamespacer.NamespacePathCallCount()).To(Equal(0))
+				Expect(fakeUnprivilegedNamespacer.NamespacePathCallCount()).To(Equal(1))
+
+				_, path := fakeUnprivilegedNamespacer.NamespacePathArgsForCall(0)
+				Expect(path).To(HavePrefix(filepath.Join(volumesDir, "layers", "unprivileged", "tmp")))
+
+				// the namespaced layer is shared rather than unpacked again
+				newLiveVolume("other-volume", baseLayer)
+				Expect(fakeUnprivilegedNamespacer.NamespacePathCallCount()).To(Equal(1))
+			})
+
+			Context("when the volume is privileged", func() {
+				var vol volume.FilesystemLiveVolume
+
+				BeforeEach(func() {
+					newLiveVolume("unprivileged-volume", baseLayer)
+
+					initVolume, err := fs.NewLayeredVolume(lagertest.NewTestLogger("test"), "some-volume", []volume.Layer{baseLayer}, true)
+					Expect(err).ToNot(HaveOccurred())
+
+					vol, err = initVolume.Initialize()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("stacks it on layers namespaced for privileged volumes, apart from the unprivileged ones", func() {
+					Expect(fakePrivilegedNamespacer.NamespacePathCallCount()).To(Equal(1))
+
+					_, path := fakePrivilegedNamespacer.NamespacePathArgsForCall(0)
+					Expect(path).To(HavePrefix(filepath.Join(volumesDir, "layers", "privileged", "tmp")))
+
+					Expect(layeredDriver.layers[1]).To(Equal([]string{privilegedLayerDir(baseLayer)}))
+
+					layers, err := vol.LoadLayers()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(layers).To(Equal([]string{privilegedLayerDir(baseLayer)}))
+				})
+
+				It("releases the layers from the privileged store", func() {
+					Expect(vol.Destroy()).To(Succeed())
+					Expect(privilegedLayerDir(baseLayer)).ToNot(BeADirectory())
+					Expect(layerDir(baseLayer)).To(BeADirectory())
+				})
+
+				It("recounts its references in the privileged store when reopened", func() {
+					newFilesystem(layeredDriver)
+
+					Expect(privilegedLayerDir(baseLayer)).To(BeADirectory())
+					Expect(layerDir(baseLayer)).To(BeADirectory())
+				})
+			})
+
+			Context("when a layer is a section of a file verified by its diff ID", func() {
+				var layer volume.Layer
+
+				BeforeEach(func() {
+					content := layerTar(map[string]string{"archived": "archived"})
+
+					archive := filepath.Join(blobsDir, "image.tar")
+					Expect(os.WriteFile(archive, append(append([]byte("before"), content...), "after"...), 0644)).To(Succeed())
+
+					layer = volume.Layer{
+						Digest: digest.FromBytes(content),
+						DiffID: true,
+						Blob:   archive,
+						Offset: int64(len("before")),
+						Size:   int64(len(content)),
+					}
+				})
+
+				It("unpacks the section", func() {
+					vol := newLiveVolume("some-volume", layer)
+					Expect(filepath.Join(vol.DataPath(), "archived")).To(BeAnExistingFile())
+				})
+
+				Context("when the section does not match the diff ID", func() {
+					BeforeEach(func() {
+						layer.Digest = digest.FromString("bogus")
+					})
+
+					It("fails", func() {
+						_, err := fs.NewLayeredVolume(lagertest.NewTestLogger("test"), "some-volume", []volume.Layer{layer}, false)
+						Expect(err).To(HaveOccurred())
+					})
+				})
+			})
+
+			Context("when a blob does not match its digest", func() {
+				BeforeEach(func() {
+					Expect(os.WriteFile(otherLayer.Blob, []byte("bogus"), 0644)).To(Succeed())
+				})
+
+				It("fails without leaving any layer behind", func() {
+					_, err := fs.NewLayeredVolume(lagertest.NewTestLogger("test"), "some-volume", []volume.Layer{baseLayer, otherLayer}, false)
+					Expect(err).To(HaveOccurred())
+
+					Expect(layerDir(baseLayer)).ToNot(BeADirectory())
+					Expect(layerDir(otherLayer)).ToNot(BeADirectory())
+				})
+			})
+		})
+
+		Context("when the driver does not stack layers", func() {
+			var fs volume.Filesystem
+
+			BeforeEach(func() {
+				fs = newFilesystem(&driver.NaiveDriver{})
+			})
+
+			It("applies the layers onto the volume", func() {
+				whiteoutLayer := writeLayer(map[string]string{".wh.base": ""})
+
+				vol, err := fs.NewLayeredVolume(lagertest.NewTestLogger("test"), "some-volume", []volume.Layer{baseLayer, otherLayer, whiteoutLayer}, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(filepath.Join(vol.DataPath(), "other")).To(BeAnExistingFile())
+				Expect(filepath.Join(vol.DataPath(), "base")).ToNot(BeAnExistingFile())
+				Expect(layerDir(baseLayer)).ToNot(BeADirectory())
+
+				layers, err := vol.LoadLayers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(layers).To(BeEmpty())
+			})
+		})
+	})
+})
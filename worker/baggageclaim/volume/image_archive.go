@@ -0,0 +1,166 @@
+package volume
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// imageArchiveManifestFile lists the images saved in an image archive, as
+// written by `docker save` or by the registry-image resource.
+const imageArchiveManifestFile = "manifest.json"
+
+type imageArchiveManifest struct {
+	Config string
+	Layers []string
+}
+
+type imageArchiveEntry struct {
+	offset int64
+	size   int64
+}
+
+// imageArchiveLayers finds the layers of the image saved in the archive.
+// The layers aren't extracted; each one is read from its section of the
+// archive.
+//
+// Archives only record the digests of the uncompressed layers, through the
+// image's config, so those are what the layers are verified against.
+func imageArchiveLayers(archivePath string) ([]Layer, error) {
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer archive.Close()
+
+	entries, err := indexImageArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []imageArchiveManifest
+	err = readImageArchiveEntry(archive, entries, imageArchiveManifestFile, &manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(manifests) != 1 {
+		return nil, fmt.Errorf("%w: archive holds %d images rather than one", ErrInvalidManifest, len(manifests))
+	}
+
+	manifest := manifests[0]
+
+	var config specs.Image
+	err = readImageArchiveEntry(archive, entries, manifest.Config, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	diffIDs := config.RootFS.DiffIDs
+	if len(diffIDs) != len(manifest.Layers) {
+		return nil, fmt.Errorf("%w: image has %d layers but %d diff IDs", ErrInvalidManifest, len(manifest.Layers), len(diffIDs))
+	}
+
+	layers := make([]Layer, len(manifest.Layers))
+	for i, name := range manifest.Layers {
+		err := diffIDs[i].Validate()
+		if err != nil {
+			return nil, fmt.Errorf("%w: layer %d: %s", ErrInvalidManifest, i, err)
+		}
+
+		entry, found := entries[path.Clean(name)]
+		if !found {
+			return nil, fmt.Errorf("%w: layer %s not found in archive", ErrInvalidManifest, name)
+		}
+
+		if entry.size == 0 {
+			return nil, fmt.Errorf("%w: layer %s is empty", ErrInvalidManifest, name)
+		}
+
+		layers[i] = Layer{
+			Digest: diffIDs[i],
+			DiffID: true,
+			Blob:   archivePath,
+			Offset: entry.offset,
+			Size:   entry.size,
+		}
+	}
+
+	return layers, nil
+}
+
+// indexImageArchive finds where each file in the archive starts. Links are
+// resolved to the file they point to, as `docker save` links layers that
+// appear more than once.
+func indexImageArchive(archive *os.File) (map[string]imageArchiveEntry, error) {
+	entries := map[string]imageArchiveEntry{}
+	links := map[string]string{}
+
+	reader := tar.NewReader(archive)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidManifest, err)
+		}
+
+		name := path.Clean(header.Name)
+
+		switch header.Typeflag {
+		case tar.TypeReg:
+			// the reader has only consumed the header, so the archive is
+			// positioned at the start of the file's content
+			offset, err := archive.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return nil, err
+			}
+
+			entries[name] = imageArchiveEntry{
+				offset: offset,
+				size:   header.Size,
+			}
+		case tar.TypeSymlink:
+			links[name] = path.Join(path.Dir(name), header.Linkname)
+		case tar.TypeLink:
+			links[name] = path.Clean(header.Linkname)
+		}
+	}
+
+	for name, target := range links {
+		entry, found := entries[target]
+		if found {
+			entries[name] = entry
+		}
+	}
+
+	return entries, nil
+}
+
+func readImageArchiveEntry(archive *os.File, entries map[string]imageArchiveEntry, name string, dest interface{}) error {
+	entry, found := entries[path.Clean(name)]
+	if !found {
+		return fmt.Errorf("%w: %s not found in archive", ErrInvalidManifest, name)
+	}
+
+	content, err := io.ReadAll(io.NewSectionReader(archive, entry.offset, entry.size))
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(content, dest)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidManifest, err)
+	}
+
+	return nil
+}
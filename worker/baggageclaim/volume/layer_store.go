@@ -0,0 +1,302 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/worker/baggageclaim/uidgid"
+	"github.com/containerd/containerd/archive"
+	"github.com/containerd/containerd/archive/compression"
+	"github.com/opencontainers/go-digest"
+)
+
+// Layer is a layer of an OCI image, stored as a (possibly compressed) tar
+// blob.
+type Layer struct {
+	Digest digest.Digest
+
+	// DiffID is set when the Digest is that of the uncompressed tar rather
+	// than that of the blob, as images saved as an archive only record the
+	// former.
+	DiffID bool
+
+	// Blob is the file holding the blob. If Size is non-zero, the blob is
+	// the section of the file starting at Offset rather than the whole file.
+	Blob   string
+	Offset int64
+	Size   int64
+}
+
+const (
+	layersDirname             = "layers"
+	layersTmpDirname          = "tmp"
+	privilegedLayersDirname   = "privileged"
+	unprivilegedLayersDirname = "unprivileged"
+)
+
+// layerStore holds unpacked image layers, addressed by their digest, so
+// that volumes created from images sharing layers share their storage too.
+//
+// A layer is kept for as long as a volume references it. References are
+// counted in memory and recounted from the volumes on disk when the store
+// is opened.
+//
+// Layers are namespaced as they're unpacked, so that volumes stacked on
+// them don't have to be; namespacing a stacked volume would copy every file
+// up from the layers. Privileged and unprivileged volumes therefore each
+// have a store of their own.
+type layerStore struct {
+	dir    string
+	tmpDir string
+
+	namespacer uidgid.Namespacer
+
+	unpackLocks LockManager
+
+	refsL sync.Mutex
+	refs  map[digest.Digest]int
+}
+
+func newLayerStore(dir string, namespacer uidgid.Namespacer) (*layerStore, error) {
+	tmpDir := filepath.Join(dir, layersTmpDirname)
+
+	// anything left in the tmp dir is from unpacking that was interrupted
+	err := os.RemoveAll(tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(tmpDir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &layerStore{
+		dir:    dir,
+		tmpDir: tmpDir,
+
+		namespacer: namespacer,
+
+		unpackLocks: NewLockManager(),
+
+		refs: map[digest.Digest]int{},
+	}, nil
+}
+
+func (store *layerStore) path(dgst digest.Digest) string {
+	return filepath.Join(store.dir, dgst.Algorithm().String(), dgst.Encoded())
+}
+
+// acquire takes a reference to each of the layers, unpacking the ones that
+// aren't in the store yet, and returns their directories.
+func (store *layerStore) acquire(logger lager.Logger, layers []Layer, convertWhiteouts bool) ([]string, error) {
+	digests := make([]digest.Digest, len(layers))
+	for i, layer := range layers {
+		digests[i] = layer.Digest
+	}
+
+	// references are taken first so that a layer isn't removed from under us
+	// while it's being unpacked
+	store.ref(digests)
+
+	dirs := make([]string, len(layers))
+	for i, layer := range layers {
+		dir, err := store.unpack(logger, layer, convertWhiteouts)
+		if err != nil {
+			store.release(digests)
+			return nil, fmt.Errorf("unpack layer %s: %w", layer.Digest, err)
+		}
+
+		dirs[i] = dir
+	}
+
+	return dirs, nil
+}
+
+// release drops a reference to each of the layers, removing the ones that
+// are no longer referenced.
+func (store *layerStore) release(digests []digest.Digest) error {
+	store.refsL.Lock()
+	defer store.refsL.Unlock()
+
+	var removeErr error
+	for _, dgst := range digests {
+		store.refs[dgst]--
+		if store.refs[dgst] > 0 {
+			continue
+		}
+
+		delete(store.refs, dgst)
+
+		err := os.RemoveAll(store.path(dgst))
+		if err != nil {
+			removeErr = err
+		}
+	}
+
+	return removeErr
+}
+
+func (store *layerStore) ref(digests []digest.Digest) {
+	store.refsL.Lock()
+	defer store.refsL.Unlock()
+
+	for _, dgst := range digests {
+		store.refs[dgst]++
+	}
+}
+
+// prune removes the layers that aren't referenced by any volume.
+func (store *layerStore) prune() error {
+	store.refsL.Lock()
+	defer store.refsL.Unlock()
+
+	algorithms, err := os.ReadDir(store.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, algorithm := range algorithms {
+		if algorithm.Name() == layersTmpDirname {
+			continue
+		}
+
+		layerDirs, err := os.ReadDir(filepath.Join(store.dir, algorithm.Name()))
+		if err != nil {
+			return err
+		}
+
+		for _, layerDir := range layerDirs {
+			dgst := digest.NewDigestFromEncoded(digest.Algorithm(algorithm.Name()), layerDir.Name())
+			if store.refs[dgst] > 0 {
+				continue
+			}
+
+			err := os.RemoveAll(filepath.Join(store.dir, algorithm.Name(), layerDir.Name()))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// unpack unpacks the layer into the store, unless it's already there. The
+// blob is verified against the layer's digest while it's being unpacked.
+func (store *layerStore) unpack(logger lager.Logger, layer Layer, convertWhiteouts bool) (string, error) {
+	dir := store.path(layer.Digest)
+
+	store.unpackLocks.Lock(layer.Digest.String())
+	defer store.unpackLocks.Unlock(layer.Digest.String())
+
+	_, err := os.Stat(dir)
+	if err == nil {
+		return dir, nil
+	}
+
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	tmpDir, err := os.MkdirTemp(store.tmpDir, layer.Digest.Encoded())
+	if err != nil {
+		return "", err
+	}
+
+	defer os.RemoveAll(tmpDir)
+
+	err = os.Chmod(tmpDir, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	err = applyLayer(layer, tmpDir, convertWhiteouts)
+	if err != nil {
+		return "", err
+	}
+
+	err = store.namespacer.NamespacePath(logger, tmpDir)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.MkdirAll(filepath.Dir(dir), 0755)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.Rename(tmpDir, dir)
+	if err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// applyLayer unpacks the layer onto dest. Whiteouts either remove what they
+// hide from dest, or are converted for the layer to be used as an overlay
+// lower dir.
+func applyLayer(layer Layer, dest string, convertWhiteouts bool) error {
+	blob, err := os.Open(layer.Blob)
+	if err != nil {
+		return err
+	}
+
+	defer blob.Close()
+
+	var compressed io.Reader = blob
+	if layer.Size != 0 {
+		compressed = io.NewSectionReader(blob, layer.Offset, layer.Size)
+	}
+
+	verifier := layer.Digest.Verifier()
+
+	if !layer.DiffID {
+		compressed = io.TeeReader(compressed, verifier)
+	}
+
+	decompressed, err := compression.DecompressStream(compressed)
+	if err != nil {
+		return err
+	}
+
+	defer decompressed.Close()
+
+	var uncompressed io.Reader = decompressed
+	if layer.DiffID {
+		uncompressed = io.TeeReader(decompressed, verifier)
+	}
+
+	var opts []archive.ApplyOpt
+	if convertWhiteouts {
+		opts = append(opts, overlayWhiteouts())
+	}
+
+	_, err = archive.Apply(context.Background(), dest, uncompressed, opts...)
+	if err != nil {
+		return err
+	}
+
+	// the tar stream may end before the blob does, e.g. with padding
+	rest := compressed
+	if layer.DiffID {
+		rest = uncompressed
+	}
+
+	_, err = io.Copy(io.Discard, rest)
+	if err != nil {
+		return err
+	}
+
+	if !verifier.Verified() {
+		return fmt.Errorf("blob %s does not match its digest", layer.Digest)
+	}
+
+	return nil
+}
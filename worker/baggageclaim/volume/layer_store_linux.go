@@ -0,0 +1,7 @@
+package volume
+
+import "github.com/containerd/containerd/archive"
+
+func overlayWhiteouts() archive.ApplyOpt {
+	return archive.WithConvertWhiteout(archive.OverlayConvertWhiteout)
+}
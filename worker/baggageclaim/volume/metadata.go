@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+
+	"github.com/opencontainers/go-digest"
 )
 
 type VolumeState string
@@ -11,6 +13,7 @@ type VolumeState string
 const (
 	propertiesFileName   = "properties.json"
 	isPrivilegedFileName = "privileged.json"
+	layersFileName       = "layers.json"
 )
 
 type Metadata struct {
@@ -80,6 +83,51 @@ func (ipf *isPrivilegedFile) IsPrivileged() (bool, error) {
 	return isPrivileged, nil
 }
 
+// LayerRefs are the layers a layered volume is made of, along with which of
+// the layer stores they're held in.
+type LayerRefs struct {
+	Privileged bool            `json:"privileged"`
+	Digests    []digest.Digest `json:"digests"`
+}
+
+// Layers File
+func (md *Metadata) Layers() (LayerRefs, error) {
+	return md.layersFile().Layers()
+}
+
+func (md *Metadata) StoreLayers(layers LayerRefs) error {
+	return md.layersFile().WriteLayers(layers)
+}
+
+func (md *Metadata) layersFile() *layersFile {
+	return &layersFile{path: filepath.Join(md.path, layersFileName)}
+}
+
+type layersFile struct {
+	path string
+}
+
+func (lf *layersFile) WriteLayers(layers LayerRefs) error {
+	return writeMetadataFile(lf.path, layers)
+}
+
+// Layers returns none for volumes that aren't made of layers.
+func (lf *layersFile) Layers() (LayerRefs, error) {
+	_, err := os.Stat(lf.path)
+	if os.IsNotExist(err) {
+		return LayerRefs{}, nil
+	}
+
+	var layers LayerRefs
+
+	err = readMetadataFile(lf.path, &layers)
+	if err != nil {
+		return LayerRefs{}, err
+	}
+
+	return layers, nil
+}
+
 func readMetadataFile(path string, properties interface{}) error {
 	file, err := os.Open(path)
 	if err != nil {
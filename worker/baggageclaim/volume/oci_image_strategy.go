@@ -0,0 +1,136 @@
+package volume
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/concourse/concourse/worker/baggageclaim"
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+var ErrInvalidManifest = errors.New("invalid image manifest")
+
+// OCIImageStrategy creates a volume from an image in its parent volume,
+// either laid out as an OCI image layout directory or saved as an image
+// archive. An archive holds a single image, so no manifest is given for one.
+type OCIImageStrategy struct {
+	ParentHandle string
+	Path         string
+	Manifest     string
+	Privileged   bool
+}
+
+func (strategy OCIImageStrategy) Materialize(logger lager.Logger, handle string, fs Filesystem, streamer Streamer) (FilesystemInitVolume, error) {
+	if strategy.ParentHandle == "" {
+		logger.Info("parent-not-specified")
+		return nil, ErrNoParentVolumeProvided
+	}
+
+	parentVolume, found, err := fs.LookupVolume(strategy.ParentHandle)
+	if err != nil {
+		logger.Error("failed-to-lookup-parent", err)
+		return nil, err
+	}
+
+	if !found {
+		logger.Info("parent-not-found")
+		return nil, ErrParentVolumeNotFound
+	}
+
+	// confine the image to the parent volume
+	imagePath := filepath.Join(parentVolume.DataPath(), filepath.Clean("/"+strategy.Path))
+
+	info, err := os.Stat(imagePath)
+	if err != nil {
+		logger.Error("failed-to-stat-image", err)
+		return nil, err
+	}
+
+	var layers []Layer
+	if info.IsDir() {
+		layers, err = strategy.layoutLayers(logger, imagePath)
+	} else {
+		layers, err = strategy.archiveLayers(logger, imagePath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.NewLayeredVolume(logger, handle, layers, strategy.Privileged)
+}
+
+func (strategy OCIImageStrategy) layoutLayers(logger lager.Logger, layoutDir string) ([]Layer, error) {
+	manifestDigest, err := digest.Parse(strategy.Manifest)
+	if err != nil {
+		logger.Info("malformed-manifest-digest", lager.Data{"manifest": strategy.Manifest})
+		return nil, fmt.Errorf("%w: %s", ErrInvalidManifest, err)
+	}
+
+	var manifest specs.Manifest
+	err = readBlob(layoutDir, manifestDigest, &manifest)
+	if err != nil {
+		logger.Error("failed-to-read-manifest", err)
+		return nil, err
+	}
+
+	layers := make([]Layer, len(manifest.Layers))
+	for i, desc := range manifest.Layers {
+		err := desc.Digest.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("%w: layer %d: %s", ErrInvalidManifest, i, err)
+		}
+
+		layers[i] = Layer{
+			Digest: desc.Digest,
+			Blob:   blobPath(layoutDir, desc.Digest),
+		}
+	}
+
+	return layers, nil
+}
+
+func (strategy OCIImageStrategy) archiveLayers(logger lager.Logger, archivePath string) ([]Layer, error) {
+	if strategy.Manifest != "" {
+		logger.Info("manifest-given-for-archive", lager.Data{"manifest": strategy.Manifest})
+		return nil, fmt.Errorf("%w: archives hold a single image, so no manifest can be selected", ErrInvalidManifest)
+	}
+
+	layers, err := imageArchiveLayers(archivePath)
+	if err != nil {
+		logger.Error("failed-to-read-archive", err)
+		return nil, err
+	}
+
+	return layers, nil
+}
+
+func (OCIImageStrategy) String() string {
+	return baggageclaim.StrategyOCIImage
+}
+
+func blobPath(layoutDir string, dgst digest.Digest) string {
+	return filepath.Join(layoutDir, specs.ImageBlobsDir, dgst.Algorithm().String(), dgst.Encoded())
+}
+
+func readBlob(layoutDir string, dgst digest.Digest, dest interface{}) error {
+	content, err := os.ReadFile(blobPath(layoutDir, dgst))
+	if err != nil {
+		return err
+	}
+
+	if dgst.Algorithm().FromBytes(content) != dgst {
+		return fmt.Errorf("%w: blob %s does not match its digest", ErrInvalidManifest, dgst)
+	}
+
+	err = json.Unmarshal(content, dest)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidManifest, err)
+	}
+
+	return nil
+}
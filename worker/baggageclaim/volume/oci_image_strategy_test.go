@@ -0,0 +1,331 @@
+package volume_test
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/concourse/concourse/worker/baggageclaim/volume"
+	"github.com/concourse/concourse/worker/baggageclaim/volume/volumefakes"
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OCIImageStrategy", func() {
+	var (
+		strategy Strategy
+
+		parentDir      string
+		manifestDigest digest.Digest
+		layerDigests   []digest.Digest
+	)
+
+	writeBlob := func(content []byte) digest.Digest {
+		dgst := digest.FromBytes(content)
+
+		blobDir := filepath.Join(parentDir, "oci", "blobs", "sha256")
+		Expect(os.MkdirAll(blobDir, 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(blobDir, dgst.Encoded()), content, 0644)).To(Succeed())
+
+		return dgst
+	}
+
+	BeforeEach(func() {
+		var err error
+		parentDir, err = os.MkdirTemp("", "oci-image-strategy")
+		Expect(err).ToNot(HaveOccurred())
+
+		layerDigests = []digest.Digest{
+			writeBlob([]byte("some-layer")),
+			writeBlob([]byte("some-other-layer")),
+		}
+
+		manifest, err := json.Marshal(specs.Manifest{
+			MediaType: specs.MediaTypeImageManifest,
+			Layers: []specs.Descriptor{
+				{MediaType: specs.MediaTypeImageLayerGzip, Digest: layerDigests[0]},
+				{MediaType: specs.MediaTypeImageLayerGzip, Digest: layerDigests[1]},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		manifestDigest = writeBlob(manifest)
+
+		strategy = OCIImageStrategy{
+			ParentHandle: "parent-volume",
+			Path:         "oci",
+			Manifest:     manifestDigest.String(),
+			Privileged:   true,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(parentDir)).To(Succeed())
+	})
+
+	Describe("Materialize", func() {
+		var (
+			fakeFilesystem *volumefakes.FakeFilesystem
+
+			materializedVolume FilesystemInitVolume
+			materializeErr     error
+		)
+
+		BeforeEach(func() {
+			fakeFilesystem = new(volumefakes.FakeFilesystem)
+		})
+
+		JustBeforeEach(func() {
+			materializedVolume, materializeErr = strategy.Materialize(
+				lagertest.NewTestLogger("test"),
+				"some-volume",
+				fakeFilesystem,
+				new(volumefakes.FakeStreamer),
+			)
+		})
+
+		Context("when the parent volume can be found", func() {
+			BeforeEach(func() {
+				parentVolume := new(volumefakes.FakeFilesystemLiveVolume)
+				parentVolume.DataPathReturns(parentDir)
+				fakeFilesystem.LookupVolumeReturns(parentVolume, true, nil)
+			})
+
+			Context("when creating the layered volume succeeds", func() {
+				var fakeVolume *volumefakes.FakeFilesystemInitVolume
+
+				BeforeEach(func() {
+					fakeVolume = new(volumefakes.FakeFilesystemInitVolume)
+					fakeFilesystem.NewLayeredVolumeReturns(fakeVolume, nil)
+				})
+
+				It("returns it", func() {
+					Expect(materializeErr).ToNot(HaveOccurred())
+					Expect(materializedVolume).To(Equal(fakeVolume))
+				})
+
+				It("looked up the parent with the correct handle", func() {
+					handle := fakeFilesystem.LookupVolumeArgsForCall(0)
+					Expect(handle).To(Equal("parent-volume"))
+				})
+
+				It("creates it from the layers of the manifest", func() {
+					_, handle, layers, privileged := fakeFilesystem.NewLayeredVolumeArgsForCall(0)
+					Expect(handle).To(Equal("some-volume"))
+					Expect(privileged).To(BeTrue())
+					Expect(layers).To(Equal([]Layer{
+						{
+							Digest: layerDigests[0],
+							Blob:   filepath.Join(parentDir, "oci", "blobs", "sha256", layerDigests[0].Encoded()),
+						},
+						{
+							Digest: layerDigests[1],
+							Blob:   filepath.Join(parentDir, "oci", "blobs", "sha256", layerDigests[1].Encoded()),
+						},
+					}))
+				})
+
+				Context("when the path escapes the parent volume", func() {
+					BeforeEach(func() {
+						strategy = OCIImageStrategy{
+							ParentHandle: "parent-volume",
+							Path:         "../../oci",
+							Manifest:     manifestDigest.String(),
+						}
+					})
+
+					It("is confined to the parent volume", func() {
+						Expect(materializeErr).ToNot(HaveOccurred())
+
+						_, _, layers, _ := fakeFilesystem.NewLayeredVolumeArgsForCall(0)
+						Expect(layers[0].Blob).To(HavePrefix(parentDir))
+					})
+				})
+
+				Context("when the image is saved as an archive", func() {
+					var (
+						archivePath   string
+						layerContents []string
+						diffIDs       []digest.Digest
+					)
+
+					writeArchive := func(files [][2]string) {
+						archive, err := os.Create(archivePath)
+						Expect(err).ToNot(HaveOccurred())
+
+						defer archive.Close()
+
+						tarWriter := tar.NewWriter(archive)
+						for _, file := range files {
+							Expect(tarWriter.WriteHeader(&tar.Header{
+								Name: file[0],
+								Mode: 0644,
+								Size: int64(len(file[1])),
+							})).To(Succeed())
+
+							_, err := tarWriter.Write([]byte(file[1]))
+							Expect(err).ToNot(HaveOccurred())
+						}
+						Expect(tarWriter.Close()).To(Succeed())
+					}
+
+					BeforeEach(func() {
+						archivePath = filepath.Join(parentDir, "image.tar")
+						layerContents = []string{"some-layer", "some-other-layer"}
+						diffIDs = []digest.Digest{
+							digest.FromString("some-diff-id"),
+							digest.FromString("some-other-diff-id"),
+						}
+
+						config, err := json.Marshal(specs.Image{
+							RootFS: specs.RootFS{Type: "layers", DiffIDs: diffIDs},
+						})
+						Expect(err).ToNot(HaveOccurred())
+
+						// laid out as the registry-image resource saves images
+						writeArchive([][2]string{
+							{"sha256:some-config", string(config)},
+							{"some-layer.tar.gz", layerContents[0]},
+							{"some-other-layer.tar.gz", layerContents[1]},
+							{"manifest.json", `[{"Config":"sha256:some-config","RepoTags":["some-image:latest"],"Layers":["some-layer.tar.gz","some-other-layer.tar.gz"]}]`},
+						})
+
+						strategy = OCIImageStrategy{
+							ParentHandle: "parent-volume",
+							Path:         "image.tar",
+						}
+					})
+
+					It("creates it from the sections of the archive holding the layers, verified by their diff IDs", func() {
+						Expect(materializeErr).ToNot(HaveOccurred())
+
+						_, handle, layers, _ := fakeFilesystem.NewLayeredVolumeArgsForCall(0)
+						Expect(handle).To(Equal("some-volume"))
+						Expect(layers).To(HaveLen(2))
+
+						archive, err := os.Open(archivePath)
+						Expect(err).ToNot(HaveOccurred())
+
+						defer archive.Close()
+
+						for i, layer := range layers {
+							Expect(layer.Digest).To(Equal(diffIDs[i]))
+							Expect(layer.DiffID).To(BeTrue())
+							Expect(layer.Blob).To(Equal(archivePath))
+
+							content, err := io.ReadAll(io.NewSectionReader(archive, layer.Offset, layer.Size))
+							Expect(err).ToNot(HaveOccurred())
+							Expect(string(content)).To(Equal(layerContents[i]))
+						}
+					})
+
+					Context("when a manifest is given", func() {
+						BeforeEach(func() {
+							strategy = OCIImageStrategy{
+								ParentHandle: "parent-volume",
+								Path:         "image.tar",
+								Manifest:     manifestDigest.String(),
+							}
+						})
+
+						It("returns ErrInvalidManifest", func() {
+							Expect(materializeErr).To(MatchError(ErrInvalidManifest))
+						})
+					})
+
+					Context("when the archive holds more than one image", func() {
+						BeforeEach(func() {
+							writeArchive([][2]string{
+								{"manifest.json", `[{"Config":"a","Layers":[]},{"Config":"b","Layers":[]}]`},
+							})
+						})
+
+						It("returns ErrInvalidManifest", func() {
+							Expect(materializeErr).To(MatchError(ErrInvalidManifest))
+							Expect(fakeFilesystem.NewLayeredVolumeCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the layers do not match the diff IDs of the config", func() {
+						BeforeEach(func() {
+							writeArchive([][2]string{
+								{"sha256:some-config", `{"rootfs":{"type":"layers","diff_ids":[]}}`},
+								{"some-layer.tar.gz", layerContents[0]},
+								{"manifest.json", `[{"Config":"sha256:some-config","Layers":["some-layer.tar.gz"]}]`},
+							})
+						})
+
+						It("returns ErrInvalidManifest", func() {
+							Expect(materializeErr).To(MatchError(ErrInvalidManifest))
+						})
+					})
+				})
+			})
+
+			Context("when creating the layered volume fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeFilesystem.NewLayeredVolumeReturns(nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(materializeErr).To(Equal(disaster))
+				})
+			})
+
+			Context("when the manifest does not match its digest", func() {
+				BeforeEach(func() {
+					blob := filepath.Join(parentDir, "oci", "blobs", "sha256", manifestDigest.Encoded())
+					Expect(os.WriteFile(blob, []byte("{}"), 0644)).To(Succeed())
+				})
+
+				It("returns ErrInvalidManifest", func() {
+					Expect(materializeErr).To(MatchError(ErrInvalidManifest))
+					Expect(fakeFilesystem.NewLayeredVolumeCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the manifest digest is malformed", func() {
+				BeforeEach(func() {
+					strategy = OCIImageStrategy{
+						ParentHandle: "parent-volume",
+						Path:         "oci",
+						Manifest:     "bogus",
+					}
+				})
+
+				It("returns ErrInvalidManifest", func() {
+					Expect(materializeErr).To(MatchError(ErrInvalidManifest))
+				})
+			})
+		})
+
+		Context("when no parent volume is given", func() {
+			BeforeEach(func() {
+				strategy = OCIImageStrategy{Manifest: manifestDigest.String()}
+			})
+
+			It("returns ErrNoParentVolumeProvided", func() {
+				Expect(materializeErr).To(Equal(ErrNoParentVolumeProvided))
+			})
+		})
+
+		Context("when the parent handle does not exist", func() {
+			BeforeEach(func() {
+				fakeFilesystem.LookupVolumeReturns(nil, false, nil)
+			})
+
+			It("returns ErrParentVolumeNotFound", func() {
+				Expect(materializeErr).To(Equal(ErrParentVolumeNotFound))
+			})
+		})
+	})
+})
@@ -187,12 +187,21 @@ func (repo *repository) CreateVolume(ctx context.Context, handle string, strateg
 		return Volume{}, err
 	}
 
-	err = repo.namespacer(isPrivileged).NamespacePath(logger, initVolume.DataPath())
+	layers, err := initVolume.LoadLayers()
 	if err != nil {
-		logger.Error("failed-to-namespace-data", err)
+		logger.Error("failed-to-load-layers", err)
 		return Volume{}, err
 	}
 
+	// layered volumes are stacked on layers that are namespaced already
+	if len(layers) == 0 {
+		err = repo.namespacer(isPrivileged).NamespacePath(logger, initVolume.DataPath())
+		if err != nil {
+			logger.Error("failed-to-namespace-data", err)
+			return Volume{}, err
+		}
+	}
+
 	liveVolume, err := initVolume.Initialize()
 	if err != nil {
 		logger.Error("failed-to-initialize-volume", err)
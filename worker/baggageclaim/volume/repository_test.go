@@ -185,6 +185,18 @@ var _ = Describe("Repository", func() {
 							})
 						})
 					})
+
+					Context("when the volume is made of layers", func() {
+						BeforeEach(func() {
+							fakeInitVolume.LoadLayersReturns([]string{"some-layer"}, nil)
+						})
+
+						It("does not namespace the data path, as the layers are namespaced already", func() {
+							Expect(createErr).ToNot(HaveOccurred())
+							Expect(fakePrivilegedNamespacer.NamespacePathCallCount()).To(Equal(0))
+							Expect(fakeUnprivilegedNamespacer.NamespacePathCallCount()).To(Equal(0))
+						})
+					})
 				})
 
 				Context("when the volume cannot be initialized", func() {
@@ -51,6 +51,16 @@ func (s *strategerizer) StrategyFor(request baggageclaim.VolumeRequest) (Strateg
 			Path:           path,
 			FollowSymlinks: followSymlinks,
 		}
+	case baggageclaim.StrategyOCIImage:
+		volume, _ := strategyInfo["volume"].(string)
+		path, _ := strategyInfo["path"].(string)
+		manifest, _ := strategyInfo["manifest"].(string)
+		strategy = OCIImageStrategy{
+			ParentHandle: volume,
+			Path:         path,
+			Manifest:     manifest,
+			Privileged:   request.Privileged,
+		}
 	default:
 		return nil, ErrUnknownStrategy
 	}
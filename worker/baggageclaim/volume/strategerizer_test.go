@@ -102,5 +102,31 @@ var _ = Describe("Strategerizer", func() {
 				Expect(strategy).To(Equal(volume.COWStrategy{ParentHandle: "parent-handle"}))
 			})
 		})
+
+		Context("with an OCI image strategy", func() {
+			BeforeEach(func() {
+				volume := new(baggageclaimfakes.FakeVolume)
+				volume.HandleReturns("parent-handle")
+				request.Strategy = baggageclaim.OCIImageStrategy{
+					Parent:   volume,
+					Path:     "oci",
+					Manifest: "sha256:some-digest",
+				}.Encode()
+				request.Privileged = true
+			})
+
+			It("succeeds", func() {
+				Expect(strategyForErr).ToNot(HaveOccurred())
+			})
+
+			It("constructs an OCI image strategy", func() {
+				Expect(strategy).To(Equal(volume.OCIImageStrategy{
+					ParentHandle: "parent-handle",
+					Path:         "oci",
+					Manifest:     "sha256:some-digest",
+					Privileged:   true,
+				}))
+			})
+		})
 	})
 })
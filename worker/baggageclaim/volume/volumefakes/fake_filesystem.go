@@ -4,6 +4,8 @@ package volumefakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/lager/v3"
+
 	"github.com/concourse/concourse/worker/baggageclaim/volume"
 )
 
@@ -35,6 +37,22 @@ type FakeFilesystem struct {
 		result2 bool
 		result3 error
 	}
+	NewLayeredVolumeStub        func(lager.Logger, string, []volume.Layer, bool) (volume.FilesystemInitVolume, error)
+	newLayeredVolumeMutex       sync.RWMutex
+	newLayeredVolumeArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 []volume.Layer
+		arg4 bool
+	}
+	newLayeredVolumeReturns struct {
+		result1 volume.FilesystemInitVolume
+		result2 error
+	}
+	newLayeredVolumeReturnsOnCall map[int]struct {
+		result1 volume.FilesystemInitVolume
+		result2 error
+	}
 	NewVolumeStub        func(string) (volume.FilesystemInitVolume, error)
 	newVolumeMutex       sync.RWMutex
 	newVolumeArgsForCall []struct {
@@ -175,6 +193,78 @@ func (fake *FakeFilesystem) LookupVolumeReturnsOnCall(i int, result1 volume.File
 	}{result1, result2, result3}
 }
 
+func (fake *FakeFilesystem) NewLayeredVolume(arg1 lager.Logger, arg2 string, arg3 []volume.Layer, arg4 bool) (volume.FilesystemInitVolume, error) {
+	var arg3Copy []volume.Layer
+	if arg3 != nil {
+		arg3Copy = make([]volume.Layer, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.newLayeredVolumeMutex.Lock()
+	ret, specificReturn := fake.newLayeredVolumeReturnsOnCall[len(fake.newLayeredVolumeArgsForCall)]
+	fake.newLayeredVolumeArgsForCall = append(fake.newLayeredVolumeArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 []volume.Layer
+		arg4 bool
+	}{arg1, arg2, arg3Copy, arg4})
+	stub := fake.NewLayeredVolumeStub
+	fakeReturns := fake.newLayeredVolumeReturns
+	fake.recordInvocation("NewLayeredVolume", []interface{}{arg1, arg2, arg3Copy, arg4})
+	fake.newLayeredVolumeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFilesystem) NewLayeredVolumeCallCount() int {
+	fake.newLayeredVolumeMutex.RLock()
+	defer fake.newLayeredVolumeMutex.RUnlock()
+	return len(fake.newLayeredVolumeArgsForCall)
+}
+
+func (fake *FakeFilesystem) NewLayeredVolumeCalls(stub func(lager.Logger, string, []volume.Layer, bool) (volume.FilesystemInitVolume, error)) {
+	fake.newLayeredVolumeMutex.Lock()
+	defer fake.newLayeredVolumeMutex.Unlock()
+	fake.NewLayeredVolumeStub = stub
+}
+
+func (fake *FakeFilesystem) NewLayeredVolumeArgsForCall(i int) (lager.Logger, string, []volume.Layer, bool) {
+	fake.newLayeredVolumeMutex.RLock()
+	defer fake.newLayeredVolumeMutex.RUnlock()
+	argsForCall := fake.newLayeredVolumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFilesystem) NewLayeredVolumeReturns(result1 volume.FilesystemInitVolume, result2 error) {
+	fake.newLayeredVolumeMutex.Lock()
+	defer fake.newLayeredVolumeMutex.Unlock()
+	fake.NewLayeredVolumeStub = nil
+	fake.newLayeredVolumeReturns = struct {
+		result1 volume.FilesystemInitVolume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFilesystem) NewLayeredVolumeReturnsOnCall(i int, result1 volume.FilesystemInitVolume, result2 error) {
+	fake.newLayeredVolumeMutex.Lock()
+	defer fake.newLayeredVolumeMutex.Unlock()
+	fake.NewLayeredVolumeStub = nil
+	if fake.newLayeredVolumeReturnsOnCall == nil {
+		fake.newLayeredVolumeReturnsOnCall = make(map[int]struct {
+			result1 volume.FilesystemInitVolume
+			result2 error
+		})
+	}
+	fake.newLayeredVolumeReturnsOnCall[i] = struct {
+		result1 volume.FilesystemInitVolume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeFilesystem) NewVolume(arg1 string) (volume.FilesystemInitVolume, error) {
 	fake.newVolumeMutex.Lock()
 	ret, specificReturn := fake.newVolumeReturnsOnCall[len(fake.newVolumeArgsForCall)]
@@ -246,6 +336,8 @@ func (fake *FakeFilesystem) Invocations() map[string][][]interface{} {
 	defer fake.listVolumesMutex.RUnlock()
 	fake.lookupVolumeMutex.RLock()
 	defer fake.lookupVolumeMutex.RUnlock()
+	fake.newLayeredVolumeMutex.RLock()
+	defer fake.newLayeredVolumeMutex.RUnlock()
 	fake.newVolumeMutex.RLock()
 	defer fake.newVolumeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
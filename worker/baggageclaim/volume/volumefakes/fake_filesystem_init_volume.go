@@ -50,6 +50,18 @@ type FakeFilesystemInitVolume struct {
 		result1 volume.FilesystemLiveVolume
 		result2 error
 	}
+	LoadLayersStub        func() ([]string, error)
+	loadLayersMutex       sync.RWMutex
+	loadLayersArgsForCall []struct {
+	}
+	loadLayersReturns struct {
+		result1 []string
+		result2 error
+	}
+	loadLayersReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	LoadPrivilegedStub        func() (bool, error)
 	loadPrivilegedMutex       sync.RWMutex
 	loadPrivilegedArgsForCall []struct {
@@ -329,6 +341,62 @@ func (fake *FakeFilesystemInitVolume) InitializeReturnsOnCall(i int, result1 vol
 	}{result1, result2}
 }
 
+func (fake *FakeFilesystemInitVolume) LoadLayers() ([]string, error) {
+	fake.loadLayersMutex.Lock()
+	ret, specificReturn := fake.loadLayersReturnsOnCall[len(fake.loadLayersArgsForCall)]
+	fake.loadLayersArgsForCall = append(fake.loadLayersArgsForCall, struct {
+	}{})
+	stub := fake.LoadLayersStub
+	fakeReturns := fake.loadLayersReturns
+	fake.recordInvocation("LoadLayers", []interface{}{})
+	fake.loadLayersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFilesystemInitVolume) LoadLayersCallCount() int {
+	fake.loadLayersMutex.RLock()
+	defer fake.loadLayersMutex.RUnlock()
+	return len(fake.loadLayersArgsForCall)
+}
+
+func (fake *FakeFilesystemInitVolume) LoadLayersCalls(stub func() ([]string, error)) {
+	fake.loadLayersMutex.Lock()
+	defer fake.loadLayersMutex.Unlock()
+	fake.LoadLayersStub = stub
+}
+
+func (fake *FakeFilesystemInitVolume) LoadLayersReturns(result1 []string, result2 error) {
+	fake.loadLayersMutex.Lock()
+	defer fake.loadLayersMutex.Unlock()
+	fake.LoadLayersStub = nil
+	fake.loadLayersReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFilesystemInitVolume) LoadLayersReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.loadLayersMutex.Lock()
+	defer fake.loadLayersMutex.Unlock()
+	fake.LoadLayersStub = nil
+	if fake.loadLayersReturnsOnCall == nil {
+		fake.loadLayersReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.loadLayersReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeFilesystemInitVolume) LoadPrivileged() (bool, error) {
 	fake.loadPrivilegedMutex.Lock()
 	ret, specificReturn := fake.loadPrivilegedReturnsOnCall[len(fake.loadPrivilegedArgsForCall)]
@@ -633,6 +701,8 @@ func (fake *FakeFilesystemInitVolume) Invocations() map[string][][]interface{} {
 	defer fake.handleMutex.RUnlock()
 	fake.initializeMutex.RLock()
 	defer fake.initializeMutex.RUnlock()
+	fake.loadLayersMutex.RLock()
+	defer fake.loadLayersMutex.RUnlock()
 	fake.loadPrivilegedMutex.RLock()
 	defer fake.loadPrivilegedMutex.RUnlock()
 	fake.loadPropertiesMutex.RLock()
@@ -38,6 +38,18 @@ type FakeFilesystemLiveVolume struct {
 	handleReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LoadLayersStub        func() ([]string, error)
+	loadLayersMutex       sync.RWMutex
+	loadLayersArgsForCall []struct {
+	}
+	loadLayersReturns struct {
+		result1 []string
+		result2 error
+	}
+	loadLayersReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	LoadPrivilegedStub        func() (bool, error)
 	loadPrivilegedMutex       sync.RWMutex
 	loadPrivilegedArgsForCall []struct {
@@ -274,6 +286,62 @@ func (fake *FakeFilesystemLiveVolume) HandleReturnsOnCall(i int, result1 string)
 	}{result1}
 }
 
+func (fake *FakeFilesystemLiveVolume) LoadLayers() ([]string, error) {
+	fake.loadLayersMutex.Lock()
+	ret, specificReturn := fake.loadLayersReturnsOnCall[len(fake.loadLayersArgsForCall)]
+	fake.loadLayersArgsForCall = append(fake.loadLayersArgsForCall, struct {
+	}{})
+	stub := fake.LoadLayersStub
+	fakeReturns := fake.loadLayersReturns
+	fake.recordInvocation("LoadLayers", []interface{}{})
+	fake.loadLayersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFilesystemLiveVolume) LoadLayersCallCount() int {
+	fake.loadLayersMutex.RLock()
+	defer fake.loadLayersMutex.RUnlock()
+	return len(fake.loadLayersArgsForCall)
+}
+
+func (fake *FakeFilesystemLiveVolume) LoadLayersCalls(stub func() ([]string, error)) {
+	fake.loadLayersMutex.Lock()
+	defer fake.loadLayersMutex.Unlock()
+	fake.LoadLayersStub = stub
+}
+
+func (fake *FakeFilesystemLiveVolume) LoadLayersReturns(result1 []string, result2 error) {
+	fake.loadLayersMutex.Lock()
+	defer fake.loadLayersMutex.Unlock()
+	fake.LoadLayersStub = nil
+	fake.loadLayersReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFilesystemLiveVolume) LoadLayersReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.loadLayersMutex.Lock()
+	defer fake.loadLayersMutex.Unlock()
+	fake.LoadLayersStub = nil
+	if fake.loadLayersReturnsOnCall == nil {
+		fake.loadLayersReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.loadLayersReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeFilesystemLiveVolume) LoadPrivileged() (bool, error) {
 	fake.loadPrivilegedMutex.Lock()
 	ret, specificReturn := fake.loadPrivilegedReturnsOnCall[len(fake.loadPrivilegedArgsForCall)]
@@ -640,6 +708,8 @@ func (fake *FakeFilesystemLiveVolume) Invocations() map[string][][]interface{} {
 	defer fake.destroyMutex.RUnlock()
 	fake.handleMutex.RLock()
 	defer fake.handleMutex.RUnlock()
+	fake.loadLayersMutex.RLock()
+	defer fake.loadLayersMutex.RUnlock()
 	fake.loadPrivilegedMutex.RLock()
 	defer fake.loadPrivilegedMutex.RUnlock()
 	fake.loadPropertiesMutex.RLock()
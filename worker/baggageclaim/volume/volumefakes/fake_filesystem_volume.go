@@ -38,6 +38,18 @@ type FakeFilesystemVolume struct {
 	handleReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LoadLayersStub        func() ([]string, error)
+	loadLayersMutex       sync.RWMutex
+	loadLayersArgsForCall []struct {
+	}
+	loadLayersReturns struct {
+		result1 []string
+		result2 error
+	}
+	loadLayersReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	LoadPrivilegedStub        func() (bool, error)
 	loadPrivilegedMutex       sync.RWMutex
 	loadPrivilegedArgsForCall []struct {
@@ -261,6 +273,62 @@ func (fake *FakeFilesystemVolume) HandleReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeFilesystemVolume) LoadLayers() ([]string, error) {
+	fake.loadLayersMutex.Lock()
+	ret, specificReturn := fake.loadLayersReturnsOnCall[len(fake.loadLayersArgsForCall)]
+	fake.loadLayersArgsForCall = append(fake.loadLayersArgsForCall, struct {
+	}{})
+	stub := fake.LoadLayersStub
+	fakeReturns := fake.loadLayersReturns
+	fake.recordInvocation("LoadLayers", []interface{}{})
+	fake.loadLayersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFilesystemVolume) LoadLayersCallCount() int {
+	fake.loadLayersMutex.RLock()
+	defer fake.loadLayersMutex.RUnlock()
+	return len(fake.loadLayersArgsForCall)
+}
+
+func (fake *FakeFilesystemVolume) LoadLayersCalls(stub func() ([]string, error)) {
+	fake.loadLayersMutex.Lock()
+	defer fake.loadLayersMutex.Unlock()
+	fake.LoadLayersStub = stub
+}
+
+func (fake *FakeFilesystemVolume) LoadLayersReturns(result1 []string, result2 error) {
+	fake.loadLayersMutex.Lock()
+	defer fake.loadLayersMutex.Unlock()
+	fake.LoadLayersStub = nil
+	fake.loadLayersReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFilesystemVolume) LoadLayersReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.loadLayersMutex.Lock()
+	defer fake.loadLayersMutex.Unlock()
+	fake.LoadLayersStub = nil
+	if fake.loadLayersReturnsOnCall == nil {
+		fake.loadLayersReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.loadLayersReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeFilesystemVolume) LoadPrivileged() (bool, error) {
 	fake.loadPrivilegedMutex.Lock()
 	ret, specificReturn := fake.loadPrivilegedReturnsOnCall[len(fake.loadPrivilegedArgsForCall)]
@@ -563,6 +631,8 @@ func (fake *FakeFilesystemVolume) Invocations() map[string][][]interface{} {
 	defer fake.destroyMutex.RUnlock()
 	fake.handleMutex.RLock()
 	defer fake.handleMutex.RUnlock()
+	fake.loadLayersMutex.RLock()
+	defer fake.loadLayersMutex.RUnlock()
 	fake.loadPrivilegedMutex.RLock()
 	defer fake.loadPrivilegedMutex.RUnlock()
 	fake.loadPropertiesMutex.RLock()
@@ -40,6 +40,8 @@ type GardenBackend struct {
 	ociHooksDir string
 	// the deserialized hooks
 	ociHooks specs.Hooks
+	// additional runtime classes that containers can request
+	runtimeClasses RuntimeClasses
 
 	maxContainers  int
 	requestTimeout time.Duration
@@ -118,6 +120,14 @@ func WithOciHooksDir(ociHooksDir string) GardenBackendOpt {
 	}
 }
 
+// WithRuntimeClasses configures the runtime classes, in addition to the
+// default one, that containers can be created with.
+func WithRuntimeClasses(runtimeClasses RuntimeClasses) GardenBackendOpt {
+	return func(b *GardenBackend) {
+		b.runtimeClasses = runtimeClasses
+	}
+}
+
 type When struct {
 	Always      bool              `json:"always,omitempty"`
 	Annotations map[string]string `json:"annotations,omitempty"`
@@ -278,23 +288,68 @@ func (b *GardenBackend) Ping() (err error) {
 func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container, error) {
 	ctx := context.Background()
 
+	egress, err := EgressRules(gdnSpec.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("egress rules: %w", err)
+	}
+
+	// the init task of a checkpointable container is the process that gets
+	// run in it, so starting it is deferred until then.
+	checkpointable := isCheckpointable(gdnSpec.Properties)
+	if checkpointable && b.isHermetic(gdnSpec) {
+		gdnSpec.Properties[hermeticProperty] = "true"
+	}
+
 	cont, err := b.createContainer(ctx, gdnSpec)
 	if err != nil {
 		return nil, fmt.Errorf("new container: %w", err)
 	}
 
-	err = b.startTask(ctx, cont, b.isHermetic(gdnSpec))
-	if err != nil {
-		return nil, fmt.Errorf("starting task: %w", err)
+	if !checkpointable {
+		err = b.startTask(ctx, cont, cio.NullIO, b.isHermetic(gdnSpec), egress)
+		if err != nil {
+			return nil, fmt.Errorf("starting task: %w", err)
+		}
 	}
 
 	return NewContainer(
 		cont,
 		b.killer,
 		b.rootfsManager,
+		b,
 	), nil
 }
 
+// StartTask starts the init task of a checkpointable container, restricting
+// its network according to the properties the container was created with.
+func (b *GardenBackend) StartTask(ctx context.Context, cont containerd.Container, ioCreator cio.Creator, opts ...containerd.NewTaskOpts) (containerd.Task, error) {
+	labels, err := cont.Labels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("labels retrieval: %w", err)
+	}
+
+	properties := labelsToProperties(labels)
+
+	egress, err := EgressRules(properties)
+	if err != nil {
+		return nil, fmt.Errorf("egress rules: %w", err)
+	}
+
+	hermetic := properties[hermeticProperty] == "true"
+
+	task, err := b.newTask(ctx, cont, ioCreator, hermetic, egress, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = task.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
 func (b *GardenBackend) isHermetic(gdnSpec garden.ContainerSpec) bool {
 	if len(gdnSpec.NetOut) != 0 {
 		return false
@@ -316,6 +371,11 @@ func (b *GardenBackend) createContainer(ctx context.Context, gdnSpec garden.Cont
 		return nil, fmt.Errorf("checking container capacity: %w", err)
 	}
 
+	runtime, err := b.runtimeClasses.Runtime(gdnSpec.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("runtime class: %w", err)
+	}
+
 	maxUid, maxGid, err := b.userNamespace.MaxValidIds()
 	if err != nil {
 		return nil, fmt.Errorf("getting uid and gid maps: %w", err)
@@ -337,28 +397,42 @@ func (b *GardenBackend) createContainer(ctx context.Context, gdnSpec garden.Cont
 	if err != nil {
 		return nil, fmt.Errorf("convert properties to labels: %w", err)
 	}
-	return b.client.NewContainer(ctx, gdnSpec.Handle, labels, oci)
+	return b.client.NewContainer(ctx, gdnSpec.Handle, labels, oci, runtime)
+}
+
+func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container, ioCreator cio.Creator, hermetic bool, egress []EgressRule) error {
+	task, err := b.newTask(ctx, cont, ioCreator, hermetic, egress)
+	if err != nil {
+		return err
+	}
+
+	return task.Start(ctx)
 }
 
-func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container, hermetic bool) error {
-	task, err := cont.NewTask(ctx, cio.NullIO, containerd.WithNoNewKeyring)
+func (b *GardenBackend) newTask(ctx context.Context, cont containerd.Container, ioCreator cio.Creator, hermetic bool, egress []EgressRule, opts ...containerd.NewTaskOpts) (containerd.Task, error) {
+	task, err := cont.NewTask(ctx, ioCreator, append([]containerd.NewTaskOpts{containerd.WithNoNewKeyring}, opts...)...)
 	if err != nil {
-		return fmt.Errorf("new task: %w", err)
+		return nil, fmt.Errorf("new task: %w", err)
 	}
 
 	err = b.network.Add(ctx, task, cont.ID())
 	if err != nil {
-		return fmt.Errorf("network add: %w", err)
+		return nil, fmt.Errorf("network add: %w", err)
 	}
 
-	if hermetic {
+	if len(egress) > 0 {
+		err = b.network.RestrictContainerTraffic(cont.ID(), egress)
+		if err != nil {
+			return nil, fmt.Errorf("network restrict container traffic: %w", err)
+		}
+	} else if hermetic {
 		err = b.network.DropContainerTraffic(cont.ID())
 		if err != nil {
-			return fmt.Errorf("network drop container traffic: %w", err)
+			return nil, fmt.Errorf("network drop container traffic: %w", err)
 		}
 	}
 
-	return task.Start(ctx)
+	return task, nil
 }
 
 // Destroy gracefully destroys a container.
@@ -436,6 +510,7 @@ func (b *GardenBackend) Containers(properties garden.Properties) ([]garden.Conta
 			containerdContainer,
 			b.killer,
 			b.rootfsManager,
+			b,
 		)
 	}
 
@@ -457,6 +532,7 @@ func (b *GardenBackend) Lookup(handle string) (garden.Container, error) {
 		containerdContainer,
 		b.killer,
 		b.rootfsManager,
+		b,
 	), nil
 }
 
@@ -10,10 +10,12 @@ import (
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
 	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/cio"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
@@ -145,6 +147,101 @@ func (s *BackendSuite) TestCreateWithContainerNetOutSet() {
 	s.Equal(0, s.network.DropContainerTrafficCallCount())
 }
 
+func (s *BackendSuite) TestCreateWithEgressRules() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeTask.StartReturns(nil)
+
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.IDReturns("some-container-ID")
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	gdnSpec := minimumValidGdnSpec
+	gdnSpec.NetOut = nil
+	gdnSpec.Properties = garden.Properties{
+		runtime.EgressProperty: `["10.0.0.0/8","artifacts.example.com:443"]`,
+	}
+
+	_, err := s.backend.Create(gdnSpec)
+	s.NoError(err)
+
+	s.Equal(0, s.network.DropContainerTrafficCallCount())
+	s.Equal(1, s.network.RestrictContainerTrafficCallCount())
+
+	containerId, rules := s.network.RestrictContainerTrafficArgsForCall(0)
+	s.Equal("some-container-ID", containerId)
+	s.Equal([]runtime.EgressRule{
+		{Destination: "10.0.0.0/8"},
+		{Destination: "artifacts.example.com", Port: 443},
+	}, rules)
+}
+
+func (s *BackendSuite) TestCreateWithInvalidEgressRules() {
+	gdnSpec := minimumValidGdnSpec
+	gdnSpec.Properties = garden.Properties{
+		runtime.EgressProperty: `["artifacts.example.com:https"]`,
+	}
+
+	_, err := s.backend.Create(gdnSpec)
+	s.Error(err)
+	s.Contains(err.Error(), "egress rule 'artifacts.example.com:https' has invalid port 'https'")
+
+	s.Equal(0, s.client.NewContainerCallCount())
+}
+
+func (s *BackendSuite) TestCreateWithIPv6EgressRules() {
+	gdnSpec := minimumValidGdnSpec
+	gdnSpec.Properties = garden.Properties{
+		runtime.EgressProperty: `["[fd00::1]:80"]`,
+	}
+
+	_, err := s.backend.Create(gdnSpec)
+	s.Error(err)
+	s.Contains(err.Error(), "IPv6 destination, which is not supported")
+
+	s.Equal(0, s.client.NewContainerCallCount())
+}
+
+func (s *BackendSuite) TestCreateCheckpointableDefersTask() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	gdnSpec := minimumValidGdnSpec
+	gdnSpec.NetOut = nil
+	gdnSpec.Properties = garden.Properties{
+		runtime.CheckpointableProperty: "true",
+	}
+
+	_, err := s.backend.Create(gdnSpec)
+	s.NoError(err)
+
+	s.Equal(0, fakeContainer.NewTaskCallCount())
+	s.Equal(0, s.network.AddCallCount())
+
+	_, _, labels, _, _ := s.client.NewContainerArgsForCall(0)
+	s.Equal("true", labels["concourse:hermetic.0"])
+}
+
+func (s *BackendSuite) TestStartTaskRestrictsNetwork() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.IDReturns("some-container-ID")
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	fakeContainer.LabelsReturns(map[string]string{
+		"concourse:hermetic.0": "true",
+	}, nil)
+
+	task, err := s.backend.StartTask(context.Background(), fakeContainer, cio.NullIO)
+	s.NoError(err)
+	s.Equal(fakeTask, task)
+
+	s.Equal(1, s.network.AddCallCount())
+	s.Equal(1, s.network.DropContainerTrafficCallCount())
+	s.Equal(1, fakeTask.StartCallCount())
+}
+
 func (s *BackendSuite) TestCreateContainerNewTaskFailure() {
 	fakeContainer := new(libcontainerdfakes.FakeContainer)
 
@@ -209,13 +306,91 @@ func (s *BackendSuite) TestCreateMaxContainersReached() {
 	s.Contains(err.Error(), "max containers reached")
 }
 
+func (s *BackendSuite) TestCreateWithRuntimeClass() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	backend, err := runtime.NewGardenBackend(s.client,
+		runtime.WithKiller(s.killer),
+		runtime.WithNetwork(s.network),
+		runtime.WithUserNamespace(s.userns),
+		runtime.WithRuntimeClasses(runtime.RuntimeClasses{
+			"runsc": "io.containerd.runsc.v1",
+		}),
+	)
+	s.NoError(err)
+
+	for _, tc := range []struct {
+		desc         string
+		runtimeClass string
+		runtime      string
+		succeeds     bool
+	}{
+		{
+			desc:     "no runtime class uses the default runtime",
+			succeeds: true,
+		},
+		{
+			desc:         "default runtime class uses the default runtime",
+			runtimeClass: "runc",
+			succeeds:     true,
+		},
+		{
+			desc:         "configured runtime class uses its runtime",
+			runtimeClass: "runsc",
+			runtime:      "io.containerd.runsc.v1",
+			succeeds:     true,
+		},
+		{
+			desc:         "unknown runtime class",
+			runtimeClass: "kata",
+			succeeds:     false,
+		},
+	} {
+		s.T().Run(tc.desc, func(t *testing.T) {
+			s.client.NewContainerReturns(fakeContainer, nil)
+			calls := s.client.NewContainerCallCount()
+
+			gdnSpec := minimumValidGdnSpec
+			gdnSpec.Properties = garden.Properties{}
+			if tc.runtimeClass != "" {
+				gdnSpec.Properties[atc.RuntimeClassProperty] = tc.runtimeClass
+			}
+
+			_, err := backend.Create(gdnSpec)
+			if !tc.succeeds {
+				s.Error(err)
+				s.Contains(err.Error(), "unsupported runtime class")
+				s.Equal(calls, s.client.NewContainerCallCount())
+				return
+			}
+
+			s.NoError(err)
+			s.Equal(calls+1, s.client.NewContainerCallCount())
+
+			_, _, _, _, actualRuntime := s.client.NewContainerArgsForCall(calls)
+			s.Equal(tc.runtime, actualRuntime)
+		})
+	}
+}
+
+func (s *BackendSuite) TestRuntimeClassNames() {
+	s.Equal([]string{"runc"}, runtime.RuntimeClasses{}.Names())
+	s.Equal([]string{"kata", "runc", "runsc"}, runtime.RuntimeClasses{
+		"runsc": "io.containerd.runsc.v1",
+		"kata":  "io.containerd.kata.v2",
+	}.Names())
+}
+
 func (s *BackendSuite) TestCreateMaxContainersReachedConcurrent() {
 	fakeTask := new(libcontainerdfakes.FakeTask)
 	fakeContainer := new(libcontainerdfakes.FakeContainer)
 
 	fakeContainer.NewTaskReturns(fakeTask, nil)
 
-	s.client.NewContainerStub = func(context context.Context, str string, strings map[string]string, spec *specs.Spec) (container containerd.Container, e error) {
+	s.client.NewContainerStub = func(context context.Context, str string, strings map[string]string, spec *specs.Spec, runtime string) (container containerd.Container, e error) {
 		s.client.ContainersReturns([]containerd.Container{fakeContainer}, nil)
 		return fakeContainer, nil
 	}
@@ -260,7 +435,7 @@ func (s *BackendSuite) TestCreateContainerLockTimeout() {
 	fakeContainer.IDReturns("handle")
 	fakeContainer.NewTaskReturns(fakeTask, nil)
 
-	s.client.NewContainerStub = func(context context.Context, str string, strings map[string]string, spec *specs.Spec) (container containerd.Container, e error) {
+	s.client.NewContainerStub = func(context context.Context, str string, strings map[string]string, spec *specs.Spec, runtime string) (container containerd.Container, e error) {
 		s.client.ContainersReturns([]containerd.Container{fakeContainer}, nil)
 		time.Sleep(500 * time.Millisecond)
 		return fakeContainer, nil
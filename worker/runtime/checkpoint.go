@@ -0,0 +1,281 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/cio"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/runtime/v2/runc/options"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+const (
+	// CheckpointableProperty marks a container whose process can be
+	// checkpointed with CRIU. The first process run in such a container
+	// becomes the container's init process rather than being exec'ed next to
+	// it, as CRIU can only dump the process tree under the init process.
+	CheckpointableProperty = "concourse:checkpointable"
+
+	// CheckpointProperty is set on a checkpointable container to dump its
+	// process into a directory, given as a path inside the container (i.e. a
+	// volume mounted into it). The process exits once the dump is written.
+	CheckpointProperty = "concourse:checkpoint"
+
+	// CheckpointedProperty is set once the process of a checkpointable
+	// container has been dumped, telling the checkpoint was written and the
+	// process stopped because of it rather than on its own.
+	CheckpointedProperty = "concourse:checkpointed"
+
+	// RestoreProperty makes a checkpointable container restore its process
+	// from a dump in a directory, given as a path inside the container, when
+	// the process is first attached to.
+	RestoreProperty = "concourse:restore"
+
+	// initProcessProperty records the ID of the process that was run as the
+	// init process of a checkpointable container.
+	initProcessProperty = "concourse:init-process"
+
+	// hermeticProperty records that a checkpointable container must not
+	// reach outside, as its network is only set up once its process starts.
+	hermeticProperty = "concourse:hermetic"
+)
+
+//counterfeiter:generate . TaskStarter
+
+// TaskStarter creates and starts the init task of a container, attaching it
+// to the network.
+type TaskStarter interface {
+	StartTask(ctx context.Context, container containerd.Container, ioCreator cio.Creator, opts ...containerd.NewTaskOpts) (containerd.Task, error)
+}
+
+func isCheckpointable(properties garden.Properties) bool {
+	return properties[CheckpointableProperty] == "true"
+}
+
+// isInitProcess determines whether the process is the init task of a
+// checkpointable container. A container that's yet to be restored adopts the
+// first process attached to.
+func isInitProcess(properties garden.Properties, id string) bool {
+	initID, found := properties[initProcessProperty]
+	if !found {
+		return properties[RestoreProperty] != ""
+	}
+
+	return initID == id
+}
+
+// checkpointable determines whether the container was created with
+// CheckpointableProperty.
+func (c *Container) checkpointable(ctx context.Context) (garden.Properties, bool, error) {
+	labels, err := c.container.Labels(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("labels retrieval: %w", err)
+	}
+
+	properties := labelsToProperties(labels)
+	return properties, isCheckpointable(properties), nil
+}
+
+// runInit runs the process as the init task of a checkpointable container.
+func (c *Container) runInit(
+	ctx context.Context,
+	spec garden.ProcessSpec,
+	procSpec specs.Process,
+	processIO garden.ProcessIO,
+) (garden.Process, error) {
+	containerSpec, err := c.container.Spec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("container spec: %w", err)
+	}
+
+	containerSpec.Process = &procSpec
+
+	err = c.container.Update(ctx, containerd.UpdateContainerOpts(containerd.WithSpec(containerSpec)))
+	if err != nil {
+		return nil, fmt.Errorf("update container spec: %w", err)
+	}
+
+	id := procID(spec)
+
+	err = c.SetProperty(initProcessProperty, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cioOpts := containerdCIO(processIO, spec.TTY != nil)
+
+	task, err := c.starter.StartTask(ctx, c.container, cio.NewCreator(cioOpts...))
+	if err != nil {
+		if isNoSuchExecutable(err) {
+			return nil, garden.ExecutableNotFoundError{Message: err.Error()}
+		}
+		return nil, fmt.Errorf("start task: %w", err)
+	}
+
+	return c.initProcess(ctx, id, task)
+}
+
+// attachInit attaches to the init task of a checkpointable container,
+// restoring it from its checkpoint if it hasn't been started yet.
+func (c *Container) attachInit(
+	ctx context.Context,
+	id string,
+	properties garden.Properties,
+	processIO garden.ProcessIO,
+) (garden.Process, error) {
+	cioOpts := containerdCIO(processIO, true)
+
+	task, err := c.container.Task(ctx, cio.NewAttach(cioOpts...))
+	if err != nil && !errdefs.IsNotFound(err) {
+		return nil, fmt.Errorf("task: %w", err)
+	}
+
+	if err == nil {
+		status, err := task.Status(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("task status: %w", err)
+		}
+
+		if status.Status != containerd.Running {
+			return nil, fmt.Errorf("proc not running: status = %s", status.Status)
+		}
+
+		return c.initProcess(ctx, id, task)
+	}
+
+	checkpoint := properties[RestoreProperty]
+	if checkpoint == "" {
+		return nil, fmt.Errorf("task: %w", err)
+	}
+
+	imagePath, err := c.hostPath(ctx, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.SetProperty(initProcessProperty, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err = c.starter.StartTask(ctx, c.container, cio.NewCreator(cioOpts...), containerd.WithRestoreImagePath(imagePath))
+	if err != nil {
+		return nil, fmt.Errorf("restore task: %w", err)
+	}
+
+	return c.initProcess(ctx, id, task)
+}
+
+func (c *Container) initProcess(ctx context.Context, id string, task containerd.Task) (garden.Process, error) {
+	exitStatusC, err := task.Wait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("task wait: %w", err)
+	}
+
+	return &initProcess{
+		Process: NewProcess(task, exitStatusC),
+		id:      id,
+	}, nil
+}
+
+// checkpoint dumps the init task of a checkpointable container into a
+// directory inside the container, leaving the task stopped.
+func (c *Container) checkpoint(ctx context.Context, dir string) error {
+	_, checkpointable, err := c.checkpointable(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !checkpointable {
+		return ErrInvalidInput("container is not checkpointable")
+	}
+
+	imagePath, err := c.hostPath(ctx, dir)
+	if err != nil {
+		return err
+	}
+
+	task, err := c.container.Task(ctx, cio.Load)
+	if err != nil {
+		return fmt.Errorf("task lookup: %w", err)
+	}
+
+	_, err = task.Checkpoint(ctx, withCheckpointOptions(imagePath))
+	if err != nil {
+		return fmt.Errorf("checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// withCheckpointOptions dumps the task into imagePath and stops it. The
+// network namespace is left out of the dump, as the container is meant to
+// be restored on another worker, with its own network. Open TCP connections
+// therefore can't be checkpointed.
+func withCheckpointOptions(imagePath string) containerd.CheckpointTaskOpts {
+	return func(info *containerd.CheckpointTaskInfo) error {
+		if !containerd.CheckRuntime(info.Runtime(), "io.containerd.runc") {
+			return ErrInvalidInput(fmt.Sprintf("checkpointing is not supported by runtime %q", info.Runtime()))
+		}
+
+		info.Options = &options.CheckpointOptions{
+			Exit:            true,
+			Terminal:        true,
+			FileLocks:       true,
+			EmptyNamespaces: []string{"network"},
+			ImagePath:       imagePath,
+		}
+
+		return nil
+	}
+}
+
+// hostPath translates a path inside the container to the path on the host,
+// based on the container's mounts.
+func (c *Container) hostPath(ctx context.Context, containerPath string) (string, error) {
+	spec, err := c.container.Spec(ctx)
+	if err != nil {
+		return "", fmt.Errorf("container spec: %w", err)
+	}
+
+	containerPath = filepath.Clean(containerPath)
+
+	var mount *specs.Mount
+	for i, m := range spec.Mounts {
+		if m.Type != "bind" {
+			continue
+		}
+
+		if containerPath != m.Destination && !strings.HasPrefix(containerPath, m.Destination+"/") {
+			continue
+		}
+
+		// the most specific mount wins
+		if mount == nil || len(m.Destination) > len(mount.Destination) {
+			mount = &spec.Mounts[i]
+		}
+	}
+
+	if mount == nil {
+		return "", ErrInvalidInput(fmt.Sprintf("no volume mounted at %s", containerPath))
+	}
+
+	return filepath.Join(mount.Source, strings.TrimPrefix(containerPath, mount.Destination)), nil
+}
+
+// initProcess is the process run as the init task of a checkpointable
+// container. It's identified by the ID it was run with rather than by the
+// container's ID.
+type initProcess struct {
+	*Process
+	id string
+}
+
+func (p *initProcess) ID() string {
+	return p.id
+}
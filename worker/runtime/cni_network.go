@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/concourse/concourse/worker/runtime/iptables"
@@ -291,6 +292,13 @@ func WithAllowHostAccess() CNINetworkOpt {
 	}
 }
 
+// WithHostLookup overrides how the hostnames in egress rules are resolved.
+func WithHostLookup(lookupIP func(host string) ([]net.IP, error)) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.lookupIP = lookupIP
+	}
+}
+
 // WithIptables allows for a custom implementation of the iptables.Iptables interface
 // to be provided.
 func WithIptables(ipt iptables.Iptables) CNINetworkOpt {
@@ -320,6 +328,7 @@ type cniNetwork struct {
 	restrictedNetworks []string
 	allowHostAccess    bool
 	ipt                iptables.Iptables
+	lookupIP           func(host string) ([]net.IP, error)
 }
 
 var _ Network = (*cniNetwork)(nil)
@@ -363,6 +372,10 @@ func NewCNINetwork(opts ...CNINetworkOpt) (*cniNetwork, error) {
 		}
 	}
 
+	if n.lookupIP == nil {
+		n.lookupIP = net.LookupIP
+	}
+
 	if n.ipt == nil {
 		n.ipt, err = iptables.New()
 
@@ -531,9 +544,176 @@ func (n cniNetwork) ResumeContainerTraffic(containerHandle string) error {
 		return fmt.Errorf("error deleting iptables rule in FORWARD: %w", err)
 	}
 
+	egressChain := egressChainName(containerHandle)
+
+	// only containers with an egress policy have a chain; iptables fails to
+	// check for a rule jumping to a chain that does not exist
+	exists, err := n.ipt.ChainExists(filterTable, egressChain)
+	if err != nil {
+		return fmt.Errorf("error checking for egress chain: %w", err)
+	}
+
+	if !exists {
+		return nil
+	}
+
+	for _, chain := range []string{"INPUT", "FORWARD"} {
+		err = n.ipt.DeleteRule(filterTable, chain, "-s", containerIp, "-j", egressChain)
+		if err != nil {
+			return fmt.Errorf("error deleting egress iptables rule in %s: %w", chain, err)
+		}
+	}
+
+	err = n.ipt.DeleteChainIfExists(filterTable, egressChain)
+	if err != nil {
+		return fmt.Errorf("error deleting egress chain: %w", err)
+	}
+
 	return nil
 }
 
+// egressLogPrefix prefixes the kernel log entries of rejected egress traffic.
+const egressLogPrefix = "concourse-egress-denied: "
+
+func (n cniNetwork) RestrictContainerTraffic(containerHandle string, rules []EgressRule) error {
+	containerIp, err := n.store.ContainerIpLookup(containerHandle)
+	if err != nil {
+		return fmt.Errorf("error getting container IP: %w", err)
+	}
+
+	egressChain := egressChainName(containerHandle)
+
+	err = n.ipt.CreateChainOrFlushIfExists(filterTable, egressChain)
+	if err != nil {
+		return fmt.Errorf("create chain or flush if exists failed: %w", err)
+	}
+
+	err = n.ipt.AppendRule(filterTable, egressChain, "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "RETURN")
+	if err != nil {
+		return fmt.Errorf("appending return rule for RELATED & ESTABLISHED connections failed: %w", err)
+	}
+
+	nameServers, err := n.nameServerIPs()
+	if err != nil {
+		return fmt.Errorf("getting nameservers: %w", err)
+	}
+
+	for _, nameServer := range nameServers {
+		err = n.appendEgressReturnRules(egressChain, nameServer, 53)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range rules {
+		destinations, err := n.egressDestinations(rule)
+		if err != nil {
+			return err
+		}
+
+		for _, destination := range destinations {
+			err = n.appendEgressReturnRules(egressChain, destination, rule.Port)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = n.ipt.AppendRule(filterTable, egressChain, "-m", "limit", "--limit", "10/min", "-j", "LOG", "--log-prefix", egressLogPrefix)
+	if err != nil {
+		return fmt.Errorf("appending log rule failed: %w", err)
+	}
+
+	err = n.ipt.AppendRule(filterTable, egressChain, "-j", "REJECT")
+	if err != nil {
+		return fmt.Errorf("appending reject rule failed: %w", err)
+	}
+
+	for _, chain := range []string{"INPUT", "FORWARD"} {
+		err = n.ipt.InsertRule(filterTable, chain, 1, "-s", containerIp, "-j", egressChain)
+		if err != nil {
+			return fmt.Errorf("error inserting egress iptables rule to %s: %w", chain, err)
+		}
+	}
+
+	return nil
+}
+
+func (n cniNetwork) appendEgressReturnRules(chain string, destination string, port uint16) error {
+	if port == 0 {
+		err := n.ipt.AppendRule(filterTable, chain, "-d", destination, "-j", "RETURN")
+		if err != nil {
+			return fmt.Errorf("appending return rule for %s failed: %w", destination, err)
+		}
+
+		return nil
+	}
+
+	for _, protocol := range []string{"tcp", "udp"} {
+		err := n.ipt.AppendRule(filterTable, chain, "-d", destination, "-p", protocol, "--dport", strconv.Itoa(int(port)), "-j", "RETURN")
+		if err != nil {
+			return fmt.Errorf("appending return rule for %s:%d/%s failed: %w", destination, port, protocol, err)
+		}
+	}
+
+	return nil
+}
+
+// egressDestinations resolves the IPv4 destinations of a rule. Hostnames are
+// resolved once, when the container is created.
+func (n cniNetwork) egressDestinations(rule EgressRule) ([]string, error) {
+	if _, ipNet, err := net.ParseCIDR(rule.Destination); err == nil {
+		if ipNet.IP.To4() == nil {
+			return nil, nil
+		}
+
+		return []string{ipNet.String()}, nil
+	}
+
+	if ip := net.ParseIP(rule.Destination); ip != nil {
+		if ip.To4() == nil {
+			return nil, nil
+		}
+
+		return []string{ip.String()}, nil
+	}
+
+	ips, err := n.lookupIP(rule.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("resolving egress destination %s: %w", rule.Destination, err)
+	}
+
+	var destinations []string
+	for _, ip := range ips {
+		if ip.To4() != nil {
+			destinations = append(destinations, ip.String())
+		}
+	}
+
+	return destinations, nil
+}
+
+func (n cniNetwork) nameServerIPs() ([]string, error) {
+	entries, err := n.generateResolvConfContents()
+	if err != nil {
+		return nil, err
+	}
+
+	var ips []string
+	for _, entry := range strings.Split(string(entries), "\n") {
+		fields := strings.Fields(entry)
+		if len(fields) != 2 || fields[0] != "nameserver" {
+			continue
+		}
+
+		if ip := net.ParseIP(fields[1]); ip != nil && ip.To4() != nil {
+			ips = append(ips, ip.String())
+		}
+	}
+
+	return ips, nil
+}
+
 func (n cniNetwork) Add(ctx context.Context, task containerd.Task, containerHandle string) error {
 	if task == nil {
 		return ErrInvalidInput("nil task")
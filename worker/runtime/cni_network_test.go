@@ -409,11 +409,17 @@ func (s *CNINetworkSuite) TestResumeContainerTraffic() {
 	s.NoError(err)
 
 	s.store.ContainerIpLookupReturns("10.8.0.1", nil)
+	s.iptables.ChainExistsReturns(true, nil)
 
 	err = network.ResumeContainerTraffic("some-handle")
 	s.NoError(err)
 
-	s.Equal(s.iptables.DeleteRuleCallCount(), 2)
+	s.Equal(1, s.iptables.ChainExistsCallCount())
+	table, chain := s.iptables.ChainExistsArgsForCall(0)
+	s.Equal("filter", table)
+	s.Equal("CC-EGRESS-3373f2d1a1310b5646", chain)
+
+	s.Equal(s.iptables.DeleteRuleCallCount(), 4)
 	table, chain, rulespec := s.iptables.DeleteRuleArgsForCall(0)
 	s.Equal("filter", table)
 	s.Equal("INPUT", chain)
@@ -423,4 +429,118 @@ func (s *CNINetworkSuite) TestResumeContainerTraffic() {
 	s.Equal("filter", table)
 	s.Equal("FORWARD", chain)
 	s.Equal([]string{"-s", "10.8.0.1", "-j", "DROP"}, rulespec)
+
+	table, chain, rulespec = s.iptables.DeleteRuleArgsForCall(2)
+	s.Equal("filter", table)
+	s.Equal("INPUT", chain)
+	s.Equal([]string{"-s", "10.8.0.1", "-j", "CC-EGRESS-3373f2d1a1310b5646"}, rulespec)
+
+	table, chain, rulespec = s.iptables.DeleteRuleArgsForCall(3)
+	s.Equal("filter", table)
+	s.Equal("FORWARD", chain)
+	s.Equal([]string{"-s", "10.8.0.1", "-j", "CC-EGRESS-3373f2d1a1310b5646"}, rulespec)
+
+	s.Equal(1, s.iptables.DeleteChainIfExistsCallCount())
+	table, chain = s.iptables.DeleteChainIfExistsArgsForCall(0)
+	s.Equal("filter", table)
+	s.Equal("CC-EGRESS-3373f2d1a1310b5646", chain)
+}
+
+func (s *CNINetworkSuite) TestResumeContainerTrafficWithoutEgressChain() {
+	network, err := runtime.NewCNINetwork(
+		runtime.WithDefaultsForTesting(),
+		runtime.WithCNIFileStore(s.store),
+		runtime.WithIptables(s.iptables),
+	)
+	s.NoError(err)
+
+	s.store.ContainerIpLookupReturns("10.8.0.1", nil)
+	s.iptables.ChainExistsReturns(false, nil)
+
+	err = network.ResumeContainerTraffic("some-handle")
+	s.NoError(err)
+
+	s.Equal(2, s.iptables.DeleteRuleCallCount())
+	s.Equal(0, s.iptables.DeleteChainIfExistsCallCount())
+}
+
+func (s *CNINetworkSuite) TestRestrictContainerTraffic() {
+	network, err := runtime.NewCNINetwork(
+		runtime.WithDefaultsForTesting(),
+		runtime.WithCNIFileStore(s.store),
+		runtime.WithIptables(s.iptables),
+		runtime.WithNameServers([]string{"8.8.8.8"}),
+		runtime.WithHostLookup(func(host string) ([]net.IP, error) {
+			s.Equal("artifacts.example.com", host)
+			return []net.IP{net.ParseIP("192.0.2.10"), net.ParseIP("2001:db8::10")}, nil
+		}),
+	)
+	s.NoError(err)
+
+	s.store.ContainerIpLookupReturns("10.8.0.1", nil)
+
+	err = network.RestrictContainerTraffic("some-handle", []runtime.EgressRule{
+		{Destination: "10.0.0.0/8"},
+		{Destination: "artifacts.example.com", Port: 443},
+	})
+	s.NoError(err)
+
+	s.Equal(1, s.iptables.CreateChainOrFlushIfExistsCallCount())
+	table, chain := s.iptables.CreateChainOrFlushIfExistsArgsForCall(0)
+	s.Equal("filter", table)
+	s.Equal("CC-EGRESS-3373f2d1a1310b5646", chain)
+
+	var rulespecs [][]string
+	for i := 0; i < s.iptables.AppendRuleCallCount(); i++ {
+		table, chain, rulespec := s.iptables.AppendRuleArgsForCall(i)
+		s.Equal("filter", table)
+		s.Equal("CC-EGRESS-3373f2d1a1310b5646", chain)
+		rulespecs = append(rulespecs, rulespec)
+	}
+
+	s.Equal([][]string{
+		{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "RETURN"},
+		{"-d", "8.8.8.8", "-p", "tcp", "--dport", "53", "-j", "RETURN"},
+		{"-d", "8.8.8.8", "-p", "udp", "--dport", "53", "-j", "RETURN"},
+		{"-d", "10.0.0.0/8", "-j", "RETURN"},
+		{"-d", "192.0.2.10", "-p", "tcp", "--dport", "443", "-j", "RETURN"},
+		{"-d", "192.0.2.10", "-p", "udp", "--dport", "443", "-j", "RETURN"},
+		{"-m", "limit", "--limit", "10/min", "-j", "LOG", "--log-prefix", "concourse-egress-denied: "},
+		{"-j", "REJECT"},
+	}, rulespecs)
+
+	s.Equal(2, s.iptables.InsertRuleCallCount())
+	table, chain, pos, rulespec := s.iptables.InsertRuleArgsForCall(0)
+	s.Equal("filter", table)
+	s.Equal("INPUT", chain)
+	s.Equal(1, pos)
+	s.Equal([]string{"-s", "10.8.0.1", "-j", "CC-EGRESS-3373f2d1a1310b5646"}, rulespec)
+
+	table, chain, pos, rulespec = s.iptables.InsertRuleArgsForCall(1)
+	s.Equal("filter", table)
+	s.Equal("FORWARD", chain)
+	s.Equal(1, pos)
+	s.Equal([]string{"-s", "10.8.0.1", "-j", "CC-EGRESS-3373f2d1a1310b5646"}, rulespec)
+}
+
+func (s *CNINetworkSuite) TestRestrictContainerTrafficLookupFailure() {
+	network, err := runtime.NewCNINetwork(
+		runtime.WithDefaultsForTesting(),
+		runtime.WithCNIFileStore(s.store),
+		runtime.WithIptables(s.iptables),
+		runtime.WithNameServers([]string{"8.8.8.8"}),
+		runtime.WithHostLookup(func(host string) ([]net.IP, error) {
+			return nil, errors.New("no such host")
+		}),
+	)
+	s.NoError(err)
+
+	s.store.ContainerIpLookupReturns("10.8.0.1", nil)
+
+	err = network.RestrictContainerTraffic("some-handle", []runtime.EgressRule{
+		{Destination: "artifacts.example.com", Port: 443},
+	})
+	s.EqualError(err, "resolving egress destination artifacts.example.com: no such host")
+
+	s.Equal(0, s.iptables.InsertRuleCallCount())
 }
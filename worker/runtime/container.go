@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/garden"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/cio"
+	"github.com/containerd/containerd/errdefs"
 	uuid "github.com/nu7hatch/gouuid"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -33,17 +34,20 @@ type Container struct {
 	container     containerd.Container
 	killer        Killer
 	rootfsManager RootfsManager
+	starter       TaskStarter
 }
 
 func NewContainer(
 	container containerd.Container,
 	killer Killer,
 	rootfsManager RootfsManager,
+	starter TaskStarter,
 ) *Container {
 	return &Container{
 		container:     container,
 		killer:        killer,
 		rootfsManager: rootfsManager,
+		starter:       starter,
 	}
 }
 
@@ -73,6 +77,25 @@ func (c *Container) Stop(kill bool) error {
 		return fmt.Errorf("kill: %w", err)
 	}
 
+	_, checkpointable, err := c.checkpointable(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the process of a checkpointable container is its init task, which the
+	// killer leaves alone.
+	if checkpointable {
+		signal := GracefulSignal
+		if kill {
+			signal = UngracefulSignal
+		}
+
+		err = task.Kill(ctx, signal)
+		if err != nil && !errdefs.IsNotFound(err) {
+			return fmt.Errorf("kill init: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -101,7 +124,20 @@ func (c *Container) Run(
 
 	task, err := c.container.Task(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("task retrieval: %w", err)
+		if !errdefs.IsNotFound(err) {
+			return nil, fmt.Errorf("task retrieval: %w", err)
+		}
+
+		_, checkpointable, lerr := c.checkpointable(ctx)
+		if lerr != nil {
+			return nil, lerr
+		}
+
+		if !checkpointable {
+			return nil, fmt.Errorf("task retrieval: %w", err)
+		}
+
+		return c.runInit(ctx, spec, procSpec, processIO)
 	}
 
 	id := procID(spec)
@@ -158,6 +194,15 @@ func (c *Container) Attach(pid string, processIO garden.ProcessIO) (process gard
 		return nil, ErrInvalidInput("empty pid")
 	}
 
+	properties, checkpointable, err := c.checkpointable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if checkpointable && isInitProcess(properties, pid) {
+		return c.attachInit(ctx, pid, properties, processIO)
+	}
+
 	task, err := c.container.Task(ctx, cio.Load)
 	if err != nil {
 		return nil, fmt.Errorf("task: %w", err)
@@ -219,7 +264,18 @@ func (c *Container) Property(name string) (string, error) {
 // Set a named property on a container to a specified value.
 //
 func (c *Container) SetProperty(name string, value string) error {
-	labelSet, err := propertiesToLabels(garden.Properties{name: value})
+	properties := garden.Properties{name: value}
+
+	if name == CheckpointProperty {
+		err := c.checkpoint(context.Background(), value)
+		if err != nil {
+			return err
+		}
+
+		properties[CheckpointedProperty] = "true"
+	}
+
+	labelSet, err := propertiesToLabels(properties)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
+	"github.com/containerd/containerd/errdefs"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -22,6 +23,7 @@ type ContainerSuite struct {
 	containerdTask      *libcontainerdfakes.FakeTask
 	rootfsManager       *runtimefakes.FakeRootfsManager
 	killer              *runtimefakes.FakeKiller
+	starter             *runtimefakes.FakeTaskStarter
 }
 
 func (s *ContainerSuite) SetupTest() {
@@ -30,11 +32,13 @@ func (s *ContainerSuite) SetupTest() {
 	s.containerdTask = new(libcontainerdfakes.FakeTask)
 	s.rootfsManager = new(runtimefakes.FakeRootfsManager)
 	s.killer = new(runtimefakes.FakeKiller)
+	s.starter = new(runtimefakes.FakeTaskStarter)
 
 	s.container = runtime.NewContainer(
 		s.containerdContainer,
 		s.killer,
 		s.rootfsManager,
+		s.starter,
 	)
 }
 
@@ -70,6 +74,106 @@ func (s *ContainerSuite) TestStopErrorsKill() {
 	s.True(errors.Is(err, expectedErr))
 }
 
+func (s *ContainerSuite) TestStopCheckpointableSignalsInit() {
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdContainer.LabelsReturns(map[string]string{
+		"concourse:checkpointable.0": "true",
+	}, nil)
+
+	err := s.container.Stop(false)
+	s.NoError(err)
+	s.Equal(1, s.killer.KillCallCount())
+	s.Equal(1, s.containerdTask.KillCallCount())
+	_, signal, _ := s.containerdTask.KillArgsForCall(0)
+	s.Equal(runtime.GracefulSignal, signal)
+}
+
+func (s *ContainerSuite) TestRunCheckpointableStartsInitTask() {
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Process: &specs.Process{},
+		Root:    &specs.Root{},
+	}, nil)
+	s.containerdContainer.TaskReturns(nil, errdefs.ErrNotFound)
+	s.containerdContainer.LabelsReturns(map[string]string{
+		"concourse:checkpointable.0": "true",
+	}, nil)
+	s.starter.StartTaskReturns(s.containerdTask, nil)
+
+	proc, err := s.container.Run(garden.ProcessSpec{ID: "some-proc", Path: "/bin/task"}, garden.ProcessIO{})
+	s.NoError(err)
+	s.Equal("some-proc", proc.ID())
+
+	s.Equal(0, s.containerdTask.ExecCallCount())
+	s.Equal(1, s.starter.StartTaskCallCount())
+	s.Equal(1, s.containerdContainer.UpdateCallCount())
+
+	_, labelSet := s.containerdContainer.SetLabelsArgsForCall(0)
+	s.Equal(map[string]string{"concourse:init-process.0": "some-proc"}, labelSet)
+}
+
+func (s *ContainerSuite) TestRunWithoutTaskNotCheckpointable() {
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Process: &specs.Process{},
+		Root:    &specs.Root{},
+	}, nil)
+	s.containerdContainer.TaskReturns(nil, errdefs.ErrNotFound)
+
+	_, err := s.container.Run(garden.ProcessSpec{}, garden.ProcessIO{})
+	s.True(errors.Is(err, errdefs.ErrNotFound))
+	s.Equal(0, s.starter.StartTaskCallCount())
+}
+
+func (s *ContainerSuite) TestAttachRestoresCheckpoint() {
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Mounts: []specs.Mount{
+			{Type: "bind", Source: "/volumes/a", Destination: "/tmp/build"},
+			{Type: "bind", Source: "/volumes/b", Destination: "/tmp/build/checkpoint"},
+		},
+	}, nil)
+	s.containerdContainer.TaskReturns(nil, errdefs.ErrNotFound)
+	s.containerdContainer.LabelsReturns(map[string]string{
+		"concourse:checkpointable.0": "true",
+		"concourse:restore.0":        "/tmp/build/checkpoint/dump",
+	}, nil)
+	s.starter.StartTaskReturns(s.containerdTask, nil)
+
+	proc, err := s.container.Attach("some-proc", garden.ProcessIO{})
+	s.NoError(err)
+	s.Equal("some-proc", proc.ID())
+
+	s.Equal(1, s.starter.StartTaskCallCount())
+	_, _, _, opts := s.starter.StartTaskArgsForCall(0)
+	s.Len(opts, 1)
+}
+
+func (s *ContainerSuite) TestSetCheckpointPropertyNotCheckpointable() {
+	err := s.container.SetProperty(runtime.CheckpointProperty, "/tmp/build/checkpoint")
+	s.Error(err)
+	s.Equal(0, s.containerdContainer.SetLabelsCallCount())
+}
+
+func (s *ContainerSuite) TestSetCheckpointPropertyCheckpoints() {
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Mounts: []specs.Mount{
+			{Type: "bind", Source: "/volumes/b", Destination: "/tmp/build/checkpoint"},
+		},
+	}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdContainer.LabelsReturns(map[string]string{
+		"concourse:checkpointable.0": "true",
+	}, nil)
+
+	err := s.container.SetProperty(runtime.CheckpointProperty, "/tmp/build/checkpoint")
+	s.NoError(err)
+
+	s.Equal(1, s.containerdTask.CheckpointCallCount())
+	s.Equal(1, s.containerdContainer.SetLabelsCallCount())
+
+	_, labels := s.containerdContainer.SetLabelsArgsForCall(0)
+	s.Equal("/tmp/build/checkpoint", labels["concourse:checkpoint.0"])
+	s.Equal("true", labels["concourse:checkpointed.0"])
+}
+
 func (s *ContainerSuite) TestRunContainerSpecErr() {
 	expectedErr := errors.New("spec-err")
 	s.containerdContainer.SpecReturns(nil, expectedErr)
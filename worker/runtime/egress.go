@@ -0,0 +1,58 @@
+package runtime
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+)
+
+// EgressProperty is the container property through which the destinations a
+// container is allowed to reach are requested.
+const EgressProperty = "concourse:egress"
+
+// EgressRule is a destination that a container is allowed to reach.
+type EgressRule struct {
+	// Destination is either an IPv4 address, an IPv4 CIDR range or a hostname.
+	Destination string
+
+	// Port restricts the rule to a single TCP/UDP port. Zero allows any port.
+	Port uint16
+}
+
+// EgressRules parses the egress rules requested through the properties of a
+// container. No rules means that egress is not restricted by rules.
+func EgressRules(properties garden.Properties) ([]EgressRule, error) {
+	payload := properties[EgressProperty]
+	if payload == "" {
+		return nil, nil
+	}
+
+	var specs []string
+	err := json.Unmarshal([]byte(payload), &specs)
+	if err != nil {
+		return nil, ErrInvalidInput(fmt.Sprintf("invalid egress rules: %s", err))
+	}
+
+	rules := make([]EgressRule, len(specs))
+	for i, spec := range specs {
+		destination, port, err := atc.EgressRule(spec).Destination()
+		if err != nil {
+			return nil, ErrInvalidInput(err.Error())
+		}
+
+		rules[i] = EgressRule{Destination: destination, Port: port}
+	}
+
+	return rules, nil
+}
+
+// egressChainName is the name of the iptables chain holding the egress rules
+// of a container. Chain names are limited to 28 characters, which is too short
+// for a handle, so it is hashed into as many characters as fit.
+func egressChainName(containerHandle string) string {
+	sum := sha256.Sum256([]byte(containerHandle))
+	return fmt.Sprintf("CC-EGRESS-%x", sum[:9])
+}
@@ -12,6 +12,8 @@ type Iptables interface {
 	AppendRule(table string, chain string, rulespec ...string) error
 	InsertRule(table string, chain string, pos int, rulespec ...string) error
 	DeleteRule(table string, chain string, rulespec ...string) error
+	DeleteChainIfExists(table string, chain string) error
+	ChainExists(table string, chain string) (bool, error)
 }
 
 type iptables struct {
@@ -52,3 +54,12 @@ func (ipt *iptables) DeleteRule(table string, chain string, rulespec ...string)
 	err := ipt.goipt.DeleteIfExists(table, chain, rulespec...)
 	return err
 }
+
+func (ipt *iptables) DeleteChainIfExists(table string, chain string) error {
+	err := ipt.goipt.ClearAndDeleteChain(table, chain)
+	return err
+}
+
+func (ipt *iptables) ChainExists(table string, chain string) (bool, error) {
+	return ipt.goipt.ChainExists(table, chain)
+}
@@ -21,6 +21,20 @@ type FakeIptables struct {
 	appendRuleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ChainExistsStub        func(string, string) (bool, error)
+	chainExistsMutex       sync.RWMutex
+	chainExistsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	chainExistsReturns struct {
+		result1 bool
+		result2 error
+	}
+	chainExistsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	CreateChainOrFlushIfExistsStub        func(string, string) error
 	createChainOrFlushIfExistsMutex       sync.RWMutex
 	createChainOrFlushIfExistsArgsForCall []struct {
@@ -33,6 +47,18 @@ type FakeIptables struct {
 	createChainOrFlushIfExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteChainIfExistsStub        func(string, string) error
+	deleteChainIfExistsMutex       sync.RWMutex
+	deleteChainIfExistsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteChainIfExistsReturns struct {
+		result1 error
+	}
+	deleteChainIfExistsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteRuleStub        func(string, string, ...string) error
 	deleteRuleMutex       sync.RWMutex
 	deleteRuleArgsForCall []struct {
@@ -127,6 +153,71 @@ func (fake *FakeIptables) AppendRuleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeIptables) ChainExists(arg1 string, arg2 string) (bool, error) {
+	fake.chainExistsMutex.Lock()
+	ret, specificReturn := fake.chainExistsReturnsOnCall[len(fake.chainExistsArgsForCall)]
+	fake.chainExistsArgsForCall = append(fake.chainExistsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ChainExistsStub
+	fakeReturns := fake.chainExistsReturns
+	fake.recordInvocation("ChainExists", []interface{}{arg1, arg2})
+	fake.chainExistsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIptables) ChainExistsCallCount() int {
+	fake.chainExistsMutex.RLock()
+	defer fake.chainExistsMutex.RUnlock()
+	return len(fake.chainExistsArgsForCall)
+}
+
+func (fake *FakeIptables) ChainExistsCalls(stub func(string, string) (bool, error)) {
+	fake.chainExistsMutex.Lock()
+	defer fake.chainExistsMutex.Unlock()
+	fake.ChainExistsStub = stub
+}
+
+func (fake *FakeIptables) ChainExistsArgsForCall(i int) (string, string) {
+	fake.chainExistsMutex.RLock()
+	defer fake.chainExistsMutex.RUnlock()
+	argsForCall := fake.chainExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) ChainExistsReturns(result1 bool, result2 error) {
+	fake.chainExistsMutex.Lock()
+	defer fake.chainExistsMutex.Unlock()
+	fake.ChainExistsStub = nil
+	fake.chainExistsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) ChainExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.chainExistsMutex.Lock()
+	defer fake.chainExistsMutex.Unlock()
+	fake.ChainExistsStub = nil
+	if fake.chainExistsReturnsOnCall == nil {
+		fake.chainExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.chainExistsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeIptables) CreateChainOrFlushIfExists(arg1 string, arg2 string) error {
 	fake.createChainOrFlushIfExistsMutex.Lock()
 	ret, specificReturn := fake.createChainOrFlushIfExistsReturnsOnCall[len(fake.createChainOrFlushIfExistsArgsForCall)]
@@ -189,6 +280,68 @@ func (fake *FakeIptables) CreateChainOrFlushIfExistsReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeIptables) DeleteChainIfExists(arg1 string, arg2 string) error {
+	fake.deleteChainIfExistsMutex.Lock()
+	ret, specificReturn := fake.deleteChainIfExistsReturnsOnCall[len(fake.deleteChainIfExistsArgsForCall)]
+	fake.deleteChainIfExistsArgsForCall = append(fake.deleteChainIfExistsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteChainIfExistsStub
+	fakeReturns := fake.deleteChainIfExistsReturns
+	fake.recordInvocation("DeleteChainIfExists", []interface{}{arg1, arg2})
+	fake.deleteChainIfExistsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsCallCount() int {
+	fake.deleteChainIfExistsMutex.RLock()
+	defer fake.deleteChainIfExistsMutex.RUnlock()
+	return len(fake.deleteChainIfExistsArgsForCall)
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsCalls(stub func(string, string) error) {
+	fake.deleteChainIfExistsMutex.Lock()
+	defer fake.deleteChainIfExistsMutex.Unlock()
+	fake.DeleteChainIfExistsStub = stub
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsArgsForCall(i int) (string, string) {
+	fake.deleteChainIfExistsMutex.RLock()
+	defer fake.deleteChainIfExistsMutex.RUnlock()
+	argsForCall := fake.deleteChainIfExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsReturns(result1 error) {
+	fake.deleteChainIfExistsMutex.Lock()
+	defer fake.deleteChainIfExistsMutex.Unlock()
+	fake.DeleteChainIfExistsStub = nil
+	fake.deleteChainIfExistsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsReturnsOnCall(i int, result1 error) {
+	fake.deleteChainIfExistsMutex.Lock()
+	defer fake.deleteChainIfExistsMutex.Unlock()
+	fake.DeleteChainIfExistsStub = nil
+	if fake.deleteChainIfExistsReturnsOnCall == nil {
+		fake.deleteChainIfExistsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteChainIfExistsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeIptables) DeleteRule(arg1 string, arg2 string, arg3 ...string) error {
 	fake.deleteRuleMutex.Lock()
 	ret, specificReturn := fake.deleteRuleReturnsOnCall[len(fake.deleteRuleArgsForCall)]
@@ -321,8 +474,12 @@ func (fake *FakeIptables) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.appendRuleMutex.RLock()
 	defer fake.appendRuleMutex.RUnlock()
+	fake.chainExistsMutex.RLock()
+	defer fake.chainExistsMutex.RUnlock()
 	fake.createChainOrFlushIfExistsMutex.RLock()
 	defer fake.createChainOrFlushIfExistsMutex.RUnlock()
+	fake.deleteChainIfExistsMutex.RLock()
+	defer fake.deleteChainIfExistsMutex.RUnlock()
 	fake.deleteRuleMutex.RLock()
 	defer fake.deleteRuleMutex.RUnlock()
 	fake.insertRuleMutex.RLock()
@@ -37,13 +37,16 @@ type Client interface {
 	//
 	Stop() (err error)
 
-	// NewContainer creates a container in containerd.
+	// NewContainer creates a container in containerd. If runtime is
+	// non-empty, the container's tasks are run using that runtime (e.g.
+	// `io.containerd.runsc.v1`) rather than containerd's default.
 	//
 	NewContainer(
 		ctx context.Context,
 		id string,
 		labels map[string]string,
 		oci *specs.Spec,
+		runtime string,
 	) (
 		container containerd.Container, err error,
 	)
@@ -115,17 +118,23 @@ func (c *client) Stop() (err error) {
 }
 
 func (c *client) NewContainer(
-	ctx context.Context, id string, labels map[string]string, oci *specs.Spec,
+	ctx context.Context, id string, labels map[string]string, oci *specs.Spec, runtime string,
 ) (
 	containerd.Container, error,
 ) {
 	ctx, cancel := createTimeoutContext(ctx, c.requestTimeout)
 	defer cancel()
 
-	return c.containerd.NewContainer(ctx, id,
+	opts := []containerd.NewContainerOpts{
 		containerd.WithSpec(oci),
 		containerd.WithContainerLabels(labels),
-	)
+	}
+
+	if runtime != "" {
+		opts = append(opts, containerd.WithRuntime(runtime, nil))
+	}
+
+	return c.containerd.NewContainer(ctx, id, opts...)
 }
 
 func (c *client) Containers(
@@ -30,6 +30,11 @@ type Network interface {
 	// Drop all incoming traffic from a container
 	DropContainerTraffic(containerHandle string) (err error)
 
-	// Resume all incoming traffic from a container
+	// Reject all outgoing traffic from a container that isn't headed to
+	// one of the allowed destinations, logging the rejected connections
+	RestrictContainerTraffic(containerHandle string, rules []EgressRule) (err error)
+
+	// Resume all incoming traffic from a container, lifting any drops or
+	// restrictions
 	ResumeContainerTraffic(containerHandle string) (err error)
 }
@@ -0,0 +1,52 @@
+package runtime
+
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+)
+
+// DefaultRuntimeClass is the runtime class that's served by containerd's
+// default runtime.
+const DefaultRuntimeClass = "runc"
+
+// RuntimeClasses maps the name of a runtime class (e.g. `runsc`) to the
+// containerd runtime (e.g. `io.containerd.runsc.v1`) that implements it.
+type RuntimeClasses map[string]string
+
+// Names returns the sorted names of all the runtime classes that can be
+// served, including the default one.
+func (r RuntimeClasses) Names() []string {
+	names := []string{DefaultRuntimeClass}
+	for name := range r {
+		if name != DefaultRuntimeClass {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Runtime determines the containerd runtime to use for the runtime class
+// requested through the properties of a container. An empty runtime means
+// that containerd's default should be used.
+func (r RuntimeClasses) Runtime(properties garden.Properties) (string, error) {
+	class := properties[atc.RuntimeClassProperty]
+	if class == "" {
+		return "", nil
+	}
+
+	runtime, found := r[class]
+	if found {
+		return runtime, nil
+	}
+
+	if class == DefaultRuntimeClass {
+		return "", nil
+	}
+
+	return "", ErrInvalidInput(fmt.Sprintf("unsupported runtime class %q", class))
+}
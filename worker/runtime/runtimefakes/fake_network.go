@@ -48,6 +48,18 @@ type FakeNetwork struct {
 	removeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RestrictContainerTrafficStub        func(string, []runtime.EgressRule) error
+	restrictContainerTrafficMutex       sync.RWMutex
+	restrictContainerTrafficArgsForCall []struct {
+		arg1 string
+		arg2 []runtime.EgressRule
+	}
+	restrictContainerTrafficReturns struct {
+		result1 error
+	}
+	restrictContainerTrafficReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ResumeContainerTrafficStub        func(string) error
 	resumeContainerTrafficMutex       sync.RWMutex
 	resumeContainerTrafficArgsForCall []struct {
@@ -273,6 +285,73 @@ func (fake *FakeNetwork) RemoveReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNetwork) RestrictContainerTraffic(arg1 string, arg2 []runtime.EgressRule) error {
+	var arg2Copy []runtime.EgressRule
+	if arg2 != nil {
+		arg2Copy = make([]runtime.EgressRule, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.restrictContainerTrafficMutex.Lock()
+	ret, specificReturn := fake.restrictContainerTrafficReturnsOnCall[len(fake.restrictContainerTrafficArgsForCall)]
+	fake.restrictContainerTrafficArgsForCall = append(fake.restrictContainerTrafficArgsForCall, struct {
+		arg1 string
+		arg2 []runtime.EgressRule
+	}{arg1, arg2Copy})
+	stub := fake.RestrictContainerTrafficStub
+	fakeReturns := fake.restrictContainerTrafficReturns
+	fake.recordInvocation("RestrictContainerTraffic", []interface{}{arg1, arg2Copy})
+	fake.restrictContainerTrafficMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetwork) RestrictContainerTrafficCallCount() int {
+	fake.restrictContainerTrafficMutex.RLock()
+	defer fake.restrictContainerTrafficMutex.RUnlock()
+	return len(fake.restrictContainerTrafficArgsForCall)
+}
+
+func (fake *FakeNetwork) RestrictContainerTrafficCalls(stub func(string, []runtime.EgressRule) error) {
+	fake.restrictContainerTrafficMutex.Lock()
+	defer fake.restrictContainerTrafficMutex.Unlock()
+	fake.RestrictContainerTrafficStub = stub
+}
+
+func (fake *FakeNetwork) RestrictContainerTrafficArgsForCall(i int) (string, []runtime.EgressRule) {
+	fake.restrictContainerTrafficMutex.RLock()
+	defer fake.restrictContainerTrafficMutex.RUnlock()
+	argsForCall := fake.restrictContainerTrafficArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNetwork) RestrictContainerTrafficReturns(result1 error) {
+	fake.restrictContainerTrafficMutex.Lock()
+	defer fake.restrictContainerTrafficMutex.Unlock()
+	fake.RestrictContainerTrafficStub = nil
+	fake.restrictContainerTrafficReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetwork) RestrictContainerTrafficReturnsOnCall(i int, result1 error) {
+	fake.restrictContainerTrafficMutex.Lock()
+	defer fake.restrictContainerTrafficMutex.Unlock()
+	fake.RestrictContainerTrafficStub = nil
+	if fake.restrictContainerTrafficReturnsOnCall == nil {
+		fake.restrictContainerTrafficReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restrictContainerTrafficReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNetwork) ResumeContainerTraffic(arg1 string) error {
 	fake.resumeContainerTrafficMutex.Lock()
 	ret, specificReturn := fake.resumeContainerTrafficReturnsOnCall[len(fake.resumeContainerTrafficArgsForCall)]
@@ -460,6 +539,8 @@ func (fake *FakeNetwork) Invocations() map[string][][]interface{} {
 	defer fake.dropContainerTrafficMutex.RUnlock()
 	fake.removeMutex.RLock()
 	defer fake.removeMutex.RUnlock()
+	fake.restrictContainerTrafficMutex.RLock()
+	defer fake.restrictContainerTrafficMutex.RUnlock()
 	fake.resumeContainerTrafficMutex.RLock()
 	defer fake.resumeContainerTrafficMutex.RUnlock()
 	fake.setupHostNetworkMutex.RLock()
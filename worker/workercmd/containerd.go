@@ -154,9 +154,14 @@ func (cmd *WorkerCommand) buildUpBackendOpts(logger lager.Logger, cniNetwork run
 		runtime.WithInitBinPath(cmd.Containerd.InitBin),
 		runtime.WithSeccompProfilePath(cmd.Containerd.SeccompProfilePath),
 		runtime.WithOciHooksDir(cmd.Containerd.OCIHooksDir),
+		runtime.WithRuntimeClasses(cmd.runtimeClasses()),
 	}, nil
 }
 
+func (cmd *WorkerCommand) runtimeClasses() runtime.RuntimeClasses {
+	return runtime.RuntimeClasses(cmd.Containerd.RuntimeClasses)
+}
+
 // containerdRunner spawns a containerd and a Garden server process for use as the container
 // runtime of Concourse.
 func (cmd *WorkerCommand) containerdRunner(logger lager.Logger) (ifrit.Runner, error) {
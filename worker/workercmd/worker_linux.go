@@ -15,6 +15,7 @@ import (
 	concourseCmd "github.com/concourse/concourse/cmd"
 	"github.com/concourse/concourse/worker/network"
 	"github.com/concourse/flag/v2"
+	"github.com/containerd/containerd/platforms"
 	"github.com/jessevdk/go-flags"
 	"github.com/tedsuo/ifrit"
 )
@@ -45,6 +46,8 @@ type ContainerdRuntime struct {
 	CNIPluginsDir      string        `long:"cni-plugins-dir" description:"Path to CNI network plugins. By default will set to the concourse/bin directory the concourse binary is in."`
 	RequestTimeout     time.Duration `long:"request-timeout" default:"5m" description:"How long to wait for requests to Containerd to complete. 0 means no timeout."`
 
+	RuntimeClasses map[string]string `long:"runtime-class" description:"Additional runtime class that tasks can request, mapped to the containerd runtime implementing it. Can be specified multiple times. e.g. 'runsc:io.containerd.runsc.v1'."`
+
 	Network struct {
 		ExternalIP flag.IP `long:"external-ip" description:"IP address to use to reach container's mapped ports. Autodetected if not specified."`
 		//TODO can DNSConfig be simplifed to just a bool rather than struct with a bool?
@@ -95,6 +98,10 @@ func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, i
 	worker := cmd.Worker.Worker()
 	worker.Platform = "linux"
 
+	platform := platforms.DefaultSpec()
+	worker.Architecture = platform.Architecture
+	worker.Variant = platform.Variant
+
 	if cmd.Certs.Dir != "" {
 		worker.CertsPath = &cmd.Certs.Dir
 	}
@@ -117,6 +124,7 @@ func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, i
 	case cmd.Runtime == houdiniRuntime:
 		runner, err = cmd.houdiniRunner(logger)
 	case cmd.Runtime == containerdRuntime:
+		worker.RuntimeClasses = cmd.runtimeClasses().Names()
 		runner, err = cmd.containerdRunner(logger)
 	case cmd.Runtime == guardianRuntime:
 		runner, err = cmd.guardianRunner(logger)
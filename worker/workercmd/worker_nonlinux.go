@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/lager/v3"
 	"github.com/concourse/concourse/atc"
+	"github.com/containerd/containerd/platforms"
 	"github.com/jessevdk/go-flags"
 	"github.com/tedsuo/ifrit"
 )
@@ -33,6 +34,9 @@ func (cmd WorkerCommand) LessenRequirements(prefix string, command *flags.Comman
 func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, ifrit.Runner, error) {
 	worker := cmd.Worker.Worker()
 	worker.Platform = runtime.GOOS
+	platform := platforms.DefaultSpec()
+	worker.Architecture = platform.Architecture
+	worker.Variant = platform.Variant
 	var err error
 	worker.Name, err = cmd.workerName()
 	if err != nil {